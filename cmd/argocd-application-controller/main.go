@@ -3,7 +3,11 @@ package main
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -21,7 +25,11 @@ import (
 	"github.com/argoproj/argo-cd/errors"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/reposerver"
+	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/cli"
+	"github.com/argoproj/argo-cd/util/healthz"
+	"github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/otlp"
 	"github.com/argoproj/argo-cd/util/stats"
 )
 
@@ -30,17 +38,44 @@ const (
 	cliName = "argocd-application-controller"
 	// Default time in seconds for application resync period
 	defaultAppResyncPeriod = 180
+	// Default time limit in seconds of an application reconciliation
+	defaultReconcileTimeout = 300
+	// Default time limit in seconds of an operation of an application
+	defaultOperationTimeout = 3600
+	// Default port of the health check and metrics endpoints
+	defaultHealthzPort = 8082
+	// Default maximum number of kubectl apply and delete calls running at the same time against each cluster
+	defaultKubectlParallelismLimit = 20
+	// Default time in seconds between two garbage collections of the status of the applications
+	defaultStatusGCInterval = 3600
 )
 
 func newCommand() *cobra.Command {
 	var (
 		clientConfig        clientcmd.ClientConfig
 		appResyncPeriod     int64
+		reconcileTimeout    int64
+		operationTimeout    int64
+		errorBackoff        int64
+		errorBackoffMax     int64
+		errorBackoffJitter  float64
 		repoServerAddress   string
 		statusProcessors    int
 		operationProcessors int
 		logLevel            string
 		glogLevel           int
+		healthzPort         int
+		reconcileBuckets    string
+		otlpEndpoint        string
+		otlpInterval        int64
+		otlpInstanceName    string
+		appNamespaces       []string
+		kubectlParallelism  int
+		statusGCInterval    int64
+		operationRetention  int64
+		clusterQPS          float32
+		clusterBurst        int
+		profileDumperSrc    func() *stats.ProfileDumper
 	)
 	var command = cobra.Command{
 		Use:   cliName,
@@ -52,8 +87,11 @@ func newCommand() *cobra.Command {
 			config, err := clientConfig.ClientConfig()
 			errors.CheckError(err)
 
-			kubeClient := kubernetes.NewForConfigOrDie(config)
-			appClient := appclientset.NewForConfigOrDie(config)
+			kubeClientMetrics := kube.NewClientMetrics()
+			kubeClientMetrics.ClusterQPS = clusterQPS
+			kubeClientMetrics.ClusterBurst = clusterBurst
+			kubeClient := kubernetes.NewForConfigOrDie(kubeClientMetrics.WrapConfig(config))
+			appClient := appclientset.NewForConfigOrDie(kubeClientMetrics.WrapConfig(config))
 
 			namespace, _, err := clientConfig.Namespace()
 			errors.CheckError(err)
@@ -65,16 +103,41 @@ func newCommand() *cobra.Command {
 				kubeClient,
 				appClient,
 				repoClientset,
-				resyncDuration)
+				resyncDuration,
+				controller.ApplicationControllerOpts{
+					AppNamespaces:    appNamespaces,
+					ReconcileTimeout: time.Duration(reconcileTimeout) * time.Second,
+					OperationTimeout: time.Duration(operationTimeout) * time.Second,
+					RefreshBackoff: controller.RefreshBackoff{
+						Initial: time.Duration(errorBackoff) * time.Second,
+						Max:     time.Duration(errorBackoffMax) * time.Second,
+						Jitter:  errorBackoffJitter,
+					},
+					ReconcileBuckets:        parseBuckets(reconcileBuckets),
+					KubectlParallelismLimit: kubectlParallelism,
+					StatusGCInterval:        time.Duration(statusGCInterval) * time.Second,
+					OperationRetention:      time.Duration(operationRetention) * time.Second,
+					KubeClientMetrics:       kubeClientMetrics,
+				})
 			secretController := controller.NewSecretController(kubeClient, repoClientset, resyncDuration, namespace)
 
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 
-			log.Infof("Application Controller (version: %s) starting (namespace: %s)", argocd.GetVersion(), namespace)
+			log.Infof("Application Controller (version: %s) starting (namespace: %s, application namespaces: %s)", argocd.GetVersion(), namespace, strings.Join(appNamespaces, ","))
 			stats.RegisterStackDumper()
 			stats.StartStatsTicker(10 * time.Minute)
-			stats.RegisterHeapDumper("memprofile")
+			profileDumperSrc().RegisterSignalHandler()
+
+			mux := http.NewServeMux()
+			healthz.ServeHealthCheck(mux, appController.HealthCheck)
+			healthz.ServeReadinessCheck(mux, appController.ReadinessChecks()...)
+			appController.ServeMetrics(mux, append(stats.NewRuntimeCollectors(), kubeClientMetrics, kube.ClusterCacheMetrics())...)
+			go func() { errors.CheckError(http.ListenAndServe(fmt.Sprintf(":%d", healthzPort), mux)) }()
+			if otlpEndpoint != "" {
+				exporter := otlp.NewExporter(otlpEndpoint, appController.MetricsGatherer(), otlp.ResourceAttributes(cliName, otlpInstanceName, argocd.GetVersion().Version))
+				go exporter.Run(ctx, time.Duration(otlpInterval)*time.Second)
+			}
 
 			go secretController.Run(ctx)
 			go appController.Run(ctx, statusProcessors, operationProcessors)
@@ -85,14 +148,53 @@ func newCommand() *cobra.Command {
 
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
 	command.Flags().Int64Var(&appResyncPeriod, "app-resync", defaultAppResyncPeriod, "Time period in seconds for application resync.")
+	command.Flags().Int64Var(&reconcileTimeout, "reconcile-timeout", defaultReconcileTimeout, "Time limit in seconds of the requests made to clusters and the repo server during an application reconciliation.")
+	command.Flags().Int64Var(&operationTimeout, "operation-timeout", defaultOperationTimeout, "Time limit in seconds of the requests made to clusters and the repo server while running an operation of an application, such as a sync.")
+	command.Flags().Int64Var(&errorBackoff, "refresh-error-backoff", int64(controller.DefaultRefreshBackoff.Initial/time.Second), "Time in seconds before retrying a failed application reconciliation, doubled after each consecutive failure.")
+	command.Flags().Int64Var(&errorBackoffMax, "refresh-error-backoff-max", int64(controller.DefaultRefreshBackoff.Max/time.Second), "Maximum time in seconds before retrying a failed application reconciliation.")
+	command.Flags().Float64Var(&errorBackoffJitter, "refresh-error-backoff-jitter", controller.DefaultRefreshBackoff.Jitter, "Maximum fraction of the retry delay of a failed application reconciliation randomly added to it.")
 	command.Flags().StringVar(&repoServerAddress, "repo-server", "localhost:8081", "Repo server address.")
 	command.Flags().IntVar(&statusProcessors, "status-processors", 1, "Number of application status processors")
 	command.Flags().IntVar(&operationProcessors, "operation-processors", 1, "Number of application operation processors")
 	command.Flags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
 	command.Flags().IntVar(&glogLevel, "gloglevel", 0, "Set the glog logging level")
+	command.Flags().IntVar(&healthzPort, "healthz-port", defaultHealthzPort, "Port of the health check, readiness check and metrics endpoints")
+	command.Flags().StringVar(&reconcileBuckets, "reconcile-duration-buckets", "", "Comma separated buckets of the reconcile duration histogram, in seconds (e.g. 0.5,1,5,30)")
+	command.Flags().StringVar(&otlpEndpoint, "otlp-metrics-endpoint", "", "OTLP/HTTP endpoint the metrics are pushed to, in addition to being served (e.g. http://otel-collector:4318/v1/metrics)")
+	command.Flags().Int64Var(&otlpInterval, "otlp-metrics-interval", 60, "Time period in seconds between two pushes of the metrics to the OTLP endpoint (must be positive)")
+	command.Flags().StringSliceVar(&appNamespaces, "application-namespaces", []string{}, "Comma separated list of namespaces, other than the installation namespace, applications are watched in (e.g. team-a,team-b)")
+	command.Flags().IntVar(&kubectlParallelism, "kubectl-parallelism-limit", defaultKubectlParallelismLimit, "Maximum number of kubectl apply and delete calls running at the same time against each cluster (0 for no limit)")
+	command.Flags().StringVar(&otlpInstanceName, "otlp-instance-name", "", "Instance name reported in the service.instance.id resource attribute of the OTLP metrics (defaults to the hostname)")
+	command.Flags().Int64Var(&statusGCInterval, "status-gc-interval", defaultStatusGCInterval, "Time period in seconds between two garbage collections of the excess history, old operation details and orphaned hook records of the applications (0 to disable)")
+	command.Flags().Int64Var(&operationRetention, "operation-retention", int64(argo.DefaultOperationRetention/time.Second), "Time in seconds the resource results of completed operations are kept in the status of the applications")
+	command.Flags().Float32Var(&clusterQPS, "cluster-qps", kube.DefaultClusterQPS, "Maximum rate of the requests made to each managed cluster, shared by all the applications of the cluster")
+	command.Flags().IntVar(&clusterBurst, "cluster-burst", kube.DefaultClusterBurst, "Maximum number of requests made at once to each managed cluster")
+	profileDumperSrc = stats.AddProfileFlagsToCmd(&command)
 	return &command
 }
 
+// parseBuckets parses the comma separated buckets of a histogram. Returns nil if no buckets are given
+func parseBuckets(bucketsStr string) []float64 {
+	if bucketsStr == "" {
+		return nil
+	}
+	var buckets []float64
+	for _, part := range strings.Split(bucketsStr, ",") {
+		bucket, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			log.Fatalf("Invalid bucket '%s': %v", part, err)
+		}
+		buckets = append(buckets, bucket)
+	}
+	sort.Float64s(buckets)
+	for i := 1; i < len(buckets); i++ {
+		if buckets[i] == buckets[i-1] {
+			log.Fatalf("Duplicate bucket '%v'", buckets[i])
+		}
+	}
+	return buckets
+}
+
 func main() {
 	if err := newCommand().Execute(); err != nil {
 		fmt.Println(err)
@@ -3,19 +3,24 @@ package main
 import (
 	"fmt"
 	"net"
+	"net/http"
 	"os"
+	"path/filepath"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/argoproj/argo-cd"
 	"github.com/argoproj/argo-cd/errors"
 	"github.com/argoproj/argo-cd/reposerver"
+	"github.com/argoproj/argo-cd/reposerver/metrics"
 	"github.com/argoproj/argo-cd/reposerver/repository"
 	"github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/cli"
 	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/healthz"
 	"github.com/argoproj/argo-cd/util/ksonnet"
 	"github.com/argoproj/argo-cd/util/stats"
 	"github.com/argoproj/argo-cd/util/tls"
@@ -25,12 +30,22 @@ const (
 	// CLIName is the name of the CLI
 	cliName = "argocd-repo-server"
 	port    = 8081
+	// Default port of the health check and metrics endpoints
+	defaultMetricsPort = 8084
+	// Default interval between two evictions of the repository checkouts exceeding the limits
+	defaultCheckoutGCInterval = 5 * time.Minute
 )
 
 func newCommand() *cobra.Command {
 	var (
 		logLevel               string
+		metricsPort            int
+		checkoutDir            string
+		maxCheckouts           int
+		maxCheckoutDiskUsage   string
+		checkoutGCInterval     time.Duration
 		tlsConfigCustomizerSrc func() (tls.ConfigCustomizer, error)
+		profileDumperSrc       func() *stats.ProfileDumper
 	)
 	var command = cobra.Command{
 		Use:   cliName,
@@ -41,7 +56,20 @@ func newCommand() *cobra.Command {
 			tlsConfigCustomizer, err := tlsConfigCustomizerSrc()
 			errors.CheckError(err)
 
-			server, err := reposerver.NewServer(git.NewFactory(), newCache(), tlsConfigCustomizer)
+			checkoutOpts := repository.CheckoutOptions{RootDir: checkoutDir, MaxCheckouts: maxCheckouts}
+			if maxCheckoutDiskUsage != "" {
+				quantity, err := resource.ParseQuantity(maxCheckoutDiskUsage)
+				errors.CheckError(err)
+				checkoutOpts.MaxDiskUsage = quantity.Value()
+			}
+			checkouts := repository.NewCheckouts(checkoutOpts)
+			errors.CheckError(checkouts.CleanOrphans())
+			checkouts.Evict()
+			go checkouts.Run(checkoutGCInterval, make(chan struct{}))
+
+			metricsServer := metrics.NewMetricsServer()
+			gitFactory := metrics.NewGitClientFactory(git.NewFactory(), metricsServer)
+			server, err := reposerver.NewServer(gitFactory, metrics.NewCache(newCache(), metricsServer), metricsServer, checkouts, tlsConfigCustomizer)
 			errors.CheckError(err)
 			grpc := server.CreateGRPC()
 			listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
@@ -54,7 +82,22 @@ func newCommand() *cobra.Command {
 			log.Infof("ksonnet version: %s", ksVers)
 			stats.RegisterStackDumper()
 			stats.StartStatsTicker(10 * time.Minute)
-			stats.RegisterHeapDumper("memprofile")
+			profileDumperSrc().RegisterSignalHandler()
+
+			mux := http.NewServeMux()
+			metricsServer.ServeMetrics(mux, stats.NewRuntimeCollectors()...)
+			healthz.ServeHealthCheck(mux, func() error {
+				return nil
+			})
+			localClientset := reposerver.NewRepositoryServerClientset(fmt.Sprintf("localhost:%d", port))
+			healthz.ServeReadinessCheck(mux, healthz.Check{
+				Name: "grpc",
+				Check: func() error {
+					return reposerver.CheckHealth(localClientset)
+				},
+			})
+			go func() { errors.CheckError(http.ListenAndServe(fmt.Sprintf(":%d", metricsPort), mux)) }()
+
 			err = grpc.Serve(listener)
 			errors.CheckError(err)
 			return nil
@@ -62,7 +105,13 @@ func newCommand() *cobra.Command {
 	}
 
 	command.Flags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
+	command.Flags().IntVar(&metricsPort, "metrics-port", defaultMetricsPort, "Port of the health check, readiness check and metrics endpoints")
+	command.Flags().StringVar(&checkoutDir, "checkout-dir", filepath.Join(os.TempDir(), "argocd-repos"), "Directory the repositories are checked out in, in its checkouts subdirectory. Files of the subdirectory which are not checkouts are removed on start")
+	command.Flags().IntVar(&maxCheckouts, "max-checkouts", 0, "Maximum number of repository checkouts, the least recently used ones being evicted (0 for no limit)")
+	command.Flags().StringVar(&maxCheckoutDiskUsage, "max-checkout-disk-usage", "", "Maximum disk usage of the repository checkouts (e.g. 10Gi), the least recently used ones being evicted (no limit if empty)")
+	command.Flags().DurationVar(&checkoutGCInterval, "checkout-gc-interval", defaultCheckoutGCInterval, "Interval between two evictions of the repository checkouts exceeding the limits (0 to only evict them on start)")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
+	profileDumperSrc = stats.AddProfileFlagsToCmd(&command)
 	return &command
 }
 
@@ -2,8 +2,14 @@ package commands
 
 import (
 	"context"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"strings"
 	"time"
 
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
@@ -12,7 +18,10 @@ import (
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/reposerver"
 	"github.com/argoproj/argo-cd/server"
+	"github.com/argoproj/argo-cd/util/audit"
 	"github.com/argoproj/argo-cd/util/cli"
+	"github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/ratelimit"
 	"github.com/argoproj/argo-cd/util/stats"
 	"github.com/argoproj/argo-cd/util/tls"
 )
@@ -36,7 +45,23 @@ func NewCommand() *cobra.Command {
 		repoServerAddress      string
 		dexServerAddress       string
 		disableAuth            bool
+		metricsAppLabels       []string
+		metricsCountByKind     bool
+		metricsTLS             bool
+		metricsTokenFile       string
+		metricsClientCAFile    string
+		otlpEndpoint           string
+		otlpInterval           int64
+		otlpInstanceName       string
+		auditLogSink           string
+		auditLogFile           string
+		rateLimit              float64
+		rateLimitBurst         int
+		methodRateLimits       []string
+		rateLimitProxies       []string
+		enableGRPCWeb          bool
 		tlsConfigCustomizerSrc func() (tls.ConfigCustomizer, error)
+		profileDumperSrc       func() *stats.ProfileDumper
 	)
 	var command = &cobra.Command{
 		Use:   cliName,
@@ -55,25 +80,54 @@ func NewCommand() *cobra.Command {
 			tlsConfigCustomizer, err := tlsConfigCustomizerSrc()
 			errors.CheckError(err)
 
-			kubeclientset := kubernetes.NewForConfigOrDie(config)
-			appclientset := appclientset.NewForConfigOrDie(config)
+			if metricsClientCAFile != "" && !metricsTLS {
+				log.Fatal("--metrics-client-ca-file requires --metrics-tls")
+			}
+			metricsToken, metricsClientCAs, err := loadMetricsAuth(metricsTokenFile, metricsClientCAFile)
+			errors.CheckError(err)
+
+			kubeClientMetrics := kube.NewClientMetrics()
+			kubeclientset := kubernetes.NewForConfigOrDie(kubeClientMetrics.WrapConfig(config))
+			appclientset := appclientset.NewForConfigOrDie(kubeClientMetrics.WrapConfig(config))
 			repoclientset := reposerver.NewRepositoryServerClientset(repoServerAddress)
 
+			var auditSink audit.Sink
+			if auditLogSink != "" {
+				auditSink, err = audit.NewSink(auditLogSink, auditLogFile, kubeclientset, namespace)
+				errors.CheckError(err)
+			}
+
+			rateLimiter, err := newRateLimiter(rateLimit, rateLimitBurst, methodRateLimits, rateLimitProxies)
+			errors.CheckError(err)
+
 			argoCDOpts := server.ArgoCDServerOpts{
-				Insecure:            insecure,
-				Namespace:           namespace,
-				StaticAssetsDir:     staticAssetsDir,
-				KubeClientset:       kubeclientset,
-				AppClientset:        appclientset,
-				RepoClientset:       repoclientset,
-				DexServerAddr:       dexServerAddress,
-				DisableAuth:         disableAuth,
-				TLSConfigCustomizer: tlsConfigCustomizer,
+				Insecure:                   insecure,
+				Namespace:                  namespace,
+				StaticAssetsDir:            staticAssetsDir,
+				KubeClientset:              kubeclientset,
+				AppClientset:               appclientset,
+				RepoClientset:              repoclientset,
+				DexServerAddr:              dexServerAddress,
+				DisableAuth:                disableAuth,
+				TLSConfigCustomizer:        tlsConfigCustomizer,
+				ProfileDumper:              profileDumperSrc(),
+				KubeClientMetrics:          kubeClientMetrics,
+				MetricsApplicationLabels:   metricsAppLabels,
+				MetricsResourceCountByKind: metricsCountByKind,
+				MetricsTLS:                 metricsTLS,
+				MetricsBearerToken:         metricsToken,
+				MetricsClientCAs:           metricsClientCAs,
+				OTLPMetricsEndpoint:        otlpEndpoint,
+				OTLPMetricsInterval:        time.Duration(otlpInterval) * time.Second,
+				OTLPInstanceName:           otlpInstanceName,
+				AuditSink:                  auditSink,
+				RateLimiter:                rateLimiter,
+				EnableGRPCWeb:              enableGRPCWeb,
 			}
 
 			stats.RegisterStackDumper()
 			stats.StartStatsTicker(10 * time.Minute)
-			stats.RegisterHeapDumper("memprofile")
+			argoCDOpts.ProfileDumper.RegisterSignalHandler()
 
 			for {
 				argocd := server.NewServer(argoCDOpts)
@@ -93,7 +147,75 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringVar(&repoServerAddress, "repo-server", DefaultRepoServerAddr, "Repo server address")
 	command.Flags().StringVar(&dexServerAddress, "dex-server", DefaultDexServerAddr, "Dex server address")
 	command.Flags().BoolVar(&disableAuth, "disable-auth", false, "Disable client authentication")
+	command.Flags().StringSliceVar(&metricsAppLabels, "metrics-application-labels", []string{}, "Comma separated list of application labels added to the application metrics (e.g. team,env)")
+	command.Flags().BoolVar(&metricsCountByKind, "metrics-resource-count-by-kind", false, "Break down the number of resources managed by applications by group and kind")
+	command.Flags().BoolVar(&metricsTLS, "metrics-tls", false, "Serve the metrics endpoint over TLS, using the server certificate")
+	command.Flags().StringVar(&metricsTokenFile, "metrics-bearer-token-file", "", "Path to a file containing the bearer token required to access the metrics endpoint")
+	command.Flags().StringVar(&metricsClientCAFile, "metrics-client-ca-file", "", "Path to a PEM file of the certificate authorities of the client certificates required to access the metrics endpoint (requires --metrics-tls)")
+	command.Flags().StringVar(&otlpEndpoint, "otlp-metrics-endpoint", "", "OTLP/HTTP endpoint the metrics are pushed to, in addition to being served (e.g. http://otel-collector:4318/v1/metrics)")
+	command.Flags().Int64Var(&otlpInterval, "otlp-metrics-interval", 60, "Time period in seconds between two pushes of the metrics to the OTLP endpoint (must be positive)")
+	command.Flags().StringVar(&otlpInstanceName, "otlp-instance-name", "", "Instance name reported in the service.instance.id resource attribute of the OTLP metrics (defaults to the hostname)")
+	command.Flags().StringVar(&auditLogSink, "audit-log-sink", "", "Record the calls to the mutating API methods to an audit log sink. One of: stdout|file|events")
+	command.Flags().StringVar(&auditLogFile, "audit-log-file", "", "Path to the file the audit records are appended to (requires --audit-log-sink=file)")
+	command.Flags().Float64Var(&rateLimit, "rate-limit", 0, "Maximum number of API requests per second of each client, identified by its user or by its IP address if it is not authenticated (0 for no limit)")
+	command.Flags().IntVar(&rateLimitBurst, "rate-limit-burst", 0, "Maximum number of API requests each client makes at once (defaults to the rate limit)")
+	command.Flags().StringSliceVar(&methodRateLimits, "method-rate-limits", []string{}, "Comma separated list of the rate limits of API methods for each client, formatted as METHOD=RATE[:BURST] (e.g. application.ApplicationService/Sync=0.5:2)")
+	command.Flags().StringSliceVar(&rateLimitProxies, "rate-limit-trusted-proxies", ratelimit.DefaultTrustedProxies, "Comma separated list of the IP addresses or CIDR ranges of the proxies whose X-Forwarded-For header identifies the clients of the requests they forward")
+	command.Flags().BoolVar(&enableGRPCWeb, "grpc-web", false, "Serve the gRPC API to gRPC-Web clients, over HTTP/1.1, for browsers and clients behind proxies not supporting HTTP/2")
 	command.AddCommand(cli.NewVersionCmd(cliName))
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(command)
+	profileDumperSrc = stats.AddProfileFlagsToCmd(command)
 	return command
 }
+
+// loadMetricsAuth returns the bearer token and the client certificate authorities required to access
+// the metrics endpoint, read from the given files. Both are optional
+func loadMetricsAuth(tokenFile, clientCAFile string) (string, *x509.CertPool, error) {
+	var token string
+	if tokenFile != "" {
+		data, err := ioutil.ReadFile(tokenFile)
+		if err != nil {
+			return "", nil, err
+		}
+		token = strings.TrimSpace(string(data))
+		if token == "" {
+			return "", nil, fmt.Errorf("Metrics bearer token file %s is empty", tokenFile)
+		}
+	}
+	var clientCAs *x509.CertPool
+	if clientCAFile != "" {
+		data, err := ioutil.ReadFile(clientCAFile)
+		if err != nil {
+			return "", nil, err
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(data) {
+			return "", nil, fmt.Errorf("No certificate found in metrics client CA file %s", clientCAFile)
+		}
+	}
+	return token, clientCAs, nil
+}
+
+// newRateLimiter returns the limiter of the API requests, or nil if the requests are not limited
+func newRateLimiter(rateLimit float64, burst int, methodRateLimits []string, trustedProxies []string) (*ratelimit.Limiter, error) {
+	if rateLimit <= 0 && len(methodRateLimits) == 0 {
+		return nil, nil
+	}
+	clientLimit := ratelimit.Limit{Rate: rateLimit, Burst: burst}
+	if clientLimit.Burst <= 0 {
+		clientLimit.Burst = int(math.Ceil(rateLimit))
+	}
+	methodLimits := make(map[string]ratelimit.Limit)
+	for _, s := range methodRateLimits {
+		method, limit, err := ratelimit.ParseMethodLimit(s)
+		if err != nil {
+			return nil, err
+		}
+		methodLimits[method] = limit
+	}
+	proxies, err := ratelimit.ParseTrustedProxies(trustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	return ratelimit.NewLimiter(clientLimit, methodLimits, proxies), nil
+}
@@ -370,6 +370,9 @@ func NewSettingsCommand() *cobra.Command {
 
 			_, err = settings.UpdateSettings(superuserPassword, settingsMgr, updateSignature, updateSuperuser, namespace)
 			errors.CheckError(err)
+			if updateSuperuser {
+				errors.CheckError(settingsMgr.DeleteInitialAdminPassword())
+			}
 		},
 	}
 	command.Flags().BoolVar(&updateSuperuser, "update-superuser", false, "force updating the  superuser password")
@@ -11,6 +11,7 @@ import (
 	"github.com/argoproj/argo-cd/server/account"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/cli"
+	jwt "github.com/dgrijalva/jwt-go"
 	"github.com/spf13/cobra"
 	"golang.org/x/crypto/ssh/terminal"
 )
@@ -25,6 +26,8 @@ func NewAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 		},
 	}
 	command.AddCommand(NewAccountUpdatePasswordCommand(clientOpts))
+	command.AddCommand(NewAccountRevokeTokenCommand(clientOpts))
+	command.AddCommand(NewAccountInvalidateSessionsCommand(clientOpts))
 	return command
 }
 
@@ -32,6 +35,7 @@ func NewAccountUpdatePasswordCommand(clientOpts *argocdclient.ClientOptions) *co
 	var (
 		currentPassword string
 		newPassword     string
+		accountName     string
 	)
 	var command = &cobra.Command{
 		Use:   "update-password",
@@ -42,7 +46,9 @@ func NewAccountUpdatePasswordCommand(clientOpts *argocdclient.ClientOptions) *co
 				os.Exit(1)
 			}
 
-			if currentPassword == "" {
+			acdClient := argocdclient.NewClientOrDie(clientOpts)
+			// the current password is not needed to update the password of another account
+			if currentPassword == "" && (accountName == "" || accountName == currentUsername(acdClient)) {
 				fmt.Print("*** Enter current password: ")
 				password, err := terminal.ReadPassword(syscall.Stdin)
 				errors.CheckError(err)
@@ -58,9 +64,10 @@ func NewAccountUpdatePasswordCommand(clientOpts *argocdclient.ClientOptions) *co
 			updatePasswordRequest := account.UpdatePasswordRequest{
 				NewPassword:     newPassword,
 				CurrentPassword: currentPassword,
+				Name:            accountName,
 			}
 
-			conn, usrIf := argocdclient.NewClientOrDie(clientOpts).NewAccountClientOrDie()
+			conn, usrIf := acdClient.NewAccountClientOrDie()
 			defer util.Close(conn)
 			_, err := usrIf.UpdatePassword(context.Background(), &updatePasswordRequest)
 			errors.CheckError(err)
@@ -70,5 +77,60 @@ func NewAccountUpdatePasswordCommand(clientOpts *argocdclient.ClientOptions) *co
 
 	command.Flags().StringVar(&currentPassword, "current-password", "", "current password you wish to change")
 	command.Flags().StringVar(&newPassword, "new-password", "", "new password you want to update to")
+	command.Flags().StringVar(&accountName, "account", "", "name of the account whose password is updated, defaults to the current account")
+	return command
+}
+
+// currentUsername returns the subject of the auth token of the client, or an empty string if the
+// token cannot be parsed
+func currentUsername(acdClient argocdclient.Client) string {
+	parser := &jwt.Parser{
+		SkipClaimsValidation: true,
+	}
+	claims := jwt.StandardClaims{}
+	_, _, err := parser.ParseUnverified(acdClient.ClientOptions().AuthToken, &claims)
+	if err != nil {
+		return ""
+	}
+	return claims.Subject
+}
+
+// NewAccountRevokeTokenCommand returns a new instance of an `argocd account revoke-token` command
+func NewAccountRevokeTokenCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "revoke-token TOKEN",
+		Short: "Revoke an auth token, which is rejected until it expires",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, usrIf := argocdclient.NewClientOrDie(clientOpts).NewAccountClientOrDie()
+			defer util.Close(conn)
+			_, err := usrIf.RevokeToken(context.Background(), &account.RevokeTokenRequest{Token: args[0]})
+			errors.CheckError(err)
+			fmt.Printf("Token revoked\n")
+		},
+	}
+	return command
+}
+
+// NewAccountInvalidateSessionsCommand returns a new instance of an `argocd account invalidate-sessions` command
+func NewAccountInvalidateSessionsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "invalidate-sessions",
+		Short: "Invalidate all the auth tokens issued so far, including the current one",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, usrIf := argocdclient.NewClientOrDie(clientOpts).NewAccountClientOrDie()
+			defer util.Close(conn)
+			_, err := usrIf.InvalidateSessions(context.Background(), &account.InvalidateSessionsRequest{})
+			errors.CheckError(err)
+			fmt.Printf("Sessions invalidated, log in again with 'argocd login'\n")
+		},
+	}
 	return command
 }
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/url"
 	"os"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"text/tabwriter"
@@ -29,10 +31,12 @@ import (
 	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/server/application"
+	"github.com/argoproj/argo-cd/server/project"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/config"
 	"github.com/argoproj/argo-cd/util/diff"
+	"github.com/argoproj/argo-cd/util/git"
 	"github.com/argoproj/argo-cd/util/ksonnet"
 	kubeutil "github.com/argoproj/argo-cd/util/kube"
 )
@@ -53,13 +57,25 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationSetCommand(clientOpts))
 	command.AddCommand(NewApplicationUnsetCommand(clientOpts))
 	command.AddCommand(NewApplicationSyncCommand(clientOpts))
+	command.AddCommand(NewApplicationBulkSyncCommand(clientOpts))
+	command.AddCommand(NewApplicationBulkRefreshCommand(clientOpts))
+	command.AddCommand(NewApplicationGarbageCollectCommand(clientOpts))
 	command.AddCommand(NewApplicationHistoryCommand(clientOpts))
+	command.AddCommand(NewApplicationRevisionMetadataCommand(clientOpts))
+	command.AddCommand(NewApplicationParameterAuditCommand(clientOpts))
+	command.AddCommand(NewApplicationEventsCommand(clientOpts))
 	command.AddCommand(NewApplicationRollbackCommand(clientOpts))
 	command.AddCommand(NewApplicationListCommand(clientOpts))
+	command.AddCommand(NewApplicationSummaryCommand(clientOpts))
+	command.AddCommand(NewApplicationDeploymentMetricsCommand(clientOpts))
+	command.AddCommand(NewApplicationSyncStatusCommand(clientOpts))
 	command.AddCommand(NewApplicationDeleteCommand(clientOpts))
 	command.AddCommand(NewApplicationWaitCommand(clientOpts))
 	command.AddCommand(NewApplicationManifestsCommand(clientOpts))
 	command.AddCommand(NewApplicationTerminateOpCommand(clientOpts))
+	command.AddCommand(NewApplicationLogsCommand(clientOpts))
+	command.AddCommand(NewApplicationExecCommand(clientOpts))
+	command.AddCommand(NewApplicationActionsCommand(clientOpts))
 	return command
 }
 
@@ -115,26 +131,45 @@ func NewApplicationCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 			if appOpts.destNamespace != "" {
 				app.Spec.Destination.Namespace = appOpts.destNamespace
 			}
+			if appOpts.destName != "" {
+				app.Spec.Destination.Name = appOpts.destName
+			}
 			if appOpts.namePrefix != "" {
 				app.Spec.Source.NamePrefix = appOpts.namePrefix
 			}
 			setParameterOverrides(&app, appOpts.parameters)
+			setOverrides(&app, appOpts.overrides)
+			setResourceMetadata(&app, appOpts.resourceLabels, appOpts.resourceAnnotations)
 			if len(appOpts.valuesFiles) > 0 {
 				app.Spec.Source.ValuesFiles = appOpts.valuesFiles
 			}
+			if appOpts.directory {
+				app.Spec.Source.Directory = &argoappv1.ApplicationSourceDirectory{
+					Jsonnet: appOpts.jsonnet,
+					ExtVars: appOpts.getJsonnetExtVars(),
+				}
+			}
 			switch appOpts.syncPolicy {
 			case "automated":
 				app.Spec.SyncPolicy = &argoappv1.SyncPolicy{
 					Automated: &argoappv1.SyncPolicyAutomated{
-						Prune: appOpts.autoPrune,
+						Prune:    appOpts.autoPrune,
+						Schedule: appOpts.syncSchedule,
 					},
 				}
-			case "none", "":
+			case "none":
+				// an empty sync policy opts out of the default sync policy of the project
+				app.Spec.SyncPolicy = &argoappv1.SyncPolicy{}
+			case "":
 				app.Spec.SyncPolicy = nil
 			default:
 				log.Fatalf("Invalid sync-policy: %s", appOpts.syncPolicy)
 			}
-			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			acdClient := argocdclient.NewClientOrDie(clientOpts)
+			if len(appOpts.syncOptions) > 0 {
+				setSyncOptions(acdClient, &app, appOpts.syncOptions)
+			}
+			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer util.Close(conn)
 			appCreateRequest := application.ApplicationCreateRequest{
 				Application: app,
@@ -152,6 +187,40 @@ func NewApplicationCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 	return command
 }
 
+// getAppSyncPolicy returns the sync policy of the application, which is the default sync policy of its
+// project if the application does not set one, and whether it was inherited from the project. The
+// sync policy of the application is returned if the project cannot be retrieved
+func getAppSyncPolicy(acdClient argocdclient.Client, app *argoappv1.Application) (*argoappv1.SyncPolicy, bool) {
+	if app.Spec.SyncPolicy != nil {
+		return app.Spec.SyncPolicy, false
+	}
+	conn, projIf := acdClient.NewProjectClientOrDie()
+	defer util.Close(conn)
+	proj, err := projIf.Get(context.Background(), &project.ProjectQuery{Name: app.Spec.GetProject()})
+	if err != nil {
+		log.Warnf("Failed to get the default sync policy of project %s: %v", app.Spec.GetProject(), err)
+		return nil, false
+	}
+	return proj.Spec.SyncPolicy, proj.Spec.SyncPolicy != nil
+}
+
+// setSyncOptions sets the sync options of the application. Since any sync policy of the application
+// replaces the default sync policy of its project, applications inheriting the project policy get a
+// copy of it, so that setting sync options does not disable the automated sync of the project
+func setSyncOptions(acdClient argocdclient.Client, app *argoappv1.Application, syncOptions []string) {
+	if app.Spec.SyncPolicy == nil {
+		conn, projIf := acdClient.NewProjectClientOrDie()
+		defer util.Close(conn)
+		proj, err := projIf.Get(context.Background(), &project.ProjectQuery{Name: app.Spec.GetProject()})
+		errors.CheckError(err)
+		app.Spec.SyncPolicy = proj.Spec.SyncPolicy.DeepCopy()
+	}
+	if app.Spec.SyncPolicy == nil {
+		app.Spec.SyncPolicy = &argoappv1.SyncPolicy{}
+	}
+	app.Spec.SyncPolicy.SyncOptions = syncOptions
+}
+
 // NewApplicationGetCommand returns a new instance of an `argocd app get` command
 func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -159,6 +228,7 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 		showParams    bool
 		showOperation bool
 		refresh       bool
+		hardRefresh   bool
 	)
 	var command = &cobra.Command{
 		Use:   "get APPNAME",
@@ -172,7 +242,7 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer util.Close(conn)
 			appName := args[0]
-			app, err := appIf.Get(context.Background(), &application.ApplicationQuery{Name: &appName, Refresh: refresh})
+			app, err := appIf.Get(context.Background(), &application.ApplicationQuery{Name: &appName, Refresh: refresh, HardRefresh: hardRefresh})
 			errors.CheckError(err)
 			switch output {
 			case "yaml":
@@ -186,6 +256,9 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 			case "":
 				fmt.Printf(printOpFmtStr, "Name:", app.Name)
 				fmt.Printf(printOpFmtStr, "Server:", app.Spec.Destination.Server)
+				if app.Spec.Destination.Name != "" {
+					fmt.Printf(printOpFmtStr, "Cluster:", app.Spec.Destination.Name)
+				}
 				fmt.Printf(printOpFmtStr, "Namespace:", app.Spec.Destination.Namespace)
 				fmt.Printf(printOpFmtStr, "URL:", appURL(acdClient, app))
 				fmt.Printf(printOpFmtStr, "Repo:", app.Spec.Source.RepoURL)
@@ -200,16 +273,43 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 				if len(app.Spec.Source.NamePrefix) > 0 {
 					fmt.Printf(printOpFmtStr, "Name Prefix:", app.Spec.Source.NamePrefix)
 				}
+				if len(app.Spec.Source.Override) > 0 {
+					fmt.Printf(printOpFmtStr, "Overrides:", formatLabels(app.Spec.Source.Override))
+				}
+				if metadata := app.Spec.ResourceMetadata; metadata != nil {
+					if len(metadata.Labels) > 0 {
+						fmt.Printf(printOpFmtStr, "Resource Labels:", formatLabels(metadata.Labels))
+					}
+					if len(metadata.Annotations) > 0 {
+						fmt.Printf(printOpFmtStr, "Resource Annots:", formatLabels(metadata.Annotations))
+					}
+				}
+				policy, inherited := getAppSyncPolicy(acdClient, app)
 				var syncPolicy string
-				if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Automated != nil {
+				if policy != nil && policy.Automated != nil {
 					syncPolicy = "Automated"
-					if app.Spec.SyncPolicy.Automated.Prune {
+					if policy.Automated.Prune {
 						syncPolicy += " (Prune)"
 					}
+					if policy.Automated.Schedule != "" {
+						syncPolicy += fmt.Sprintf(" (Schedule: %s)", policy.Automated.Schedule)
+					}
 				} else {
 					syncPolicy = "<none>"
 				}
+				if inherited {
+					syncPolicy += " (project default)"
+				}
 				fmt.Printf(printOpFmtStr, "Sync Policy:", syncPolicy)
+				if policy != nil && len(policy.SyncOptions) > 0 {
+					fmt.Printf(printOpFmtStr, "Sync Options:", strings.Join(policy.SyncOptions, ","))
+				}
+				if lock := app.Status.OperationLock; lock != nil {
+					fmt.Printf(printOpFmtStr, "Operation Lock:", fmt.Sprintf("%s (since %s)", lock.Holder, lock.AcquiredAt.Format(time.RFC3339)))
+				}
+				if len(app.Status.QueuedOperations) > 0 {
+					fmt.Printf(printOpFmtStr, "Queued Ops:", strconv.Itoa(len(app.Status.QueuedOperations)))
+				}
 
 				if len(app.Status.Conditions) > 0 {
 					fmt.Println()
@@ -240,13 +340,18 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 	command.Flags().BoolVar(&showOperation, "show-operation", false, "Show application operation")
 	command.Flags().BoolVar(&showParams, "show-params", false, "Show application parameters and overrides")
 	command.Flags().BoolVar(&refresh, "refresh", false, "Refresh application data when retrieving")
+	command.Flags().BoolVar(&hardRefresh, "hard-refresh", false, "Refresh application data as well as target manifests cache")
 	return command
 }
 
 func printAppConditions(w io.Writer, app *argoappv1.Application) {
-	fmt.Fprintf(w, "CONDITION\tMESSAGE\n")
+	fmt.Fprintf(w, "CONDITION\tMESSAGE\tLAST TRANSITION\n")
 	for _, item := range app.Status.Conditions {
-		fmt.Fprintf(w, "%s\t%s", item.Type, item.Message)
+		lastTransition := ""
+		if item.LastTransitionTime != nil {
+			lastTransition = item.LastTransitionTime.String()
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", item.Type, item.Message, lastTransition)
 	}
 }
 
@@ -306,7 +411,9 @@ func printParams(app *argoappv1.Application) {
 // NewApplicationSetCommand returns a new instance of an `argocd app set` command
 func NewApplicationSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		appOpts appOptions
+		appOpts                   appOptions
+		previousDestinationPolicy string
+		confirm                   bool
 	)
 	var command = &cobra.Command{
 		Use:   "set APPNAME",
@@ -317,7 +424,17 @@ func NewApplicationSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 				os.Exit(1)
 			}
 			appName := args[0]
-			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			switch argoappv1.PreviousDestinationPolicy(previousDestinationPolicy) {
+			case "", argoappv1.PreviousDestinationPolicyOrphan:
+			case argoappv1.PreviousDestinationPolicyPrune:
+				if !confirm {
+					log.Fatal("Pruning deletes all application resources at the previous destination. Re-run with --yes to confirm")
+				}
+			default:
+				log.Fatalf("Invalid previous-destination: %s", previousDestinationPolicy)
+			}
+			acdClient := argocdclient.NewClientOrDie(clientOpts)
+			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer util.Close(conn)
 			app, err := appIf.Get(context.Background(), &application.ApplicationQuery{Name: &appName})
 			errors.CheckError(err)
@@ -339,21 +456,37 @@ func NewApplicationSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 					app.Spec.Destination.Server = appOpts.destServer
 				case "dest-namespace":
 					app.Spec.Destination.Namespace = appOpts.destNamespace
+				case "dest-name":
+					app.Spec.Destination.Name = appOpts.destName
 				case "project":
 					app.Spec.Project = appOpts.project
 				case "name-prefix":
 					app.Spec.Source.NamePrefix = appOpts.namePrefix
+				case "directory":
+					if appOpts.directory {
+						app.Spec.Source.Directory = &argoappv1.ApplicationSourceDirectory{}
+					} else {
+						app.Spec.Source.Directory = nil
+					}
 				case "sync-policy":
+					// the sync options are kept when the sync policy changes
+					var syncOptions argoappv1.SyncOptions
+					if app.Spec.SyncPolicy != nil {
+						syncOptions = app.Spec.SyncPolicy.SyncOptions
+					}
 					switch appOpts.syncPolicy {
 					case "automated":
 						app.Spec.SyncPolicy = &argoappv1.SyncPolicy{
 							Automated: &argoappv1.SyncPolicyAutomated{},
 						}
 					case "none":
-						app.Spec.SyncPolicy = nil
+						app.Spec.SyncPolicy = &argoappv1.SyncPolicy{}
 					default:
 						log.Fatalf("Invalid sync-policy: %s", appOpts.syncPolicy)
 					}
+					app.Spec.SyncPolicy.SyncOptions = syncOptions
+				case "sync-option":
+					setSyncOptions(acdClient, app, appOpts.syncOptions)
 				}
 			})
 			if visited == 0 {
@@ -367,8 +500,28 @@ func NewApplicationSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 				}
 				app.Spec.SyncPolicy.Automated.Prune = appOpts.autoPrune
 			}
+			if c.Flags().Changed("sync-schedule") {
+				if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil {
+					log.Fatal("Cannot set --sync-schedule: application not configured with automatic sync")
+				}
+				app.Spec.SyncPolicy.Automated.Schedule = appOpts.syncSchedule
+			}
+			if c.Flags().Changed("directory-jsonnet") {
+				if app.Spec.Source.Directory == nil {
+					log.Fatal("Cannot set --directory-jsonnet: application not configured as a plain directory")
+				}
+				app.Spec.Source.Directory.Jsonnet = appOpts.jsonnet
+			}
+			if c.Flags().Changed("jsonnet-ext-var") || c.Flags().Changed("jsonnet-ext-code") {
+				if app.Spec.Source.Directory == nil {
+					log.Fatal("Cannot set jsonnet external variables: application not configured as a plain directory")
+				}
+				app.Spec.Source.Directory.ExtVars = appOpts.getJsonnetExtVars()
+			}
 
 			setParameterOverrides(app, appOpts.parameters)
+			setOverrides(app, appOpts.overrides)
+			setResourceMetadata(app, appOpts.resourceLabels, appOpts.resourceAnnotations)
 			oldOverrides := app.Spec.Source.ComponentParameterOverrides
 			updatedSpec, err := appIf.UpdateSpec(context.Background(), &application.ApplicationUpdateSpecRequest{
 				Name: &app.Name,
@@ -378,9 +531,22 @@ func NewApplicationSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 
 			newOverrides := updatedSpec.Source.ComponentParameterOverrides
 			checkDroppedParams(newOverrides, oldOverrides)
+
+			if previousDestinationPolicy != "" {
+				app, err = appIf.Get(context.Background(), &application.ApplicationQuery{Name: &appName})
+				errors.CheckError(err)
+				if app.Annotations == nil {
+					app.Annotations = make(map[string]string)
+				}
+				app.Annotations[common.AnnotationKeyPreviousDestinationPolicy] = previousDestinationPolicy
+				_, err = appIf.Update(context.Background(), &application.ApplicationUpdateRequest{Application: app})
+				errors.CheckError(err)
+			}
 		},
 	}
 	addAppFlags(command, &appOpts)
+	command.Flags().StringVar(&previousDestinationPolicy, "previous-destination", "", "How to handle resources at the previous destination after the destination is changed (prune, orphan)")
+	command.Flags().BoolVar(&confirm, "yes", false, "Confirm pruning of resources at the previous destination")
 	return command
 }
 
@@ -397,18 +563,45 @@ func checkDroppedParams(newOverrides []argoappv1.ComponentParameter, oldOverride
 }
 
 type appOptions struct {
-	repoURL       string
-	appPath       string
-	env           string
-	revision      string
-	destServer    string
-	destNamespace string
-	parameters    []string
-	valuesFiles   []string
-	project       string
-	syncPolicy    string
-	autoPrune     bool
-	namePrefix    string
+	repoURL             string
+	appPath             string
+	env                 string
+	revision            string
+	destServer          string
+	destNamespace       string
+	destName            string
+	parameters          []string
+	valuesFiles         []string
+	project             string
+	syncPolicy          string
+	autoPrune           bool
+	syncSchedule        string
+	syncOptions         []string
+	namePrefix          string
+	directory           bool
+	jsonnet             bool
+	extVars             []string
+	extCodes            []string
+	overrides           []string
+	resourceLabels      []string
+	resourceAnnotations []string
+}
+
+// getJsonnetExtVars parses the jsonnet external variables of the form name=value
+func (opts *appOptions) getJsonnetExtVars() []argoappv1.JsonnetVar {
+	var extVars []argoappv1.JsonnetVar
+	parse := func(varStrs []string, code bool) {
+		for _, varStr := range varStrs {
+			parts := strings.SplitN(varStr, "=", 2)
+			if len(parts) != 2 {
+				log.Fatalf("Expected jsonnet external variable of the form: name=value. Received: %s", varStr)
+			}
+			extVars = append(extVars, argoappv1.JsonnetVar{Name: parts[0], Value: parts[1], Code: code})
+		}
+	}
+	parse(opts.extVars, false)
+	parse(opts.extCodes, true)
+	return extVars
 }
 
 func addAppFlags(command *cobra.Command, opts *appOptions) {
@@ -417,13 +610,23 @@ func addAppFlags(command *cobra.Command, opts *appOptions) {
 	command.Flags().StringVar(&opts.env, "env", "", "Application environment to monitor")
 	command.Flags().StringVar(&opts.revision, "revision", "HEAD", "The tracking source branch, tag, or commit the application will sync to")
 	command.Flags().StringVar(&opts.destServer, "dest-server", "", "K8s cluster URL (overrides the server URL specified in the ksonnet app.yaml)")
+	command.Flags().StringVar(&opts.destName, "dest-name", "", "Name of a registered K8s cluster (takes precedence over --dest-server)")
 	command.Flags().StringVar(&opts.destNamespace, "dest-namespace", "", "K8s target namespace (overrides the namespace specified in the ksonnet app.yaml)")
 	command.Flags().StringArrayVarP(&opts.parameters, "parameter", "p", []string{}, "set a parameter override (e.g. -p guestbook=image=example/guestbook:latest)")
 	command.Flags().StringArrayVar(&opts.valuesFiles, "values", []string{}, "Helm values file(s) to use")
 	command.Flags().StringVar(&opts.project, "project", "", "Application project name")
 	command.Flags().StringVar(&opts.syncPolicy, "sync-policy", "", "Set the sync policy (one of: automated, none)")
 	command.Flags().BoolVar(&opts.autoPrune, "auto-prune", false, "Set automatic pruning when sync is automated")
+	command.Flags().StringVar(&opts.syncSchedule, "sync-schedule", "", "Cron schedule restricting when an automated sync is performed (e.g. '0 2 * * *')")
+	command.Flags().StringArrayVar(&opts.syncOptions, "sync-option", []string{}, "Sync option applied to every sync of the app (e.g. --sync-option PruneLast=true)")
 	command.Flags().StringVar(&opts.namePrefix, "name-prefix", "", "Set a prefix to add to resource names for kustomize and helm app")
+	command.Flags().BoolVar(&opts.directory, "directory", false, "Treat the path as a plain directory of YAML/JSON manifests, skipping tool detection")
+	command.Flags().BoolVar(&opts.jsonnet, "directory-jsonnet", false, "Evaluate jsonnet files when the path is a plain directory")
+	command.Flags().StringArrayVar(&opts.extVars, "jsonnet-ext-var", []string{}, "Jsonnet external string variable when the path is a plain directory (e.g. --jsonnet-ext-var environment=staging)")
+	command.Flags().StringArrayVar(&opts.extCodes, "jsonnet-ext-code", []string{}, "Jsonnet external code variable when the path is a plain directory (e.g. --jsonnet-ext-code replicas=3)")
+	command.Flags().StringArrayVar(&opts.overrides, "override", []string{}, "Set a value passed to the tool rendering the app: helm value (e.g. --override image.tag=v1.2), kustomize image tag (e.g. --override nginx=1.15.4) or jsonnet external variable")
+	command.Flags().StringArrayVar(&opts.resourceLabels, "resource-label", []string{}, "Label added to every resource deployed by the app (e.g. --resource-label team=a)")
+	command.Flags().StringArrayVar(&opts.resourceAnnotations, "resource-annotation", []string{}, "Annotation added to every resource deployed by the app (e.g. --resource-annotation cost-center=1234)")
 }
 
 // NewApplicationUnsetCommand returns a new instance of an `argocd app unset` command
@@ -431,13 +634,16 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 	var (
 		parameters  []string
 		valuesFiles []string
+		overrides   []string
+		labels      []string
+		annotations []string
 		namePrefix  bool
 	)
 	var command = &cobra.Command{
 		Use:   "unset APPNAME -p COMPONENT=PARAM",
 		Short: "Unset application parameters",
 		Run: func(c *cobra.Command, args []string) {
-			if len(args) != 1 || (len(parameters) == 0 && len(valuesFiles) == 0 && !namePrefix) {
+			if len(args) != 1 || (len(parameters) == 0 && len(valuesFiles) == 0 && len(overrides) == 0 && len(labels) == 0 && len(annotations) == 0 && !namePrefix) {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
@@ -483,6 +689,29 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 					}
 				}
 			}
+			for _, key := range overrides {
+				if _, ok := app.Spec.Source.Override[key]; ok {
+					delete(app.Spec.Source.Override, key)
+					updated = true
+				}
+			}
+			if metadata := app.Spec.ResourceMetadata; metadata != nil {
+				for _, key := range labels {
+					if _, ok := metadata.Labels[key]; ok {
+						delete(metadata.Labels, key)
+						updated = true
+					}
+				}
+				for _, key := range annotations {
+					if _, ok := metadata.Annotations[key]; ok {
+						delete(metadata.Annotations, key)
+						updated = true
+					}
+				}
+				if len(metadata.Labels) == 0 && len(metadata.Annotations) == 0 {
+					app.Spec.ResourceMetadata = nil
+				}
+			}
 			if namePrefix {
 				app.Spec.Source.NamePrefix = ""
 				updated = true
@@ -500,6 +729,9 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 	}
 	command.Flags().StringArrayVarP(&parameters, "parameter", "p", []string{}, "unset a parameter override (e.g. -p guestbook=image)")
 	command.Flags().StringArrayVar(&valuesFiles, "values", []string{}, "unset one or more helm values files")
+	command.Flags().StringArrayVar(&overrides, "override", []string{}, "unset one or more source overrides (e.g. --override image.tag)")
+	command.Flags().StringArrayVar(&labels, "resource-label", []string{}, "unset one or more labels added to resources (e.g. --resource-label team)")
+	command.Flags().StringArrayVar(&annotations, "resource-annotation", []string{}, "unset one or more annotations added to resources (e.g. --resource-annotation cost-center)")
 	command.Flags().BoolVar(&namePrefix, "name-prefix", false, "Unset the name prefix")
 
 	return command
@@ -508,9 +740,11 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 // NewApplicationDiffCommand returns a new instance of an `argocd app diff` command
 func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		refresh bool
-		local   string
-		env     string
+		refresh     bool
+		hardRefresh bool
+		local       string
+		env         string
+		revision    string
 	)
 	var command = &cobra.Command{
 		Use:   "diff APPNAME",
@@ -523,7 +757,16 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
 			defer util.Close(conn)
 			appName := args[0]
-			app, err := appIf.Get(context.Background(), &application.ApplicationQuery{Name: &appName, Refresh: refresh})
+			if revision != "" {
+				if local != "" || env != "" {
+					log.Fatal("--revision option invalid when performing local diff")
+				}
+				res, err := appIf.Diff(context.Background(), &application.ApplicationDiffQuery{Name: &appName, Revision: revision})
+				errors.CheckError(err)
+				printRevisionDiff(res)
+				return
+			}
+			app, err := appIf.Get(context.Background(), &application.ApplicationQuery{Name: &appName, Refresh: refresh, HardRefresh: hardRefresh})
 			errors.CheckError(err)
 			liveObjs, err := app.Status.ComparisonResult.LiveObjects()
 			errors.CheckError(err)
@@ -572,11 +815,33 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		},
 	}
 	command.Flags().BoolVar(&refresh, "refresh", false, "Refresh application data when retrieving")
+	command.Flags().BoolVar(&hardRefresh, "hard-refresh", false, "Refresh application data as well as target manifests cache")
 	command.Flags().StringVar(&local, "local", "", "Compare live app to a local ksonnet app")
 	command.Flags().StringVar(&env, "env", "", "Compare live app to a specific environment")
+	command.Flags().StringVar(&revision, "revision", "", "Compare live app to its manifests at a revision (a branch, tag or commit SHA), e.g. to preview the changes of a pull request")
 	return command
 }
 
+// printRevisionDiff prints the differences of the resources returned by the diff of an application at a revision
+func printRevisionDiff(res *application.ApplicationDiffResponse) {
+	for _, item := range res.Items {
+		fmt.Printf("===== %s %s ======\n", item.Kind, item.Name)
+		if !item.Diff.Modified {
+			continue
+		}
+		target, err := argoappv1.UnmarshalToUnstructured(item.Diff.NormalizedTargetState)
+		errors.CheckError(err)
+		live, err := argoappv1.UnmarshalToUnstructured(item.Diff.NormalizedLiveState)
+		errors.CheckError(err)
+		formatOpts := formatter.AsciiFormatterConfig{
+			Coloring: terminal.IsTerminal(int(os.Stdout.Fd())),
+		}
+		out, err := diff.TwoWayDiff(target, live).ASCIIFormat(target, formatOpts)
+		errors.CheckError(err)
+		fmt.Println(out)
+	}
+}
+
 func getObjKindName(compare, live *unstructured.Unstructured) (string, string) {
 	if compare == nil {
 		return live.GetKind(), live.GetName()
@@ -589,7 +854,7 @@ func setAppLabels(appName string, compareObjs []*unstructured.Unstructured) {
 		if obj == nil {
 			continue
 		}
-		_ = kubeutil.SetLabel(obj, common.LabelApplicationName, appName)
+		_ = kubeutil.SetLabel(obj, common.LabelApplicationName, kubeutil.TruncateLabelValue(appName))
 	}
 }
 
@@ -597,6 +862,8 @@ func setAppLabels(appName string, compareObjs []*unstructured.Unstructured) {
 func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
 		cascade bool
+		force   bool
+		reason  string
 	)
 	var command = &cobra.Command{
 		Use:   "delete APPNAME",
@@ -615,12 +882,132 @@ func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 				if c.Flag("cascade").Changed {
 					appDeleteReq.Cascade = &cascade
 				}
+				if force {
+					if reason == "" {
+						log.Fatal("A --reason is required to force the deletion of a protected application")
+					}
+					appDeleteReq.Force = true
+					appDeleteReq.Reason = reason
+				}
 				_, err := appIf.Delete(context.Background(), &appDeleteReq)
 				errors.CheckError(err)
 			}
 		},
 	}
 	command.Flags().BoolVar(&cascade, "cascade", true, "Perform a cascaded deletion of all application resources")
+	command.Flags().BoolVar(&force, "force", false, "Override the delete protection of the application")
+	command.Flags().StringVar(&reason, "reason", "", "Reason for forcing the deletion of a protected application")
+	return command
+}
+
+// NewApplicationSummaryCommand returns a new instance of an `argocd app summary` command
+func NewApplicationSummaryCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		projects []string
+	)
+	var command = &cobra.Command{
+		Use:   "summary",
+		Short: "Print the number of applications by sync status, health status and project",
+		Run: func(c *cobra.Command, args []string) {
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			summary, err := appIf.Summary(context.Background(), &application.ApplicationQuery{Projects: projects})
+			errors.CheckError(err)
+			fmt.Printf(printOpFmtStr, "Applications:", strconv.FormatInt(summary.Total, 10))
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			printCounts := func(header string, counts map[string]int64) {
+				fmt.Fprintln(w)
+				fmt.Fprintf(w, "%s\tCOUNT\n", header)
+				keys := make([]string, 0, len(counts))
+				for key := range counts {
+					keys = append(keys, key)
+				}
+				sort.Strings(keys)
+				for _, key := range keys {
+					fmt.Fprintf(w, "%s\t%d\n", key, counts[key])
+				}
+			}
+			printCounts("SYNC STATUS", summary.SyncStatus)
+			printCounts("HEALTH STATUS", summary.HealthStatus)
+			printCounts("PROJECT", summary.Projects)
+			_ = w.Flush()
+		},
+	}
+	command.Flags().StringArrayVarP(&projects, "project", "p", []string{}, "Only count applications of the given projects")
+	return command
+}
+
+// NewApplicationDeploymentMetricsCommand returns a new instance of an `argocd app deployment-metrics` command
+func NewApplicationDeploymentMetricsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		projects []string
+		selector string
+		days     int64
+	)
+	var command = &cobra.Command{
+		Use:   "deployment-metrics",
+		Short: "Print the deployment frequency and lead time of applications and projects",
+		Run: func(c *cobra.Command, args []string) {
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			metrics, err := appIf.DeploymentMetrics(context.Background(), &application.DeploymentMetricsQuery{
+				Projects: projects,
+				Selector: selector,
+				Days:     days,
+			})
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			printMetrics := func(header string, items []*application.DeploymentMetrics) {
+				fmt.Fprintf(w, "%s\tSINCE\tDEPLOYMENTS\tPER DAY\tLEAD TIME\n", header)
+				for _, item := range items {
+					leadTime := "-"
+					if item.LeadTimeSeconds > 0 {
+						leadTime = (time.Duration(item.LeadTimeSeconds) * time.Second).String()
+					}
+					since := "-"
+					if item.PeriodStart != nil {
+						since = item.PeriodStart.Format(time.RFC3339)
+					}
+					fmt.Fprintf(w, "%s\t%s\t%d\t%.2f\t%s\n", item.Name, since, item.Deployments, item.DeploymentsPerDay, leadTime)
+				}
+			}
+			printMetrics("NAME", metrics.Applications)
+			fmt.Fprintln(w)
+			printMetrics("PROJECT", metrics.Projects)
+			_ = w.Flush()
+		},
+	}
+	command.Flags().StringArrayVarP(&projects, "project", "p", []string{}, "Only include applications of the given projects")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Only include applications matching the given label selector")
+	command.Flags().Int64Var(&days, "days", application.DefaultDeploymentMetricsDays, "Number of days the deployments are counted over")
+	return command
+}
+
+// NewApplicationSyncStatusCommand returns a new instance of an `argocd app sync-status` command
+func NewApplicationSyncStatusCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "sync-status APPNAME",
+		Short: "Print the sync status, health and operation phase of an application",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			syncStatus, err := appIf.GetApplicationSyncStatus(context.Background(), &application.ApplicationSyncStatusQuery{Name: &args[0]})
+			errors.CheckError(err)
+			operationPhase := syncStatus.OperationPhase
+			if syncStatus.OperationInProgress && operationPhase == "" {
+				operationPhase = "Pending"
+			}
+			fmt.Printf(printOpFmtStr, "Name:", syncStatus.Name)
+			fmt.Printf(printOpFmtStr, "Sync Status:", syncStatus.SyncStatus)
+			fmt.Printf(printOpFmtStr, "Revision:", syncStatus.Revision)
+			fmt.Printf(printOpFmtStr, "Health Status:", syncStatus.HealthStatus)
+			fmt.Printf(printOpFmtStr, "Operation:", operationPhase)
+		},
+	}
 	return command
 }
 
@@ -628,6 +1015,7 @@ func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
 		output string
+		query  application.ApplicationQuery
 	)
 	var command = &cobra.Command{
 		Use:   "list",
@@ -635,7 +1023,7 @@ func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		Run: func(c *cobra.Command, args []string) {
 			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
 			defer util.Close(conn)
-			apps, err := appIf.List(context.Background(), &application.ApplicationQuery{})
+			apps, err := appIf.List(context.Background(), &query)
 			errors.CheckError(err)
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 			var fmtStr string
@@ -648,9 +1036,13 @@ func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			}
 			fmt.Fprintf(w, fmtStr, headers...)
 			for _, app := range apps.Items {
+				cluster := app.Spec.Destination.Server
+				if app.Spec.Destination.Name != "" {
+					cluster = app.Spec.Destination.Name
+				}
 				vals := []interface{}{
 					app.Name,
-					app.Spec.Destination.Server,
+					cluster,
 					app.Spec.Destination.Namespace,
 					app.Spec.GetProject(),
 					app.Status.ComparisonResult.Status,
@@ -663,9 +1055,19 @@ func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				fmt.Fprintf(w, fmtStr, vals...)
 			}
 			_ = w.Flush()
+			if apps.Continue != "" {
+				fmt.Printf("\nMore applications available, list them with: --continue %s\n", apps.Continue)
+			}
 		},
 	}
 	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: wide")
+	command.Flags().StringArrayVarP(&query.Projects, "project", "p", []string{}, "Only list applications of the given projects")
+	command.Flags().StringVarP(&query.Selector, "selector", "l", "", "Only list applications matching the given label selector (e.g. team=guestbook)")
+	command.Flags().StringVar(&query.Search, "search", "", "Only list applications whose name matches the given wildcard pattern (e.g. guestbook-*)")
+	command.Flags().StringArrayVar(&query.SyncStatus, "sync-status", []string{}, "Only list applications with the given sync statuses (e.g. OutOfSync)")
+	command.Flags().StringArrayVar(&query.HealthStatus, "health-status", []string{}, "Only list applications with the given health statuses (e.g. Degraded)")
+	command.Flags().Int64Var(&query.Limit, "limit", 0, "Maximum number of applications to list")
+	command.Flags().StringVar(&query.Continue, "continue", "", "Continue listing from the given application (as returned by a previous call)")
 	return command
 }
 
@@ -838,13 +1240,16 @@ func printAppResources(w io.Writer, app *argoappv1.Application, showOperation bo
 // NewApplicationSyncCommand returns a new instance of an `argocd app sync` command
 func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		revision  string
-		resources *[]string
-		prune     bool
-		dryRun    bool
-		timeout   uint
-		strategy  string
-		force     bool
+		revision    string
+		resources   *[]string
+		prune       bool
+		dryRun      bool
+		timeout     uint
+		strategy    string
+		force       bool
+		watch       bool
+		queue       bool
+		syncOptions []string
 	)
 	const (
 		resourceFieldDelimiter = ":"
@@ -878,11 +1283,13 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				}
 			}
 			syncReq := application.ApplicationSyncRequest{
-				Name:      &appName,
-				DryRun:    dryRun,
-				Revision:  revision,
-				Resources: syncResources,
-				Prune:     prune,
+				Name:        &appName,
+				DryRun:      dryRun,
+				Revision:    revision,
+				Resources:   syncResources,
+				Prune:       prune,
+				Queue:       queue,
+				SyncOptions: syncOptions,
 			}
 			switch strategy {
 			case "apply":
@@ -895,11 +1302,30 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				log.Fatalf("Unknown sync strategy: '%s'", strategy)
 			}
 			ctx := context.Background()
-			_, err := appIf.Sync(ctx, &syncReq)
+			app, err := appIf.Sync(ctx, &syncReq)
 			errors.CheckError(err)
+			if queued := len(app.Status.QueuedOperations); queued > 0 {
+				fmt.Printf("Sync of %s queued behind %d operation(s)\n", appName, queued)
+				return
+			}
 
-			app, err := waitOnApplicationStatus(appIf, appName, timeout, false, false, true, syncResources)
-			errors.CheckError(err)
+			if watch {
+				watchCtx, cancel := context.WithCancel(ctx)
+				defer cancel()
+				if timeout != 0 {
+					time.AfterFunc(time.Duration(timeout)*time.Second, cancel)
+				}
+				err = watchOperationProgress(watchCtx, appIf, appName)
+				errors.CheckError(err)
+				app, err = appIf.Get(ctx, &application.ApplicationQuery{Name: &appName})
+				errors.CheckError(err)
+				fmt.Println()
+				fmt.Printf(printOpFmtStr, "Application:", app.Name)
+				printOperationResult(app.Status.OperationState)
+			} else {
+				app, err = waitOnApplicationStatus(appIf, appName, timeout, false, false, true, syncResources)
+				errors.CheckError(err)
+			}
 
 			pruningRequired := 0
 			for _, resDetails := range app.Status.OperationState.SyncResult.Resources {
@@ -923,9 +1349,197 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
 	command.Flags().StringVar(&strategy, "strategy", "", "Sync strategy (one of: apply|hook)")
 	command.Flags().BoolVar(&force, "force", false, "Use a force apply")
+	command.Flags().BoolVar(&watch, "watch", false, "Print the progress of the sync as it is streamed from the server")
+	command.Flags().BoolVar(&queue, "queue", false, "Queue the sync if another operation is in progress, instead of failing")
+	command.Flags().StringArrayVar(&syncOptions, "sync-option", []string{}, "Sync option of this sync, in addition to the sync options of the app (e.g. --sync-option PruneLast=true)")
 	return command
 }
 
+// NewApplicationBulkSyncCommand returns a new instance of an `argocd app bulk-sync` command
+func NewApplicationBulkSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		projects    []string
+		selector    string
+		prune       bool
+		dryRun      bool
+		strategy    string
+		force       bool
+		parallelism int64
+	)
+	var command = &cobra.Command{
+		Use:   "bulk-sync",
+		Short: "Initiate the sync of all the applications of the given projects which match the given label selector",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 0 || (len(projects) == 0 && selector == "") {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			syncReq := application.ApplicationBulkSyncRequest{
+				Projects:    projects,
+				Selector:    selector,
+				DryRun:      dryRun,
+				Prune:       prune,
+				Parallelism: parallelism,
+			}
+			switch strategy {
+			case "apply":
+				syncReq.Strategy = &argoappv1.SyncStrategy{Apply: &argoappv1.SyncStrategyApply{}}
+				syncReq.Strategy.Apply.Force = force
+			case "", "hook":
+				syncReq.Strategy = &argoappv1.SyncStrategy{Hook: &argoappv1.SyncStrategyHook{}}
+				syncReq.Strategy.Hook.Force = force
+			default:
+				log.Fatalf("Unknown sync strategy: '%s'", strategy)
+			}
+			resp, err := appIf.BulkSync(context.Background(), &syncReq)
+			errors.CheckError(err)
+			printBulkResponse(resp)
+		},
+	}
+	command.Flags().StringArrayVarP(&projects, "project", "p", []string{}, "Only sync applications of the given projects")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Only sync applications matching the label selector (e.g. release=2018-11)")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Preview apply without affecting cluster")
+	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources")
+	command.Flags().StringVar(&strategy, "strategy", "", "Sync strategy (one of: apply|hook)")
+	command.Flags().BoolVar(&force, "force", false, "Use a force apply")
+	command.Flags().Int64Var(&parallelism, "parallelism", application.DefaultBulkParallelism, "Maximum number of applications synced concurrently")
+	return command
+}
+
+// NewApplicationBulkRefreshCommand returns a new instance of an `argocd app bulk-refresh` command
+func NewApplicationBulkRefreshCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		projects    []string
+		selector    string
+		hardRefresh bool
+		parallelism int64
+	)
+	var command = &cobra.Command{
+		Use:   "bulk-refresh",
+		Short: "Refresh all the applications of the given projects which match the given label selector",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 0 || (len(projects) == 0 && selector == "") {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			resp, err := appIf.BulkRefresh(context.Background(), &application.ApplicationBulkRefreshRequest{
+				Projects:    projects,
+				Selector:    selector,
+				HardRefresh: hardRefresh,
+				Parallelism: parallelism,
+			})
+			errors.CheckError(err)
+			printBulkResponse(resp)
+		},
+	}
+	command.Flags().StringArrayVarP(&projects, "project", "p", []string{}, "Only refresh applications of the given projects")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Only refresh applications matching the label selector (e.g. release=2018-11)")
+	command.Flags().BoolVar(&hardRefresh, "hard-refresh", false, "Refresh application data as well as target manifests cache")
+	command.Flags().Int64Var(&parallelism, "parallelism", application.DefaultBulkParallelism, "Maximum number of applications refreshed concurrently")
+	return command
+}
+
+// NewApplicationGarbageCollectCommand returns a new instance of an `argocd app gc` command
+func NewApplicationGarbageCollectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		projects           []string
+		selector           string
+		dryRun             bool
+		operationRetention time.Duration
+	)
+	var command = &cobra.Command{
+		Use:   "gc",
+		Short: "Remove the excess history, the details of old completed operations and the orphaned hook records from the status of applications",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			resp, err := appIf.GarbageCollect(context.Background(), &application.ApplicationGarbageCollectRequest{
+				Projects:           projects,
+				Selector:           selector,
+				DryRun:             dryRun,
+				OperationRetention: int64(operationRetention / time.Second),
+			})
+			errors.CheckError(err)
+			printBulkResponse(resp)
+		},
+	}
+	command.Flags().StringArrayVarP(&projects, "project", "p", []string{}, "Only garbage collect applications of the given projects")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Only garbage collect applications matching the label selector (e.g. release=2018-11)")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Print the records which would be removed, without removing them")
+	command.Flags().DurationVar(&operationRetention, "operation-retention", argo.DefaultOperationRetention, "Time the resource results of completed operations are kept (e.g. 72h)")
+	return command
+}
+
+// printBulkResponse prints the results of a bulk operation, and exits with an error if the operation
+// failed for any application
+func printBulkResponse(resp *application.ApplicationBulkResponse) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "NAME\tRESULT\tMESSAGE\n")
+	for _, result := range resp.Results {
+		res := "Succeeded"
+		if !result.Succeeded {
+			res = "Failed"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", result.Name, res, result.Message)
+	}
+	_ = w.Flush()
+	fmt.Println()
+	fmt.Printf(printOpFmtStr, "Succeeded:", strconv.FormatInt(resp.Succeeded, 10))
+	fmt.Printf(printOpFmtStr, "Failed:", strconv.FormatInt(resp.Failed, 10))
+	if resp.Failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// watchOperationProgress prints the progress of the current operation of an application until it
+// is completed
+func watchOperationProgress(ctx context.Context, appIf application.ApplicationServiceClient, appName string) error {
+	stream, err := appIf.WatchOperation(ctx, &application.OperationWatchQuery{Name: &appName})
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 5, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TIMESTAMP\tSTEP\tKIND\tNAME\tSTATUS\tMESSAGE")
+	completed := false
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		timestamp := time.Now().Format(time.RFC3339)
+		opState := event.OperationState
+		switch event.Type {
+		case application.OperationProgressStarted:
+			fmt.Fprintf(w, "%s\t%s\t\t\t%s\t%s\n", timestamp, event.Type, opState.Phase, opState.Message)
+		case application.OperationProgressPhaseTransition:
+			fmt.Fprintf(w, "%s\t%s\t\t\t%s\t\n", timestamp, event.Type, event.SyncPhase)
+		case application.OperationProgressResourceResult:
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", timestamp, event.Type, event.Resource.Kind, event.Resource.Name, event.Resource.Status, event.Resource.Message)
+		case application.OperationProgressHookStatus:
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", timestamp, event.Type, event.Hook.Kind, event.Hook.Name, event.Hook.Status, event.Hook.Message)
+		case application.OperationProgressCompleted:
+			completed = true
+			fmt.Fprintf(w, "%s\t%s\t\t\t%s\t%s\n", timestamp, event.Type, opState.Phase, opState.Message)
+		}
+		_ = w.Flush()
+	}
+	if !completed {
+		return fmt.Errorf("Progress of the operation of %q ended before its completion", appName)
+	}
+	return nil
+}
+
 // ResourceState tracks the state of a resource when waiting on an application status.
 type resourceState struct {
 	Kind    string
@@ -1157,6 +1771,48 @@ func setParameterOverrides(app *argoappv1.Application, parameters []string) {
 	app.Spec.Source.ComponentParameterOverrides = newParams
 }
 
+// setOverrides updates or adds source overrides of the form key=value in the application
+func setOverrides(app *argoappv1.Application, overrides []string) {
+	if len(overrides) == 0 {
+		return
+	}
+	if app.Spec.Source.Override == nil {
+		app.Spec.Source.Override = make(map[string]string)
+	}
+	for _, overrideStr := range overrides {
+		parts := strings.SplitN(overrideStr, "=", 2)
+		if len(parts) != 2 {
+			log.Fatalf("Expected override of the form: key=value. Received: %s", overrideStr)
+		}
+		app.Spec.Source.Override[parts[0]] = parts[1]
+	}
+}
+
+// setResourceMetadata updates or adds labels and annotations of the form key=value stamped onto the resources of the application
+func setResourceMetadata(app *argoappv1.Application, labels []string, annotations []string) {
+	if len(labels) == 0 && len(annotations) == 0 {
+		return
+	}
+	if app.Spec.ResourceMetadata == nil {
+		app.Spec.ResourceMetadata = &argoappv1.ResourceMetadata{}
+	}
+	parse := func(kind string, values map[string]string, pairs []string) map[string]string {
+		if len(pairs) > 0 && values == nil {
+			values = make(map[string]string)
+		}
+		for _, pair := range pairs {
+			parts := strings.SplitN(pair, "=", 2)
+			if len(parts) != 2 {
+				log.Fatalf("Expected resource %s of the form: key=value. Received: %s", kind, pair)
+			}
+			values[parts[0]] = parts[1]
+		}
+		return values
+	}
+	app.Spec.ResourceMetadata.Labels = parse("label", app.Spec.ResourceMetadata.Labels, labels)
+	app.Spec.ResourceMetadata.Annotations = parse("annotation", app.Spec.ResourceMetadata.Annotations, annotations)
+}
+
 // NewApplicationHistoryCommand returns a new instance of an `argocd app history` command
 func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -1178,19 +1834,31 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 			switch output {
 			case "wide":
-				fmt.Fprintf(w, "ID\tDATE\tCOMMIT\tPARAMETERS\n")
+				fmt.Fprintf(w, "ID\tDATE\tCOMMIT\tINITIATED BY\tAUTHOR\tMESSAGE\tPARAMETERS\n")
 			default:
-				fmt.Fprintf(w, "ID\tDATE\tCOMMIT\n")
+				fmt.Fprintf(w, "ID\tDATE\tCOMMIT\tINITIATED BY\n")
 			}
 			for _, depInfo := range app.Status.History {
+				initiator := initiatorString(depInfo.InitiatedBy)
 				switch output {
 				case "wide":
 					manifest, err := appIf.GetManifests(context.Background(), &application.ApplicationManifestQuery{Name: &appName, Revision: depInfo.Revision})
 					errors.CheckError(err)
 					paramStr := paramString(manifest.GetParams())
-					fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", depInfo.ID, depInfo.DeployedAt, depInfo.Revision, paramStr)
+					author, message := "-", "-"
+					// the metadata of revisions which are not commits (e.g. Helm chart versions) is unknown
+					if git.IsCommitSHA(depInfo.Revision) {
+						// the metadata is unavailable e.g. for commits removed from the repository by a force push
+						metadata, err := appIf.RevisionMetadata(context.Background(), &application.RevisionMetadataQuery{Name: &appName, Revision: &depInfo.Revision})
+						if err != nil {
+							log.Warnf("Failed to get the metadata of revision %s: %v", depInfo.Revision, err)
+						} else {
+							author, message = metadata.Author, strings.SplitN(metadata.Message, "\n", 2)[0]
+						}
+					}
+					fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n", depInfo.ID, depInfo.DeployedAt, depInfo.Revision, initiator, author, message, paramStr)
 				default:
-					fmt.Fprintf(w, "%d\t%s\t%s\n", depInfo.ID, depInfo.DeployedAt, depInfo.Revision)
+					fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", depInfo.ID, depInfo.DeployedAt, depInfo.Revision, initiator)
 				}
 			}
 			_ = w.Flush()
@@ -1200,6 +1868,120 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 	return command
 }
 
+// NewApplicationRevisionMetadataCommand returns a new instance of an `argocd app revision-metadata` command
+func NewApplicationRevisionMetadataCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "revision-metadata APPNAME REVISION",
+		Short: "Show the author, date, message, tags and signature status of a commit of the repository of an application",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			appName := args[0]
+			metadata, err := appIf.RevisionMetadata(context.Background(), &application.RevisionMetadataQuery{Name: &appName, Revision: &args[1]})
+			errors.CheckError(err)
+			fmt.Printf(printOpFmtStr, "Author:", metadata.Author)
+			if metadata.CommitTime != nil {
+				fmt.Printf(printOpFmtStr, "Date:", metadata.CommitTime.Format(time.RFC3339))
+			}
+			if len(metadata.Tags) > 0 {
+				fmt.Printf(printOpFmtStr, "Tags:", strings.Join(metadata.Tags, ","))
+			}
+			signatureStatus := metadata.SignatureStatus
+			if signatureStatus == "" {
+				signatureStatus = "Unsigned"
+			}
+			fmt.Printf(printOpFmtStr, "Signature:", signatureStatus)
+			fmt.Println()
+			fmt.Println(metadata.Message)
+		},
+	}
+	return command
+}
+
+// NewApplicationParameterAuditCommand returns a new instance of an `argocd app parameter-audit` command
+func NewApplicationParameterAuditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		query application.ApplicationParameterAuditQuery
+	)
+	var command = &cobra.Command{
+		Use:   "parameter-audit APPNAME",
+		Short: "Show who changed the parameter overrides of an application",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			query.Name = &args[0]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			records, err := appIf.ListParameterOverrideRecords(context.Background(), &query)
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "DATE\tUSER\tCOMPONENT\tNAME\tPREVIOUS VALUE\tVALUE\n")
+			unset := func(value *string) string {
+				if value == nil {
+					return "<unset>"
+				}
+				return *value
+			}
+			for _, record := range records.Items {
+				for _, change := range record.Changes {
+					component := change.Component
+					if change.SourceOverride {
+						component = "<override>"
+					}
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", record.Time, record.User, component, change.Name, unset(change.PreviousValue), unset(change.Value))
+				}
+			}
+			_ = w.Flush()
+		},
+	}
+	command.Flags().StringVar(&query.User, "user", "", "Only show the changes made by the given user")
+	return command
+}
+
+// NewApplicationEventsCommand returns a new instance of an `argocd app events` command
+func NewApplicationEventsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "events APPNAME",
+		Short: "Show the events of an application and of its resources",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			events, err := appIf.ListEvents(context.Background(), &application.ApplicationEventsQuery{Name: &args[0]})
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "LAST SEEN\tTYPE\tREASON\tOBJECT\tMESSAGE\n")
+			for _, event := range events.Items {
+				lastSeen := event.LastTimestamp
+				if lastSeen.IsZero() {
+					lastSeen = event.FirstTimestamp
+				}
+				object := fmt.Sprintf("%s/%s", strings.ToLower(event.InvolvedObject.Kind), event.InvolvedObject.Name)
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", lastSeen.Format(time.RFC3339), event.Type, event.Reason, object, event.Message)
+			}
+			_ = w.Flush()
+		},
+	}
+	return command
+}
+
+// initiatorString returns a human readable description of who initiated an operation
+func initiatorString(initiator argoappv1.OperationInitiator) string {
+	if initiator.Automated {
+		return "automated sync policy"
+	}
+	return initiator.Username
+}
+
 func paramString(params []*argoappv1.ComponentParameter) string {
 	if len(params) == 0 {
 		return ""
@@ -1219,7 +2001,7 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 	)
 	var command = &cobra.Command{
 		Use:   "rollback APPNAME",
-		Short: "Rollback application to a previous deployed version",
+		Short: "Rollback application to a previous deployed version, disabling its automated sync",
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) != 2 {
 				c.HelpFunc()(c, args)
@@ -1287,6 +2069,7 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 	var (
 		source   string
 		revision string
+		archive  string
 	)
 	var command = &cobra.Command{
 		Use:   "manifests APPNAME",
@@ -1300,6 +2083,20 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
 			defer util.Close(conn)
 			ctx := context.Background()
+			if archive != "" {
+				if source != "git" {
+					log.Fatal("--archive is only supported with --source git")
+				}
+				res, err := appIf.GetManifestsArchive(ctx, &application.ApplicationManifestQuery{
+					Name:     &appName,
+					Revision: revision,
+				})
+				errors.CheckError(err)
+				err = ioutil.WriteFile(archive, res.Data, 0644)
+				errors.CheckError(err)
+				fmt.Printf("Manifests of application '%s' written to %s\n", appName, archive)
+				return
+			}
 			app, err := appIf.Get(ctx, &application.ApplicationQuery{Name: &appName})
 			errors.CheckError(err)
 
@@ -1340,7 +2137,8 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 		},
 	}
 	command.Flags().StringVar(&source, "source", "git", "Source of manifests. One of: live|git")
-	command.Flags().StringVar(&revision, "revision", "", "Show manifests at a specific revision")
+	command.Flags().StringVar(&revision, "revision", "", "Show manifests at a specific revision (e.g. a branch or tag), without changing the application")
+	command.Flags().StringVar(&archive, "archive", "", "Write the rendered manifests to the given file as a gzipped tarball")
 	return command
 }
 
@@ -1365,3 +2163,181 @@ func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *c
 	}
 	return command
 }
+
+// NewApplicationLogsCommand returns a new instance of an `argocd app logs` command
+func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		query application.ApplicationPodLogsQuery
+	)
+	var command = &cobra.Command{
+		Use:   "logs APPNAME PODNAME",
+		Short: "Print the logs of a pod of an application",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			query.Name = &args[0]
+			query.PodName = &args[1]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			stream, err := appIf.PodLogs(context.Background(), &query)
+			errors.CheckError(err)
+			for {
+				entry, err := stream.Recv()
+				if err == io.EOF {
+					return
+				}
+				errors.CheckError(err)
+				fmt.Println(entry.Content)
+			}
+		},
+	}
+	command.Flags().StringVarP(&query.Container, "container", "c", "", "Container to print the logs of, if the pod has several containers")
+	command.Flags().StringVar(&query.Namespace, "namespace", "", "Namespace of the pod (default is the namespace of the application destination)")
+	command.Flags().BoolVarP(&query.Follow, "follow", "f", false, "Stream the logs as they are written")
+	command.Flags().Int64Var(&query.TailLines, "tail", 0, "Number of lines to print from the end of the logs (default is all lines)")
+	command.Flags().Int64Var(&query.SinceSeconds, "since-seconds", 0, "Only print the logs written in the given number of seconds (default is all logs)")
+	return command
+}
+
+// NewApplicationExecCommand returns a new instance of an `argocd app exec` command
+func NewApplicationExecCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		req application.ApplicationExecRequest
+	)
+	var command = &cobra.Command{
+		Use:   "exec APPNAME PODNAME [-- COMMAND [ARG...]]",
+		Short: "Run a command in a pod of an application (default is a shell)",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) < 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			req.Name = &args[0]
+			req.PodName = &args[1]
+			req.Command = args[2:]
+			stdinFd := int(os.Stdin.Fd())
+			req.Tty = terminal.IsTerminal(stdinFd)
+			if req.Tty {
+				if width, height, err := terminal.GetSize(stdinFd); err == nil {
+					req.TerminalSize = &application.TerminalSize{Width: uint32(width), Height: uint32(height)}
+				}
+			}
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			stream, err := appIf.Exec(context.Background())
+			errors.CheckError(err)
+			errors.CheckError(stream.Send(&req))
+			// the terminal is restored explicitly on errors, since exiting skips the deferred calls
+			restore := func() {}
+			if req.Tty {
+				state, err := terminal.MakeRaw(stdinFd)
+				errors.CheckError(err)
+				restore = func() { _ = terminal.Restore(stdinFd, state) }
+			}
+			defer restore()
+			go func() {
+				buf := make([]byte, 1024)
+				for {
+					n, err := os.Stdin.Read(buf)
+					if n > 0 {
+						if stream.Send(&application.ApplicationExecRequest{Stdin: buf[:n]}) != nil {
+							return
+						}
+					}
+					if err != nil {
+						_ = stream.CloseSend()
+						return
+					}
+				}
+			}()
+			for {
+				out, err := stream.Recv()
+				if err == io.EOF {
+					return
+				}
+				if err != nil {
+					restore()
+					errors.CheckError(err)
+				}
+				_, _ = os.Stdout.Write(out.Stdout)
+				_, _ = os.Stderr.Write(out.Stderr)
+			}
+		},
+	}
+	command.Flags().StringVarP(&req.Container, "container", "c", "", "Container to run the command in, if the pod has several containers")
+	command.Flags().StringVar(&req.Namespace, "namespace", "", "Namespace of the pod (default is the namespace of the application destination)")
+	return command
+}
+
+// NewApplicationActionsCommand returns a new instance of an `argocd app actions` command
+func NewApplicationActionsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "actions",
+		Short: "Manage the custom actions of the resources of an application",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewApplicationActionsListCommand(clientOpts))
+	command.AddCommand(NewApplicationActionsRunCommand(clientOpts))
+	return command
+}
+
+// NewApplicationActionsListCommand returns a new instance of an `argocd app actions list` command
+func NewApplicationActionsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		query application.ApplicationResourceActionsQuery
+	)
+	var command = &cobra.Command{
+		Use:   "list APPNAME",
+		Short: "List the custom actions of a resource of an application",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			query.Name = &args[0]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			actions, err := appIf.ListResourceActions(context.Background(), &query)
+			errors.CheckError(err)
+			for _, action := range actions.Actions {
+				fmt.Println(action.Name)
+			}
+		},
+	}
+	command.Flags().StringVar(&query.APIVersion, "api-version", "", "API version of the resource")
+	command.Flags().StringVar(&query.Kind, "kind", "", "Kind of the resource")
+	command.Flags().StringVar(&query.ResourceName, "resource-name", "", "Name of the resource")
+	return command
+}
+
+// NewApplicationActionsRunCommand returns a new instance of an `argocd app actions run` command
+func NewApplicationActionsRunCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		req application.ApplicationRunResourceActionRequest
+	)
+	var command = &cobra.Command{
+		Use:   "run APPNAME ACTION",
+		Short: "Run a custom action on a resource of an application",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			req.Name = &args[0]
+			req.Action = args[1]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			_, err := appIf.RunResourceAction(context.Background(), &req)
+			errors.CheckError(err)
+		},
+	}
+	command.Flags().StringVar(&req.APIVersion, "api-version", "", "API version of the resource")
+	command.Flags().StringVar(&req.Kind, "kind", "", "Kind of the resource")
+	command.Flags().StringVar(&req.ResourceName, "resource-name", "", "Name of the resource")
+	return command
+}
@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/errors"
@@ -38,6 +39,8 @@ func NewClusterCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clientc
 	command.AddCommand(NewClusterGetCommand(clientOpts))
 	command.AddCommand(NewClusterListCommand(clientOpts))
 	command.AddCommand(NewClusterRemoveCommand(clientOpts))
+	command.AddCommand(NewClusterResourcesCommand(clientOpts))
+	command.AddCommand(NewClusterResyncCommand(clientOpts))
 	return command
 }
 
@@ -48,6 +51,7 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 		upsert         bool
 		awsRoleArn     string
 		awsClusterName string
+		namespaces     []string
 	)
 	var command = &cobra.Command{
 		Use:   "add",
@@ -84,7 +88,11 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 				// Install RBAC resources for managing the cluster
 				clientset, err := kubernetes.NewForConfig(conf)
 				errors.CheckError(err)
-				managerBearerToken, err = common.InstallClusterManagerRBAC(clientset)
+				if len(namespaces) > 0 {
+					managerBearerToken, err = common.InstallNamespacedManagerRBAC(clientset, namespaces)
+				} else {
+					managerBearerToken, err = common.InstallClusterManagerRBAC(clientset)
+				}
 				errors.CheckError(err)
 			}
 			conn, clusterIf := argocdclient.NewClientOrDie(clientOpts).NewClusterClientOrDie()
@@ -93,6 +101,7 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 			if inCluster {
 				clst.Server = common.KubernetesInternalAPIServerAddr
 			}
+			clst.Namespaces = namespaces
 			clstCreateReq := cluster.ClusterCreateRequest{
 				Cluster: clst,
 				Upsert:  upsert,
@@ -107,6 +116,7 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 	command.Flags().BoolVar(&upsert, "upsert", false, "Override an existing cluster with the same name even if the spec differs")
 	command.Flags().StringVar(&awsClusterName, "aws-cluster-name", "", "AWS Cluster name if set then aws-iam-authenticator will be used to access cluster")
 	command.Flags().StringVar(&awsRoleArn, "aws-role-arn", "", "Optional AWS role arn. If set then AWS IAM Authenticator assume a role to perform cluster operations instead of the default AWS credential provider chain.")
+	command.Flags().StringArrayVar(&namespaces, "namespace", []string{}, "Restrict Argo CD to the given namespace of the cluster, using namespaced Roles only (can be repeated multiple times)")
 	return command
 }
 
@@ -210,6 +220,9 @@ func NewClusterGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 
 // NewClusterRemoveCommand returns a new instance of an `argocd cluster list` command
 func NewClusterRemoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		force bool
+	)
 	var command = &cobra.Command{
 		Use:   "rm",
 		Short: "Remove cluster credentials",
@@ -228,11 +241,12 @@ func NewClusterRemoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 				// TODO(jessesuen): find the right context and remove manager RBAC artifacts
 				// err := common.UninstallClusterManagerRBAC(clientset)
 				// errors.CheckError(err)
-				_, err := clusterIf.Delete(context.Background(), &cluster.ClusterQuery{Server: clusterName})
+				_, err := clusterIf.Delete(context.Background(), &cluster.ClusterQuery{Server: clusterName, Force: force})
 				errors.CheckError(err)
 			}
 		},
 	}
+	command.Flags().BoolVar(&force, "force", false, "Remove the cluster even if applications are still deployed to it")
 	return command
 }
 
@@ -247,12 +261,84 @@ func NewClusterListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 			clusters, err := clusterIf.List(context.Background(), &cluster.ClusterQuery{})
 			errors.CheckError(err)
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-			fmt.Fprintf(w, "SERVER\tNAME\tSTATUS\tMESSAGE\n")
+			fmt.Fprintf(w, "SERVER\tNAME\tSTATUS\tCACHE AGE\tMESSAGE\n")
 			for _, c := range clusters.Items {
-				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", c.Server, c.Name, c.ConnectionState.Status, c.ConnectionState.Message)
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", c.Server, c.Name, c.ConnectionState.Status, cacheAgeString(c.CacheInfo), c.ConnectionState.Message)
+			}
+			_ = w.Flush()
+		},
+	}
+	return command
+}
+
+// cacheAgeString returns a human readable age of the last full resync of a cluster
+func cacheAgeString(cacheInfo argoappv1.ClusterCacheInfo) string {
+	if cacheInfo.LastResyncAt == nil {
+		return "<never>"
+	}
+	age := cacheInfo.Age().Round(time.Second).String()
+	if cacheInfo.IsResyncRequested() {
+		age += " (resync requested)"
+	}
+	return age
+}
+
+// NewClusterResyncCommand returns a new instance of an `argocd cluster resync` command
+func NewClusterResyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "resync SERVER",
+		Short: "Resync the resources of a cluster and refresh all its applications",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, clusterIf := argocdclient.NewClientOrDie(clientOpts).NewClusterClientOrDie()
+			defer util.Close(conn)
+			_, err := clusterIf.Resync(context.Background(), &cluster.ClusterQuery{Server: args[0]})
+			errors.CheckError(err)
+			fmt.Printf("Resync of cluster '%s' requested\n", args[0])
+		},
+	}
+	return command
+}
+
+// NewClusterResourcesCommand returns a new instance of an `argocd cluster resources` command
+func NewClusterResourcesCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		namespace string
+		unmanaged bool
+	)
+	var command = &cobra.Command{
+		Use:   "resources SERVER",
+		Short: "List the top-level resources of a cluster, and the applications managing them",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, clusterIf := argocdclient.NewClientOrDie(clientOpts).NewClusterClientOrDie()
+			defer util.Close(conn)
+			resources, err := clusterIf.ListResources(context.Background(), &cluster.ClusterResourcesQuery{
+				Server:    args[0],
+				Namespace: namespace,
+				Unmanaged: unmanaged,
+			})
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "NAMESPACE\tMANAGED\tUNMANAGED\n")
+			for _, ns := range resources.Namespaces {
+				fmt.Fprintf(w, "%s\t%d\t%d\n", ns.Name, ns.Managed, ns.Unmanaged)
+			}
+			fmt.Fprintln(w)
+			fmt.Fprintf(w, "GROUP\tKIND\tNAMESPACE\tNAME\tAPPLICATION\n")
+			for _, res := range resources.Items {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", res.Group, res.Kind, res.Namespace, res.Name, res.Application)
 			}
 			_ = w.Flush()
 		},
 	}
+	command.Flags().StringVar(&namespace, "namespace", "", "Only list the resources of a namespace")
+	command.Flags().BoolVar(&unmanaged, "unmanaged", false, "Only list the resources which are not managed by any application")
 	return command
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"text/tabwriter"
@@ -30,9 +31,22 @@ const (
 )
 
 type projectOpts struct {
-	description  string
-	destinations []string
-	sources      []string
+	description      string
+	destinations     []string
+	sources          []string
+	maintainers      []string
+	labels           []string
+	syncPolicy       string
+	autoPrune        bool
+	syncStrategy     string
+	prune            string
+	deleteProtection bool
+	maxResources     int64
+	maxManifestSize  int64
+	appLabels        []string
+	appFinalizers    []string
+	appDestServer    string
+	appDestNamespace string
 }
 
 type policyOpts struct {
@@ -57,6 +71,22 @@ func (opts *projectOpts) GetDestinations() []v1alpha1.ApplicationDestination {
 	return destinations
 }
 
+func (opts *projectOpts) GetLabels() map[string]string {
+	return parseLabels(opts.labels)
+}
+
+func parseLabels(labelStrs []string) map[string]string {
+	labels := make(map[string]string)
+	for _, labelStr := range labelStrs {
+		parts := strings.SplitN(labelStr, "=", 2)
+		if len(parts) != 2 {
+			log.Fatalf("Expected label of the form: key=value. Received: %s", labelStr)
+		}
+		labels[parts[0]] = parts[1]
+	}
+	return labels
+}
+
 // NewProjectCommand returns a new instance of an `argocd proj` command
 func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var command = &cobra.Command{
@@ -74,12 +104,16 @@ func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewProjectSetCommand(clientOpts))
 	command.AddCommand(NewProjectAddDestinationCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveDestinationCommand(clientOpts))
+	command.AddCommand(NewProjectAddDestinationServiceAccountCommand(clientOpts))
+	command.AddCommand(NewProjectRemoveDestinationServiceAccountCommand(clientOpts))
 	command.AddCommand(NewProjectAddSourceCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveSourceCommand(clientOpts))
 	command.AddCommand(NewProjectAllowClusterResourceCommand(clientOpts))
 	command.AddCommand(NewProjectDenyClusterResourceCommand(clientOpts))
 	command.AddCommand(NewProjectAllowNamespaceResourceCommand(clientOpts))
 	command.AddCommand(NewProjectDenyNamespaceResourceCommand(clientOpts))
+	command.AddCommand(NewProjectPauseCommand(clientOpts))
+	command.AddCommand(NewProjectResumeCommand(clientOpts))
 	return command
 }
 
@@ -88,6 +122,116 @@ func addProjFlags(command *cobra.Command, opts *projectOpts) {
 	command.Flags().StringArrayVarP(&opts.destinations, "dest", "d", []string{},
 		"Permitted destination server and namespace (e.g. https://192.168.99.100:8443,default)")
 	command.Flags().StringArrayVarP(&opts.sources, "src", "s", []string{}, "Permitted git source repository URL")
+	command.Flags().StringArrayVar(&opts.maintainers, "maintainer", []string{}, "Person or team responsible for the project")
+	command.Flags().StringArrayVarP(&opts.labels, "label", "l", []string{}, "Project label in the form of key=value")
+	command.Flags().StringVar(&opts.syncPolicy, "sync-policy", "", "Default sync policy of applications which don't define one (one of: automated, none)")
+	command.Flags().BoolVar(&opts.autoPrune, "auto-prune", false, "Set automatic pruning in the default sync policy")
+	command.Flags().StringVar(&opts.syncStrategy, "sync-strategy", "", "Default strategy of syncs which don't specify one (one of: apply, hook, none)")
+	command.Flags().StringVar(&opts.prune, "prune", "", "Prune option enforced on all syncs (one of: true, false, none)")
+	command.Flags().BoolVar(&opts.deleteProtection, "delete-protection", false, "Refuse to delete applications of the project unless forced with a reason")
+	command.Flags().Int64Var(&opts.maxResources, "max-resources", 0, "Maximum number of resources of each application of the project (0 means no limit)")
+	command.Flags().Int64Var(&opts.maxManifestSize, "max-manifest-size", 0, "Maximum total size in bytes of the manifests of each application of the project (0 means no limit)")
+	command.Flags().StringArrayVar(&opts.appLabels, "app-label", []string{}, "Label added to new applications of the project in the form of key=value")
+	command.Flags().StringArrayVar(&opts.appFinalizers, "app-finalizer", []string{}, "Finalizer added to new applications of the project")
+	command.Flags().StringVar(&opts.appDestServer, "app-dest-server", "", "Destination server of new applications of the project which don't set one")
+	command.Flags().StringVar(&opts.appDestNamespace, "app-dest-namespace", "", "Destination namespace of new applications of the project which don't set one")
+}
+
+// GetSyncPolicy returns the default sync policy of the project
+func (opts *projectOpts) GetSyncPolicy() *v1alpha1.SyncPolicy {
+	switch opts.syncPolicy {
+	case "automated":
+		return &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{Prune: opts.autoPrune}}
+	case "none", "":
+		if opts.autoPrune {
+			log.Fatal("Cannot set --auto-prune: default sync policy is not automated")
+		}
+		return nil
+	default:
+		log.Fatalf("Invalid sync-policy: %s", opts.syncPolicy)
+	}
+	return nil
+}
+
+// SetApplicationLimits updates the application limits of the project with the limits which were set
+func (opts *projectOpts) SetApplicationLimits(flags *pflag.FlagSet, proj *v1alpha1.AppProject) {
+	limits := proj.Spec.ApplicationLimits
+	if limits == nil {
+		limits = &v1alpha1.ApplicationLimits{}
+	}
+	if flags.Changed("max-resources") {
+		limits.MaxResources = opts.maxResources
+	}
+	if flags.Changed("max-manifest-size") {
+		limits.MaxManifestSize = opts.maxManifestSize
+	}
+	if limits.MaxResources < 0 || limits.MaxManifestSize < 0 {
+		log.Fatal("Application limits must not be negative")
+	}
+	if limits.MaxResources == 0 && limits.MaxManifestSize == 0 {
+		limits = nil
+	}
+	proj.Spec.ApplicationLimits = limits
+}
+
+// SetApplicationTemplate updates the application template of the project with the defaults which were set
+func (opts *projectOpts) SetApplicationTemplate(flags *pflag.FlagSet, proj *v1alpha1.AppProject) {
+	tmpl := proj.Spec.ApplicationTemplate
+	if tmpl == nil {
+		tmpl = &v1alpha1.ApplicationTemplate{}
+	}
+	if flags.Changed("app-label") {
+		tmpl.Labels = parseLabels(opts.appLabels)
+	}
+	if flags.Changed("app-finalizer") {
+		tmpl.Finalizers = opts.appFinalizers
+	}
+	if flags.Changed("app-dest-server") {
+		tmpl.Destination.Server = opts.appDestServer
+	}
+	if flags.Changed("app-dest-namespace") {
+		tmpl.Destination.Namespace = opts.appDestNamespace
+	}
+	if len(tmpl.Labels) == 0 && len(tmpl.Annotations) == 0 && len(tmpl.Finalizers) == 0 &&
+		tmpl.Destination.Server == "" && tmpl.Destination.Namespace == "" && tmpl.SyncPolicy == nil {
+		tmpl = nil
+	}
+	proj.Spec.ApplicationTemplate = tmpl
+}
+
+// SetSyncOptions updates the sync options of the project with the options which were set
+func (opts *projectOpts) SetSyncOptions(flags *pflag.FlagSet, proj *v1alpha1.AppProject) {
+	syncOpts := proj.Spec.SyncOptions
+	if syncOpts == nil {
+		syncOpts = &v1alpha1.ProjectSyncOptions{}
+	}
+	if flags.Changed("sync-strategy") {
+		switch opts.syncStrategy {
+		case "apply":
+			syncOpts.DefaultStrategy = &v1alpha1.SyncStrategy{Apply: &v1alpha1.SyncStrategyApply{}}
+		case "hook":
+			syncOpts.DefaultStrategy = &v1alpha1.SyncStrategy{Hook: &v1alpha1.SyncStrategyHook{}}
+		case "none":
+			syncOpts.DefaultStrategy = nil
+		default:
+			log.Fatalf("Invalid sync-strategy: %s", opts.syncStrategy)
+		}
+	}
+	if flags.Changed("prune") {
+		switch opts.prune {
+		case "true", "false":
+			prune := opts.prune == "true"
+			syncOpts.Prune = &prune
+		case "none":
+			syncOpts.Prune = nil
+		default:
+			log.Fatalf("Invalid prune option: %s", opts.prune)
+		}
+	}
+	if syncOpts.DefaultStrategy == nil && syncOpts.Prune == nil {
+		syncOpts = nil
+	}
+	proj.Spec.SyncOptions = syncOpts
 }
 
 func addPolicyFlags(command *cobra.Command, opts *policyOpts) {
@@ -436,13 +580,22 @@ func NewProjectCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 			}
 			projName := args[0]
 			proj := v1alpha1.AppProject{
-				ObjectMeta: v1.ObjectMeta{Name: projName},
+				ObjectMeta: v1.ObjectMeta{
+					Name:   projName,
+					Labels: opts.GetLabels(),
+				},
 				Spec: v1alpha1.AppProjectSpec{
-					Description:  opts.description,
-					Destinations: opts.GetDestinations(),
-					SourceRepos:  opts.sources,
+					Description:      opts.description,
+					Destinations:     opts.GetDestinations(),
+					SourceRepos:      opts.sources,
+					Maintainers:      opts.maintainers,
+					SyncPolicy:       opts.GetSyncPolicy(),
+					DeleteProtection: opts.deleteProtection,
 				},
 			}
+			opts.SetSyncOptions(c.Flags(), &proj)
+			opts.SetApplicationLimits(c.Flags(), &proj)
+			opts.SetApplicationTemplate(c.Flags(), &proj)
 			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
 			defer util.Close(conn)
 
@@ -484,6 +637,14 @@ func NewProjectSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 					proj.Spec.Destinations = opts.GetDestinations()
 				case "src":
 					proj.Spec.SourceRepos = opts.sources
+				case "maintainer":
+					proj.Spec.Maintainers = opts.maintainers
+				case "label":
+					proj.Labels = opts.GetLabels()
+				case "sync-policy":
+					proj.Spec.SyncPolicy = opts.GetSyncPolicy()
+				case "delete-protection":
+					proj.Spec.DeleteProtection = opts.deleteProtection
 				}
 			})
 			if visited == 0 {
@@ -491,6 +652,15 @@ func NewProjectSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
+			if c.Flags().Changed("auto-prune") && !c.Flags().Changed("sync-policy") {
+				if proj.Spec.SyncPolicy == nil || proj.Spec.SyncPolicy.Automated == nil {
+					log.Fatal("Cannot set --auto-prune: default sync policy is not automated")
+				}
+				proj.Spec.SyncPolicy.Automated.Prune = opts.autoPrune
+			}
+			opts.SetSyncOptions(c.Flags(), proj)
+			opts.SetApplicationLimits(c.Flags(), proj)
+			opts.SetApplicationTemplate(c.Flags(), proj)
 
 			_, err = projIf.Update(context.Background(), &project.ProjectUpdateRequest{Project: proj})
 			errors.CheckError(err)
@@ -571,6 +741,78 @@ func NewProjectRemoveDestinationCommand(clientOpts *argocdclient.ClientOptions)
 	return command
 }
 
+// NewProjectAddDestinationServiceAccountCommand returns a new instance of an `argocd proj add-destination-service-account` command
+func NewProjectAddDestinationServiceAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "add-destination-service-account PROJECT SERVER NAMESPACE SERVICE_ACCOUNT",
+		Short: "Add service account impersonated when syncing to project destination",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 4 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			server := args[1]
+			namespace := args[2]
+			serviceAccount := args[3]
+			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
+			defer util.Close(conn)
+
+			proj, err := projIf.Get(context.Background(), &project.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			for _, sa := range proj.Spec.DestinationServiceAccounts {
+				if sa.Namespace == namespace && sa.Server == server {
+					log.Fatal("Specified destination already has a service account in project")
+				}
+			}
+			proj.Spec.DestinationServiceAccounts = append(proj.Spec.DestinationServiceAccounts, v1alpha1.DestinationServiceAccount{Server: server, Namespace: namespace, ServiceAccount: serviceAccount})
+			_, err = projIf.Update(context.Background(), &project.ProjectUpdateRequest{Project: proj})
+			errors.CheckError(err)
+		},
+	}
+	return command
+}
+
+// NewProjectRemoveDestinationServiceAccountCommand returns a new instance of an `argocd proj remove-destination-service-account` command
+func NewProjectRemoveDestinationServiceAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "remove-destination-service-account PROJECT SERVER NAMESPACE",
+		Short: "Remove service account impersonated when syncing to project destination",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 3 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			server := args[1]
+			namespace := args[2]
+			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
+			defer util.Close(conn)
+
+			proj, err := projIf.Get(context.Background(), &project.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			index := -1
+			for i, sa := range proj.Spec.DestinationServiceAccounts {
+				if sa.Namespace == namespace && sa.Server == server {
+					index = i
+					break
+				}
+			}
+			if index == -1 {
+				log.Fatal("Specified destination has no service account in project")
+			} else {
+				proj.Spec.DestinationServiceAccounts = append(proj.Spec.DestinationServiceAccounts[:index], proj.Spec.DestinationServiceAccounts[index+1:]...)
+				_, err = projIf.Update(context.Background(), &project.ProjectUpdateRequest{Project: proj})
+				errors.CheckError(err)
+			}
+		},
+	}
+
+	return command
+}
+
 // NewProjectAddSourceCommand returns a new instance of an `argocd proj add-src` command
 func NewProjectAddSourceCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var command = &cobra.Command{
@@ -749,6 +991,9 @@ func NewProjectRemoveSourceCommand(clientOpts *argocdclient.ClientOptions) *cobr
 
 // NewProjectDeleteCommand returns a new instance of an `argocd proj delete` command
 func NewProjectDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		force bool
+	)
 	var command = &cobra.Command{
 		Use:   "delete PROJECT",
 		Short: "Delete project",
@@ -760,31 +1005,100 @@ func NewProjectDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
 			defer util.Close(conn)
 			for _, name := range args {
-				_, err := projIf.Delete(context.Background(), &project.ProjectQuery{Name: name})
+				_, err := projIf.Delete(context.Background(), &project.ProjectQuery{Name: name, Force: force})
 				errors.CheckError(err)
 			}
 		},
 	}
+	command.Flags().BoolVar(&force, "force", false, "Delete the project even if applications still belong to it")
+	return command
+}
+
+// NewProjectPauseCommand returns a new instance of an `argocd proj pause` command
+func NewProjectPauseCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		comparisons bool
+		global      bool
+	)
+	var command = &cobra.Command{
+		Use:   "pause [PROJECT]",
+		Short: "Pause the automated syncs of the applications of a project, or of all applications",
+		Run: func(c *cobra.Command, args []string) {
+			req := project.ProjectReconciliationPauseRequest{Pause: string(v1alpha1.ReconciliationPauseSync)}
+			if comparisons {
+				req.Pause = string(v1alpha1.ReconciliationPauseAll)
+			}
+			setReconciliationPause(c, args, global, clientOpts, &req)
+		},
+	}
+	command.Flags().BoolVar(&comparisons, "comparisons", false, "Also pause the comparisons of the applications")
+	command.Flags().BoolVar(&global, "global", false, "Pause all applications instead of the applications of a project")
+	return command
+}
+
+// NewProjectResumeCommand returns a new instance of an `argocd proj resume` command
+func NewProjectResumeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		global bool
+	)
+	var command = &cobra.Command{
+		Use:   "resume [PROJECT]",
+		Short: "Resume the reconciliation of the applications of a project, or of all applications",
+		Run: func(c *cobra.Command, args []string) {
+			setReconciliationPause(c, args, global, clientOpts, &project.ProjectReconciliationPauseRequest{})
+		},
+	}
+	command.Flags().BoolVar(&global, "global", false, "Resume all applications instead of the applications of a project")
 	return command
 }
 
+// setReconciliationPause sets the reconciliation pause of the project given in the arguments, or of all
+// applications if global is true
+func setReconciliationPause(c *cobra.Command, args []string, global bool, clientOpts *argocdclient.ClientOptions, req *project.ProjectReconciliationPauseRequest) {
+	if (global && len(args) != 0) || (!global && len(args) != 1) {
+		c.HelpFunc()(c, args)
+		os.Exit(1)
+	}
+	if !global {
+		req.Name = args[0]
+	}
+	conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
+	defer util.Close(conn)
+	_, err := projIf.SetReconciliationPause(context.Background(), req)
+	errors.CheckError(err)
+}
+
 // NewProjectListCommand returns a new instance of an `argocd proj list` command
 func NewProjectListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		selector string
+	)
 	var command = &cobra.Command{
 		Use:   "list",
 		Short: "List projects",
 		Run: func(c *cobra.Command, args []string) {
 			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
 			defer util.Close(conn)
-			projects, err := projIf.List(context.Background(), &project.ProjectQuery{})
+			projects, err := projIf.List(context.Background(), &project.ProjectQuery{Selector: selector})
 			errors.CheckError(err)
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-			fmt.Fprintf(w, "NAME\tDESCRIPTION\tDESTINATIONS\tSOURCES\tCLUSTER-RESOURCE-WHITELIST\tNAMESPACE-RESOURCE-BLACKLIST\n")
+			fmt.Fprintf(w, "NAME\tDESCRIPTION\tMAINTAINERS\tLABELS\tDESTINATIONS\tSOURCES\tCLUSTER-RESOURCE-WHITELIST\tNAMESPACE-RESOURCE-BLACKLIST\n")
 			for _, p := range projects.Items {
-				fmt.Fprintf(w, "%s\t%s\t%v\t%v\t%v\t%v\n", p.Name, p.Spec.Description, p.Spec.Destinations, p.Spec.SourceRepos, p.Spec.ClusterResourceWhitelist, p.Spec.NamespaceResourceBlacklist)
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%v\t%v\t%v\t%v\n", p.Name, p.Spec.Description, strings.Join(p.Spec.Maintainers, ","), formatLabels(p.Labels), p.Spec.Destinations, p.Spec.SourceRepos, p.Spec.ClusterResourceWhitelist, p.Spec.NamespaceResourceBlacklist)
 			}
 			_ = w.Flush()
 		},
 	}
+	command.Flags().StringVarP(&selector, "selector", "l", "", "List projects by label")
 	return command
 }
+
+// formatLabels returns labels as a sorted, comma separated list of key=value pairs
+func formatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
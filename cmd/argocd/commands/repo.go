@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	log "github.com/sirupsen/logrus"
@@ -33,6 +34,9 @@ func NewRepoCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewRepoAddCommand(clientOpts))
 	command.AddCommand(NewRepoListCommand(clientOpts))
 	command.AddCommand(NewRepoRemoveCommand(clientOpts))
+	command.AddCommand(NewRepoRefsCommand(clientOpts))
+	command.AddCommand(NewRepoAppsCommand(clientOpts))
+	command.AddCommand(NewRepoHelmChartsCommand(clientOpts))
 	return command
 }
 
@@ -64,7 +68,7 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 			// NOTE: it is important not to run git commands to test git credentials on the user's
 			// system since it may mess with their git credential store (e.g. osx keychain).
 			// See issue #315
-			err := git.TestRepo(repo.Repo, "", "", repo.SSHPrivateKey)
+			err := git.TestRepo(context.Background(), repo.Repo, "", "", repo.SSHPrivateKey)
 			if err != nil {
 				if git.IsSSHURL(repo.Repo) {
 					// If we failed using git SSH credentials, then the repo is automatically bad
@@ -94,6 +98,9 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 
 // NewRepoRemoveCommand returns a new instance of an `argocd repo list` command
 func NewRepoRemoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		force bool
+	)
 	var command = &cobra.Command{
 		Use:   "rm REPO",
 		Short: "Remove git repository credentials",
@@ -105,31 +112,139 @@ func NewRepoRemoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 			conn, repoIf := argocdclient.NewClientOrDie(clientOpts).NewRepoClientOrDie()
 			defer util.Close(conn)
 			for _, repoURL := range args {
-				_, err := repoIf.Delete(context.Background(), &repository.RepoQuery{Repo: repoURL})
+				_, err := repoIf.Delete(context.Background(), &repository.RepoQuery{Repo: repoURL, Force: force})
 				errors.CheckError(err)
 			}
 		},
 	}
+	command.Flags().BoolVar(&force, "force", false, "Remove the repository even if applications still use it")
 	return command
 }
 
 // NewRepoListCommand returns a new instance of an `argocd repo rm` command
 func NewRepoListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		query repository.RepoQuery
+	)
 	var command = &cobra.Command{
 		Use:   "list",
 		Short: "List configured repositories",
 		Run: func(c *cobra.Command, args []string) {
 			conn, repoIf := argocdclient.NewClientOrDie(clientOpts).NewRepoClientOrDie()
 			defer util.Close(conn)
-			repos, err := repoIf.List(context.Background(), &repository.RepoQuery{})
+			repos, err := repoIf.List(context.Background(), &query)
 			errors.CheckError(err)
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-			fmt.Fprintf(w, "REPO\tUSER\tSTATUS\tMESSAGE\n")
+			fmt.Fprintf(w, "REPO\tUSER\tAPPS\tSTATUS\tMESSAGE\n")
 			for _, r := range repos.Items {
-				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.Repo, r.Username, r.ConnectionState.Status, r.ConnectionState.Message)
+				fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", r.Repo, r.Username, r.ApplicationCount, r.ConnectionState.Status, r.ConnectionState.Message)
+			}
+			_ = w.Flush()
+			if repos.Continue != "" {
+				fmt.Printf("\nMore repositories available, list them with: --continue %s\n", repos.Continue)
+			}
+		},
+	}
+	command.Flags().StringVar(&query.Search, "search", "", "Only list repositories whose URL contains the given string")
+	command.Flags().BoolVar(&query.Unused, "unused", false, "Only list repositories not used by any application")
+	command.Flags().Int64Var(&query.Limit, "limit", 0, "Maximum number of repositories to list")
+	command.Flags().StringVar(&query.Continue, "continue", "", "Continue listing from the given repository (as returned by a previous call)")
+	return command
+}
+
+// NewRepoRefsCommand returns a new instance of an `argocd repo refs` command
+func NewRepoRefsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		query repository.RepoRefsQuery
+	)
+	var command = &cobra.Command{
+		Use:   "refs REPO",
+		Short: "List the branches and tags of a repository",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			query.Repo = args[0]
+			conn, repoIf := argocdclient.NewClientOrDie(clientOpts).NewRepoClientOrDie()
+			defer util.Close(conn)
+			refs, err := repoIf.ListRefs(context.Background(), &query)
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "NAME\tTYPE\n")
+			for _, ref := range refs.Items {
+				fmt.Fprintf(w, "%s\t%s\n", ref.Name, ref.Type)
+			}
+			_ = w.Flush()
+			if refs.Continue != "" {
+				fmt.Printf("\nMore refs available, list them with: --continue %s\n", refs.Continue)
+			}
+		},
+	}
+	command.Flags().StringVar(&query.Type, "type", "", "Only list refs of the given type. One of: branch, tag")
+	command.Flags().StringVar(&query.Search, "search", "", "Only list refs whose name contains the given string")
+	command.Flags().Int64Var(&query.Limit, "limit", 0, "Maximum number of refs to list")
+	command.Flags().StringVar(&query.Continue, "continue", "", "Continue listing from the given ref (as returned by a previous call)")
+	return command
+}
+
+// NewRepoAppsCommand returns a new instance of an `argocd repo apps` command
+func NewRepoAppsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		revision string
+	)
+	var command = &cobra.Command{
+		Use:   "apps REPO",
+		Short: "List the paths of the applications of a repository, along with their type",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, repoIf := argocdclient.NewClientOrDie(clientOpts).NewRepoClientOrDie()
+			defer util.Close(conn)
+			apps, err := repoIf.ListApps(context.Background(), &repository.RepoAppsQuery{Repo: args[0], Revision: revision})
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "PATH\tTYPE\n")
+			for _, app := range apps.Items {
+				fmt.Fprintf(w, "%s\t%s\n", app.AppPath, app.Type)
+			}
+			_ = w.Flush()
+		},
+	}
+	command.Flags().StringVar(&revision, "revision", "HEAD", "Revision of the repository to inspect")
+	return command
+}
+
+// NewRepoHelmChartsCommand returns a new instance of an `argocd repo helm-charts` command
+func NewRepoHelmChartsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		chart string
+	)
+	var command = &cobra.Command{
+		Use:   "helm-charts HELM_REPO_NAME",
+		Short: "List the charts and their versions of a configured helm repository",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, repoIf := argocdclient.NewClientOrDie(clientOpts).NewRepoClientOrDie()
+			defer util.Close(conn)
+			charts, err := repoIf.ListHelmCharts(context.Background(), &repository.HelmChartsQuery{Name: args[0]})
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "CHART\tVERSIONS\n")
+			for _, ch := range charts.Items {
+				if chart != "" && ch.Name != chart {
+					continue
+				}
+				fmt.Fprintf(w, "%s\t%s\n", ch.Name, strings.Join(ch.Versions, ","))
 			}
 			_ = w.Flush()
 		},
 	}
+	command.Flags().StringVar(&chart, "chart", "", "Only list the versions of the given chart")
 	return command
 }
@@ -16,8 +16,13 @@ const (
 	// SecretTypeCluster indicates a secret type of cluster
 	SecretTypeCluster = "cluster"
 
+	// SecretTypeHelmRepository indicates a secret type of helm repository
+	SecretTypeHelmRepository = "helm-repository"
+
 	// AuthCookieName is the HTTP cookie name where we store our auth token
 	AuthCookieName = "argocd.token"
+	// StateCookieName is the HTTP cookie name binding an OAuth2 login flow to the browser which started it
+	StateCookieName = "argocd.oauthstate"
 	// ResourcesFinalizerName is a number of application CRD finalizer
 	ResourcesFinalizerName = "resources-finalizer." + MetadataPrefix
 
@@ -30,6 +35,13 @@ const (
 	ArgoCDSecretName        = "argocd-secret"
 	ArgoCDConfigMapName     = "argocd-cm"
 	ArgoCDRBACConfigMapName = "argocd-rbac-cm"
+	// ArgoCDInitialAdminSecretName is the secret holding the randomly generated initial admin password
+	ArgoCDInitialAdminSecretName = "argocd-initial-admin-secret"
+	// ArgoCDTokenDenylistConfigMapName is the ConfigMap holding the revoked auth tokens
+	ArgoCDTokenDenylistConfigMapName = "argocd-token-denylist"
+	// ParameterOverrideRecordsPrefix is the prefix of the names of the ConfigMaps holding the parameter
+	// override records of each application, followed by the name of the application
+	ParameterOverrideRecordsPrefix = "argocd-parameter-records-"
 )
 
 const (
@@ -39,6 +51,8 @@ const (
 	LoginEndpoint = "/auth/login"
 	// CallbackEndpoint is Argo CD's final callback endpoint we reach after OAuth 2.0 login flow has been completed
 	CallbackEndpoint = "/auth/callback"
+	// ProfileDumpEndpoint is the endpoint which dumps heap and goroutine profiles of the API server
+	ProfileDumpEndpoint = "/debug/profile/dump"
 	// ArgoCDClientAppName is name of the Oauth client app used when registering our web app to dex
 	ArgoCDClientAppName = "Argo CD"
 	// ArgoCDClientAppID is the Oauth client ID we will use when registering our app to dex
@@ -62,12 +76,21 @@ var (
 	// LabelKeySecretType contains the type of argocd secret (either 'cluster' or 'repo')
 	LabelKeySecretType = MetadataPrefix + "/secret-type"
 
+	// LabelKeyClusterNameHash contains the hash of the name of the cluster of a cluster secret, to look up clusters by name
+	LabelKeyClusterNameHash = MetadataPrefix + "/cluster-name-hash"
+
 	// AnnotationConnectionStatus contains connection state status
 	AnnotationConnectionStatus = MetadataPrefix + "/connection-status"
 	// AnnotationConnectionMessage contains additional information about connection status
 	AnnotationConnectionMessage = MetadataPrefix + "/connection-message"
 	// AnnotationConnectionModifiedAt contains timestamp when connection state had been modified
 	AnnotationConnectionModifiedAt = MetadataPrefix + "/connection-modified-at"
+	// AnnotationCacheLastResyncAt contains timestamp of the last full resync of the resources of a cluster
+	AnnotationCacheLastResyncAt = MetadataPrefix + "/cache-last-resync-at"
+	// AnnotationCacheResyncRequestedAt contains timestamp of the last forced resync request of a cluster
+	AnnotationCacheResyncRequestedAt = MetadataPrefix + "/cache-resync-requested-at"
+	// AnnotationCacheHandledResyncRequestedAt contains the value of the resync request annotation of a cluster last handled by the controller
+	AnnotationCacheHandledResyncRequestedAt = MetadataPrefix + "/cache-handled-resync-requested-at"
 
 	// AnnotationHook contains the hook type of a resource
 	AnnotationHook = MetadataPrefix + "/hook"
@@ -76,6 +99,16 @@ var (
 	// AnnotationHelmHook is the helm hook annotation
 	AnnotationHelmHook = "helm.sh/hook"
 
+	// AnnotationAuditUser is the annotation key in the audit events which holds the user who made the
+	// recorded change
+	AnnotationAuditUser = MetadataPrefix + "/user"
+	// AnnotationParameterChanges is the annotation key in the audit events of the parameter override
+	// changes which holds the JSON encoded changes
+	AnnotationParameterChanges = MetadataPrefix + "/parameter-changes"
+	// LabelKeyParameterOverrideRecords is the label of the ConfigMaps holding the parameter override records
+	// of an application, which holds the name of the application
+	LabelKeyParameterOverrideRecords = MetadataPrefix + "/parameter-records"
+
 	// LabelKeyApplicationControllerInstanceID is the label which allows to separate application among multiple running application controllers.
 	LabelKeyApplicationControllerInstanceID = application.ApplicationFullName + "/controller-instanceid"
 
@@ -86,6 +119,30 @@ var (
 	// arbitrary value (i.e. timestamp) on a git event, to  force the controller to wake up and
 	// re-evaluate the application
 	AnnotationKeyRefresh = application.ApplicationFullName + "/refresh"
+
+	// AnnotationKeyRefreshType is the annotation key in the application which holds the type of the
+	// requested refresh (i.e. normal or hard). It is removed by the controller once the refresh is done
+	AnnotationKeyRefreshType = application.ApplicationFullName + "/refresh-type"
+
+	// AnnotationKeyPreviousDestinationPolicy is the annotation key in the application which holds how
+	// resources at the previous destination are handled after a destination change (i.e. prune or
+	// orphan). It is removed by the controller once the destination change is complete
+	AnnotationKeyPreviousDestinationPolicy = application.ApplicationFullName + "/previous-destination-policy"
+
+	// AnnotationDeleteProtection is the annotation key in the application which, when set to true, causes
+	// the deletion of the application to be refused unless it is forced with a reason
+	AnnotationDeleteProtection = MetadataPrefix + "/delete-protection"
+
+	// AnnotationPauseReconciliation is the annotation key in a project, or in the argocd-cm ConfigMap for
+	// all projects, which pauses the automated syncs ("sync"), or the automated syncs and the comparisons
+	// ("all") of the applications, e.g. during cluster upgrades
+	AnnotationPauseReconciliation = MetadataPrefix + "/pause-reconciliation"
+
+	// AnnotationKeyManifestGeneratePaths is the annotation key in the application which holds the
+	// semicolon-separated paths, besides the source path, whose changes require the manifests of the
+	// application to be regenerated. Paths starting with "/" are relative to the root of the repository,
+	// and other paths are relative to the source path
+	AnnotationKeyManifestGeneratePaths = application.ApplicationFullName + "/manifest-generate-paths"
 )
 
 // ArgoCDManagerServiceAccount is the name of the service account for managing a cluster
@@ -107,3 +164,12 @@ var ArgoCDManagerPolicyRules = []rbacv1.PolicyRule{
 		Verbs:           []string{"*"},
 	},
 }
+
+// ArgoCDManagerNamespacePolicyRules are the policies to give argocd-manager in each namespace of a namespace-scoped cluster
+var ArgoCDManagerNamespacePolicyRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{"*"},
+		Resources: []string{"*"},
+		Verbs:     []string{"*"},
+	},
+}
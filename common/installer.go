@@ -115,6 +115,84 @@ func CreateClusterRoleBinding(
 	return nil
 }
 
+// CreateRole creates a role in a namespace
+func CreateRole(
+	clientset kubernetes.Interface,
+	roleName string,
+	namespace string,
+	rules []rbacv1.PolicyRule,
+) error {
+	role := rbacv1.Role{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "rbac.authorization.k8s.io/v1",
+			Kind:       "Role",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      roleName,
+			Namespace: namespace,
+		},
+		Rules: rules,
+	}
+	rclient := clientset.RbacV1().Roles(namespace)
+	_, err := rclient.Create(&role)
+	if err != nil {
+		if !apierr.IsAlreadyExists(err) {
+			return fmt.Errorf("Failed to create Role %q in namespace %q: %v", roleName, namespace, err)
+		}
+		_, err = rclient.Update(&role)
+		if err != nil {
+			return fmt.Errorf("Failed to update Role %q in namespace %q: %v", roleName, namespace, err)
+		}
+		log.Infof("Role %q in namespace %q updated", roleName, namespace)
+	} else {
+		log.Infof("Role %q in namespace %q created", roleName, namespace)
+	}
+	return nil
+}
+
+// CreateRoleBinding creates a RoleBinding granting a role in a namespace to a service account
+func CreateRoleBinding(
+	clientset kubernetes.Interface,
+	roleBindingName,
+	serviceAccountName,
+	roleName string,
+	serviceAccountNamespace string,
+	namespace string,
+) error {
+	roleBinding := rbacv1.RoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "rbac.authorization.k8s.io/v1",
+			Kind:       "RoleBinding",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      roleBindingName,
+			Namespace: namespace,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "Role",
+			Name:     roleName,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      serviceAccountName,
+				Namespace: serviceAccountNamespace,
+			},
+		},
+	}
+	_, err := clientset.RbacV1().RoleBindings(namespace).Create(&roleBinding)
+	if err != nil {
+		if !apierr.IsAlreadyExists(err) {
+			return fmt.Errorf("Failed to create RoleBinding %q in namespace %q: %v", roleBindingName, namespace, err)
+		}
+		log.Infof("RoleBinding %q in namespace %q already exists", roleBindingName, namespace)
+		return nil
+	}
+	log.Infof("RoleBinding %q in namespace %q created, bound %q to %q", roleBindingName, namespace, serviceAccountName, roleName)
+	return nil
+}
+
 // InstallClusterManagerRBAC installs RBAC resources for a cluster manager to operate a cluster. Returns a token
 func InstallClusterManagerRBAC(clientset kubernetes.Interface) (string, error) {
 	const ns = "kube-system"
@@ -134,11 +212,37 @@ func InstallClusterManagerRBAC(clientset kubernetes.Interface) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	return getServiceAccountBearerToken(clientset, ns, ArgoCDManagerServiceAccount)
+}
 
+// InstallNamespacedManagerRBAC installs RBAC resources for a cluster manager which is only permitted
+// to operate the given namespaces of a cluster. No cluster-wide permissions are granted. Returns a token
+func InstallNamespacedManagerRBAC(clientset kubernetes.Interface, namespaces []string) (string, error) {
+	const ns = "kube-system"
+	err := CreateServiceAccount(clientset, ArgoCDManagerServiceAccount, ns)
+	if err != nil {
+		return "", err
+	}
+	for _, namespace := range namespaces {
+		err = CreateRole(clientset, ArgoCDManagerClusterRole, namespace, ArgoCDManagerNamespacePolicyRules)
+		if err != nil {
+			return "", err
+		}
+		err = CreateRoleBinding(clientset, ArgoCDManagerClusterRoleBinding, ArgoCDManagerServiceAccount, ArgoCDManagerClusterRole, ns, namespace)
+		if err != nil {
+			return "", err
+		}
+	}
+	return getServiceAccountBearerToken(clientset, ns, ArgoCDManagerServiceAccount)
+}
+
+// getServiceAccountBearerToken waits for the token secret of a service account to be created and returns the token
+func getServiceAccountBearerToken(clientset kubernetes.Interface, ns string, serviceAccountName string) (string, error) {
+	var err error
 	var serviceAccount *apiv1.ServiceAccount
 	var secretName string
 	err = wait.Poll(500*time.Millisecond, 30*time.Second, func() (bool, error) {
-		serviceAccount, err = clientset.CoreV1().ServiceAccounts(ns).Get(ArgoCDManagerServiceAccount, metav1.GetOptions{})
+		serviceAccount, err = clientset.CoreV1().ServiceAccounts(ns).Get(serviceAccountName, metav1.GetOptions{})
 		if err != nil {
 			return false, err
 		}
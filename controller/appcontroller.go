@@ -4,12 +4,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/rand"
+	"net/http"
 	"reflect"
 	"runtime/debug"
 	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/robfig/cron"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/status"
 	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -25,37 +30,106 @@ import (
 	"k8s.io/client-go/util/workqueue"
 
 	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/controller/metrics"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
 	appinformers "github.com/argoproj/argo-cd/pkg/client/informers/externalversions"
+	v1alpha1informers "github.com/argoproj/argo-cd/pkg/client/informers/externalversions/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/reposerver"
+	"github.com/argoproj/argo-cd/reposerver/repository"
 	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/health"
+	"github.com/argoproj/argo-cd/util/healthz"
 	"github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/settings"
 )
 
 const (
 	watchResourcesRetryTimeout  = 10 * time.Second
 	updateOperationStateTimeout = 1 * time.Second
+	// autoSyncScheduleLookback is the number of status refresh periods during which a scheduled
+	// automated sync is still performed after the scheduled time
+	autoSyncScheduleLookback = 2
 )
 
+// RefreshBackoff configures the delay before the reconciliation of an application is retried after
+// it failed
+type RefreshBackoff struct {
+	// Initial is the delay after the first failure, doubled after each consecutive failure
+	Initial time.Duration
+	// Max is the maximum delay
+	Max time.Duration
+	// Jitter is the maximum fraction of the delay randomly added to it, which spreads the retries of
+	// applications failing at the same time
+	Jitter float64
+}
+
+// DefaultRefreshBackoff is the default delay before retrying a failed reconciliation
+var DefaultRefreshBackoff = RefreshBackoff{
+	Initial: 10 * time.Second,
+	Max:     5 * time.Minute,
+	Jitter:  0.1,
+}
+
+// Delay returns the delay before retrying a reconciliation after the given number of consecutive failures
+func (b RefreshBackoff) Delay(failures int) time.Duration {
+	delay := b.Initial
+	for i := 1; i < failures && delay < b.Max; i++ {
+		delay *= 2
+	}
+	if delay > b.Max {
+		delay = b.Max
+	}
+	if b.Jitter > 0 {
+		delay += time.Duration(rand.Float64() * b.Jitter * float64(delay))
+	}
+	return delay
+}
+
+// refreshFailure holds the consecutive reconciliation failures of an application
+type refreshFailure struct {
+	failures int
+	retryAt  time.Time
+	// source is the application source which failed to reconcile. Changing the source retries
+	// immediately
+	source appv1.ApplicationSource
+}
+
 // ApplicationController is the controller for application resources.
 type ApplicationController struct {
-	namespace             string
-	kubeClientset         kubernetes.Interface
-	kubectl               kube.Kubectl
-	applicationClientset  appclientset.Interface
-	auditLogger           *argo.AuditLogger
-	appRefreshQueue       workqueue.RateLimitingInterface
-	appOperationQueue     workqueue.RateLimitingInterface
-	appInformer           cache.SharedIndexInformer
+	namespace string
+	// appNamespaces are the namespaces the applications are watched in, including the installation namespace
+	appNamespaces        []string
+	kubeClientset        kubernetes.Interface
+	kubectl              kube.Kubectl
+	applicationClientset appclientset.Interface
+	auditLogger          *argo.AuditLogger
+	appRefreshQueue      workqueue.RateLimitingInterface
+	appOperationQueue    workqueue.RateLimitingInterface
+	// appInformers are the application informers, by namespace
+	appInformers          map[string]cache.SharedIndexInformer
+	projInformer          cache.SharedIndexInformer
+	projLister            applisters.AppProjectLister
+	cmInformer            cache.SharedIndexInformer
 	appStateManager       AppStateManager
 	statusRefreshTimeout  time.Duration
+	reconcileTimeout      time.Duration
+	operationTimeout      time.Duration
 	repoClientset         reposerver.Clientset
 	db                    db.ArgoDB
 	forceRefreshApps      map[string]bool
 	forceRefreshAppsMutex *sync.Mutex
+	refreshBackoff        RefreshBackoff
+	refreshFailures       map[string]refreshFailure
+	refreshFailuresMutex  *sync.Mutex
+	metricsServer         *metrics.MetricsServer
+	kubeClientMetrics     *kube.ClientMetrics
+	// statusGCInterval is the time period between two garbage collections of the status of the
+	// applications, or 0 if they are not garbage collected
+	statusGCInterval time.Duration
+	statusGCPolicy   argo.GCPolicy
 }
 
 type ApplicationControllerConfig struct {
@@ -63,19 +137,70 @@ type ApplicationControllerConfig struct {
 	Namespace  string
 }
 
-// NewApplicationController creates new instance of ApplicationController.
+// DefaultReconcileTimeout is the default time limit of the requests made during an application
+// reconciliation
+const DefaultReconcileTimeout = 5 * time.Minute
+
+// DefaultOperationTimeout is the default time limit of the requests made while running an operation
+const DefaultOperationTimeout = time.Hour
+
+// ApplicationControllerOpts are the optional settings of the application controller. The zero value of
+// each setting selects its default
+type ApplicationControllerOpts struct {
+	// AppNamespaces are the namespaces the applications are watched in, besides the installation namespace
+	AppNamespaces []string
+	// ReconcileTimeout is the time limit of the requests made during an application reconciliation.
+	// Defaults to DefaultReconcileTimeout
+	ReconcileTimeout time.Duration
+	// OperationTimeout is the time limit of the requests made while running an operation of an
+	// application, such as a sync or the deletion of its resources. Defaults to DefaultOperationTimeout
+	OperationTimeout time.Duration
+	// RefreshBackoff is the delay before retrying a failed reconciliation. Defaults to DefaultRefreshBackoff
+	RefreshBackoff RefreshBackoff
+	// ReconcileBuckets are the buckets of the reconciliation duration histogram
+	ReconcileBuckets []float64
+	// KubectlParallelismLimit is the number of kubectl commands changing resources which run at the same
+	// time against each cluster, or 0 for no limit
+	KubectlParallelismLimit int
+	// StatusGCInterval is the time period between two garbage collections of the status of the
+	// applications, or 0 if they are not garbage collected
+	StatusGCInterval time.Duration
+	// KubeClientMetrics records the requests made to the managed clusters and limits their rate per
+	// cluster, if set
+	KubeClientMetrics *kube.ClientMetrics
+	// OperationRetention is the time the resource results of completed operations are kept in the status
+	// of the applications. Defaults to argo.DefaultOperationRetention
+	OperationRetention time.Duration
+}
+
+// NewApplicationController creates new instance of ApplicationController. Applications are watched in the
+// installation namespace and in the additional namespaces of the options, with an informer per namespace
 func NewApplicationController(
 	namespace string,
 	kubeClientset kubernetes.Interface,
 	applicationClientset appclientset.Interface,
 	repoClientset reposerver.Clientset,
 	appResyncPeriod time.Duration,
+	opts ApplicationControllerOpts,
 ) *ApplicationController {
+	if opts.ReconcileTimeout == 0 {
+		opts.ReconcileTimeout = DefaultReconcileTimeout
+	}
+	if opts.OperationTimeout == 0 {
+		opts.OperationTimeout = DefaultOperationTimeout
+	}
+	if opts.RefreshBackoff == (RefreshBackoff{}) {
+		opts.RefreshBackoff = DefaultRefreshBackoff
+	}
+	if opts.OperationRetention == 0 {
+		opts.OperationRetention = argo.DefaultOperationRetention
+	}
 	db := db.NewDB(namespace, kubeClientset)
-	kubectlCmd := kube.KubectlCmd{}
-	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectlCmd)
+	kubectlCmd := kube.NewClusterLimitedKubectl(kube.KubectlCmd{}, opts.KubectlParallelismLimit)
+	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectlCmd, opts.KubeClientMetrics)
 	ctrl := ApplicationController{
 		namespace:             namespace,
+		appNamespaces:         applicationNamespaces(namespace, opts.AppNamespaces),
 		kubeClientset:         kubeClientset,
 		kubectl:               kubectlCmd,
 		applicationClientset:  applicationClientset,
@@ -85,22 +210,53 @@ func NewApplicationController(
 		appStateManager:       appStateManager,
 		db:                    db,
 		statusRefreshTimeout:  appResyncPeriod,
+		reconcileTimeout:      opts.ReconcileTimeout,
+		operationTimeout:      opts.OperationTimeout,
 		forceRefreshApps:      make(map[string]bool),
 		forceRefreshAppsMutex: &sync.Mutex{},
+		refreshBackoff:        opts.RefreshBackoff,
+		refreshFailures:       make(map[string]refreshFailure),
+		refreshFailuresMutex:  &sync.Mutex{},
 		auditLogger:           argo.NewAuditLogger(namespace, kubeClientset, "application-controller"),
+		metricsServer:         metrics.NewMetricsServer(opts.ReconcileBuckets),
+		kubeClientMetrics:     opts.KubeClientMetrics,
+		statusGCInterval:      opts.StatusGCInterval,
+		statusGCPolicy:        argo.GCPolicy{MaxHistory: MaxHistoryCount, OperationRetention: opts.OperationRetention},
+	}
+	ctrl.appInformers = make(map[string]cache.SharedIndexInformer)
+	for _, appNamespace := range ctrl.appNamespaces {
+		ctrl.appInformers[appNamespace] = ctrl.newApplicationInformer(appNamespace)
 	}
-	ctrl.appInformer = ctrl.newApplicationInformer()
+	ctrl.projInformer = v1alpha1informers.NewAppProjectInformer(applicationClientset, namespace, appResyncPeriod, cache.Indexers{})
+	ctrl.projLister = applisters.NewAppProjectLister(ctrl.projInformer.GetIndexer())
+	ctrl.cmInformer = settings.NewSettingsManager(kubeClientset, namespace).NewConfigMapInformer()
+	ctrl.metricsServer.RegisterOperationQueue(ctrl.appOperationQueue)
 	return &ctrl
 }
 
+// ServeMetrics registers the endpoint exposing the controller metrics, and the metrics of the given
+// collectors, on the given mux
+func (ctrl *ApplicationController) ServeMetrics(mux *http.ServeMux, collectors ...prometheus.Collector) {
+	ctrl.metricsServer.ServeMetrics(mux, collectors...)
+}
+
+// MetricsGatherer returns the gatherer of the metrics of the controller
+func (ctrl *ApplicationController) MetricsGatherer() prometheus.Gatherer {
+	return ctrl.metricsServer.Gatherer()
+}
+
 // Run starts the Application CRD controller.
 func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int, operationProcessors int) {
 	defer runtime.HandleCrash()
 	defer ctrl.appRefreshQueue.ShutDown()
 
-	go ctrl.appInformer.Run(ctx.Done())
+	for _, informer := range ctrl.appInformers {
+		go informer.Run(ctx.Done())
+	}
+	go ctrl.projInformer.Run(ctx.Done())
+	go ctrl.cmInformer.Run(ctx.Done())
 
-	if !cache.WaitForCacheSync(ctx.Done(), ctrl.appInformer.HasSynced) {
+	if !cache.WaitForCacheSync(ctx.Done(), ctrl.appInformersHaveSynced, ctrl.projInformer.HasSynced, ctrl.cmInformer.HasSynced) {
 		log.Error("Timed out waiting for caches to sync")
 		return
 	}
@@ -121,36 +277,203 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 		}, time.Second, ctx.Done())
 	}
 
+	if ctrl.statusGCInterval > 0 {
+		go wait.Until(ctrl.garbageCollectAppStatuses, ctrl.statusGCInterval, ctx.Done())
+	}
+
 	<-ctx.Done()
 }
 
-func (ctrl *ApplicationController) forceAppRefresh(appName string) {
+// HealthCheck returns an error if the controller stopped processing applications
+func (ctrl *ApplicationController) HealthCheck() error {
+	if ctrl.appRefreshQueue.ShuttingDown() || ctrl.appOperationQueue.ShuttingDown() {
+		return fmt.Errorf("application queues are shut down")
+	}
+	return nil
+}
+
+// ReadinessChecks returns the checks of the dependencies the controller needs to process applications
+func (ctrl *ApplicationController) ReadinessChecks() []healthz.Check {
+	return []healthz.Check{{
+		Name: "application-informer",
+		Check: func() error {
+			if !ctrl.appInformersHaveSynced() {
+				return fmt.Errorf("application informers have not synced")
+			}
+			return nil
+		},
+	}, {
+		Name: "kubernetes",
+		Check: func() error {
+			_, err := ctrl.kubeClientset.Discovery().ServerVersion()
+			return err
+		},
+	}, {
+		Name: "repo-server",
+		Check: func() error {
+			return reposerver.CheckHealth(ctrl.repoClientset)
+		},
+	}}
+}
+
+// trackingLabelIndex is the name of the index of the applications by the value of the label their
+// resources are tracked with
+const trackingLabelIndex = "trackingLabel"
+
+// applicationNamespaces returns the namespaces the applications are watched in: the installation namespace,
+// followed by the additional namespaces
+func applicationNamespaces(namespace string, additional []string) []string {
+	namespaces := []string{namespace}
+	for _, ns := range additional {
+		if ns != "" && !containsString(namespaces, ns) {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces
+}
+
+func containsString(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
+
+// appKey returns the key of the application in the informers and the queues
+func appKey(app *appv1.Application) string {
+	return app.Namespace + "/" + app.Name
+}
+
+// getAppProject returns the project of the application from the project informer. The returned project
+// is shared with the informer and must not be modified
+func (ctrl *ApplicationController) getAppProject(app *appv1.Application) (*appv1.AppProject, error) {
+	name := app.Spec.Project
+	if app.Spec.BelongsToDefaultProject() {
+		name = common.DefaultAppProjectName
+	}
+	return ctrl.projLister.AppProjects(ctrl.namespace).Get(name)
+}
+
+// appInformersHaveSynced returns true if the application informers of all namespaces have synced
+func (ctrl *ApplicationController) appInformersHaveSynced() bool {
+	for _, informer := range ctrl.appInformers {
+		if !informer.HasSynced() {
+			return false
+		}
+	}
+	return true
+}
+
+// listApps returns the applications of all the watched namespaces
+func (ctrl *ApplicationController) listApps() []*appv1.Application {
+	var apps []*appv1.Application
+	for _, namespace := range ctrl.appNamespaces {
+		for _, obj := range ctrl.appInformers[namespace].GetIndexer().List() {
+			if app, ok := obj.(*appv1.Application); ok {
+				apps = append(apps, app)
+			}
+		}
+	}
+	return apps
+}
+
+// garbageCollectAppStatuses trims the excess history, the details of old completed operations and the
+// orphaned hook records from the status of all the applications
+func (ctrl *ApplicationController) garbageCollectAppStatuses() {
+	for _, app := range ctrl.listApps() {
+		logCtx := log.WithFields(log.Fields{"application": app.Name})
+		appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+		result, err := argo.GarbageCollectApp(appIf, app, ctrl.statusGCPolicy, false)
+		if err != nil {
+			logCtx.Warnf("Failed to garbage collect application status: %v", err)
+		} else if !result.IsEmpty() {
+			logCtx.Infof("Garbage collected application status: %s", result)
+		}
+	}
+}
+
+// getApp returns the application with the given key from the informer of its namespace
+func (ctrl *ApplicationController) getApp(key string) (interface{}, bool, error) {
+	namespace, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return nil, false, err
+	}
+	informer, ok := ctrl.appInformers[namespace]
+	if !ok {
+		return nil, false, nil
+	}
+	return informer.GetIndexer().GetByKey(key)
+}
+
+func (ctrl *ApplicationController) forceAppRefresh(appKey string) {
 	ctrl.forceRefreshAppsMutex.Lock()
 	defer ctrl.forceRefreshAppsMutex.Unlock()
-	ctrl.forceRefreshApps[appName] = true
+	ctrl.forceRefreshApps[appKey] = true
 }
 
-func (ctrl *ApplicationController) isRefreshForced(appName string) bool {
+func (ctrl *ApplicationController) isRefreshForced(appKey string) bool {
 	ctrl.forceRefreshAppsMutex.Lock()
 	defer ctrl.forceRefreshAppsMutex.Unlock()
-	_, ok := ctrl.forceRefreshApps[appName]
+	_, ok := ctrl.forceRefreshApps[appKey]
 	if ok {
-		delete(ctrl.forceRefreshApps, appName)
+		delete(ctrl.forceRefreshApps, appKey)
 	}
 	return ok
 }
 
+// getRefreshRetry returns the time after which the failed reconciliation of the application is
+// retried. Returns false if the last reconciliation did not fail, or the application source changed since
+func (ctrl *ApplicationController) getRefreshRetry(app *appv1.Application) (time.Time, bool) {
+	ctrl.refreshFailuresMutex.Lock()
+	defer ctrl.refreshFailuresMutex.Unlock()
+	failure, ok := ctrl.refreshFailures[appKey(app)]
+	if !ok || !failure.source.Equals(app.Spec.Source) {
+		return time.Time{}, false
+	}
+	return failure.retryAt, true
+}
+
+// setRefreshResult records whether the reconciliation of the application failed. Consecutive
+// failures are retried with an exponential backoff, and a success resets the backoff
+func (ctrl *ApplicationController) setRefreshResult(appKey string, app *appv1.Application, failed bool) {
+	if !failed {
+		ctrl.clearRefreshFailure(appKey)
+		return
+	}
+	ctrl.refreshFailuresMutex.Lock()
+	defer ctrl.refreshFailuresMutex.Unlock()
+	failure := ctrl.refreshFailures[appKey]
+	if !failure.source.Equals(app.Spec.Source) {
+		failure = refreshFailure{source: *app.Spec.Source.DeepCopy()}
+	}
+	failure.failures++
+	delay := ctrl.refreshBackoff.Delay(failure.failures)
+	failure.retryAt = time.Now().Add(delay)
+	ctrl.refreshFailures[appKey] = failure
+	log.WithField("application", app.Name).Infof("Reconciliation failed %d time(s), retrying in %v", failure.failures, delay)
+	ctrl.appRefreshQueue.AddAfter(appKey, delay)
+}
+
+func (ctrl *ApplicationController) clearRefreshFailure(appKey string) {
+	ctrl.refreshFailuresMutex.Lock()
+	defer ctrl.refreshFailuresMutex.Unlock()
+	delete(ctrl.refreshFailures, appKey)
+}
+
 // watchClusterResources watches for resource changes annotated with application label on specified cluster and schedule corresponding app refresh.
-func (ctrl *ApplicationController) watchClusterResources(ctx context.Context, item appv1.Cluster) {
+// An empty namespace watches resources of the whole cluster.
+func (ctrl *ApplicationController) watchClusterResources(ctx context.Context, item appv1.Cluster, namespace string) {
 	retryUntilSucceed(func() (err error) {
 		defer func() {
 			if r := recover(); r != nil {
 				err = fmt.Errorf("Recovered from panic: %v\n", r)
 			}
 		}()
-		config := item.RESTConfig()
+		config := ctrl.kubeClientMetrics.WrapClusterConfig(item.RESTConfig())
 		watchStartTime := time.Now()
-		ch, err := ctrl.kubectl.WatchResources(ctx, config, "", func(gvk schema.GroupVersionKind) metav1.ListOptions {
+		ch, err := ctrl.kubectl.WatchResources(ctx, config, namespace, func(gvk schema.GroupVersionKind) metav1.ListOptions {
 			ops := metav1.ListOptions{}
 			if !kube.IsCRDGroupVersionKind(gvk) {
 				ops.LabelSelector = common.LabelApplicationName
@@ -161,6 +484,8 @@ func (ctrl *ApplicationController) watchClusterResources(ctx context.Context, it
 		if err != nil {
 			return err
 		}
+		// changes made while the cluster was not watched were missed, so all its applications are refreshed
+		ctrl.resyncCluster(&item)
 		for event := range ch {
 			eventObj := event.Object.(*unstructured.Unstructured)
 			if kube.IsCRD(eventObj) {
@@ -175,25 +500,116 @@ func (ctrl *ApplicationController) watchClusterResources(ctx context.Context, it
 			if objLabels == nil {
 				objLabels = make(map[string]string)
 			}
-			if appName, ok := objLabels[common.LabelApplicationName]; ok {
-				ctrl.forceAppRefresh(appName)
-				ctrl.appRefreshQueue.Add(ctrl.namespace + "/" + appName)
+			if labelValue, ok := objLabels[common.LabelApplicationName]; ok {
+				for _, app := range ctrl.appsByTrackingLabel(labelValue) {
+					ctrl.forceAppRefresh(appKey(app))
+					ctrl.appRefreshQueue.Add(appKey(app))
+				}
 			}
 		}
 		return fmt.Errorf("resource updates channel has closed")
-	}, fmt.Sprintf("watch app resources on %s", item.Server), ctx, watchResourcesRetryTimeout)
+	}, fmt.Sprintf("watch app resources on %s (namespace: %q)", item.Server, namespace), ctx, watchResourcesRetryTimeout)
+
+}
+
+// resyncCluster refreshes all the applications deployed to the cluster, and records the time of the resync
+// along with the resync request of the cluster it handles
+func (ctrl *ApplicationController) resyncCluster(cluster *appv1.Cluster) {
+	resyncedAt := time.Now()
+	for _, app := range ctrl.listApps() {
+		if isAppDestinationCluster(app, cluster) {
+			ctrl.forceAppRefresh(appKey(app))
+			ctrl.appRefreshQueue.Add(appKey(app))
+		}
+	}
+	err := ctrl.db.SetClusterResynced(context.Background(), cluster.Server, resyncedAt, cluster.CacheInfo.ResyncRequestedAt)
+	if err != nil {
+		log.Warnf("Failed to record the resync of cluster %s: %v", cluster.Server, err)
+	}
+}
+
+// appsByTrackingLabel returns the applications which resources are labeled with the given value. Names of
+// applications exceeding the maximum label value length are truncated in the label, and applications of
+// different namespaces may have the same name
+func (ctrl *ApplicationController) appsByTrackingLabel(labelValue string) []*appv1.Application {
+	var apps []*appv1.Application
+	for _, namespace := range ctrl.appNamespaces {
+		objs, err := ctrl.appInformers[namespace].GetIndexer().ByIndex(trackingLabelIndex, labelValue)
+		if err != nil {
+			log.Warnf("Failed to look up the applications tracked with label value '%s': %v", labelValue, err)
+			continue
+		}
+		for _, obj := range objs {
+			if app, ok := obj.(*appv1.Application); ok {
+				apps = append(apps, app)
+			}
+		}
+	}
+	return apps
+}
 
+// findTrackingLabelCollision returns the name of another application which resources are labeled with
+// the same tracking label value as the resources of the given application, if any. Applications of other
+// namespaces are qualified with their namespace
+func (ctrl *ApplicationController) findTrackingLabelCollision(app *appv1.Application) string {
+	for _, other := range ctrl.appsByTrackingLabel(argo.TrackingLabelValue(app, ctrl.namespace)) {
+		if other.Namespace != app.Namespace {
+			return appKey(other)
+		} else if other.Name != app.Name {
+			return other.Name
+		}
+	}
+	return ""
 }
 
-func isClusterHasApps(apps []interface{}, cluster *appv1.Cluster) bool {
-	for _, obj := range apps {
-		if app, ok := obj.(*appv1.Application); ok && app.Spec.Destination.Server == cluster.Server {
+// watchedNamespaces returns the namespaces which should be watched on the cluster. A cluster which is not
+// restricted to a list of namespaces is watched as a whole
+func watchedNamespaces(cluster *appv1.Cluster) []string {
+	if cluster.IsNamespaced() {
+		return cluster.Namespaces
+	}
+	return []string{""}
+}
+
+func isClusterHasApps(apps []*appv1.Application, cluster *appv1.Cluster) bool {
+	for _, app := range apps {
+		if isAppDestinationCluster(app, cluster) {
 			return true
 		}
 	}
 	return false
 }
 
+// isAppDestinationCluster returns true if the app is deployed to the given cluster
+func isAppDestinationCluster(app *appv1.Application, cluster *appv1.Cluster) bool {
+	if app.Spec.Destination.Name != "" {
+		return app.Spec.Destination.Name == cluster.Name
+	}
+	return app.Spec.Destination.Server == cluster.Server
+}
+
+// destinationServer returns the server URL of the destination cluster of the app, which is resolved
+// if the destination is set by cluster name
+func (ctrl *ApplicationController) destinationServer(app *appv1.Application) string {
+	if app.Spec.Destination.Name == "" {
+		return app.Spec.Destination.Server
+	}
+	clst, err := argo.GetDestinationCluster(context.Background(), app.Spec.Destination, ctrl.db)
+	if err != nil {
+		log.Warnf("Failed to resolve the destination cluster %s of application %s: %v", app.Spec.Destination.Name, app.Name, err)
+		return ""
+	}
+	return clst.Server
+}
+
+// isNewResyncRequest returns whether the resync request of the cluster differs from the one of the
+// cluster when its watch was started
+func isNewResyncRequest(watched *appv1.Cluster, cluster *appv1.Cluster) bool {
+	requestedAt := cluster.CacheInfo.ResyncRequestedAt
+	watchedRequestedAt := watched.CacheInfo.ResyncRequestedAt
+	return requestedAt != nil && (watchedRequestedAt == nil || !watchedRequestedAt.Equal(requestedAt))
+}
+
 // WatchAppsResources watches for resource changes annotated with application label on all registered clusters and schedule corresponding app refresh.
 func (ctrl *ApplicationController) watchAppsResources() {
 	watchingClusters := make(map[string]struct {
@@ -204,7 +620,14 @@ func (ctrl *ApplicationController) watchAppsResources() {
 	retryUntilSucceed(func() error {
 		clusterEventCallback := func(event *db.ClusterEvent) {
 			info, ok := watchingClusters[event.Cluster.Server]
-			hasApps := isClusterHasApps(ctrl.appInformer.GetStore().List(), event.Cluster)
+			hasApps := isClusterHasApps(ctrl.listApps(), event.Cluster)
+
+			if ok && event.Type == watch.Modified && (!reflect.DeepEqual(info.cluster.Namespaces, event.Cluster.Namespaces) || isNewResyncRequest(info.cluster, event.Cluster)) {
+				// managed namespaces have changed, or a resync was requested, so the watch has to be restarted
+				info.cancel()
+				delete(watchingClusters, event.Cluster.Server)
+				ok = false
+			}
 
 			// cluster resources must be watched only if cluster has at least one app
 			if (event.Type == watch.Deleted || !hasApps) && ok {
@@ -219,7 +642,9 @@ func (ctrl *ApplicationController) watchAppsResources() {
 					cancel:  cancel,
 					cluster: event.Cluster,
 				}
-				go ctrl.watchClusterResources(ctx, *event.Cluster)
+				for _, namespace := range watchedNamespaces(event.Cluster) {
+					go ctrl.watchClusterResources(ctx, *event.Cluster, namespace)
+				}
 			}
 		}
 
@@ -227,10 +652,10 @@ func (ctrl *ApplicationController) watchAppsResources() {
 			if app, ok := obj.(*appv1.Application); ok {
 				var cluster *appv1.Cluster
 				info, infoOk := watchingClusters[app.Spec.Destination.Server]
-				if infoOk {
+				if infoOk && app.Spec.Destination.Name == "" {
 					cluster = info.cluster
 				} else {
-					cluster, _ = ctrl.db.GetCluster(context.Background(), app.Spec.Destination.Server)
+					cluster, _ = argo.GetDestinationCluster(context.Background(), app.Spec.Destination, ctrl.db)
 				}
 				if cluster != nil {
 					// trigger cluster event every time when app created/deleted to either start or stop watching resources
@@ -239,7 +664,9 @@ func (ctrl *ApplicationController) watchAppsResources() {
 			}
 		}
 
-		ctrl.appInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{AddFunc: onAppModified, DeleteFunc: onAppModified})
+		for _, informer := range ctrl.appInformers {
+			informer.AddEventHandler(cache.ResourceEventHandlerFuncs{AddFunc: onAppModified, DeleteFunc: onAppModified})
+		}
 
 		return ctrl.db.WatchClusters(context.Background(), clusterEventCallback)
 
@@ -291,7 +718,7 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 		ctrl.appOperationQueue.Done(appKey)
 	}()
 
-	obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(appKey.(string))
+	obj, exists, err := ctrl.getApp(appKey.(string))
 	if err != nil {
 		log.Errorf("Failed to get application '%s' from informer index: %+v", appKey, err)
 		return
@@ -305,16 +732,26 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 		log.Warnf("Key '%s' in index is not an application", appKey)
 		return
 	}
+	// bound the requests to the destination cluster and the repo server, so that an unresponsive
+	// cluster or repository does not block the worker. Operations may legitimately run for much longer
+	// than a reconciliation, so they are bounded by their own time limit
+	ctx, cancel := context.WithTimeout(context.Background(), ctrl.operationTimeout)
+	defer cancel()
 	if app.Operation != nil {
-		ctrl.processRequestedAppOperation(app)
+		// only the items of the queue with an operation to run are counted as operations in flight
+		ctrl.metricsServer.IncOperationsInflight()
+		defer ctrl.metricsServer.DecOperationsInflight()
+		ctrl.processRequestedAppOperation(ctx, app)
+	} else if len(app.Status.QueuedOperations) > 0 {
+		ctrl.startQueuedOperation(app)
 	} else if app.DeletionTimestamp != nil && app.CascadedDeletion() {
-		ctrl.finalizeApplicationDeletion(app)
+		ctrl.finalizeApplicationDeletion(ctx, app)
 	}
 
 	return
 }
 
-func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Application) {
+func (ctrl *ApplicationController) finalizeApplicationDeletion(ctx context.Context, app *appv1.Application) {
 	logCtx := log.WithField("application", app.Name)
 	logCtx.Infof("Deleting resources")
 	// Get refreshed application info, since informer app copy might be stale
@@ -326,22 +763,33 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 		return
 	}
 
-	clst, err := ctrl.db.GetCluster(context.Background(), app.Spec.Destination.Server)
+	destinations := []appv1.ApplicationDestination{app.Spec.Destination}
+	if app.IsDestinationChangePending() {
+		// resources at the previous destination are still managed by the application
+		destinations = append(destinations, *app.Status.ObservedDestination)
+	}
+	for _, dest := range destinations {
+		var clst *appv1.Cluster
+		clst, err = argo.GetDestinationCluster(ctx, dest, ctrl.db)
+		if err != nil {
+			break
+		}
+		err = kube.DeleteResourcesWithLabel(ctx, ctrl.kubeClientMetrics.WrapClusterConfig(clst.RESTConfig()), dest.Namespace, common.LabelApplicationName, argo.TrackingLabelValue(app, ctrl.namespace), clst.IsNamespaced())
+		if err != nil {
+			break
+		}
+	}
 
 	if err == nil {
-		config := clst.RESTConfig()
-		err = kube.DeleteResourcesWithLabel(config, app.Spec.Destination.Namespace, common.LabelApplicationName, app.Name)
+		app.SetCascadedDeletion(false)
+		var patch []byte
+		patch, err = json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"finalizers": app.Finalizers,
+			},
+		})
 		if err == nil {
-			app.SetCascadedDeletion(false)
-			var patch []byte
-			patch, err = json.Marshal(map[string]interface{}{
-				"metadata": map[string]interface{}{
-					"finalizers": app.Finalizers,
-				},
-			})
-			if err == nil {
-				_, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Patch(app.Name, types.MergePatchType, patch)
-			}
+			_, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Patch(app.Name, types.MergePatchType, patch)
 		}
 	}
 	if err != nil {
@@ -357,6 +805,8 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 }
 
 func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condition appv1.ApplicationCondition) {
+	now := metav1.Now()
+	condition.LastTransitionTime = &now
 	index := -1
 	for i, exiting := range app.Status.Conditions {
 		if exiting.Type == condition.Type {
@@ -365,6 +815,9 @@ func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condi
 		}
 	}
 	if index > -1 {
+		if app.Status.Conditions[index].Message == condition.Message {
+			condition.LastTransitionTime = app.Status.Conditions[index].LastTransitionTime
+		}
 		app.Status.Conditions[index] = condition
 	} else {
 		app.Status.Conditions = append(app.Status.Conditions, condition)
@@ -383,7 +836,7 @@ func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condi
 	}
 }
 
-func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Application) {
+func (ctrl *ApplicationController) processRequestedAppOperation(ctx context.Context, app *appv1.Application) {
 	logCtx := log.WithField("application", app.Name)
 	var state *appv1.OperationState
 	// Recover from any unexpected panics and automatically set the status to be failed
@@ -397,6 +850,7 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 				state.Message = fmt.Sprintf("%v", r)
 			}
 			ctrl.setOperationState(app, state)
+			ctrl.metricsServer.IncSync(app, state)
 		}
 	}()
 	if isOperationInProgress(app) {
@@ -404,7 +858,7 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		// We need to detect if the app object we pulled off the informer is stale and doesn't
 		// reflect the fact that the operation is completed. We don't want to perform the operation
 		// again. To detect this, always retrieve the latest version to ensure it is not stale.
-		freshApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(ctrl.namespace).Get(app.ObjectMeta.Name, metav1.GetOptions{})
+		freshApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(app.ObjectMeta.Name, metav1.GetOptions{})
 		if err != nil {
 			logCtx.Errorf("Failed to retrieve latest application state: %v", err)
 			return
@@ -421,12 +875,12 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		ctrl.setOperationState(app, state)
 		logCtx.Infof("Initialized new operation: %v", *app.Operation)
 	}
-	ctrl.appStateManager.SyncAppState(app, state)
+	ctrl.appStateManager.SyncAppState(ctx, app, state)
 
 	if state.Phase == appv1.OperationRunning {
 		// It's possible for an app to be terminated while we were operating on it. We do not want
 		// to clobber the Terminated state with Running. Get the latest app state to check for this.
-		freshApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(ctrl.namespace).Get(app.ObjectMeta.Name, metav1.GetOptions{})
+		freshApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(app.ObjectMeta.Name, metav1.GetOptions{})
 		if err == nil {
 			if freshApp.Status.OperationState != nil && freshApp.Status.OperationState.Phase == appv1.OperationTerminating {
 				state.Phase = appv1.OperationTerminating
@@ -440,9 +894,27 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 
 	ctrl.setOperationState(app, state)
 	if state.Phase.Completed() {
+		ctrl.metricsServer.IncSync(app, state)
 		// if we just completed an operation, force a refresh so that UI will report up-to-date
 		// sync/health information
-		ctrl.forceAppRefresh(app.ObjectMeta.Name)
+		ctrl.forceAppRefresh(appKey(app))
+	}
+}
+
+// startQueuedOperation starts the first queued operation of the application, once the operation
+// in progress completed
+func (ctrl *ApplicationController) startQueuedOperation(app *appv1.Application) {
+	logCtx := log.WithField("application", app.Name)
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	op, err := argo.StartQueuedAppOperation(appIf, app.Name)
+	if err != nil {
+		logCtx.Errorf("Failed to start queued operation: %v", err)
+		return
+	}
+	if op != nil {
+		message := fmt.Sprintf("Initiated queued sync to '%s'", op.Sync.Revision)
+		ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: v1.EventTypeNormal}, message)
+		logCtx.Info(message)
 	}
 }
 
@@ -456,15 +928,17 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 			now := metav1.Now()
 			state.FinishedAt = &now
 		}
+		statusPatch := map[string]interface{}{
+			"operationState": state,
+		}
 		patch := map[string]interface{}{
-			"status": map[string]interface{}{
-				"operationState": state,
-			},
+			"status": statusPatch,
 		}
 		if state.Phase.Completed() {
-			// If operation is completed, clear the operation field to indicate no operation is
-			// in progress.
+			// If operation is completed, clear the operation field and release the operation lock
+			// to indicate no operation is in progress.
 			patch["operation"] = nil
+			statusPatch["operationLock"] = nil
 		}
 		if reflect.DeepEqual(app.Status.OperationState, state) {
 			log.Infof("No operation updates necessary to '%s'. Skipping patch", app.Name)
@@ -474,7 +948,7 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 		if err != nil {
 			return err
 		}
-		appClient := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(ctrl.namespace)
+		appClient := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
 		_, err = appClient.Patch(app.Name, types.MergePatchType, patchJSON)
 		if err != nil {
 			return err
@@ -510,13 +984,14 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		ctrl.appRefreshQueue.Done(appKey)
 	}()
 
-	obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(appKey.(string))
+	obj, exists, err := ctrl.getApp(appKey.(string))
 	if err != nil {
 		log.Errorf("Failed to get application '%s' from informer index: %+v", appKey, err)
 		return
 	}
 	if !exists {
 		// This happens after app was deleted, but the work queue still had an entry for it.
+		ctrl.clearRefreshFailure(appKey.(string))
 		return
 	}
 	app, ok := obj.(*appv1.Application)
@@ -524,22 +999,51 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		log.Warnf("Key '%s' in index is not an application", appKey)
 		return
 	}
-	if !ctrl.needRefreshAppStatus(app, ctrl.statusRefreshTimeout) {
+	needRefresh, refreshType := ctrl.needRefreshAppStatus(app, ctrl.statusRefreshTimeout)
+	if !needRefresh {
+		return
+	}
+	pause, pausedBy := ctrl.getReconciliationPause(app)
+	if pause == appv1.ReconciliationPauseAll {
+		log.WithField("application", app.Name).Infof("Skipping comparison: reconciliation is paused by %s", pausedBy)
+		pausedCondition := reconciliationPausedCondition(pause, pausedBy)
+		for _, condition := range app.Status.Conditions {
+			if condition.Type == pausedCondition.Type && condition.Message == pausedCondition.Message {
+				return
+			}
+		}
+		ctrl.setAppCondition(app.DeepCopy(), pausedCondition)
 		return
 	}
 
+	startTime := time.Now()
+	defer func() {
+		ctrl.metricsServer.ObserveReconcile(ctrl.destinationServer(app), time.Since(startTime))
+	}()
+
+	// bound the requests to the destination cluster and the repo server, so that an unresponsive
+	// cluster or repository does not block the worker
+	ctx, cancel := context.WithTimeout(context.Background(), ctrl.reconcileTimeout)
+	defer cancel()
+
 	app = app.DeepCopy()
-	conditions, hasErrors := ctrl.refreshAppConditions(app)
+	conditions, hasErrors := ctrl.refreshAppConditions(ctx, app)
 	if hasErrors {
 		comparisonResult := app.Status.ComparisonResult.DeepCopy()
 		comparisonResult.Status = appv1.ComparisonStatusUnknown
 		health := app.Status.Health.DeepCopy()
 		health.Status = appv1.HealthStatusUnknown
-		ctrl.updateAppStatus(app, comparisonResult, health, nil, conditions)
+		ctrl.updateAppStatus(app, comparisonResult, health, nil, nil, conditions, nil)
+		ctrl.setRefreshResult(appKey.(string), app, true)
 		return
 	}
 
-	comparisonResult, manifestInfo, compConditions, err := ctrl.appStateManager.CompareAppState(app, "", nil)
+	observedDestination, destCondition := ctrl.reconcileDestination(ctx, app)
+	if destCondition != nil {
+		conditions = append(conditions, *destCondition)
+	}
+
+	comparisonResult, manifestInfo, compConditions, err := ctrl.appStateManager.CompareAppState(ctx, app, "", nil, refreshType == appv1.RefreshTypeHard)
 	if err != nil {
 		conditions = append(conditions, appv1.ApplicationCondition{Type: appv1.ApplicationConditionComparisonError, Message: err.Error()})
 	} else {
@@ -556,23 +1060,129 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		conditions = append(conditions, appv1.ApplicationCondition{Type: appv1.ApplicationConditionComparisonError, Message: err.Error()})
 	}
 
-	syncErrCond := ctrl.autoSync(app, comparisonResult)
-	if syncErrCond != nil {
-		conditions = append(conditions, *syncErrCond)
+	resources, err := getResourceStatuses(app, comparisonResult, manifestInfo)
+	if err != nil {
+		conditions = append(conditions, appv1.ApplicationCondition{Type: appv1.ApplicationConditionComparisonError, Message: err.Error()})
+	}
+
+	// auto-sync is suspended while the reconciliation is paused, and while a destination change is
+	// pending, to avoid managing resources in both the previous and the new destination
+	if pause != appv1.ReconciliationPauseNone {
+		conditions = append(conditions, reconciliationPausedCondition(pause, pausedBy))
+	} else if destCondition == nil {
+		syncErrCond := ctrl.autoSync(app, comparisonResult)
+		if syncErrCond != nil {
+			conditions = append(conditions, *syncErrCond)
+		}
 	}
 
-	ctrl.updateAppStatus(app, comparisonResult, healthState, parameters, conditions)
+	ctrl.updateAppStatus(app, comparisonResult, healthState, parameters, resources, conditions, observedDestination)
+	ctrl.setRefreshResult(appKey.(string), app, comparisonResult == nil || comparisonResult.Status == appv1.ComparisonStatusUnknown)
 	return
 }
 
-// needRefreshAppStatus answers if application status needs to be refreshed.
-// Returns true if application never been compared, has changed or comparison result has expired.
-func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application, statusRefreshTimeout time.Duration) bool {
+// getReconciliationPause returns which part of the reconciliation of the application is paused, either
+// for all applications by the argocd-cm ConfigMap or by the project of the application, and what paused it
+func (ctrl *ApplicationController) getReconciliationPause(app *appv1.Application) (appv1.ReconciliationPause, string) {
+	pause, pausedBy := appv1.ReconciliationPauseNone, ""
+	obj, exists, err := ctrl.cmInformer.GetIndexer().GetByKey(ctrl.namespace + "/" + common.ArgoCDConfigMapName)
+	if err != nil {
+		log.Warnf("Failed to get the global reconciliation pause: %v", err)
+	} else if argoCDCM, ok := obj.(*v1.ConfigMap); exists && ok {
+		pause, pausedBy = appv1.GetReconciliationPause(argoCDCM.Annotations), "the global maintenance mode"
+	}
+	proj, err := ctrl.getAppProject(app)
+	if err == nil {
+		if projPause := appv1.GetReconciliationPause(proj.Annotations); !pause.Includes(projPause) {
+			pause, pausedBy = projPause, fmt.Sprintf("the maintenance mode of project %s", proj.Name)
+		}
+	}
+	return pause, pausedBy
+}
+
+// reconciliationPausedCondition returns the condition reporting that the reconciliation of the application is paused
+func reconciliationPausedCondition(pause appv1.ReconciliationPause, pausedBy string) appv1.ApplicationCondition {
+	paused := "Automated syncs are"
+	if pause == appv1.ReconciliationPauseAll {
+		paused = "Automated syncs and comparisons are"
+	}
+	return appv1.ApplicationCondition{
+		Type:    appv1.ApplicationConditionReconciliationPausedWarning,
+		Message: fmt.Sprintf("%s paused by %s", paused, pausedBy),
+	}
+}
+
+// reconcileDestination detects that the application destination was changed after resources had
+// been deployed, and completes the change according to the requested previous destination policy.
+// Returns the destination in which the controller manages application resources, and a warning
+// condition if the destination change is still pending.
+func (ctrl *ApplicationController) reconcileDestination(ctx context.Context, app *appv1.Application) (*appv1.ApplicationDestination, *appv1.ApplicationCondition) {
+	if !app.IsDestinationChangePending() {
+		return app.Spec.Destination.DeepCopy(), nil
+	}
+	logCtx := log.WithField("application", app.Name)
+	prev := app.Status.ObservedDestination
+	policy, ok := app.GetPreviousDestinationPolicy()
+	if !ok {
+		message := fmt.Sprintf("Destination changed from %s to %s: resources at the previous destination must be pruned or orphaned before the application can be synced",
+			formatDestination(*prev), formatDestination(app.Spec.Destination))
+		return prev, &appv1.ApplicationCondition{Type: appv1.ApplicationConditionDestinationChangedWarning, Message: message}
+	}
+	if policy == appv1.PreviousDestinationPolicyPrune {
+		prevClst, err := argo.GetDestinationCluster(ctx, *prev, ctrl.db)
+		var clst *appv1.Cluster
+		if err == nil {
+			clst, err = argo.GetDestinationCluster(ctx, app.Spec.Destination, ctrl.db)
+		}
+		if err == nil {
+			err = kube.DeleteResourcesWithLabel(ctx, ctrl.kubeClientMetrics.WrapClusterConfig(prevClst.RESTConfig()), prev.Namespace, common.LabelApplicationName, argo.TrackingLabelValue(app, ctrl.namespace), pruneNamespacedOnly(prevClst, clst))
+		}
+		if err != nil {
+			message := fmt.Sprintf("Unable to prune resources at previous destination %s: %v", formatDestination(*prev), err)
+			return prev, &appv1.ApplicationCondition{Type: appv1.ApplicationConditionDestinationChangedWarning, Message: message}
+		}
+	}
+	message := fmt.Sprintf("Completed destination change from %s to %s (%s previous resources)", formatDestination(*prev), formatDestination(app.Spec.Destination), policy)
+	ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonResourceUpdated, Type: v1.EventTypeNormal}, message)
+	logCtx.Info(message)
+	return app.Spec.Destination.DeepCopy(), nil
+}
+
+// pruneNamespacedOnly returns whether only the namespaced resources are pruned from the previous destination
+// cluster, either because it is restricted to namespaces or because the application still uses its
+// cluster-scoped resources at the new destination in the same cluster
+func pruneNamespacedOnly(prevClst *appv1.Cluster, clst *appv1.Cluster) bool {
+	return prevClst.IsNamespaced() || prevClst.Server == clst.Server
+}
+
+// formatDestination returns a human readable representation of the destination
+func formatDestination(dest appv1.ApplicationDestination) string {
+	cluster := dest.Server
+	if dest.Name != "" {
+		cluster = dest.Name
+	}
+	return fmt.Sprintf("%s/%s", cluster, dest.Namespace)
+}
+
+// needRefreshAppStatus answers if application status needs to be refreshed, and how thoroughly.
+// Returns true if application never been compared, has changed, comparison result has expired or
+// a refresh was requested.
+func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application, statusRefreshTimeout time.Duration) (bool, appv1.RefreshType) {
 	logCtx := log.WithFields(log.Fields{"application": app.Name})
 	var reason string
+	refreshType := appv1.RefreshTypeNormal
 	expired := app.Status.ComparisonResult.ComparedAt.Add(statusRefreshTimeout).Before(time.Now().UTC())
-	if ctrl.isRefreshForced(app.Name) {
+	if requestedType, ok := app.GetRefreshType(); ok {
+		refreshType = requestedType
+		reason = fmt.Sprintf("%s refresh requested", refreshType)
+	} else if ctrl.isRefreshForced(appKey(app)) {
 		reason = "force refresh"
+	} else if retryAt, ok := ctrl.getRefreshRetry(app); ok {
+		// a failed reconciliation is only retried once its backoff expired, unless the source changed
+		if time.Now().Before(retryAt) {
+			return false, refreshType
+		}
+		reason = "retrying failed reconciliation"
 	} else if app.Status.ComparisonResult.Status == appv1.ComparisonStatusUnknown && expired {
 		reason = "comparison status unknown"
 	} else if !app.Spec.Source.Equals(app.Status.ComparisonResult.ComparedTo) {
@@ -582,12 +1192,12 @@ func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application,
 	}
 	if reason != "" {
 		logCtx.Infof("Refreshing app status (%s)", reason)
-		return true
+		return true, refreshType
 	}
-	return false
+	return false, refreshType
 }
 
-func (ctrl *ApplicationController) refreshAppConditions(app *appv1.Application) ([]appv1.ApplicationCondition, bool) {
+func (ctrl *ApplicationController) refreshAppConditions(ctx context.Context, app *appv1.Application) ([]appv1.ApplicationCondition, bool) {
 	conditions := make([]appv1.ApplicationCondition, 0)
 	proj, err := argo.GetAppProject(&app.Spec, ctrl.applicationClientset, ctrl.namespace)
 	if err != nil {
@@ -603,7 +1213,7 @@ func (ctrl *ApplicationController) refreshAppConditions(app *appv1.Application)
 			})
 		}
 	} else {
-		specConditions, err := argo.GetSpecErrors(context.Background(), &app.Spec, proj, ctrl.repoClientset, ctrl.db)
+		specConditions, err := argo.GetSpecErrors(ctx, &app.Spec, proj, ctrl.repoClientset, ctrl.db)
 		if err != nil {
 			conditions = append(conditions, appv1.ApplicationCondition{
 				Type:    appv1.ApplicationConditionUnknownError,
@@ -614,21 +1224,14 @@ func (ctrl *ApplicationController) refreshAppConditions(app *appv1.Application)
 		}
 	}
 
-	// List of condition types which have to be reevaluated by controller; all remaining conditions should stay as is.
-	reevaluateTypes := map[appv1.ApplicationConditionType]bool{
-		appv1.ApplicationConditionInvalidSpecError:      true,
-		appv1.ApplicationConditionUnknownError:          true,
-		appv1.ApplicationConditionComparisonError:       true,
-		appv1.ApplicationConditionSharedResourceWarning: true,
-		appv1.ApplicationConditionSyncError:             true,
-	}
-	appConditions := make([]appv1.ApplicationCondition, 0)
-	for i := 0; i < len(app.Status.Conditions); i++ {
-		condition := app.Status.Conditions[i]
-		if _, ok := reevaluateTypes[condition.Type]; !ok {
-			appConditions = append(appConditions, condition)
-		}
+	if other := ctrl.findTrackingLabelCollision(app); other != "" {
+		conditions = append(conditions, appv1.ApplicationCondition{
+			Type:    appv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("Resources of application are tracked with the same label value '%s' as resources of application '%s'", kube.TruncateLabelValue(app.Name), other),
+		})
 	}
+
+	appConditions := retainedConditions(app.Status.Conditions)
 	hasErrors := false
 	for i := range conditions {
 		condition := conditions[i]
@@ -641,6 +1244,31 @@ func (ctrl *ApplicationController) refreshAppConditions(app *appv1.Application)
 	return appConditions, hasErrors
 }
 
+// List of condition types which have to be reevaluated by controller; all remaining conditions should stay as is.
+var reevaluateTypes = map[appv1.ApplicationConditionType]bool{
+	appv1.ApplicationConditionInvalidSpecError:            true,
+	appv1.ApplicationConditionUnknownError:                true,
+	appv1.ApplicationConditionComparisonError:             true,
+	appv1.ApplicationConditionResourceLimitError:          true,
+	appv1.ApplicationConditionSharedResourceWarning:       true,
+	appv1.ApplicationConditionSyncError:                   true,
+	appv1.ApplicationConditionDestinationChangedWarning:   true,
+	appv1.ApplicationConditionReconciliationPausedWarning: true,
+	appv1.ApplicationConditionDeprecatedAPIWarning:        true,
+}
+
+// retainedConditions returns the conditions which are not reevaluated by the controller on refresh
+func retainedConditions(conditions []appv1.ApplicationCondition) []appv1.ApplicationCondition {
+	appConditions := make([]appv1.ApplicationCondition, 0)
+	for i := 0; i < len(conditions); i++ {
+		condition := conditions[i]
+		if _, ok := reevaluateTypes[condition.Type]; !ok {
+			appConditions = append(appConditions, condition)
+		}
+	}
+	return appConditions
+}
+
 // setApplicationHealth updates the health statuses of all resources performed in the comparison
 func setApplicationHealth(kubectl kube.Kubectl, comparisonResult *appv1.ComparisonResult) (*appv1.HealthStatus, error) {
 	var savedErr error
@@ -671,16 +1299,135 @@ func setApplicationHealth(kubectl kube.Kubectl, comparisonResult *appv1.Comparis
 	return &appHealth, savedErr
 }
 
+// setConditionTransitionTimes sets the transition time of conditions which were not observed before. Conditions
+// which already existed with the same type and message retain their original transition time.
+func setConditionTransitionTimes(existing []appv1.ApplicationCondition, conditions []appv1.ApplicationCondition) {
+	now := metav1.Now()
+	for i := range conditions {
+		if conditions[i].LastTransitionTime != nil {
+			continue
+		}
+		conditions[i].LastTransitionTime = &now
+		for _, prev := range existing {
+			if prev.Type == conditions[i].Type && prev.Message == conditions[i].Message && prev.LastTransitionTime != nil {
+				conditions[i].LastTransitionTime = prev.LastTransitionTime
+				break
+			}
+		}
+	}
+}
+
+// getResourceStatuses returns the sync and health status of each resource managed by the application,
+// including the hooks found in the generated manifests
+func getResourceStatuses(app *appv1.Application, comparisonResult *appv1.ComparisonResult, manifestInfo *repository.ManifestResponse) ([]appv1.ResourceStatus, error) {
+	resources := make([]appv1.ResourceStatus, 0)
+	if comparisonResult == nil {
+		return resources, nil
+	}
+	for _, resource := range comparisonResult.Resources {
+		obj, err := resource.TargetObject()
+		if err != nil {
+			return nil, err
+		}
+		if obj == nil {
+			obj, err = resource.LiveObject()
+			if err != nil {
+				return nil, err
+			}
+		}
+		if obj == nil {
+			continue
+		}
+		resStatus := newResourceStatus(obj, app.Spec.Destination.Namespace)
+		resStatus.Status = resource.Status
+		resStatus.Health = resource.Health
+		resources = append(resources, resStatus)
+	}
+	if manifestInfo == nil {
+		if comparisonResult.TargetHash != "" && comparisonResult.TargetHash == app.Status.ComparisonResult.TargetHash {
+			// the target manifests of the previous comparison were reused, so were its hooks
+			for _, resStatus := range app.Status.Resources {
+				if resStatus.Hook {
+					hook := &unstructured.Unstructured{}
+					hook.SetKind(resStatus.Kind)
+					hook.SetName(resStatus.Name)
+					resStatus.Health = getHookHealth(app, hook)
+					resources = append(resources, resStatus)
+				}
+			}
+		}
+		return resources, nil
+	}
+	for _, manifest := range manifestInfo.Manifests {
+		obj, err := appv1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return nil, err
+		}
+		if obj == nil || !isHook(obj) {
+			continue
+		}
+		resStatus := newResourceStatus(obj, app.Spec.Destination.Namespace)
+		resStatus.Hook = true
+		resStatus.Health = getHookHealth(app, obj)
+		resources = append(resources, resStatus)
+	}
+	return resources, nil
+}
+
+func newResourceStatus(obj *unstructured.Unstructured, defaultNamespace string) appv1.ResourceStatus {
+	gvk := obj.GroupVersionKind()
+	namespace := obj.GetNamespace()
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+	return appv1.ResourceStatus{
+		Group:     gvk.Group,
+		Version:   gvk.Version,
+		Kind:      gvk.Kind,
+		Namespace: namespace,
+		Name:      obj.GetName(),
+	}
+}
+
+// getHookHealth infers the health of a hook from its status in the most recent sync operation
+func getHookHealth(app *appv1.Application, hook *unstructured.Unstructured) appv1.HealthStatus {
+	hookHealth := appv1.HealthStatus{Status: appv1.HealthStatusUnknown}
+	if app.Status.OperationState == nil || app.Status.OperationState.SyncResult == nil {
+		return hookHealth
+	}
+	for _, hookStatus := range app.Status.OperationState.SyncResult.Hooks {
+		if hookStatus.Kind != hook.GetKind() || hookStatus.Name != hook.GetName() {
+			continue
+		}
+		switch hookStatus.Status {
+		case appv1.OperationSucceeded:
+			hookHealth.Status = appv1.HealthStatusHealthy
+		case appv1.OperationFailed, appv1.OperationError:
+			hookHealth.Status = appv1.HealthStatusDegraded
+		case appv1.OperationRunning:
+			hookHealth.Status = appv1.HealthStatusProgressing
+		}
+		hookHealth.StatusDetails = hookStatus.Message
+	}
+	return hookHealth
+}
+
 // updateAppStatus persists updates to application status. Detects if there patch
 func (ctrl *ApplicationController) updateAppStatus(
 	app *appv1.Application,
 	comparisonResult *appv1.ComparisonResult,
 	healthState *appv1.HealthStatus,
 	parameters []*appv1.ComponentParameter,
+	resources []appv1.ResourceStatus,
 	conditions []appv1.ApplicationCondition,
+	observedDestination *appv1.ApplicationDestination,
 ) {
 	logCtx := log.WithFields(log.Fields{"application": app.Name})
 	modifiedApp := app.DeepCopy()
+	now := metav1.Now()
+	modifiedApp.Status.ReconciledAt = &now
+	// the requested refresh has been performed
+	delete(modifiedApp.Annotations, common.AnnotationKeyRefreshType)
 	if comparisonResult != nil {
 		modifiedApp.Status.ComparisonResult = *comparisonResult
 		if app.Status.ComparisonResult.Status != comparisonResult.Status {
@@ -702,9 +1449,20 @@ func (ctrl *ApplicationController) updateAppStatus(
 			modifiedApp.Status.Parameters[i] = *parameters[i]
 		}
 	}
+	if resources != nil {
+		modifiedApp.Status.Resources = resources
+	}
 	if conditions != nil {
+		setConditionTransitionTimes(app.Status.Conditions, conditions)
 		modifiedApp.Status.Conditions = conditions
 	}
+	if observedDestination != nil {
+		modifiedApp.Status.ObservedDestination = observedDestination
+		if observedDestination.Equals(app.Spec.Destination) {
+			// the destination change has been completed
+			delete(modifiedApp.Annotations, common.AnnotationKeyPreviousDestinationPolicy)
+		}
+	}
 	origBytes, err := json.Marshal(app)
 	if err != nil {
 		logCtx.Errorf("Error updating (marshal orig app): %v", err)
@@ -735,11 +1493,21 @@ func (ctrl *ApplicationController) updateAppStatus(
 
 // autoSync will initiate a sync operation for an application configured with automated sync
 func (ctrl *ApplicationController) autoSync(app *appv1.Application, comparisonResult *appv1.ComparisonResult) *appv1.ApplicationCondition {
-	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil {
+	if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Automated == nil {
+		// the sync policy of the application overrides the default sync policy of its project
+		return nil
+	}
+	proj, err := ctrl.getAppProject(app)
+	if err != nil {
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: fmt.Sprintf("Failed to load application project: %v", err)}
+	}
+	// applications without a sync policy inherit the default sync policy of their project
+	policy := proj.GetSyncPolicy(&app.Spec)
+	if policy == nil || policy.Automated == nil {
 		return nil
 	}
 	logCtx := log.WithFields(log.Fields{"application": app.Name})
-	if app.Operation != nil {
+	if app.Operation != nil || len(app.Status.QueuedOperations) > 0 {
 		logCtx.Infof("Skipping auto-sync: another operation is in progress")
 		return nil
 	}
@@ -749,6 +1517,18 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, comparisonRe
 		logCtx.Infof("Skipping auto-sync: application status is %s", comparisonResult.Status)
 		return nil
 	}
+	if schedule := policy.Automated.Schedule; schedule != "" {
+		sched, err := cron.ParseStandard(schedule)
+		if err != nil {
+			return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: fmt.Sprintf("Invalid automated sync schedule '%s': %v", schedule, err)}
+		}
+		now := time.Now()
+		next := nextScheduledSync(app, sched, now, autoSyncScheduleLookback*ctrl.statusRefreshTimeout)
+		if next.After(now) {
+			logCtx.Infof("Skipping auto-sync: next scheduled sync at %v", next)
+			return nil
+		}
+	}
 	desiredCommitSHA := comparisonResult.Revision
 
 	// It is possible for manifests to remain OutOfSync even after a sync/kubectl apply (e.g.
@@ -756,24 +1536,36 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, comparisonRe
 	// application in an infinite loop. To detect this, we only attempt the Sync if the revision
 	// and parameter overrides are different from our most recent sync operation.
 	if alreadyAttemptedSync(app, desiredCommitSHA) {
-		if app.Status.OperationState.Phase != appv1.OperationSucceeded {
-			logCtx.Warnf("Skipping auto-sync: failed previous sync attempt to %s", desiredCommitSHA)
-			message := fmt.Sprintf("Failed sync attempt to %s: %s", desiredCommitSHA, app.Status.OperationState.Message)
-			return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: message}
+		switch app.Status.OperationState.Phase {
+		case appv1.OperationSucceeded:
+			logCtx.Infof("Skipping auto-sync: most recent sync already to %s", desiredCommitSHA)
+			return nil
+		case appv1.OperationTerminated:
+			// the sync was stopped by a user, who does not expect it to be retried nor reported as failed
+			logCtx.Infof("Skipping auto-sync: most recent sync to %s was terminated", desiredCommitSHA)
+			return nil
 		}
-		logCtx.Infof("Skipping auto-sync: most recent sync already to %s", desiredCommitSHA)
-		return nil
+		logCtx.Warnf("Skipping auto-sync: failed previous sync attempt to %s", desiredCommitSHA)
+		message := fmt.Sprintf("Failed sync attempt to %s: %s", desiredCommitSHA, app.Status.OperationState.Message)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: message}
 	}
 
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
 			Revision:           desiredCommitSHA,
-			Prune:              app.Spec.SyncPolicy.Automated.Prune,
+			Prune:              policy.Automated.Prune,
 			ParameterOverrides: app.Spec.Source.ComponentParameterOverrides,
 		},
+		InitiatedBy: appv1.OperationInitiator{Automated: true},
 	}
+	proj.ApplySyncOptions(op.Sync)
 	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
-	_, err := argo.SetAppOperation(context.Background(), appIf, ctrl.auditLogger, app.Name, &op)
+	_, err = argo.SetAppOperation(context.Background(), appIf, ctrl.auditLogger, app.Name, &op)
+	if argo.IsOperationLockedError(err) {
+		// an operation was requested since the application was last processed
+		logCtx.Infof("Skipping auto-sync: %s", status.Convert(err).Message())
+		return nil
+	}
 	if err != nil {
 		logCtx.Errorf("Failed to initiate auto-sync to %s: %v", desiredCommitSHA, err)
 		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: err.Error()}
@@ -784,8 +1576,19 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, comparisonRe
 	return nil
 }
 
+// nextScheduledSync returns the time of the next scheduled automated sync. Since applications are
+// only refreshed periodically, a scheduled time which passed within the lookback duration, and after
+// the most recent operation, is still due
+func nextScheduledSync(app *appv1.Application, sched cron.Schedule, now time.Time, lookback time.Duration) time.Time {
+	since := now.Add(-lookback)
+	if app.Status.OperationState != nil && app.Status.OperationState.StartedAt.After(since) {
+		since = app.Status.OperationState.StartedAt.Time
+	}
+	return sched.Next(since)
+}
+
 // alreadyAttemptedSync returns whether or not the most recent sync was performed against the
-// commitSHA and with the same parameter overrides which are currently set in the app
+// commitSHA and with the same parameter overrides and key/value overrides which are currently set in the app
 func alreadyAttemptedSync(app *appv1.Application, commitSHA string) bool {
 	if app.Status.OperationState == nil || app.Status.OperationState.Operation.Sync == nil || app.Status.OperationState.SyncResult == nil {
 		return false
@@ -796,17 +1599,35 @@ func alreadyAttemptedSync(app *appv1.Application, commitSHA string) bool {
 	if !reflect.DeepEqual(appv1.ParameterOverrides(app.Spec.Source.ComponentParameterOverrides), app.Status.OperationState.Operation.Sync.ParameterOverrides) {
 		return false
 	}
+	syncedOverride := app.Status.OperationState.SyncResult.Source.Override
+	if len(app.Spec.Source.Override) != len(syncedOverride) {
+		return false
+	}
+	for key, value := range app.Spec.Source.Override {
+		if syncedValue, ok := syncedOverride[key]; !ok || syncedValue != value {
+			return false
+		}
+	}
 	return true
 }
 
-func (ctrl *ApplicationController) newApplicationInformer() cache.SharedIndexInformer {
+func (ctrl *ApplicationController) newApplicationInformer(namespace string) cache.SharedIndexInformer {
 	appInformerFactory := appinformers.NewFilteredSharedInformerFactory(
 		ctrl.applicationClientset,
 		ctrl.statusRefreshTimeout,
-		ctrl.namespace,
+		namespace,
 		func(options *metav1.ListOptions) {},
 	)
 	informer := appInformerFactory.Argoproj().V1alpha1().Applications().Informer()
+	err := informer.AddIndexers(cache.Indexers{trackingLabelIndex: func(obj interface{}) ([]string, error) {
+		if app, ok := obj.(*appv1.Application); ok {
+			return []string{argo.TrackingLabelValue(app, ctrl.namespace)}, nil
+		}
+		return nil, nil
+	}})
+	if err != nil {
+		log.Errorf("Failed to index applications of namespace %s by tracking label: %v", namespace, err)
+	}
 	informer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
@@ -824,9 +1645,17 @@ func (ctrl *ApplicationController) newApplicationInformer() cache.SharedIndexInf
 				oldApp, oldOK := old.(*appv1.Application)
 				newApp, newOK := new.(*appv1.Application)
 				if oldOK && newOK {
-					if toggledAutomatedSync(oldApp, newApp) {
+					proj, err := ctrl.getAppProject(newApp)
+					if err != nil {
+						proj = &appv1.AppProject{}
+					}
+					if toggledAutomatedSync(proj, oldApp, newApp) {
 						log.WithField("application", newApp.Name).Info("Enabled automated sync")
-						ctrl.forceAppRefresh(newApp.Name)
+						ctrl.forceAppRefresh(key)
+					}
+					if changedDestination(oldApp, newApp) {
+						log.WithField("application", newApp.Name).Info("Destination or previous destination policy changed")
+						ctrl.forceAppRefresh(key)
 					}
 				}
 				ctrl.appRefreshQueue.Add(key)
@@ -849,16 +1678,21 @@ func isOperationInProgress(app *appv1.Application) bool {
 	return app.Status.OperationState != nil && !app.Status.OperationState.Phase.Completed()
 }
 
-// toggledAutomatedSync tests if an app went from auto-sync disabled to enabled.
-// if it was toggled to be enabled, the informer handler will force a refresh
-func toggledAutomatedSync(old *appv1.Application, new *appv1.Application) bool {
-	if new.Spec.SyncPolicy == nil || new.Spec.SyncPolicy.Automated == nil {
+// toggledAutomatedSync tests if an app went from auto-sync disabled to enabled, taking the default
+// sync policy of its project into account. If it was toggled to be enabled, the informer handler
+// will force a refresh
+func toggledAutomatedSync(proj *appv1.AppProject, old *appv1.Application, new *appv1.Application) bool {
+	if !proj.IsAutomatedSync(&new.Spec) {
 		return false
 	}
 	// auto-sync is enabled. check if it was previously disabled
-	if old.Spec.SyncPolicy == nil || old.Spec.SyncPolicy.Automated == nil {
-		return true
-	}
-	// nothing changed
-	return false
+	return !proj.IsAutomatedSync(&old.Spec)
+}
+
+// changedDestination tests if the app destination or the policy for the previous destination
+// changed. If so, the informer handler will force a refresh to (re)evaluate the destination change
+func changedDestination(old *appv1.Application, new *appv1.Application) bool {
+	oldPolicy, _ := old.GetPreviousDestinationPolicy()
+	newPolicy, _ := new.GetPreviousDestinationPolicy()
+	return !old.Spec.Destination.Equals(new.Spec.Destination) || oldPolicy != newPolicy
 }
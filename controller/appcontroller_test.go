@@ -1,31 +1,49 @@
 package controller
 
 import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/ghodss/yaml"
+	"github.com/robfig/cron"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/api/core/v1"
+	appsv1beta1 "k8s.io/api/extensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 
+	"github.com/argoproj/argo-cd/common"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
 	reposerver "github.com/argoproj/argo-cd/reposerver/mocks"
+	"github.com/argoproj/argo-cd/reposerver/repository"
+	mockrepository "github.com/argoproj/argo-cd/reposerver/repository/mocks"
+	"github.com/argoproj/argo-cd/util/argo"
+	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/stretchr/testify/assert"
 )
 
 func newFakeController(apps ...runtime.Object) *ApplicationController {
+	return newFakeControllerWithProject(defaultProj(), apps...)
+}
+
+func newFakeControllerWithProject(proj *argoappv1.AppProject, apps ...runtime.Object) *ApplicationController {
 	kubeClientset := fake.NewSimpleClientset()
-	appClientset := appclientset.NewSimpleClientset(apps...)
+	appClientset := appclientset.NewSimpleClientset(append(apps, proj)...)
 	repoClientset := reposerver.Clientset{}
-	return NewApplicationController(
-		"argocd",
-		kubeClientset,
-		appClientset,
-		&repoClientset,
-		time.Minute,
-	)
+	ctrl := NewApplicationController("argocd", kubeClientset, appClientset, &repoClientset, time.Minute, ApplicationControllerOpts{ReconcileTimeout: time.Minute})
+	// the informers are not run by the tests
+	if err := ctrl.projInformer.GetIndexer().Add(proj); err != nil {
+		panic(err)
+	}
+	return ctrl
 }
 
 var fakeApp = `
@@ -65,6 +83,16 @@ status:
       revision: aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa
 `
 
+func defaultProj() *argoappv1.AppProject {
+	return &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+}
+
 func newFakeApp() *argoappv1.Application {
 	var app argoappv1.Application
 	err := yaml.Unmarshal([]byte(fakeApp), &app)
@@ -88,6 +116,7 @@ func TestAutoSync(t *testing.T) {
 	assert.NotNil(t, app.Operation)
 	assert.NotNil(t, app.Operation.Sync)
 	assert.False(t, app.Operation.Sync.Prune)
+	assert.True(t, app.Operation.InitiatedBy.Automated)
 }
 
 func TestSkipAutoSync(t *testing.T) {
@@ -154,6 +183,50 @@ func TestSkipAutoSync(t *testing.T) {
 	app, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications("argocd").Get("my-app", metav1.GetOptions{})
 	assert.NoError(t, err)
 	assert.Nil(t, app.Operation)
+
+	// Verify we skip without an error condition when the previous sync attempt was terminated
+	app = newFakeApp()
+	app.Status.OperationState = &argoappv1.OperationState{
+		Operation: argoappv1.Operation{
+			Sync: &argoappv1.SyncOperation{},
+		},
+		Phase:   argoappv1.OperationTerminated,
+		Message: "Operation terminated",
+		SyncResult: &argoappv1.SyncOperationResult{
+			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+		},
+	}
+	ctrl = newFakeController(app)
+	cond = ctrl.autoSync(app, &compRes)
+	assert.Nil(t, cond)
+	app, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications("argocd").Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
+
+// TestAutoSyncOperationInProgress verifies we skip auto-sync without an error condition if an
+// operation was requested since the application was last processed
+func TestAutoSyncOperationInProgress(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(app)
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications("argocd")
+	manualOp := argoappv1.Operation{
+		Sync:        &argoappv1.SyncOperation{Revision: "cccccccccccccccccccccccccccccccccccccccc"},
+		InitiatedBy: argoappv1.OperationInitiator{Username: "admin"},
+	}
+	_, err := argo.SetAppOperation(context.Background(), appIf, ctrl.auditLogger, app.Name, &manualOp)
+	assert.NoError(t, err)
+
+	compRes := argoappv1.ComparisonResult{
+		Status:   argoappv1.ComparisonStatusOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond := ctrl.autoSync(app, &compRes)
+	assert.Nil(t, cond)
+	app, err = appIf.Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, &manualOp, app.Operation)
+	assert.Equal(t, "admin", app.Status.OperationLock.Holder)
 }
 
 // TestAutoSyncIndicateError verifies we skip auto-sync and return error condition if previous sync failed
@@ -229,3 +302,510 @@ func TestAutoSyncParameterOverrides(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, app.Operation)
 }
+
+// TestAutoSyncSourceOverride verifies we auto-sync if revision is same but the key/value overrides are different
+func TestAutoSyncSourceOverride(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Source.Override = map[string]string{"image.tag": "v2"}
+	compRes := argoappv1.ComparisonResult{
+		Status:   argoappv1.ComparisonStatusOutOfSync,
+		Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+	}
+	app.Status.OperationState = &argoappv1.OperationState{
+		Operation: argoappv1.Operation{
+			Sync: &argoappv1.SyncOperation{},
+		},
+		Phase: argoappv1.OperationFailed,
+		SyncResult: &argoappv1.SyncOperationResult{
+			Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			Source:   argoappv1.ApplicationSource{Override: map[string]string{"image.tag": "v1"}},
+		},
+	}
+	ctrl := newFakeController(app)
+	cond := ctrl.autoSync(app, &compRes)
+	assert.Nil(t, cond)
+	updated, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications("argocd").Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, updated.Operation)
+
+	// the sync is not retried while the overrides are unchanged
+	app.Status.OperationState.SyncResult.Source.Override = map[string]string{"image.tag": "v2"}
+	ctrl = newFakeController(app)
+	cond = ctrl.autoSync(app, &compRes)
+	assert.NotNil(t, cond)
+	updated, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications("argocd").Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, updated.Operation)
+}
+
+// TestAutoSyncProjectDefaults verifies applications without a sync policy inherit the one of their
+// project, and the project sync options are applied to the automated sync
+func TestAutoSyncProjectDefaults(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.SyncPolicy = nil
+	prune := false
+	proj := defaultProj()
+	proj.Spec.SyncPolicy = &argoappv1.SyncPolicy{Automated: &argoappv1.SyncPolicyAutomated{Prune: true}}
+	proj.Spec.SyncOptions = &argoappv1.ProjectSyncOptions{
+		DefaultStrategy: &argoappv1.SyncStrategy{Apply: &argoappv1.SyncStrategyApply{}},
+		Prune:           &prune,
+	}
+	ctrl := newFakeControllerWithProject(proj, app)
+	compRes := argoappv1.ComparisonResult{
+		Status:   argoappv1.ComparisonStatusOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond := ctrl.autoSync(app, &compRes)
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications("argocd").Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, app.Operation)
+	assert.False(t, app.Operation.Sync.Prune)
+	assert.NotNil(t, app.Operation.Sync.SyncStrategy.Apply)
+
+	// an application opting out of automated sync does not inherit the project default
+	app.Operation = nil
+	app.Spec.SyncPolicy = &argoappv1.SyncPolicy{}
+	ctrl = newFakeControllerWithProject(proj, app)
+	cond = ctrl.autoSync(app, &compRes)
+	assert.Nil(t, cond)
+	app, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications("argocd").Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
+
+func TestAutoSyncDisabledWithoutProject(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Project = "missing"
+	app.Spec.SyncPolicy = &argoappv1.SyncPolicy{}
+	ctrl := newFakeController(app)
+	compRes := argoappv1.ComparisonResult{
+		Status:   argoappv1.ComparisonStatusOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	// the project is not needed to skip applications which are not synced automatically
+	assert.Nil(t, ctrl.autoSync(app, &compRes))
+
+	app.Spec.SyncPolicy = nil
+	cond := ctrl.autoSync(app, &compRes)
+	if assert.NotNil(t, cond) {
+		assert.Equal(t, argoappv1.ApplicationConditionSyncError, cond.Type)
+	}
+}
+
+func TestToggledAutomatedSync(t *testing.T) {
+	proj := defaultProj()
+	disabled := newFakeApp()
+	disabled.Spec.SyncPolicy = &argoappv1.SyncPolicy{}
+	enabled := newFakeApp()
+	inheriting := newFakeApp()
+	inheriting.Spec.SyncPolicy = nil
+
+	assert.True(t, toggledAutomatedSync(proj, disabled, enabled))
+	assert.False(t, toggledAutomatedSync(proj, enabled, disabled))
+	assert.False(t, toggledAutomatedSync(proj, disabled, inheriting))
+
+	// the default sync policy of the project enables auto-sync of the applications inheriting it
+	proj.Spec.SyncPolicy = &argoappv1.SyncPolicy{Automated: &argoappv1.SyncPolicyAutomated{}}
+	assert.True(t, toggledAutomatedSync(proj, disabled, inheriting))
+	assert.False(t, toggledAutomatedSync(proj, inheriting, enabled))
+}
+
+func TestGetResourceStatuses(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState.SyncResult.Hooks = []*argoappv1.HookStatus{{
+		Kind:   "Job",
+		Name:   "my-hook",
+		Type:   argoappv1.HookTypePreSync,
+		Status: argoappv1.OperationSucceeded,
+	}}
+	compRes := argoappv1.ComparisonResult{
+		Resources: []argoappv1.ResourceState{{
+			TargetState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"my-deploy"}}`,
+			LiveState:   "null",
+			Status:      argoappv1.ComparisonStatusOutOfSync,
+			Health:      argoappv1.HealthStatus{Status: argoappv1.HealthStatusMissing},
+		}},
+	}
+	manifestInfo := repository.ManifestResponse{
+		Manifests: []string{
+			`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"my-deploy"}}`,
+			`{"apiVersion":"batch/v1","kind":"Job","metadata":{"name":"my-hook","annotations":{"argocd.argoproj.io/hook":"PreSync"}}}`,
+		},
+	}
+	resources, err := getResourceStatuses(app, &compRes, &manifestInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, []argoappv1.ResourceStatus{{
+		Group:     "apps",
+		Version:   "v1",
+		Kind:      "Deployment",
+		Namespace: "dummy-namespace",
+		Name:      "my-deploy",
+		Status:    argoappv1.ComparisonStatusOutOfSync,
+		Health:    argoappv1.HealthStatus{Status: argoappv1.HealthStatusMissing},
+	}, {
+		Group:     "batch",
+		Version:   "v1",
+		Kind:      "Job",
+		Namespace: "dummy-namespace",
+		Name:      "my-hook",
+		Hook:      true,
+		Health:    argoappv1.HealthStatus{Status: argoappv1.HealthStatusHealthy},
+	}}, resources)
+
+	// the hooks are kept when the target manifests of the previous comparison are reused
+	app.Status.Resources = resources
+	app.Status.ComparisonResult.TargetHash = "abc"
+	compRes.TargetHash = "abc"
+	reused, err := getResourceStatuses(app, &compRes, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, resources, reused)
+
+	compRes.TargetHash = ""
+	resources, err = getResourceStatuses(app, &compRes, nil)
+	assert.NoError(t, err)
+	assert.Len(t, resources, 1)
+}
+
+func TestSetConditionTransitionTimes(t *testing.T) {
+	observedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+	existing := []argoappv1.ApplicationCondition{{
+		Type:               argoappv1.ApplicationConditionComparisonError,
+		Message:            "failed to compare",
+		LastTransitionTime: &observedAt,
+	}}
+	conditions := []argoappv1.ApplicationCondition{{
+		Type:    argoappv1.ApplicationConditionComparisonError,
+		Message: "failed to compare",
+	}, {
+		Type:    argoappv1.ApplicationConditionInvalidSpecError,
+		Message: "invalid spec",
+	}}
+	setConditionTransitionTimes(existing, conditions)
+	assert.Equal(t, &observedAt, conditions[0].LastTransitionTime)
+	assert.NotNil(t, conditions[1].LastTransitionTime)
+	assert.True(t, conditions[1].LastTransitionTime.After(observedAt.Time))
+}
+
+func TestNeedRefreshAppStatus(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(app)
+	app.Status.ComparisonResult.ComparedAt = metav1.Now()
+	app.Status.ComparisonResult.ComparedTo = app.Spec.Source
+
+	needRefresh, _ := ctrl.needRefreshAppStatus(app, time.Hour)
+	assert.False(t, needRefresh)
+
+	app.Annotations = map[string]string{common.AnnotationKeyRefreshType: string(argoappv1.RefreshTypeHard)}
+	needRefresh, refreshType := ctrl.needRefreshAppStatus(app, time.Hour)
+	assert.True(t, needRefresh)
+	assert.Equal(t, argoappv1.RefreshTypeHard, refreshType)
+}
+
+func TestRefreshBackoffDelay(t *testing.T) {
+	backoff := RefreshBackoff{Initial: 10 * time.Second, Max: time.Minute}
+	assert.Equal(t, 10*time.Second, backoff.Delay(1))
+	assert.Equal(t, 20*time.Second, backoff.Delay(2))
+	assert.Equal(t, 40*time.Second, backoff.Delay(3))
+	assert.Equal(t, time.Minute, backoff.Delay(100))
+
+	backoff.Jitter = 0.5
+	for i := 0; i < 10; i++ {
+		delay := backoff.Delay(1)
+		assert.True(t, delay >= 10*time.Second && delay <= 15*time.Second)
+	}
+}
+
+func TestNeedRefreshAppStatusBackoff(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(app)
+	ctrl.refreshBackoff = RefreshBackoff{Initial: time.Hour, Max: time.Hour}
+	// the app has never been compared, so the source differs from the compared one
+	needRefresh, _ := ctrl.needRefreshAppStatus(app, time.Hour)
+	assert.True(t, needRefresh)
+
+	// the failed reconciliation is not retried before the backoff expires
+	ctrl.setRefreshResult("argocd/"+app.Name, app, true)
+	needRefresh, _ = ctrl.needRefreshAppStatus(app, time.Hour)
+	assert.False(t, needRefresh)
+
+	// explicit refreshes and source changes are not delayed
+	refreshApp := app.DeepCopy()
+	refreshApp.Annotations = map[string]string{common.AnnotationKeyRefreshType: string(argoappv1.RefreshTypeNormal)}
+	needRefresh, _ = ctrl.needRefreshAppStatus(refreshApp, time.Hour)
+	assert.True(t, needRefresh)
+	changedApp := app.DeepCopy()
+	changedApp.Spec.Source.Path = "other"
+	needRefresh, _ = ctrl.needRefreshAppStatus(changedApp, time.Hour)
+	assert.True(t, needRefresh)
+
+	// the backoff is reset by a successful reconciliation
+	ctrl.setRefreshResult("argocd/"+app.Name, app, false)
+	_, ok := ctrl.getRefreshRetry(app)
+	assert.False(t, ok)
+}
+
+func TestWatchedNamespaces(t *testing.T) {
+	cluster := argoappv1.Cluster{Server: "https://localhost:6443"}
+	assert.Equal(t, []string{""}, watchedNamespaces(&cluster))
+
+	cluster.Namespaces = []string{"team-a", "team-b"}
+	assert.Equal(t, []string{"team-a", "team-b"}, watchedNamespaces(&cluster))
+}
+
+func TestReconcileDestination(t *testing.T) {
+	prevDest := argoappv1.ApplicationDestination{Server: "https://localhost:6443", Namespace: "old-namespace"}
+
+	// Destination is recorded if it was never observed
+	app := newFakeApp()
+	ctrl := newFakeController(app)
+	observed, cond := ctrl.reconcileDestination(context.Background(), app)
+	assert.Nil(t, cond)
+	assert.Equal(t, app.Spec.Destination, *observed)
+
+	// Destination change of an application which was never deployed does not need to be resolved
+	app = newFakeApp()
+	app.Status.ObservedDestination = prevDest.DeepCopy()
+	observed, cond = ctrl.reconcileDestination(context.Background(), app)
+	assert.Nil(t, cond)
+	assert.Equal(t, app.Spec.Destination, *observed)
+
+	// Destination change is pending until the previous destination policy is set
+	app = newFakeApp()
+	app.Status.ObservedDestination = prevDest.DeepCopy()
+	app.Status.History = []argoappv1.DeploymentInfo{{ID: 1, Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}}
+	observed, cond = ctrl.reconcileDestination(context.Background(), app)
+	assert.NotNil(t, cond)
+	assert.Equal(t, argoappv1.ApplicationConditionDestinationChangedWarning, cond.Type)
+	assert.Equal(t, prevDest, *observed)
+
+	// Orphaned resources at previous destination are no longer managed
+	app.Annotations = map[string]string{common.AnnotationKeyPreviousDestinationPolicy: string(argoappv1.PreviousDestinationPolicyOrphan)}
+	observed, cond = ctrl.reconcileDestination(context.Background(), app)
+	assert.Nil(t, cond)
+	assert.Equal(t, app.Spec.Destination, *observed)
+}
+
+func TestPruneNamespacedOnly(t *testing.T) {
+	clst := &argoappv1.Cluster{Name: "in-cluster", Server: "https://localhost:6443"}
+	otherClst := &argoappv1.Cluster{Server: "https://other-cluster"}
+	namespacedClst := &argoappv1.Cluster{Server: "https://namespaced-cluster", Namespaces: []string{"old-namespace"}}
+
+	// cluster-scoped resources are still used when only the namespace changes
+	assert.True(t, pruneNamespacedOnly(clst, clst))
+	assert.True(t, pruneNamespacedOnly(clst, &argoappv1.Cluster{Server: clst.Server}))
+	assert.False(t, pruneNamespacedOnly(clst, otherClst))
+	assert.True(t, pruneNamespacedOnly(namespacedClst, otherClst))
+}
+
+func TestIsNewResyncRequest(t *testing.T) {
+	requestedAt := metav1.NewTime(time.Date(2018, 10, 1, 12, 0, 0, 0, time.UTC))
+	otherRequestedAt := metav1.NewTime(requestedAt.Add(-time.Hour))
+	newCluster := func(requestedAt *metav1.Time) *argoappv1.Cluster {
+		return &argoappv1.Cluster{Server: "https://localhost:6443", CacheInfo: argoappv1.ClusterCacheInfo{ResyncRequestedAt: requestedAt}}
+	}
+
+	assert.False(t, isNewResyncRequest(newCluster(nil), newCluster(nil)))
+	assert.True(t, isNewResyncRequest(newCluster(nil), newCluster(&requestedAt)))
+	// the request handled when the watch was started does not restart it again
+	assert.False(t, isNewResyncRequest(newCluster(&requestedAt), newCluster(&requestedAt)))
+	// requests are not compared with the clock of the controller, which may be behind the API server
+	assert.True(t, isNewResyncRequest(newCluster(&requestedAt), newCluster(&otherRequestedAt)))
+}
+
+func TestTrackingLabelCollision(t *testing.T) {
+	longApp := newFakeApp()
+	longApp.Name = strings.Repeat("a", 70)
+	otherApp := newFakeApp()
+	otherApp.Name = strings.Repeat("a", 80)
+	ctrl := newFakeController()
+	assert.NoError(t, ctrl.appInformers["argocd"].GetIndexer().Add(longApp))
+	assert.NoError(t, ctrl.appInformers["argocd"].GetIndexer().Add(otherApp))
+
+	labelValue := kube.TruncateLabelValue(longApp.Name)
+	apps := ctrl.appsByTrackingLabel(labelValue)
+	if assert.Len(t, apps, 1) {
+		assert.Equal(t, longApp.Name, apps[0].Name)
+	}
+	assert.Empty(t, ctrl.appsByTrackingLabel("my-app"))
+	assert.Equal(t, "", ctrl.findTrackingLabelCollision(longApp))
+
+	collidingApp := newFakeApp()
+	collidingApp.Name = labelValue
+	assert.Equal(t, longApp.Name, ctrl.findTrackingLabelCollision(collidingApp))
+}
+
+func TestNextScheduledSync(t *testing.T) {
+	sched, err := cron.ParseStandard("0 2 * * *")
+	assert.NoError(t, err)
+	lookback := 10 * time.Minute
+
+	// scheduled time passed within lookback duration
+	app := newFakeApp()
+	now := time.Date(2018, 9, 22, 2, 5, 0, 0, time.UTC)
+	assert.False(t, nextScheduledSync(app, sched, now, lookback).After(now))
+
+	// scheduled time passed earlier than lookback duration
+	now = time.Date(2018, 9, 22, 15, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2018, 9, 23, 2, 0, 0, 0, time.UTC), nextScheduledSync(app, sched, now, lookback))
+
+	// an operation was already started after the scheduled time
+	now = time.Date(2018, 9, 22, 2, 5, 0, 0, time.UTC)
+	app.Status.OperationState.StartedAt = metav1.NewTime(time.Date(2018, 9, 22, 2, 1, 0, 0, time.UTC))
+	assert.True(t, nextScheduledSync(app, sched, now, lookback).After(now))
+}
+
+func TestGetReconciliationPause(t *testing.T) {
+	app := newFakeApp()
+	proj := defaultProj()
+	proj.Annotations = map[string]string{common.AnnotationPauseReconciliation: string(argoappv1.ReconciliationPauseSync)}
+	ctrl := newFakeControllerWithProject(proj, app)
+	pause, pausedBy := ctrl.getReconciliationPause(app)
+	assert.Equal(t, argoappv1.ReconciliationPauseSync, pause)
+	assert.Equal(t, "the maintenance mode of project default", pausedBy)
+
+	// the global pause applies to all projects
+	err := ctrl.cmInformer.GetIndexer().Add(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        common.ArgoCDConfigMapName,
+			Namespace:   "argocd",
+			Annotations: map[string]string{common.AnnotationPauseReconciliation: string(argoappv1.ReconciliationPauseAll)},
+		},
+	})
+	assert.NoError(t, err)
+	pause, pausedBy = ctrl.getReconciliationPause(app)
+	assert.Equal(t, argoappv1.ReconciliationPauseAll, pause)
+	assert.Equal(t, "the global maintenance mode", pausedBy)
+
+	// invalid values pause nothing
+	ctrl = newFakeControllerWithProject(defaultProj(), app)
+	err = ctrl.cmInformer.GetIndexer().Add(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        common.ArgoCDConfigMapName,
+			Namespace:   "argocd",
+			Annotations: map[string]string{common.AnnotationPauseReconciliation: "true"},
+		},
+	})
+	assert.NoError(t, err)
+	pause, _ = ctrl.getReconciliationPause(app)
+	assert.Equal(t, argoappv1.ReconciliationPauseNone, pause)
+}
+
+func TestResyncCluster(t *testing.T) {
+	app := newFakeApp()
+	otherApp := newFakeApp()
+	otherApp.Name = "other-app"
+	otherApp.Spec.Destination.Server = "https://other-cluster"
+	ctrl := newFakeController()
+	assert.NoError(t, ctrl.appInformers["argocd"].GetIndexer().Add(app))
+	assert.NoError(t, ctrl.appInformers["argocd"].GetIndexer().Add(otherApp))
+	cluster := &argoappv1.Cluster{Server: app.Spec.Destination.Server}
+	_, err := ctrl.db.CreateCluster(context.Background(), cluster)
+	assert.NoError(t, err)
+
+	ctrl.resyncCluster(cluster)
+	assert.True(t, ctrl.isRefreshForced(appKey(app)))
+	assert.False(t, ctrl.isRefreshForced(appKey(otherApp)))
+	cluster, err = ctrl.db.GetCluster(context.Background(), cluster.Server)
+	assert.NoError(t, err)
+	assert.NotNil(t, cluster.CacheInfo.LastResyncAt)
+}
+
+func TestApplicationNamespaces(t *testing.T) {
+	assert.Equal(t, []string{"argocd"}, applicationNamespaces("argocd", nil))
+	assert.Equal(t, []string{"argocd", "team-a", "team-b"}, applicationNamespaces("argocd", []string{"team-a", "argocd", "", "team-b", "team-a"}))
+
+	app := newFakeApp()
+	otherApp := newFakeApp()
+	otherApp.Namespace = "team-a"
+	kubeClientset := fake.NewSimpleClientset()
+	appClientset := appclientset.NewSimpleClientset(defaultProj())
+	ctrl := NewApplicationController("argocd", kubeClientset, appClientset, &reposerver.Clientset{}, time.Minute, ApplicationControllerOpts{AppNamespaces: []string{"team-a"}, ReconcileTimeout: time.Minute})
+	assert.Len(t, ctrl.appInformers, 2)
+	assert.NoError(t, ctrl.appInformers["argocd"].GetIndexer().Add(app))
+	assert.NoError(t, ctrl.appInformers["team-a"].GetIndexer().Add(otherApp))
+
+	assert.Len(t, ctrl.listApps(), 2)
+	obj, exists, err := ctrl.getApp("team-a/" + otherApp.Name)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "team-a", obj.(*argoappv1.Application).Namespace)
+	_, exists, err = ctrl.getApp("unwatched/" + app.Name)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	// the resources of applications of other namespaces are tracked with a label qualified with the namespace
+	assert.Len(t, ctrl.appsByTrackingLabel(app.Name), 1)
+	assert.Len(t, ctrl.appsByTrackingLabel("team-a_"+app.Name), 1)
+	assert.Equal(t, "", ctrl.findTrackingLabelCollision(app))
+	assert.Equal(t, "", ctrl.findTrackingLabelCollision(otherApp))
+}
+
+// deprecatedAPIStateManager compares applications to the given target objects, only reporting their
+// usages of deprecated APIs in the given cluster
+type deprecatedAPIStateManager struct {
+	AppStateManager
+	clst       *argoappv1.Cluster
+	targetObjs []*unstructured.Unstructured
+}
+
+func (m *deprecatedAPIStateManager) CompareAppState(ctx context.Context, app *argoappv1.Application, revision string, overrides []argoappv1.ComponentParameter, noCache bool) (
+	*argoappv1.ComparisonResult, *repository.ManifestResponse, []argoappv1.ApplicationCondition, error) {
+	conditions := make([]argoappv1.ApplicationCondition, 0)
+	if condition := getDeprecatedAPICondition(ctx, m.clst, m.targetObjs); condition != nil {
+		conditions = append(conditions, *condition)
+	}
+	return &argoappv1.ComparisonResult{Status: argoappv1.ComparisonStatusSynced}, nil, conditions, nil
+}
+
+func TestRefreshReevaluatesDeprecatedAPICondition(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"major": "1", "minor": "15", "gitVersion": "v1.15.3"}`))
+	}))
+	defer ts.Close()
+
+	app := newFakeApp()
+	app.Status.Conditions = []argoappv1.ApplicationCondition{{Type: argoappv1.ApplicationConditionDeletionError, Message: "retained"}}
+	ctrl := newFakeController(app)
+	assert.NoError(t, ctrl.appInformers["argocd"].GetIndexer().Add(app))
+	_, err := ctrl.db.CreateRepository(context.Background(), &argoappv1.Repository{Repo: app.Spec.Source.RepoURL})
+	assert.NoError(t, err)
+	_, err = ctrl.db.CreateCluster(context.Background(), &argoappv1.Cluster{Server: app.Spec.Destination.Server})
+	assert.NoError(t, err)
+	repoServiceClient := mockrepository.RepositoryServiceClient{}
+	repoServiceClient.On("ListDir", mock.Anything, mock.Anything).Return(&repository.FileList{}, nil)
+	repoServiceClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(&repository.ManifestResponse{Manifests: []string{"{}"}}, nil)
+	repoClientset := reposerver.Clientset{}
+	repoClientset.On("NewRepositoryClient").Return(ioutil.NopCloser(nil), &repoServiceClient, nil)
+	ctrl.repoClientset = &repoClientset
+
+	deployment := kube.MustToUnstructured(&appsv1beta1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "extensions/v1beta1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook-ui", Namespace: "default"},
+	})
+	stateManager := &deprecatedAPIStateManager{clst: &argoappv1.Cluster{Server: ts.URL}, targetObjs: []*unstructured.Unstructured{deployment}}
+	ctrl.appStateManager = stateManager
+	refresh := func() []argoappv1.ApplicationCondition {
+		ctrl.appRefreshQueue.Add(appKey(app))
+		ctrl.processAppRefreshQueueItem()
+		updated, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(app.Name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.NoError(t, ctrl.appInformers["argocd"].GetIndexer().Update(updated))
+		return updated.Status.Conditions
+	}
+
+	// the warning is reported once, along with the conditions which are not reevaluated
+	refresh()
+	conditions := refresh()
+	if assert.Len(t, conditions, 2) {
+		assert.Equal(t, argoappv1.ApplicationConditionDeletionError, conditions[0].Type)
+		assert.Equal(t, argoappv1.ApplicationConditionDeprecatedAPIWarning, conditions[1].Type)
+	}
+
+	// the warning is cleared once the manifests are fixed
+	deployment.SetAPIVersion("apps/v1")
+	conditions = refresh()
+	if assert.Len(t, conditions, 1) {
+		assert.Equal(t, argoappv1.ApplicationConditionDeletionError, conditions[0].Type)
+	}
+}
@@ -0,0 +1,123 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"k8s.io/client-go/util/workqueue"
+
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// MetricsPath is the endpoint to collect controller metrics
+	MetricsPath = "/metrics"
+)
+
+// DefaultReconcileBuckets are the buckets of the reconcile duration histogram, in seconds
+var DefaultReconcileBuckets = []float64{0.25, 0.5, 1, 2, 4, 8, 16, 32, 64}
+
+// MetricsServer holds the metrics of the operations performed by the application controller
+type MetricsServer struct {
+	registry           *prometheus.Registry
+	syncCounter        *prometheus.CounterVec
+	reconcileHistogram *prometheus.HistogramVec
+	operationsInflight prometheus.Gauge
+}
+
+// NewMetricsServer returns a new metrics server of the application controller. The reconcile duration
+// histogram uses the given buckets, or DefaultReconcileBuckets if none are given
+func NewMetricsServer(reconcileBuckets []float64) *MetricsServer {
+	if len(reconcileBuckets) == 0 {
+		reconcileBuckets = DefaultReconcileBuckets
+	}
+	syncCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_app_sync_total",
+			Help: "Number of application syncs.",
+		},
+		[]string{"namespace", "name", "project", "phase", "trigger"},
+	)
+	reconcileHistogram := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_app_reconcile_duration_seconds",
+			Help:    "Application reconciliation performance.",
+			Buckets: reconcileBuckets,
+		},
+		[]string{"dest_server"},
+	)
+	operationsInflight := prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "argocd_app_operations_inflight",
+			Help: "Number of application operations being processed.",
+		},
+	)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(syncCounter)
+	registry.MustRegister(reconcileHistogram)
+	return &MetricsServer{
+		registry:           registry,
+		syncCounter:        syncCounter,
+		reconcileHistogram: reconcileHistogram,
+		operationsInflight: operationsInflight,
+	}
+}
+
+// RegisterOperationQueue registers the gauges of the operations waiting in the given queue and of
+// the operations being processed
+func (m *MetricsServer) RegisterOperationQueue(queue workqueue.Interface) {
+	operationsPending := prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: "argocd_app_operations_pending",
+			Help: "Number of application operations waiting to be processed.",
+		},
+		func() float64 {
+			return float64(queue.Len())
+		},
+	)
+	m.registry.MustRegister(operationsPending)
+	m.registry.MustRegister(m.operationsInflight)
+}
+
+// ServeMetrics registers the metrics endpoint on the given mux. The endpoint also exposes the metrics
+// of the given collectors
+func (m *MetricsServer) ServeMetrics(mux *http.ServeMux, collectors ...prometheus.Collector) {
+	m.registry.MustRegister(collectors...)
+	mux.Handle(MetricsPath, promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+}
+
+// Gatherer returns the gatherer of the metrics of the controller, including the metrics of the
+// collectors passed to ServeMetrics
+func (m *MetricsServer) Gatherer() prometheus.Gatherer {
+	return m.registry
+}
+
+// IncSync increments the sync counter of the application if the given operation is a completed sync
+func (m *MetricsServer) IncSync(app *argoappv1.Application, state *argoappv1.OperationState) {
+	if state.Operation.Sync == nil || !state.Phase.Completed() {
+		return
+	}
+	trigger := "manual"
+	if state.Operation.InitiatedBy.Automated {
+		trigger = "automated"
+	}
+	m.syncCounter.WithLabelValues(app.Namespace, app.Name, app.Spec.Project, string(state.Phase), trigger).Inc()
+}
+
+// ObserveReconcile records the duration of a reconciliation of an application deployed to the cluster
+// with the given server URL
+func (m *MetricsServer) ObserveReconcile(destServer string, duration time.Duration) {
+	m.reconcileHistogram.WithLabelValues(destServer).Observe(duration.Seconds())
+}
+
+// IncOperationsInflight increments the number of operations being processed
+func (m *MetricsServer) IncOperationsInflight() {
+	m.operationsInflight.Inc()
+}
+
+// DecOperationsInflight decrements the number of operations being processed
+func (m *MetricsServer) DecOperationsInflight() {
+	m.operationsInflight.Dec()
+}
@@ -0,0 +1,92 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+var expectedResponse = `# HELP argocd_app_sync_total Number of application syncs.
+# TYPE argocd_app_sync_total counter
+argocd_app_sync_total{name="my-app",namespace="argocd",phase="Failed",project="default",trigger="automated"} 1
+argocd_app_sync_total{name="my-app",namespace="argocd",phase="Succeeded",project="default",trigger="manual"} 2
+`
+
+func TestSyncMetrics(t *testing.T) {
+	app := &argoappv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "argocd"},
+		Spec:       argoappv1.ApplicationSpec{Project: "default"},
+	}
+	newState := func(phase argoappv1.OperationPhase, automated bool) *argoappv1.OperationState {
+		return &argoappv1.OperationState{
+			Operation: argoappv1.Operation{
+				Sync:        &argoappv1.SyncOperation{},
+				InitiatedBy: argoappv1.OperationInitiator{Automated: automated},
+			},
+			Phase: phase,
+		}
+	}
+	metricsServ := NewMetricsServer(nil)
+	metricsServ.IncSync(app, newState(argoappv1.OperationSucceeded, false))
+	metricsServ.IncSync(app, newState(argoappv1.OperationSucceeded, false))
+	metricsServ.IncSync(app, newState(argoappv1.OperationFailed, true))
+	// operations in progress are not counted
+	metricsServ.IncSync(app, newState(argoappv1.OperationRunning, false))
+
+	mux := http.NewServeMux()
+	metricsServ.ServeMetrics(mux)
+	req, err := http.NewRequest("GET", MetricsPath, nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	assert.Equal(t, expectedResponse, rr.Body.String())
+}
+
+func TestReconcileMetrics(t *testing.T) {
+	metricsServ := NewMetricsServer([]float64{1, 5})
+	metricsServ.ObserveReconcile("https://localhost:6443", 500*time.Millisecond)
+	metricsServ.ObserveReconcile("https://localhost:6443", 3*time.Second)
+
+	mux := http.NewServeMux()
+	metricsServ.ServeMetrics(mux)
+	req, err := http.NewRequest("GET", MetricsPath, nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	assert.Contains(t, body, `argocd_app_reconcile_duration_seconds_bucket{dest_server="https://localhost:6443",le="1"} 1`)
+	assert.Contains(t, body, `argocd_app_reconcile_duration_seconds_bucket{dest_server="https://localhost:6443",le="5"} 2`)
+	assert.Contains(t, body, `argocd_app_reconcile_duration_seconds_count{dest_server="https://localhost:6443"} 2`)
+}
+
+func TestOperationMetrics(t *testing.T) {
+	queue := workqueue.New()
+	queue.Add("argocd/app-1")
+	queue.Add("argocd/app-2")
+	queue.Add("argocd/app-3")
+	metricsServ := NewMetricsServer(nil)
+	metricsServ.RegisterOperationQueue(queue)
+	metricsServ.IncOperationsInflight()
+	metricsServ.IncOperationsInflight()
+	metricsServ.DecOperationsInflight()
+
+	mux := http.NewServeMux()
+	metricsServ.ServeMetrics(mux)
+	req, err := http.NewRequest("GET", MetricsPath, nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	assert.Contains(t, body, "argocd_app_operations_pending 3")
+	assert.Contains(t, body, "argocd_app_operations_inflight 1")
+}
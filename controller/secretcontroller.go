@@ -26,6 +26,11 @@ import (
 	"github.com/argoproj/argo-cd/util/git"
 )
 
+const (
+	// repoConnectionTimeout is the time limit of testing the connection to a repository
+	repoConnectionTimeout = 1 * time.Minute
+)
+
 type SecretController struct {
 	kubeClient     kubernetes.Interface
 	secretQueue    workqueue.RateLimitingInterface
@@ -92,7 +97,9 @@ func (ctrl *SecretController) getRepoConnectionState(repo *v1alpha1.Repository)
 		ModifiedAt: repo.ConnectionState.ModifiedAt,
 		Status:     v1alpha1.ConnectionStatusUnknown,
 	}
-	err := git.TestRepo(repo.Repo, repo.Username, repo.Password, repo.SSHPrivateKey)
+	ctx, cancel := context.WithTimeout(context.Background(), repoConnectionTimeout)
+	defer cancel()
+	err := git.TestRepo(ctx, repo.Repo, repo.Username, repo.Password, repo.SSHPrivateKey)
 	if err == nil {
 		state.Status = v1alpha1.ConnectionStatusSuccessful
 	} else {
@@ -2,17 +2,24 @@ package controller
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/status"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/retry"
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
@@ -20,20 +27,23 @@ import (
 	"github.com/argoproj/argo-cd/reposerver"
 	"github.com/argoproj/argo-cd/reposerver/repository"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/diff"
+	"github.com/argoproj/argo-cd/util/git"
 	kubeutil "github.com/argoproj/argo-cd/util/kube"
 )
 
 const (
-	maxHistoryCnt = 5
+	// MaxHistoryCount is the number of deployments kept in the history of applications
+	MaxHistoryCount = 5
 )
 
 // AppStateManager defines methods which allow to compare application spec and actual application state.
 type AppStateManager interface {
-	CompareAppState(app *v1alpha1.Application, revision string, overrides []v1alpha1.ComponentParameter) (
+	CompareAppState(ctx context.Context, app *v1alpha1.Application, revision string, overrides []v1alpha1.ComponentParameter, noCache bool) (
 		*v1alpha1.ComparisonResult, *repository.ManifestResponse, []v1alpha1.ApplicationCondition, error)
-	SyncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState)
+	SyncAppState(ctx context.Context, app *v1alpha1.Application, state *v1alpha1.OperationState)
 }
 
 // appStateManager allows to compare application using KSonnet CLI
@@ -43,8 +53,24 @@ type appStateManager struct {
 	kubectl       kubeutil.Kubectl
 	repoClientset reposerver.Clientset
 	namespace     string
+	// kubeClientMetrics records the requests made to the clusters, and limits their rate per cluster
+	kubeClientMetrics *kubeutil.ClientMetrics
+	// helmRepos caches the helm repositories, listed at most every helmReposCacheExpiration
+	helmReposLock     sync.Mutex
+	helmRepos         []*v1alpha1.HelmRepository
+	helmReposListedAt time.Time
+	// gitFactory creates the clients resolving the target revisions of the applications
+	gitFactory git.ClientFactory
 }
 
+// helmReposCacheExpiration is the duration the helm repositories are cached by the state manager for
+const helmReposCacheExpiration = time.Minute
+
+// comparisonVersion is hashed with the compared states so that the comparisons stored by a controller
+// which generated or compared the resources differently are not reused. It must be increased whenever
+// the processing of the target manifests, the selection of the live resources or the diff change.
+const comparisonVersion = 2
+
 // groupLiveObjects deduplicate list of kubernetes resources and choose correct version of resource: if resource has corresponding expected application resource then method pick
 // kubernetes resource with matching version, otherwise chooses single kubernetes resource with any version
 func groupLiveObjects(liveObjs []*unstructured.Unstructured, targetObjs []*unstructured.Unstructured) map[string]*unstructured.Unstructured {
@@ -85,8 +111,28 @@ func groupLiveObjects(liveObjs []*unstructured.Unstructured, targetObjs []*unstr
 	return liveByFullName
 }
 
-func (s *appStateManager) getTargetObjs(app *v1alpha1.Application, revision string, overrides []v1alpha1.ComponentParameter) ([]*unstructured.Unstructured, *repository.ManifestResponse, error) {
+// listHelmRepositories returns the helm repositories, which are only listed again once the cached ones expire
+func (s *appStateManager) listHelmRepositories(ctx context.Context) ([]*v1alpha1.HelmRepository, error) {
+	s.helmReposLock.Lock()
+	defer s.helmReposLock.Unlock()
+	if s.helmRepos != nil && time.Since(s.helmReposListedAt) < helmReposCacheExpiration {
+		return s.helmRepos, nil
+	}
+	helmRepos, err := s.db.ListHelmRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.helmRepos = helmRepos
+	s.helmReposListedAt = time.Now()
+	return helmRepos, nil
+}
+
+func (s *appStateManager) getTargetObjs(ctx context.Context, app *v1alpha1.Application, revision string, overrides []v1alpha1.ComponentParameter, noCache bool) ([]*unstructured.Unstructured, *repository.ManifestResponse, error) {
 	repo := s.getRepo(app.Spec.Source.RepoURL)
+	helmRepos, err := s.listHelmRepositories(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
 	conn, repoClient, err := s.repoClientset.NewRepositoryClient()
 	if err != nil {
 		return nil, nil, err
@@ -115,7 +161,7 @@ func (s *appStateManager) getTargetObjs(app *v1alpha1.Application, revision stri
 		}
 	}
 
-	manifestInfo, err := repoClient.GenerateManifest(context.Background(), &repository.ManifestRequest{
+	manifestInfo, err := repoClient.GenerateManifest(ctx, &repository.ManifestRequest{
 		Repo:                        repo,
 		Environment:                 app.Spec.Source.Environment,
 		Path:                        app.Spec.Source.Path,
@@ -125,12 +171,21 @@ func (s *appStateManager) getTargetObjs(app *v1alpha1.Application, revision stri
 		ValueFiles:                  app.Spec.Source.ValuesFiles,
 		Namespace:                   app.Spec.Destination.Namespace,
 		NamePrefix:                  app.Spec.Source.NamePrefix,
+		NoCache:                     noCache,
+		Directory:                   app.Spec.Source.Directory,
+		Override:                    app.Spec.Source.Override,
+		ResourceMetadata:            app.Spec.ResourceMetadata,
+		PreviousRevision:            app.Status.ComparisonResult.Revision,
+		ManifestGeneratePaths:       argo.GetAppManifestGeneratePaths(app),
+		HelmRepos:                   helmRepos,
 	})
 	if err != nil {
-		return nil, nil, err
+		// report the error of the manifest generation rather than the one of the RPC
+		return nil, nil, fmt.Errorf("Failed to generate manifests: %s", status.Convert(err).Message())
 	}
 
 	targetObjs := make([]*unstructured.Unstructured, 0)
+	labelValue := argo.TrackingLabelValue(app, s.namespace)
 	for _, manifest := range manifestInfo.Manifests {
 		obj, err := v1alpha1.UnmarshalToUnstructured(manifest)
 		if err != nil {
@@ -139,23 +194,25 @@ func (s *appStateManager) getTargetObjs(app *v1alpha1.Application, revision stri
 		if isHook(obj) {
 			continue
 		}
+		// the repo server labels the resources with the name of the application, which is qualified
+		// with its namespace for the applications outside of the namespace of the controller
+		if labelValue != kubeutil.TruncateLabelValue(app.Name) && !kubeutil.IsCRD(obj) {
+			if err := kubeutil.SetLabel(obj, common.LabelApplicationName, labelValue); err != nil {
+				return nil, nil, err
+			}
+		}
 		targetObjs = append(targetObjs, obj)
 	}
 	return targetObjs, manifestInfo, nil
 }
 
-func (s *appStateManager) getLiveObjs(app *v1alpha1.Application, targetObjs []*unstructured.Unstructured) (
+func (s *appStateManager) getLiveObjs(ctx context.Context, app *v1alpha1.Application, clst *v1alpha1.Cluster, targetObjs []*unstructured.Unstructured) (
 	[]*unstructured.Unstructured, map[string]*unstructured.Unstructured, error) {
 
-	// Get the REST config for the cluster corresponding to the environment
-	clst, err := s.db.GetCluster(context.Background(), app.Spec.Destination.Server)
-	if err != nil {
-		return nil, nil, err
-	}
-	restConfig := clst.RESTConfig()
+	restConfig := s.kubeClientMetrics.WrapClusterConfig(clst.RESTConfig())
 
 	// Retrieve the live versions of the objects. exclude any hook objects
-	labeledObjs, err := kubeutil.GetResourcesWithLabel(restConfig, app.Spec.Destination.Namespace, common.LabelApplicationName, app.Name)
+	labeledObjs, err := kubeutil.GetResourcesWithLabel(ctx, restConfig, app.Spec.Destination.Namespace, common.LabelApplicationName, argo.TrackingLabelValue(app, s.namespace), clst.IsNamespaced())
 	if err != nil {
 		return nil, nil, err
 	}
@@ -172,11 +229,11 @@ func (s *appStateManager) getLiveObjs(app *v1alpha1.Application, targetObjs []*u
 	controlledLiveObj := make([]*unstructured.Unstructured, len(targetObjs))
 
 	// Move live resources which have corresponding target object to controlledLiveObj
-	dynamicIf, err := dynamic.NewForConfig(restConfig)
+	dynamicIf, err := dynamic.NewForConfig(kubeutil.WithContext(ctx, restConfig))
 	if err != nil {
 		return nil, nil, err
 	}
-	disco, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	disco, err := discovery.NewDiscoveryClientForConfig(kubeutil.WithContext(ctx, restConfig))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -208,22 +265,148 @@ func (s *appStateManager) getLiveObjs(app *v1alpha1.Application, targetObjs []*u
 	return controlledLiveObj, liveObjByFullName, nil
 }
 
+// checkNamespacesPermitted returns an error listing the resources which explicitly set a namespace the
+// cluster is not permitted to manage. Resources without a namespace are created in the namespace of the
+// destination, which is validated with the application spec
+func checkNamespacesPermitted(clst *v1alpha1.Cluster, objs []*unstructured.Unstructured) error {
+	var forbidden []string
+	for _, obj := range objs {
+		if namespace := obj.GetNamespace(); namespace != "" && !clst.IsNamespacePermitted(namespace) {
+			forbidden = append(forbidden, fmt.Sprintf("%s %s/%s", obj.GetKind(), namespace, obj.GetName()))
+		}
+	}
+	if len(forbidden) > 0 {
+		return fmt.Errorf("Resources of namespaces not managed in cluster %s: %s", clst.Server, strings.Join(forbidden, ", "))
+	}
+	return nil
+}
+
+// getDeprecatedAPICondition returns a warning condition listing the resources of the target manifests which
+// use API versions deprecated or removed in the version of the given cluster or in the next minor version, or
+// nil if there are none
+func getDeprecatedAPICondition(ctx context.Context, clst *v1alpha1.Cluster, targetObjs []*unstructured.Unstructured) *v1alpha1.ApplicationCondition {
+	serverVersion, err := kubeutil.GetCachedServerVersion(kubeutil.WithContext(ctx, clst.RESTConfig()))
+	if err != nil {
+		log.Warnf("Failed to determine the version of cluster %s: %v", clst.Server, err)
+		return nil
+	}
+	usages := kubeutil.GetDeprecatedAPIUsages(targetObjs, serverVersion)
+	if len(usages) == 0 {
+		return nil
+	}
+	messages := make([]string, len(usages))
+	for i, usage := range usages {
+		messages[i] = usage.String()
+	}
+	return &v1alpha1.ApplicationCondition{
+		Type:    v1alpha1.ApplicationConditionDeprecatedAPIWarning,
+		Message: fmt.Sprintf("Resources use API versions deprecated or removed in the cluster version %s or in the next minor version: %s", serverVersion.GitVersion, strings.Join(messages, "; ")),
+	}
+}
+
+// checkApplicationLimits returns an error if the manifests of the application exceed the limits of
+// its project. Applications whose project cannot be loaded are reported by the spec validation.
+func (s *appStateManager) checkApplicationLimits(app *v1alpha1.Application, manifests []string) error {
+	proj, err := argo.GetAppProject(&app.Spec, s.appclientset, s.namespace)
+	if err != nil {
+		return nil
+	}
+	return checkManifestLimits(proj.Spec.ApplicationLimits, manifests)
+}
+
+// checkManifestLimits returns an error if the manifests exceed the supplied limits
+func checkManifestLimits(limits *v1alpha1.ApplicationLimits, manifests []string) error {
+	if limits == nil {
+		return nil
+	}
+	if limits.MaxResources > 0 && int64(len(manifests)) > limits.MaxResources {
+		return fmt.Errorf("Application has %d resources, exceeding the limit of %d resources of its project", len(manifests), limits.MaxResources)
+	}
+	if limits.MaxManifestSize > 0 {
+		var size int64
+		for _, manifest := range manifests {
+			size += int64(len(manifest))
+		}
+		if size > limits.MaxManifestSize {
+			return fmt.Errorf("Application manifests total %d bytes, exceeding the limit of %d bytes of its project", size, limits.MaxManifestSize)
+		}
+	}
+	return nil
+}
+
 // CompareAppState compares application git state to the live app state, using the specified
 // revision and supplied overrides. If revision or overrides are empty, then compares against
-// revision and overrides in the app spec.
-func (s *appStateManager) CompareAppState(app *v1alpha1.Application, revision string, overrides []v1alpha1.ComponentParameter) (
+// revision and overrides in the app spec. If noCache is set, manifests are regenerated instead
+// of being served from the repo server cache.
+func (s *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.Application, revision string, overrides []v1alpha1.ComponentParameter, noCache bool) (
 	*v1alpha1.ComparisonResult, *repository.ManifestResponse, []v1alpha1.ApplicationCondition, error) {
 
 	failedToLoadObjs := false
 	conditions := make([]v1alpha1.ApplicationCondition, 0)
-	targetObjs, manifestInfo, err := s.getTargetObjs(app, revision, overrides)
-	if err != nil {
-		targetObjs = make([]*unstructured.Unstructured, 0)
-		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error()})
-		failedToLoadObjs = true
+	prevResult := app.Status.ComparisonResult
+	// the state of the application is only hashed when compared with its spec, the comparisons with
+	// other revisions or overrides are not stored
+	compareSpec := revision == "" && overrides == nil
+
+	var targetObjs []*unstructured.Unstructured
+	var manifestInfo *repository.ManifestResponse
+	var manifests []string
+	var targetHash, targetRevision string
+	var err error
+	if compareSpec && !noCache && prevResult.TargetHash != "" {
+		// the target manifests of the previous comparison are reused rather than generated again while
+		// what they are generated from does not change, e.g. after the controller restarts
+		targetHash, targetRevision, err = s.resolveTargetHash(ctx, app)
+		if err != nil {
+			log.Warnf("Failed to resolve the target revision of app %s: %v", app.Name, err)
+		} else if targetHash == prevResult.TargetHash {
+			targetObjs, manifests, err = getComparedTargetObjs(&prevResult)
+			if err != nil {
+				log.Warnf("Failed to load the target manifests of the previous comparison of app %s: %v", app.Name, err)
+				targetObjs = nil
+			}
+		}
+	}
+	if targetObjs == nil {
+		targetObjs, manifestInfo, err = s.getTargetObjs(ctx, app, revision, overrides, noCache)
+		if err != nil {
+			targetObjs = make([]*unstructured.Unstructured, 0)
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error()})
+			failedToLoadObjs = true
+			targetHash = ""
+		} else {
+			manifests = manifestInfo.Manifests
+			targetRevision = manifestInfo.Revision
+			if compareSpec {
+				targetHash, err = getTargetHash(app, targetRevision)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+			}
+		}
+	} else {
+		log.Infof("Reusing target manifests of app %s: source and revision %s unchanged", app.Name, targetRevision)
+	}
+
+	if !failedToLoadObjs {
+		if err := s.checkApplicationLimits(app, manifests); err != nil {
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionResourceLimitError, Message: err.Error()})
+		}
 	}
 
-	controlledLiveObj, liveObjByFullName, err := s.getLiveObjs(app, targetObjs)
+	// Get the cluster corresponding to the environment
+	clst, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, s.db)
+	var controlledLiveObj []*unstructured.Unstructured
+	var liveObjByFullName map[string]*unstructured.Unstructured
+	if err == nil {
+		if err := checkNamespacesPermitted(clst, targetObjs); err != nil {
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error()})
+		}
+		if condition := getDeprecatedAPICondition(ctx, clst, targetObjs); condition != nil {
+			conditions = append(conditions, *condition)
+		}
+		controlledLiveObj, liveObjByFullName, err = s.getLiveObjs(ctx, app, clst, targetObjs)
+	}
 	if err != nil {
 		controlledLiveObj = make([]*unstructured.Unstructured, len(targetObjs))
 		liveObjByFullName = make(map[string]*unstructured.Unstructured)
@@ -233,7 +416,7 @@ func (s *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 
 	for _, liveObj := range controlledLiveObj {
 		if liveObj != nil && liveObj.GetLabels() != nil {
-			if appLabelVal, ok := liveObj.GetLabels()[common.LabelApplicationName]; ok && appLabelVal != "" && appLabelVal != app.Name {
+			if appLabelVal, ok := liveObj.GetLabels()[common.LabelApplicationName]; ok && appLabelVal != "" && appLabelVal != argo.TrackingLabelValue(app, s.namespace) {
 				conditions = append(conditions, v1alpha1.ApplicationCondition{
 					Type:    v1alpha1.ApplicationConditionSharedResourceWarning,
 					Message: fmt.Sprintf("Resource %s/%s is controller by applications '%s' and '%s'", liveObj.GetKind(), liveObj.GetName(), app.Name, appLabelVal),
@@ -251,6 +434,22 @@ func (s *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 		}
 	}
 
+	var stateHash string
+	if !failedToLoadObjs && compareSpec {
+		stateHash, err = getStateHash(targetHash, targetObjs, controlledLiveObj, liveObjByFullName)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		// the previous comparison holds the same resources while the target and live states did not
+		// change, so it is reused rather than diffing the resources again
+		if !noCache && prevResult.StateHash == stateHash && prevResult.Status != v1alpha1.ComparisonStatusUnknown {
+			log.Infof("Reusing comparison of app %s: target and live states unchanged", app.ObjectMeta.Name)
+			compResult := prevResult.DeepCopy()
+			compResult.ComparedAt = metav1.Time{Time: time.Now().UTC()}
+			return compResult, manifestInfo, conditions, nil
+		}
+	}
+
 	log.Infof("Comparing app %s state in cluster %s (namespace: %s)", app.ObjectMeta.Name, app.Spec.Destination.Server, app.Spec.Destination.Namespace)
 
 	// Do the actual comparison
@@ -301,6 +500,11 @@ func (s *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 			resState.LiveState = string(liveObjBytes)
 		}
 
+		resState.Diff, err = newResourceDiff(&diffResult)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
 		resources[i] = resState
 	}
 
@@ -323,12 +527,116 @@ func (s *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 		ComparedAt: metav1.Time{Time: time.Now().UTC()},
 		Resources:  resources,
 		Status:     comparisonStatus,
+		StateHash:  stateHash,
+		TargetHash: targetHash,
+		Revision:   targetRevision,
 	}
+	return &compResult, manifestInfo, conditions, nil
+}
 
-	if manifestInfo != nil {
-		compResult.Revision = manifestInfo.Revision
+// resolveTargetHash resolves the target revision of an application and returns the hash of its target
+// state along with the resolved revision. Only the remote refs of the repository are listed.
+func (s *appStateManager) resolveTargetHash(ctx context.Context, app *v1alpha1.Application) (string, string, error) {
+	repo := s.getRepo(app.Spec.Source.RepoURL)
+	gitClient, err := s.gitFactory.NewClient(repo.Repo, "", repo.Username, repo.Password, repo.SSHPrivateKey)
+	if err != nil {
+		return "", "", err
 	}
-	return &compResult, manifestInfo, conditions, nil
+	revision, err := gitClient.LsRemote(ctx, app.Spec.Source.TargetRevision)
+	if err != nil {
+		return "", "", err
+	}
+	targetHash, err := getTargetHash(app, revision)
+	if err != nil {
+		return "", "", err
+	}
+	return targetHash, revision, nil
+}
+
+// getTargetHash returns the hash of what the target manifests of an application are generated from: its
+// source and destination, the metadata added to its resources and the commit its target revision resolves to
+func getTargetHash(app *v1alpha1.Application, revision string) (string, error) {
+	data, err := json.Marshal([]interface{}{comparisonVersion, app.Spec.Source, app.Spec.Destination, app.Spec.ResourceMetadata, revision})
+	if err != nil {
+		return "", err
+	}
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:]), nil
+}
+
+// getComparedTargetObjs returns the target objects of a comparison, along with their manifests
+func getComparedTargetObjs(compResult *v1alpha1.ComparisonResult) ([]*unstructured.Unstructured, []string, error) {
+	targetObjs := make([]*unstructured.Unstructured, 0, len(compResult.Resources))
+	manifests := make([]string, 0, len(compResult.Resources))
+	for _, res := range compResult.Resources {
+		obj, err := res.TargetObject()
+		if err != nil {
+			return nil, nil, err
+		}
+		if obj != nil {
+			targetObjs = append(targetObjs, obj)
+			manifests = append(manifests, res.TargetState)
+		}
+	}
+	return targetObjs, manifests, nil
+}
+
+// getStateHash returns the hash of the state an application is compared with: the hash of its target
+// state, its target objects and the versions of its live resources
+func getStateHash(targetHash string, targetObjs []*unstructured.Unstructured, controlledLiveObj []*unstructured.Unstructured, liveObjByFullName map[string]*unstructured.Unstructured) (string, error) {
+	h := sha256.New()
+	_, _ = h.Write([]byte(targetHash + "\n"))
+	for _, targetObj := range targetObjs {
+		if targetObj == nil {
+			continue
+		}
+		data, err := json.Marshal(targetObj.Object)
+		if err != nil {
+			return "", err
+		}
+		_, _ = h.Write(append(data, '\n'))
+	}
+	versions := make(map[string]bool)
+	for _, liveObj := range controlledLiveObj {
+		if liveObj != nil {
+			versions[fmt.Sprintf("%s %s %s", getResourceFullName(liveObj), liveObj.GetUID(), liveObj.GetResourceVersion())] = true
+		}
+	}
+	for fullName, liveObj := range liveObjByFullName {
+		versions[fmt.Sprintf("%s %s %s", fullName, liveObj.GetUID(), liveObj.GetResourceVersion())] = true
+	}
+	sortedVersions := make([]string, 0, len(versions))
+	for version := range versions {
+		sortedVersions = append(sortedVersions, version)
+	}
+	sort.Strings(sortedVersions)
+	for _, version := range sortedVersions {
+		_, _ = h.Write([]byte(version + "\n"))
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// newResourceDiff converts the result of a resource diff into the form stored in the comparison result
+func newResourceDiff(diffResult *diff.DiffResult) (v1alpha1.ResourceDiff, error) {
+	resDiff := v1alpha1.ResourceDiff{
+		Modified:     diffResult.Modified,
+		ChangedPaths: diffResult.ChangedPaths(),
+	}
+	if diffResult.NormalizedTarget != nil {
+		targetBytes, err := json.Marshal(diffResult.NormalizedTarget.Object)
+		if err != nil {
+			return resDiff, err
+		}
+		resDiff.NormalizedTargetState = string(targetBytes)
+	}
+	if diffResult.NormalizedLive != nil {
+		liveBytes, err := json.Marshal(diffResult.NormalizedLive.Object)
+		if err != nil {
+			return resDiff, err
+		}
+		resDiff.NormalizedLiveState = string(liveBytes)
+	}
+	return resDiff, nil
 }
 
 func hasParent(obj *unstructured.Unstructured) bool {
@@ -379,27 +687,54 @@ func (s *appStateManager) getRepo(repoURL string) *v1alpha1.Repository {
 	return repo
 }
 
+// resolveParameters returns the parameters with the given overrides applied. Overrides of parameters
+// which are not listed are appended.
+func resolveParameters(params []*v1alpha1.ComponentParameter, overrides []v1alpha1.ComponentParameter) []v1alpha1.ComponentParameter {
+	resolved := make([]v1alpha1.ComponentParameter, 0, len(params))
+	index := make(map[string]int)
+	for i := range params {
+		param := *params[i]
+		index[param.Component+"/"+param.Name] = len(resolved)
+		resolved = append(resolved, param)
+	}
+	for _, override := range overrides {
+		if i, ok := index[override.Component+"/"+override.Name]; ok {
+			resolved[i].Value = override.Value
+		} else {
+			resolved = append(resolved, override)
+		}
+	}
+	return resolved
+}
+
+// persistDeploymentInfo appends a deployment to the application history. If overrides is nil, the
+// overrides in the app spec are assumed to have been deployed.
 func (s *appStateManager) persistDeploymentInfo(
-	app *v1alpha1.Application, revision string, envParams []*v1alpha1.ComponentParameter, overrides *[]v1alpha1.ComponentParameter) error {
+	app *v1alpha1.Application, revision string, envParams []*v1alpha1.ComponentParameter, overrides []v1alpha1.ComponentParameter,
+	startedAt metav1.Time, initiatedBy v1alpha1.OperationInitiator) error {
 
-	params := make([]v1alpha1.ComponentParameter, len(envParams))
-	for i := range envParams {
-		param := *envParams[i]
-		params[i] = param
+	if overrides == nil {
+		overrides = app.Spec.Source.ComponentParameterOverrides
 	}
+	source := app.Spec.Source.DeepCopy()
+	source.ComponentParameterOverrides = overrides
 	var nextID int64 = 0
 	if len(app.Status.History) > 0 {
 		nextID = app.Status.History[len(app.Status.History)-1].ID + 1
 	}
 	history := append(app.Status.History, v1alpha1.DeploymentInfo{
-		ComponentParameterOverrides: app.Spec.Source.ComponentParameterOverrides,
+		ComponentParameterOverrides: overrides,
 		Revision:                    revision,
 		DeployedAt:                  metav1.NewTime(time.Now().UTC()),
+		DeployStartedAt:             &startedAt,
 		ID:                          nextID,
+		InitiatedBy:                 initiatedBy,
+		Source:                      *source,
+		Parameters:                  resolveParameters(envParams, overrides),
 	})
 
-	if len(history) > maxHistoryCnt {
-		history = history[1 : maxHistoryCnt+1]
+	if len(history) > MaxHistoryCount {
+		history = history[1 : MaxHistoryCount+1]
 	}
 
 	patch, err := json.Marshal(map[string]map[string][]v1alpha1.DeploymentInfo{
@@ -410,10 +745,45 @@ func (s *appStateManager) persistDeploymentInfo(
 	if err != nil {
 		return err
 	}
-	_, err = s.appclientset.ArgoprojV1alpha1().Applications(s.namespace).Patch(app.Name, types.MergePatchType, patch)
+	_, err = s.appclientset.ArgoprojV1alpha1().Applications(app.Namespace).Patch(app.Name, types.MergePatchType, patch)
 	return err
 }
 
+// persistOperationState records the state of an in-progress operation in the application status.
+// The state is left untouched if the operation was requested to terminate in the meantime. The
+// application is updated at the version it was checked at, so that a termination requested after the
+// check is not overwritten
+func (s *appStateManager) persistOperationState(app *v1alpha1.Application, state *v1alpha1.OperationState) error {
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		freshApp, err := appIf.Get(app.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if isTerminating(freshApp) {
+			return nil
+		}
+		freshApp.Status.OperationState = state
+		_, err = appIf.Update(freshApp)
+		return err
+	})
+}
+
+// isOperationTerminating returns whether the termination of the operation of the application was requested
+// since the application was retrieved. Failures to retrieve the application are logged and ignored
+func (s *appStateManager) isOperationTerminating(app *v1alpha1.Application) bool {
+	freshApp, err := s.appclientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(app.Name, metav1.GetOptions{})
+	if err != nil {
+		log.Warnf("Failed to check whether the operation of app '%s' is terminating: %v", app.Name, err)
+		return false
+	}
+	return isTerminating(freshApp)
+}
+
+func isTerminating(app *v1alpha1.Application) bool {
+	return app.Status.OperationState != nil && app.Status.OperationState.Phase == v1alpha1.OperationTerminating
+}
+
 // NewAppStateManager creates new instance of Ksonnet app comparator
 func NewAppStateManager(
 	db db.ArgoDB,
@@ -421,12 +791,15 @@ func NewAppStateManager(
 	repoClientset reposerver.Clientset,
 	namespace string,
 	kubectl kubeutil.Kubectl,
+	kubeClientMetrics *kubeutil.ClientMetrics,
 ) AppStateManager {
 	return &appStateManager{
-		db:            db,
-		appclientset:  appclientset,
-		kubectl:       kubectl,
-		repoClientset: repoClientset,
-		namespace:     namespace,
+		db:                db,
+		appclientset:      appclientset,
+		kubectl:           kubectl,
+		repoClientset:     repoClientset,
+		namespace:         namespace,
+		kubeClientMetrics: kubeClientMetrics,
+		gitFactory:        git.NewFactory(),
 	}
 }
@@ -1,11 +1,22 @@
 package controller
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	testcore "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
 )
 
 var podManifest = []byte(`
@@ -50,3 +61,176 @@ func TestIsHook(t *testing.T) {
 	pod.SetAnnotations(map[string]string{"argocd.argoproj.io/hook": "Unknown"})
 	assert.False(t, isHook(pod))
 }
+
+func TestResolveParameters(t *testing.T) {
+	params := []*v1alpha1.ComponentParameter{
+		{Component: "guestbook", Name: "replicas", Value: "1"},
+		{Component: "guestbook", Name: "image", Value: "guestbook:v1"},
+	}
+	overrides := []v1alpha1.ComponentParameter{
+		{Component: "guestbook", Name: "image", Value: "guestbook:v2"},
+		{Component: "redis", Name: "replicas", Value: "3"},
+	}
+	resolved := resolveParameters(params, overrides)
+	assert.Equal(t, []v1alpha1.ComponentParameter{
+		{Component: "guestbook", Name: "replicas", Value: "1"},
+		{Component: "guestbook", Name: "image", Value: "guestbook:v2"},
+		{Component: "redis", Name: "replicas", Value: "3"},
+	}, resolved)
+	// overrides must not modify the generated parameters
+	assert.Equal(t, "guestbook:v1", params[1].Value)
+}
+
+func TestCheckManifestLimits(t *testing.T) {
+	manifests := []string{string(podManifest), string(podManifest)}
+	assert.NoError(t, checkManifestLimits(nil, manifests))
+	assert.NoError(t, checkManifestLimits(&v1alpha1.ApplicationLimits{}, manifests))
+	assert.NoError(t, checkManifestLimits(&v1alpha1.ApplicationLimits{MaxResources: 2, MaxManifestSize: int64(2 * len(podManifest))}, manifests))
+
+	err := checkManifestLimits(&v1alpha1.ApplicationLimits{MaxResources: 1}, manifests)
+	assert.EqualError(t, err, "Application has 2 resources, exceeding the limit of 1 resources of its project")
+
+	err = checkManifestLimits(&v1alpha1.ApplicationLimits{MaxManifestSize: int64(len(podManifest))}, manifests)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeding the limit of")
+}
+
+func TestGetStateHash(t *testing.T) {
+	app := newFakeApp()
+	targetHash, err := getTargetHash(app, "abc")
+	assert.NoError(t, err)
+	target := newPod()
+	pod := newPod()
+	pod.SetResourceVersion("1")
+	service := newPod()
+	service.SetKind("Service")
+	service.SetName("my-service")
+	service.SetResourceVersion("2")
+
+	hash, err := getStateHash(targetHash, []*unstructured.Unstructured{target}, []*unstructured.Unstructured{pod, nil}, map[string]*unstructured.Unstructured{getResourceFullName(service): service})
+	assert.NoError(t, err)
+	sameHash, err := getStateHash(targetHash, []*unstructured.Unstructured{target}, []*unstructured.Unstructured{pod, nil}, map[string]*unstructured.Unstructured{getResourceFullName(service): service})
+	assert.NoError(t, err)
+	assert.Equal(t, hash, sameHash)
+
+	// the hash changes with the live resources
+	updatedPod := pod.DeepCopy()
+	updatedPod.SetResourceVersion("3")
+	otherHash, err := getStateHash(targetHash, []*unstructured.Unstructured{target}, []*unstructured.Unstructured{updatedPod, nil}, map[string]*unstructured.Unstructured{getResourceFullName(service): service})
+	assert.NoError(t, err)
+	assert.NotEqual(t, hash, otherHash)
+	otherHash, err = getStateHash(targetHash, []*unstructured.Unstructured{target}, []*unstructured.Unstructured{pod, nil}, nil)
+	assert.NoError(t, err)
+	assert.NotEqual(t, hash, otherHash)
+
+	// and with the target state
+	otherTargetHash, err := getTargetHash(app, "def")
+	assert.NoError(t, err)
+	otherHash, err = getStateHash(otherTargetHash, []*unstructured.Unstructured{target}, []*unstructured.Unstructured{pod, nil}, map[string]*unstructured.Unstructured{getResourceFullName(service): service})
+	assert.NoError(t, err)
+	assert.NotEqual(t, hash, otherHash)
+	updatedTarget := target.DeepCopy()
+	updatedTarget.SetLabels(map[string]string{"app": "my-app"})
+	otherHash, err = getStateHash(targetHash, []*unstructured.Unstructured{updatedTarget}, []*unstructured.Unstructured{pod, nil}, map[string]*unstructured.Unstructured{getResourceFullName(service): service})
+	assert.NoError(t, err)
+	assert.NotEqual(t, hash, otherHash)
+}
+
+func TestGetTargetHash(t *testing.T) {
+	app := newFakeApp()
+	hash, err := getTargetHash(app, "abc")
+	assert.NoError(t, err)
+	sameHash, err := getTargetHash(app.DeepCopy(), "abc")
+	assert.NoError(t, err)
+	assert.Equal(t, hash, sameHash)
+
+	otherHash, err := getTargetHash(app, "def")
+	assert.NoError(t, err)
+	assert.NotEqual(t, hash, otherHash)
+	app.Spec.Source.Path = "other"
+	otherHash, err = getTargetHash(app, "abc")
+	assert.NoError(t, err)
+	assert.NotEqual(t, hash, otherHash)
+}
+
+func TestGetComparedTargetObjs(t *testing.T) {
+	pod, err := json.Marshal(newPod().Object)
+	assert.NoError(t, err)
+	compResult := &v1alpha1.ComparisonResult{Resources: []v1alpha1.ResourceState{
+		{TargetState: string(pod), LiveState: "null"},
+		{TargetState: "null", LiveState: string(pod)},
+	}}
+	targetObjs, manifests, err := getComparedTargetObjs(compResult)
+	assert.NoError(t, err)
+	assert.Len(t, targetObjs, 1)
+	assert.Equal(t, "my-pod", targetObjs[0].GetName())
+	assert.Equal(t, []string{compResult.Resources[0].TargetState}, manifests)
+}
+
+func TestCheckNamespacesPermitted(t *testing.T) {
+	clst := &v1alpha1.Cluster{Server: "https://localhost:6443", Namespaces: []string{"default", "team-a"}}
+	pod := newPod()
+	otherPod := newPod()
+	otherPod.SetNamespace("team-a")
+	assert.NoError(t, checkNamespacesPermitted(clst, []*unstructured.Unstructured{pod, otherPod}))
+
+	forbiddenPod := newPod()
+	forbiddenPod.SetNamespace("kube-system")
+	err := checkNamespacesPermitted(clst, []*unstructured.Unstructured{pod, forbiddenPod})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Pod kube-system/my-pod")
+	}
+
+	// resources of any namespace are permitted in clusters which are not restricted to namespaces
+	assert.NoError(t, checkNamespacesPermitted(&v1alpha1.Cluster{Server: clst.Server}, []*unstructured.Unstructured{forbiddenPod}))
+}
+
+func TestGetDeprecatedAPICondition(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"major": "1", "minor": "15", "gitVersion": "v1.15.3"}`))
+	}))
+	defer ts.Close()
+	clst := &v1alpha1.Cluster{Server: ts.URL}
+
+	deployment := newPod()
+	deployment.SetAPIVersion("extensions/v1beta1")
+	deployment.SetKind("Deployment")
+	deployment.SetName("guestbook-ui")
+	condition := getDeprecatedAPICondition(context.Background(), clst, []*unstructured.Unstructured{newPod(), deployment})
+	if assert.NotNil(t, condition) {
+		assert.Equal(t, v1alpha1.ApplicationConditionDeprecatedAPIWarning, condition.Type)
+		assert.True(t, strings.Contains(condition.Message, "v1.15.3"))
+		assert.True(t, strings.Contains(condition.Message, "extensions/v1beta1 Deployment guestbook-ui (deprecated in 1.9, removed in 1.16, use apps/v1)"))
+	}
+
+	assert.Nil(t, getDeprecatedAPICondition(context.Background(), clst, []*unstructured.Unstructured{newPod()}))
+}
+
+func TestPersistOperationStateKeepsTermination(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = &v1alpha1.OperationState{Phase: v1alpha1.OperationRunning}
+	appClientset := appclientset.NewSimpleClientset(app)
+	terminating := app.DeepCopy()
+	terminating.Status.OperationState = &v1alpha1.OperationState{Phase: v1alpha1.OperationTerminating}
+	terminationRequested := false
+	appClientset.PrependReactor("get", "applications", func(action testcore.Action) (bool, runtime.Object, error) {
+		return terminationRequested, terminating, nil
+	})
+	// the termination is requested between the retrieval of the application and its update
+	updates := 0
+	appClientset.PrependReactor("update", "applications", func(action testcore.Action) (bool, runtime.Object, error) {
+		updates++
+		if terminationRequested {
+			return false, nil, nil
+		}
+		terminationRequested = true
+		return true, nil, apierr.NewConflict(v1alpha1.SchemeGroupVersion.WithResource("applications").GroupResource(), app.Name, nil)
+	})
+	manager := &appStateManager{appclientset: appClientset, namespace: app.Namespace}
+
+	err := manager.persistOperationState(app, &v1alpha1.OperationState{Phase: v1alpha1.OperationRunning, Message: "applied 1 resource"})
+	assert.NoError(t, err)
+	// the state is not persisted once the application is terminating
+	assert.Equal(t, 1, updates)
+}
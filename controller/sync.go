@@ -28,8 +28,14 @@ import (
 	"github.com/argoproj/argo-cd/util/kube"
 )
 
+// applyBatchSize is the maximum number of resources of the same kind applied with a single kubectl call
+const applyBatchSize = 50
+
 type syncContext struct {
+	// ctx bounds the requests made to the cluster during the sync
+	ctx           context.Context
 	appName       string
+	appLabel      string
 	proj          *appv1.AppProject
 	comparison    *appv1.ComparisonResult
 	config        *rest.Config
@@ -43,11 +49,29 @@ type syncContext struct {
 	opState       *appv1.OperationState
 	manifestInfo  *repository.ManifestResponse
 	log           *log.Entry
+	// persistState records the operation state in the application status while the sync is still
+	// in progress, so that a restarted controller resumes the operation where it was left off
+	persistState func(state *appv1.OperationState) error
+	// terminationRequested returns whether the termination of the operation was requested while the
+	// sync is in progress, which stops the sync before it applies the next kind of resources
+	terminationRequested func() bool
+	// terminated is set once the sync stopped because its termination was requested
+	terminated bool
+	// pruneLast defers the pruning of resources until all the other resources are synced and healthy
+	pruneLast bool
+	// applyOutOfSyncOnly skips the apply of the resources which are already synced
+	applyOutOfSyncOnly bool
+	// failFast stops applying resources once a resource failed to sync, instead of applying all the
+	// remaining resources and reporting every failure
+	failFast bool
+	// managedNamespace is the destination namespace, created and updated with its managed metadata before
+	// the resources are applied, when the CreateNamespace sync option is set
+	managedNamespace *unstructured.Unstructured
 	// lock to protect concurrent updates of the result list
 	lock sync.Mutex
 }
 
-func (s *appStateManager) SyncAppState(app *appv1.Application, state *appv1.OperationState) {
+func (s *appStateManager) SyncAppState(ctx context.Context, app *appv1.Application, state *appv1.OperationState) {
 	// Sync requests might be requested with ambiguous revisions (e.g. master, HEAD, v1.2.3).
 	// This can change meaning when resuming operations (e.g a hook sync). After calculating a
 	// concrete git commit SHA, the SHA is remembered in the status.operationState.syncResult and
@@ -75,6 +99,11 @@ func (s *appStateManager) SyncAppState(app *appv1.Application, state *appv1.Oper
 		state.Message = "Invalid operation request: no operation specified"
 		return
 	}
+	if syncOp.Source != nil {
+		// the operation syncs another source than the one of the app, e.g. when rolling back
+		app = app.DeepCopy()
+		app.Spec.Source = *syncOp.Source
+	}
 
 	if revision == "" {
 		// if we get here, it means we did not remember a commit SHA which we should be syncing to.
@@ -82,8 +111,16 @@ func (s *appStateManager) SyncAppState(app *appv1.Application, state *appv1.Oper
 		// Take the value in the requested operation. We will resolve this to a SHA later.
 		revision = syncOp.Revision
 	}
+	if revision == "" {
+		// the manifests are always generated for a sync, including its hooks, rather than reusing the
+		// target state of the last comparison
+		revision = app.Spec.Source.TargetRevision
+		if revision == "" {
+			revision = "HEAD"
+		}
+	}
 
-	comparison, manifestInfo, conditions, err := s.CompareAppState(app, revision, overrides)
+	comparison, manifestInfo, conditions, err := s.CompareAppState(ctx, app, revision, overrides, false)
 	if err != nil {
 		state.Phase = appv1.OperationError
 		state.Message = err.Error()
@@ -103,37 +140,54 @@ func (s *appStateManager) SyncAppState(app *appv1.Application, state *appv1.Oper
 	// We now have a concrete commit SHA. Set this in the sync result revision so that we remember
 	// what we should be syncing to when resuming operations.
 	syncRes.Revision = manifestInfo.Revision
+	// the source is recorded as well, so that automated syncs are not retried until it changes
+	source := app.Spec.Source.DeepCopy()
+	if overrides != nil {
+		source.ComponentParameterOverrides = overrides
+	}
+	syncRes.Source = *source
 
-	clst, err := s.db.GetCluster(context.Background(), app.Spec.Destination.Server)
+	clst, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, s.db)
 	if err != nil {
 		state.Phase = appv1.OperationError
 		state.Message = err.Error()
 		return
 	}
 
-	restConfig := clst.RESTConfig()
-	dynamicIf, err := dynamic.NewForConfig(restConfig)
+	proj, err := argo.GetAppProject(&app.Spec, s.appclientset, s.namespace)
 	if err != nil {
 		state.Phase = appv1.OperationError
-		state.Message = fmt.Sprintf("Failed to initialize dynamic client: %v", err)
+		state.Message = fmt.Sprintf("Failed to load application project: %v", err)
 		return
 	}
-	disco, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	// enforce the sync options of the project, regardless of how the operation was requested
+	proj.ApplySyncOptions(&syncOp)
+
+	restConfig := s.kubeClientMetrics.WrapClusterConfig(clst.RESTConfig())
+	// apply the resources as the service account the project maps to the destination, if any
+	restConfig.Impersonate, err = getImpersonationConfig(proj, clst, app.Spec.Destination)
 	if err != nil {
 		state.Phase = appv1.OperationError
-		state.Message = fmt.Sprintf("Failed to initialize discovery client: %v", err)
+		state.Message = err.Error()
 		return
 	}
-
-	proj, err := argo.GetAppProject(&app.Spec, s.appclientset, s.namespace)
+	dynamicIf, err := dynamic.NewForConfig(kube.WithContext(ctx, restConfig))
 	if err != nil {
 		state.Phase = appv1.OperationError
-		state.Message = fmt.Sprintf("Failed to load application project: %v", err)
+		state.Message = fmt.Sprintf("Failed to initialize dynamic client: %v", err)
+		return
+	}
+	disco, err := discovery.NewDiscoveryClientForConfig(kube.WithContext(ctx, restConfig))
+	if err != nil {
+		state.Phase = appv1.OperationError
+		state.Message = fmt.Sprintf("Failed to initialize discovery client: %v", err)
 		return
 	}
 
 	syncCtx := syncContext{
+		ctx:           ctx,
 		appName:       app.Name,
+		appLabel:      argo.TrackingLabelValue(app, s.namespace),
 		proj:          proj,
 		comparison:    comparison,
 		config:        restConfig,
@@ -147,6 +201,38 @@ func (s *appStateManager) SyncAppState(app *appv1.Application, state *appv1.Oper
 		opState:       state,
 		manifestInfo:  manifestInfo,
 		log:           log.WithFields(log.Fields{"application": app.Name}),
+		persistState: func(state *appv1.OperationState) error {
+			return s.persistOperationState(app, state)
+		},
+		terminationRequested: func() bool {
+			return s.isOperationTerminating(app)
+		},
+	}
+	// the sync options of the sync policy apply to every sync of the application
+	syncOptions := append(appv1.SyncOptions{}, syncOp.SyncOptions...)
+	if policy := proj.GetSyncPolicy(&app.Spec); policy != nil {
+		syncOptions = append(syncOptions, policy.SyncOptions...)
+	}
+	syncCtx.pruneLast = syncOptions.HasOption(appv1.SyncOptionPruneLast)
+	syncCtx.applyOutOfSyncOnly = syncOptions.HasOption(appv1.SyncOptionApplyOutOfSyncOnly)
+	syncCtx.failFast = syncOptions.HasOption(appv1.SyncOptionFailFast)
+	if syncOptions.HasOption(appv1.SyncOptionCreateNamespace) && app.Spec.Destination.Namespace != "" {
+		var metadata *appv1.ResourceMetadata
+		if policy := proj.GetSyncPolicy(&app.Spec); policy != nil {
+			metadata = policy.ManagedNamespaceMetadata
+		}
+		syncCtx.managedNamespace = newManagedNamespace(app.Spec.Destination.Namespace, metadata)
+	}
+
+	// the resources are checked when the application is compared, except the hooks
+	hooks, err := syncCtx.getHooks()
+	if err == nil {
+		err = checkNamespacesPermitted(clst, hooks)
+	}
+	if err != nil {
+		state.Phase = appv1.OperationError
+		state.Message = err.Error()
+		return
 	}
 
 	if state.Phase == appv1.OperationTerminating {
@@ -156,7 +242,7 @@ func (s *appStateManager) SyncAppState(app *appv1.Application, state *appv1.Oper
 	}
 
 	if !syncOp.DryRun && len(syncOp.Resources) == 0 && syncCtx.opState.Phase.Successful() {
-		err := s.persistDeploymentInfo(app, manifestInfo.Revision, manifestInfo.Params, nil)
+		err := s.persistDeploymentInfo(app, manifestInfo.Revision, manifestInfo.Params, overrides, state.StartedAt, state.Operation.InitiatedBy)
 		if err != nil {
 			state.Phase = appv1.OperationError
 			state.Message = fmt.Sprintf("failed to record sync to history: %v", err)
@@ -164,12 +250,31 @@ func (s *appStateManager) SyncAppState(app *appv1.Application, state *appv1.Oper
 	}
 }
 
+// getImpersonationConfig returns the impersonation of the service account the project maps to the
+// destination, matched against the resolved cluster so that destinations referencing the cluster by
+// name are mapped as well. An empty config is returned if the project does not map a service account
+func getImpersonationConfig(proj *appv1.AppProject, clst *appv1.Cluster, dest appv1.ApplicationDestination) (rest.ImpersonationConfig, error) {
+	sa := proj.GetDestinationServiceAccount(appv1.ApplicationDestination{Server: clst.Server, Namespace: dest.Namespace})
+	if sa == "" {
+		return rest.ImpersonationConfig{}, nil
+	}
+	if dest.Namespace == "" {
+		return rest.ImpersonationConfig{}, fmt.Errorf("cannot impersonate service account '%s' without a destination namespace", sa)
+	}
+	return rest.ImpersonationConfig{
+		UserName: fmt.Sprintf("system:serviceaccount:%s:%s", dest.Namespace, sa),
+	}, nil
+}
+
 // syncTask holds the live and target object. At least one should be non-nil. A targetObj of nil
 // indicates the live object needs to be pruned. A liveObj of nil indicates the object has yet to
 // be deployed
 type syncTask struct {
 	liveObj   *unstructured.Unstructured
 	targetObj *unstructured.Unstructured
+	// skipApply is set for the resources which are already synced, when only the out of sync
+	// resources are applied
+	skipApply bool
 }
 
 // sync has performs the actual apply or hook based sync
@@ -204,14 +309,22 @@ func (sc *syncContext) sync() {
 	if sc.syncOp.SyncStrategy.Apply != nil {
 		if !sc.startedSyncPhase() {
 			if !sc.doApplySync(syncTasks, false, sc.syncOp.SyncStrategy.Apply.Force, true) {
+				if sc.terminated {
+					sc.terminate()
+					return
+				}
 				sc.setOperationPhase(appv1.OperationFailed, "one or more objects failed to apply")
 				return
 			}
+			_ = sc.checkpoint()
 			// If apply was successful, return here and force an app refresh. This is so the app
 			// will become requeued into the workqueue, to force a new sync/health assessment before
 			// marking the operation as completed
 			return
 		}
+		if !sc.doPruneLast(syncTasks) {
+			return
+		}
 		sc.setOperationPhase(appv1.OperationSucceeded, "successfully synced")
 	} else if sc.syncOp.SyncStrategy.Hook != nil {
 		hooks, err := sc.getHooks()
@@ -248,10 +361,15 @@ func (sc *syncContext) generateSyncTasks() ([]syncTask, bool) {
 			syncTask := syncTask{
 				liveObj:   liveObj,
 				targetObj: targetObj,
+				skipApply: sc.applyOutOfSyncOnly && liveObj != nil && targetObj != nil && resourceState.Status == appv1.ComparisonStatusSynced,
 			}
 			syncTasks = append(syncTasks, syncTask)
 		}
 	}
+	if len(syncTasks) > 0 && sc.managedNamespace != nil && !hasTargetObject(syncTasks, sc.managedNamespace) {
+		// the namespace is not a resource of the application, so that it is neither tracked nor pruned
+		syncTasks = append(syncTasks, syncTask{targetObj: sc.managedNamespace})
+	}
 	if len(syncTasks) == 0 {
 		if len(sc.comparison.Resources) == 0 {
 			sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("Application has no resources"))
@@ -262,6 +380,31 @@ func (sc *syncContext) generateSyncTasks() ([]syncTask, bool) {
 	return syncTasks, len(syncTasks) > 0
 }
 
+// newManagedNamespace returns the namespace created and updated by syncs with the CreateNamespace sync
+// option, with the given metadata
+func newManagedNamespace(name string, metadata *appv1.ResourceMetadata) *unstructured.Unstructured {
+	ns := &unstructured.Unstructured{}
+	ns.SetAPIVersion("v1")
+	ns.SetKind(kube.NamespaceKind)
+	ns.SetName(name)
+	if metadata != nil {
+		ns.SetLabels(metadata.Labels)
+		ns.SetAnnotations(metadata.Annotations)
+	}
+	return ns
+}
+
+// hasTargetObject returns whether one of the sync tasks applies the given object, e.g. because the
+// application manages its own namespace
+func hasTargetObject(syncTasks []syncTask, obj *unstructured.Unstructured) bool {
+	for _, task := range syncTasks {
+		if task.targetObj != nil && task.targetObj.GetKind() == obj.GetKind() && task.targetObj.GetName() == obj.GetName() {
+			return true
+		}
+	}
+	return false
+}
+
 // startedPreSyncPhase detects if we already started the PreSync stage of a sync operation.
 // This is equal to if we have anything in our resource or hook list
 func (sc *syncContext) startedPreSyncPhase() bool {
@@ -299,6 +442,21 @@ func (sc *syncContext) startedPostSyncPhase() bool {
 	return false
 }
 
+// checkpoint persists the progress made so far by the operation. Without it, the progress is only
+// recorded at the end of the current iteration, and a controller restarted in between would apply
+// the resources again, or run again the hooks it already completed and deleted. Failures are logged,
+// callers only need to handle them before doing something which must not be repeated.
+func (sc *syncContext) checkpoint() error {
+	if sc.persistState == nil {
+		return nil
+	}
+	err := sc.persistState(sc.opState)
+	if err != nil {
+		sc.log.Warnf("Failed to persist operation progress: %v", err)
+	}
+	return err
+}
+
 func (sc *syncContext) setOperationPhase(phase appv1.OperationPhase, message string) {
 	if sc.opState.Phase != phase || sc.opState.Message != message {
 		sc.log.Infof("Updating operation state. phase: %s -> %s, message: '%s' -> '%s'", sc.opState.Phase, phase, sc.opState.Message, message)
@@ -307,14 +465,34 @@ func (sc *syncContext) setOperationPhase(phase appv1.OperationPhase, message str
 	sc.opState.Message = message
 }
 
+// targetNamespace returns the namespace of the target object: the namespace of its manifest, or the
+// destination namespace of the application if the manifest has none. Cluster-scoped objects have none
+func (sc *syncContext) targetNamespace(targetObj *unstructured.Unstructured, namespaced bool) string {
+	if !namespaced {
+		return ""
+	}
+	if namespace := targetObj.GetNamespace(); namespace != "" {
+		return namespace
+	}
+	return sc.namespace
+}
+
+// isNamespaced returns whether the objects of the given kind are namespaced, which they are assumed to be
+// if the kind is unknown to the cluster
+func (sc *syncContext) isNamespaced(gvk schema.GroupVersionKind) bool {
+	serverRes, err := kube.ServerResourceForGroupVersionKind(sc.disco, gvk)
+	return err != nil || serverRes.Namespaced
+}
+
 // applyObject performs a `kubectl apply` of a single resource
-func (sc *syncContext) applyObject(targetObj *unstructured.Unstructured, dryRun bool, force bool) appv1.ResourceDetails {
+func (sc *syncContext) applyObject(targetObj *unstructured.Unstructured, namespaced, dryRun, force bool) appv1.ResourceDetails {
 	resDetails := appv1.ResourceDetails{
 		Name:      targetObj.GetName(),
 		Kind:      targetObj.GetKind(),
-		Namespace: sc.namespace,
+		Group:     targetObj.GroupVersionKind().Group,
+		Namespace: sc.targetNamespace(targetObj, namespaced),
 	}
-	message, err := sc.kubectl.ApplyResource(sc.config, targetObj, sc.namespace, dryRun, force)
+	message, err := sc.kubectl.ApplyResource(sc.ctx, sc.config, targetObj, sc.namespace, dryRun, force)
 	if err != nil {
 		resDetails.Message = err.Error()
 		resDetails.Status = appv1.ResourceDetailsSyncFailed
@@ -326,11 +504,47 @@ func (sc *syncContext) applyObject(targetObj *unstructured.Unstructured, dryRun
 	return resDetails
 }
 
+// applyObjects applies the objects with a single kubectl call. If the call fails, the objects are applied
+// one by one, so that only the objects which failed to apply are reported as failed
+func (sc *syncContext) applyObjects(targetObjs []*unstructured.Unstructured, namespaced, dryRun, force bool) []appv1.ResourceDetails {
+	res := make([]appv1.ResourceDetails, len(targetObjs))
+	if len(targetObjs) == 1 {
+		res[0] = sc.applyObject(targetObjs[0], namespaced, dryRun, force)
+		return res
+	}
+	messages, err := sc.kubectl.ApplyResources(sc.ctx, sc.config, targetObjs, sc.namespace, dryRun, force)
+	if err != nil {
+		sc.log.Warnf("Failed to apply %d resources at once, applying them one by one: %v", len(targetObjs), err)
+		var wg sync.WaitGroup
+		for i := range targetObjs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				res[i] = sc.applyObject(targetObjs[i], namespaced, dryRun, force)
+			}(i)
+		}
+		wg.Wait()
+		return res
+	}
+	for i, targetObj := range targetObjs {
+		res[i] = appv1.ResourceDetails{
+			Name:      targetObj.GetName(),
+			Kind:      targetObj.GetKind(),
+			Group:     targetObj.GroupVersionKind().Group,
+			Namespace: sc.targetNamespace(targetObj, namespaced),
+			Message:   messages[i],
+			Status:    appv1.ResourceDetailsSynced,
+		}
+	}
+	return res
+}
+
 // pruneObject deletes the object if both prune is true and dryRun is false. Otherwise appropriate message
 func (sc *syncContext) pruneObject(liveObj *unstructured.Unstructured, prune, dryRun bool) appv1.ResourceDetails {
 	resDetails := appv1.ResourceDetails{
 		Name:      liveObj.GetName(),
 		Kind:      liveObj.GetKind(),
+		Group:     liveObj.GroupVersionKind().Group,
 		Namespace: liveObj.GetNamespace(),
 	}
 	if prune {
@@ -338,7 +552,7 @@ func (sc *syncContext) pruneObject(liveObj *unstructured.Unstructured, prune, dr
 			resDetails.Message = "pruned (dry run)"
 			resDetails.Status = appv1.ResourceDetailsSyncedAndPruned
 		} else {
-			err := sc.kubectl.DeleteResource(sc.config, liveObj, sc.namespace)
+			err := sc.kubectl.DeleteResource(sc.ctx, sc.config, liveObj, sc.namespace)
 			if err != nil {
 				resDetails.Message = err.Error()
 				resDetails.Status = appv1.ResourceDetailsSyncFailed
@@ -390,8 +604,45 @@ func (sc *syncContext) doApplySync(syncTasks []syncTask, dryRun, force, update b
 	}
 	sort.Sort(newKindSorter(createTasks, resourceOrder))
 
+	// stopTermination stops the sync if its termination was requested. Resources are not applied
+	// anymore once it returns true
+	stopTermination := func() bool {
+		if dryRun || sc.terminationRequested == nil || !sc.terminationRequested() {
+			return false
+		}
+		sc.log.Infof("Stopping sync: termination requested")
+		sc.terminated = true
+		return true
+	}
+	if stopTermination() {
+		return false
+	}
+	// stopFailure stops the sync at the first failure when the sync fails fast. Dry runs always
+	// validate every resource, so that all the invalid manifests are reported at once
+	stopFailure := func() bool {
+		if dryRun || !sc.failFast || syncSuccessful {
+			return false
+		}
+		sc.log.Infof("Stopping sync: one or more objects failed to sync")
+		return true
+	}
+
 	var wg sync.WaitGroup
 	for _, task := range pruneTasks {
+		if sc.pruneLast && sc.syncOp.Prune && !dryRun {
+			// the resource is pruned by doPruneLast, once the other resources are synced and healthy
+			if update {
+				sc.setResourceDetails(&appv1.ResourceDetails{
+					Name:      task.liveObj.GetName(),
+					Kind:      task.liveObj.GetKind(),
+					Group:     task.liveObj.GroupVersionKind().Group,
+					Namespace: task.liveObj.GetNamespace(),
+					Message:   "pruned once the other resources are synced and healthy",
+					Status:    appv1.ResourceDetailsPrunePending,
+				})
+			}
+			continue
+		}
 		wg.Add(1)
 		go func(t syncTask) {
 			defer wg.Done()
@@ -420,7 +671,8 @@ func (sc *syncContext) doApplySync(syncTasks []syncTask, dryRun, force, update b
 					sc.setResourceDetails(&appv1.ResourceDetails{
 						Name:      task.targetObj.GetName(),
 						Kind:      task.targetObj.GetKind(),
-						Namespace: sc.namespace,
+						Group:     task.targetObj.GroupVersionKind().Group,
+						Namespace: sc.targetNamespace(task.targetObj, true),
 						Message:   err.Error(),
 						Status:    appv1.ResourceDetailsSyncFailed,
 					})
@@ -435,7 +687,8 @@ func (sc *syncContext) doApplySync(syncTasks []syncTask, dryRun, force, update b
 				sc.setResourceDetails(&appv1.ResourceDetails{
 					Name:      task.targetObj.GetName(),
 					Kind:      task.targetObj.GetKind(),
-					Namespace: sc.namespace,
+					Group:     task.targetObj.GroupVersionKind().Group,
+					Namespace: sc.targetNamespace(task.targetObj, serverRes.Namespaced),
 					Message:   fmt.Sprintf("Resource %s:%s is not permitted in project %s.", gvk.Group, gvk.Kind, sc.proj.Name),
 					Status:    appv1.ResourceDetailsSyncFailed,
 				})
@@ -443,22 +696,45 @@ func (sc *syncContext) doApplySync(syncTasks []syncTask, dryRun, force, update b
 			return
 		}
 
+		var targetObjs []*unstructured.Unstructured
+		for _, t := range tasks {
+			if isHook(t.targetObj) {
+				continue
+			}
+			if t.skipApply {
+				if update {
+					sc.setResourceDetails(&appv1.ResourceDetails{
+						Name:      t.targetObj.GetName(),
+						Kind:      t.targetObj.GetKind(),
+						Group:     t.targetObj.GroupVersionKind().Group,
+						Namespace: sc.targetNamespace(t.targetObj, serverRes.Namespaced),
+						Message:   "skipped (already synced)",
+						Status:    appv1.ResourceDetailsSynced,
+					})
+				}
+				continue
+			}
+			targetObjs = append(targetObjs, t.targetObj)
+		}
 		var createWg sync.WaitGroup
-		for i := range tasks {
+		for start := 0; start < len(targetObjs); start += applyBatchSize {
+			end := start + applyBatchSize
+			if end > len(targetObjs) {
+				end = len(targetObjs)
+			}
 			createWg.Add(1)
-			go func(t syncTask) {
+			go func(batch []*unstructured.Unstructured) {
 				defer createWg.Done()
-				if isHook(t.targetObj) {
-					return
-				}
-				resDetails := sc.applyObject(t.targetObj, dryRun, force)
-				if !resDetails.Status.Successful() {
-					syncSuccessful = false
+				res := sc.applyObjects(batch, serverRes.Namespaced, dryRun, force)
+				for i := range res {
+					if !res[i].Status.Successful() {
+						syncSuccessful = false
+					}
+					if update || !res[i].Status.Successful() {
+						sc.setResourceDetails(&res[i])
+					}
 				}
-				if update || !resDetails.Status.Successful() {
-					sc.setResourceDetails(&resDetails)
-				}
-			}(tasks[i])
+			}(targetObjs[start:end])
 		}
 		createWg.Wait()
 	}
@@ -467,6 +743,9 @@ func (sc *syncContext) doApplySync(syncTasks []syncTask, dryRun, force, update b
 	for _, task := range createTasks {
 		//Only wait if the type of the next task is different than the previous type
 		if len(tasksGroup) > 0 && tasksGroup[0].targetObj.GetKind() != task.targetObj.GetKind() {
+			if stopTermination() || stopFailure() {
+				return false
+			}
 			processCreateTasks(tasksGroup, tasksGroup[0].targetObj.GroupVersionKind())
 			tasksGroup = []syncTask{task}
 		} else {
@@ -474,14 +753,115 @@ func (sc *syncContext) doApplySync(syncTasks []syncTask, dryRun, force, update b
 		}
 	}
 	if len(tasksGroup) > 0 {
+		if stopTermination() || stopFailure() {
+			return false
+		}
 		processCreateTasks(tasksGroup, tasksGroup[0].targetObj.GroupVersionKind())
 	}
 	return syncSuccessful
 }
 
+// doPruneLast prunes the resources whose pruning was deferred by the PruneLast sync option, once the
+// other resources are healthy. Returns whether the sync can continue, which is the case when there is
+// nothing left to prune
+func (sc *syncContext) doPruneLast(syncTasks []syncTask) bool {
+	var pruneTasks []syncTask
+	for _, task := range syncTasks {
+		if task.targetObj == nil && sc.isPrunePending(task.liveObj) {
+			pruneTasks = append(pruneTasks, task)
+		}
+	}
+	if len(pruneTasks) == 0 {
+		return true
+	}
+
+	// the resources about to be pruned do not count in the health of the application
+	comparison := *sc.comparison
+	comparison.Resources = nil
+	for _, res := range sc.comparison.Resources {
+		if targetObj, err := res.TargetObject(); err != nil || targetObj != nil {
+			comparison.Resources = append(comparison.Resources, res)
+		}
+	}
+	healthState, err := setApplicationHealth(sc.kubectl, &comparison)
+	sc.log.Infof("Prune last application health check: %s", healthState.Status)
+	if err != nil {
+		sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("failed to check application health: %v", err))
+		return false
+	}
+	if healthState.Status != appv1.HealthStatusHealthy {
+		sc.setOperationPhase(appv1.OperationRunning, fmt.Sprintf("waiting for %s state to prune resources (current health: %s)", appv1.HealthStatusHealthy, healthState.Status))
+		return false
+	}
+	if sc.terminationRequested != nil && sc.terminationRequested() {
+		sc.log.Infof("Stopping sync: termination requested")
+		sc.terminated = true
+		sc.terminate()
+		return false
+	}
+
+	syncSuccessful := true
+	var wg sync.WaitGroup
+	for _, task := range pruneTasks {
+		wg.Add(1)
+		go func(t syncTask) {
+			defer wg.Done()
+			resDetails := sc.pruneObject(t.liveObj, sc.syncOp.Prune, false)
+			if !resDetails.Status.Successful() {
+				syncSuccessful = false
+			}
+			sc.setResourceDetails(&resDetails)
+		}(task)
+	}
+	wg.Wait()
+	if !syncSuccessful {
+		sc.setOperationPhase(appv1.OperationFailed, "one or more objects failed to prune")
+		return false
+	}
+	_ = sc.checkpoint()
+	return true
+}
+
+// isPrunePending returns whether the pruning of the live object was deferred until the other resources
+// are synced and healthy
+func (sc *syncContext) isPrunePending(liveObj *unstructured.Unstructured) bool {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+	obj := appv1.ResourceDetails{
+		Group:     liveObj.GroupVersionKind().Group,
+		Kind:      liveObj.GetKind(),
+		Namespace: liveObj.GetNamespace(),
+		Name:      liveObj.GetName(),
+	}
+	for _, res := range sc.syncRes.Resources {
+		if isSameResource(res, &obj) {
+			return res.Status == appv1.ResourceDetailsPrunePending
+		}
+	}
+	return false
+}
+
+// isSameResource returns whether the resource details are about the same resource, which resources of
+// other groups or namespaces with the same kind and name are not
+func isSameResource(a, b *appv1.ResourceDetails) bool {
+	return a.Group == b.Group && a.Kind == b.Kind && a.Namespace == b.Namespace && a.Name == b.Name
+}
+
 // doHookSync initiates (or continues) a hook-based sync. This method will be invoked when there may
 // already be in-flight (potentially incomplete) jobs/workflows, and should be idempotent.
 func (sc *syncContext) doHookSync(syncTasks []syncTask, hooks []*unstructured.Unstructured) {
+	// 0. Create the managed namespace, which the PreSync hooks may run in. It is applied again, and
+	// reported, along with the other resources
+	if sc.managedNamespace != nil && len(sc.syncRes.Hooks) == 0 && hasHookType(hooks, appv1.HookTypePreSync) &&
+		sc.proj.IsResourcePermitted(metav1.GroupKind{Kind: kube.NamespaceKind}, false) {
+		resDetails := sc.applyObject(sc.managedNamespace, false, false, false)
+		if !resDetails.Status.Successful() {
+			sc.setResourceDetails(&resDetails)
+			sc.setOperationPhase(appv1.OperationFailed, "failed to create the namespace of the application")
+			return
+		}
+	}
+
 	// 1. Run PreSync hooks
 	if !sc.runHooks(hooks, appv1.HookTypePreSync) {
 		return
@@ -493,9 +873,14 @@ func (sc *syncContext) doHookSync(syncTasks []syncTask, hooks []*unstructured.Un
 	shouldContinue := true
 	if !sc.startedSyncPhase() {
 		if !sc.syncNonHookTasks(syncTasks) {
+			if sc.terminated {
+				sc.terminate()
+				return
+			}
 			sc.setOperationPhase(appv1.OperationFailed, "one or more objects failed to apply")
 			return
 		}
+		_ = sc.checkpoint()
 		shouldContinue = false
 	}
 	if !sc.runHooks(hooks, appv1.HookTypeSync) {
@@ -504,6 +889,9 @@ func (sc *syncContext) doHookSync(syncTasks []syncTask, hooks []*unstructured.Un
 	if !shouldContinue {
 		return
 	}
+	if !sc.doPruneLast(syncTasks) {
+		return
+	}
 
 	// 3. Run PostSync hooks
 	// Before running PostSync hooks, we want to make rollout is complete (app is healthy). If we
@@ -573,7 +961,8 @@ func (sc *syncContext) runHooks(hooks []*unstructured.Unstructured, hookType app
 			sc.setResourceDetails(&appv1.ResourceDetails{
 				Name:      hook.GetName(),
 				Kind:      hook.GetKind(),
-				Namespace: sc.namespace,
+				Group:     hook.GroupVersionKind().Group,
+				Namespace: sc.targetNamespace(hook, sc.isNamespaced(hook.GroupVersionKind())),
 				Message:   "Skipped",
 			})
 			continue
@@ -646,11 +1035,11 @@ func (sc *syncContext) runHook(hook *unstructured.Unstructured, hookType appv1.H
 			return false, fmt.Errorf("Failed to get status of %s hook %s '%s': %v", hookType, gvk, hook.GetName(), err)
 		}
 		hook = hook.DeepCopy()
-		err = kube.SetLabel(hook, common.LabelApplicationName, sc.appName)
+		err = kube.SetLabel(hook, common.LabelApplicationName, sc.appLabel)
 		if err != nil {
 			sc.log.Warnf("Failed to set application label on hook %v: %v", hook, err)
 		}
-		_, err := sc.kubectl.ApplyResource(sc.config, hook, sc.namespace, false, false)
+		_, err := sc.kubectl.ApplyResource(sc.ctx, sc.config, hook, sc.namespace, false, false)
 		if err != nil {
 			return false, fmt.Errorf("Failed to create %s hook %s '%s': %v", hookType, gvk, hook.GetName(), err)
 		}
@@ -665,16 +1054,26 @@ func (sc *syncContext) runHook(hook *unstructured.Unstructured, hookType appv1.H
 		liveObj = existing
 	}
 	hookStatus := newHookStatus(liveObj, hookType)
-	if hookStatus.Status.Completed() {
-		if enforceDeletePolicy(hook, hookStatus.Status) {
-			err = sc.deleteHook(hook.GetName(), hook.GetKind(), hook.GetAPIVersion())
-			if err != nil {
-				hookStatus.Status = appv1.OperationFailed
-				hookStatus.Message = fmt.Sprintf("failed to delete %s hook: %v", hookStatus.Status, err)
+	updated := sc.updateHookStatus(hookStatus)
+	if hookStatus.Status.Completed() && enforceDeletePolicy(hook, hookStatus.Status) {
+		// The completion must be recorded before the hook is deleted. Otherwise, if the controller
+		// restarts in between, the hook is no longer found and gets run a second time.
+		if updated {
+			if err := sc.checkpoint(); err != nil {
+				return true, fmt.Errorf("Failed to persist status of %s hook %s '%s': %v", hookType, gvk, hook.GetName(), err)
 			}
 		}
+		err = sc.deleteHook(hook.GetName(), hook.GetKind(), hook.GetAPIVersion())
+		if err != nil {
+			failedStatus := hookStatus
+			failedStatus.Status = appv1.OperationFailed
+			failedStatus.Message = fmt.Sprintf("failed to delete %s hook: %v", hookStatus.Status, err)
+			updated = sc.updateHookStatus(failedStatus) || updated
+		}
+	} else if updated {
+		_ = sc.checkpoint()
 	}
-	return sc.updateHookStatus(hookStatus), nil
+	return updated, nil
 }
 
 // enforceDeletePolicy examines the hook deletion policy of a object and deletes it based on the status
@@ -711,6 +1110,16 @@ func isHookType(hook *unstructured.Unstructured, hookType appv1.HookType) bool {
 	return false
 }
 
+// hasHookType returns whether one of the hooks is of the given type
+func hasHookType(hooks []*unstructured.Unstructured, hookType appv1.HookType) bool {
+	for _, hook := range hooks {
+		if isHookType(hook, hookType) {
+			return true
+		}
+	}
+	return false
+}
+
 // isHook indicates if the object is either a Argo CD or Helm hook
 func isHook(obj *unstructured.Unstructured) bool {
 	return isArgoHook(obj) || isHelmHook(obj)
@@ -770,7 +1179,7 @@ func (sc *syncContext) setResourceDetails(details *appv1.ResourceDetails) {
 	sc.lock.Lock()
 	defer sc.lock.Unlock()
 	for i, res := range sc.syncRes.Resources {
-		if res.Kind == details.Kind && res.Name == details.Name {
+		if isSameResource(res, details) {
 			// update existing value
 			if res.Status != details.Status {
 				sc.log.Infof("updated resource %s/%s status: %s -> %s", res.Kind, res.Name, res.Status, details.Status)
@@ -924,7 +1333,7 @@ func (sc *syncContext) terminate() {
 		}
 	}
 	if terminateSuccessful {
-		sc.setOperationPhase(appv1.OperationFailed, "Operation terminated")
+		sc.setOperationPhase(appv1.OperationTerminated, "Operation terminated")
 	} else {
 		sc.setOperationPhase(appv1.OperationError, "Operation termination had errors")
 	}
@@ -957,6 +1366,7 @@ type sortOrder []string
 // resourceOrder represents the correct order of Kubernetes resources within a manifest
 var resourceOrder sortOrder = []string{
 	"Namespace",
+	"CustomResourceDefinition",
 	"ResourceQuota",
 	"LimitRange",
 	"PodSecurityPolicy",
@@ -966,7 +1376,6 @@ var resourceOrder sortOrder = []string{
 	"PersistentVolume",
 	"PersistentVolumeClaim",
 	"ServiceAccount",
-	"CustomResourceDefinition",
 	"ClusterRole",
 	"ClusterRoleBinding",
 	"Role",
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"sync"
 	"testing"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -17,7 +18,9 @@ import (
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	fakedisco "k8s.io/client-go/discovery/fake"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/rest"
 	testcore "k8s.io/client-go/testing"
 )
@@ -38,7 +41,7 @@ func (k mockKubectlCmd) WatchResources(
 	return k.events, nil
 }
 
-func (k mockKubectlCmd) DeleteResource(config *rest.Config, obj *unstructured.Unstructured, namespace string) error {
+func (k mockKubectlCmd) DeleteResource(ctx context.Context, config *rest.Config, obj *unstructured.Unstructured, namespace string) error {
 	command, ok := k.commands[obj.GetName()]
 	if !ok {
 		return nil
@@ -46,7 +49,15 @@ func (k mockKubectlCmd) DeleteResource(config *rest.Config, obj *unstructured.Un
 	return command.err
 }
 
-func (k mockKubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force bool) (string, error) {
+func (k mockKubectlCmd) GetResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error) {
+	return nil, nil
+}
+
+func (k mockKubectlCmd) UpdateResource(ctx context.Context, config *rest.Config, obj *unstructured.Unstructured, namespace string) (*unstructured.Unstructured, error) {
+	return obj, nil
+}
+
+func (k mockKubectlCmd) ApplyResource(ctx context.Context, config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force bool) (string, error) {
 	command, ok := k.commands[obj.GetName()]
 	if !ok {
 		return "", nil
@@ -54,6 +65,18 @@ func (k mockKubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Uns
 	return command.output, command.err
 }
 
+func (k mockKubectlCmd) ApplyResources(ctx context.Context, config *rest.Config, objs []*unstructured.Unstructured, namespace string, dryRun, force bool) ([]string, error) {
+	res := make([]string, len(objs))
+	for i, obj := range objs {
+		out, err := k.ApplyResource(ctx, config, obj, namespace, dryRun, force)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = out
+	}
+	return res, nil
+}
+
 // ConvertToVersion converts an unstructured object into the specified group/version
 func (k mockKubectlCmd) ConvertToVersion(obj *unstructured.Unstructured, group, version string) (*unstructured.Unstructured, error) {
 	return obj, nil
@@ -69,11 +92,13 @@ func newTestSyncCtx(resources ...*v1.APIResourceList) *syncContext {
 		},
 	})
 	kube.FlushServerResourcesCache()
+	syncRes := &v1alpha1.SyncOperationResult{}
 	return &syncContext{
+		ctx:        context.Background(),
 		comparison: &v1alpha1.ComparisonResult{},
 		config:     &rest.Config{},
 		namespace:  "test-namespace",
-		syncRes:    &v1alpha1.SyncOperationResult{},
+		syncRes:    syncRes,
 		syncOp: &v1alpha1.SyncOperation{
 			Prune: true,
 			SyncStrategy: &v1alpha1.SyncStrategy{
@@ -90,7 +115,7 @@ func newTestSyncCtx(resources ...*v1.APIResourceList) *syncContext {
 				},
 			},
 		},
-		opState: &v1alpha1.OperationState{},
+		opState: &v1alpha1.OperationState{SyncResult: syncRes},
 		disco:   fakeDisco,
 		log:     log.WithFields(log.Fields{"application": "fake-app"}),
 	}
@@ -252,6 +277,47 @@ func TestSyncCreateFailure(t *testing.T) {
 	assert.Equal(t, v1alpha1.ResourceDetailsSyncFailed, syncCtx.syncRes.Resources[0].Status)
 }
 
+// batchRecordingKubectl records the names of the resources applied by each batch
+type batchRecordingKubectl struct {
+	mockKubectlCmd
+	lock    sync.Mutex
+	batches [][]string
+}
+
+func (k *batchRecordingKubectl) ApplyResources(ctx context.Context, config *rest.Config, objs []*unstructured.Unstructured, namespace string, dryRun, force bool) ([]string, error) {
+	var names []string
+	for _, obj := range objs {
+		names = append(names, obj.GetName())
+	}
+	k.lock.Lock()
+	k.batches = append(k.batches, names)
+	k.lock.Unlock()
+	return k.mockKubectlCmd.ApplyResources(ctx, config, objs, namespace, dryRun, force)
+}
+
+func TestSyncAppliesResourcesInBatches(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	kubectl := &batchRecordingKubectl{mockKubectlCmd: mockKubectlCmd{
+		commands: map[string]kubectlOutput{
+			"pod-2": {output: "", err: fmt.Errorf("invalid pod")},
+		},
+	}}
+	syncCtx.kubectl = kubectl
+	syncCtx.comparison = &v1alpha1.ComparisonResult{}
+	for _, name := range []string{"pod-1", "pod-2", "pod-3"} {
+		syncCtx.comparison.Resources = append(syncCtx.comparison.Resources, v1alpha1.ResourceState{
+			TargetState: fmt.Sprintf("{\"kind\":\"pod\", \"metadata\":{\"name\":\"%s\"}}", name),
+		})
+	}
+	syncCtx.sync()
+	// the resources are dry run with a single call, then one by one since the batch failed
+	assert.Equal(t, [][]string{{"pod-1", "pod-2", "pod-3"}}, kubectl.batches)
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, "pod-2", syncCtx.syncRes.Resources[0].Name)
+	assert.Equal(t, v1alpha1.ResourceDetailsSyncFailed, syncCtx.syncRes.Resources[0].Status)
+	assert.Equal(t, v1alpha1.OperationFailed, syncCtx.opState.Phase)
+}
+
 func TestSyncPruneFailure(t *testing.T) {
 	syncCtx := newTestSyncCtx()
 	syncCtx.kubectl = mockKubectlCmd{
@@ -274,6 +340,317 @@ func TestSyncPruneFailure(t *testing.T) {
 	assert.Equal(t, v1alpha1.ResourceDetailsSyncFailed, syncCtx.syncRes.Resources[0].Status)
 }
 
+func TestSyncPersistsAppliedResources(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.comparison = &v1alpha1.ComparisonResult{
+		Resources: []v1alpha1.ResourceState{{
+			LiveState:   "",
+			TargetState: "{\"kind\":\"service\"}",
+		}},
+	}
+	var persisted []*v1alpha1.OperationState
+	syncCtx.persistState = func(state *v1alpha1.OperationState) error {
+		persisted = append(persisted, state.DeepCopy())
+		return nil
+	}
+	syncCtx.sync()
+	assert.Len(t, persisted, 1)
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+
+	// a restarted controller resumes from the persisted state and does not apply the resources again
+	syncCtx.syncRes = persisted[0].SyncResult
+	syncCtx.sync()
+	assert.Len(t, persisted, 1)
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+}
+
+func TestSyncTerminatedBetweenKinds(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.comparison = &v1alpha1.ComparisonResult{
+		Resources: []v1alpha1.ResourceState{{
+			LiveState:   "",
+			TargetState: "{\"kind\":\"pod\"}",
+		}, {
+			LiveState:   "",
+			TargetState: "{\"kind\":\"service\"}",
+		}},
+	}
+	// the termination is requested once the pods are applied
+	checks := 0
+	syncCtx.terminationRequested = func() bool {
+		checks++
+		return checks > 2
+	}
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationTerminated, syncCtx.opState.Phase)
+	if assert.Len(t, syncCtx.syncRes.Resources, 1) {
+		assert.Equal(t, "pod", syncCtx.syncRes.Resources[0].Kind)
+	}
+}
+
+func TestSyncPruneLast(t *testing.T) {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "apps/v1",
+		APIResources: []v1.APIResource{{Kind: "Deployment", Namespaced: true}},
+	})
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.pruneLast = true
+	deployment := `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"new","generation":1},"spec":{"replicas":1}%s}`
+	syncCtx.comparison = &v1alpha1.ComparisonResult{
+		Resources: []v1alpha1.ResourceState{{
+			LiveState:   "",
+			TargetState: fmt.Sprintf(deployment, ""),
+		}, {
+			LiveState:   "{\"kind\":\"pod\", \"metadata\":{\"name\":\"old\"}}",
+			TargetState: "",
+		}},
+	}
+	podStatus := func() v1alpha1.ResourceSyncStatus {
+		for _, res := range syncCtx.syncRes.Resources {
+			if res.Kind == "pod" {
+				return res.Status
+			}
+		}
+		return ""
+	}
+
+	// the old pod is not pruned while the deployment is applied
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 2)
+	assert.Equal(t, v1alpha1.ResourceDetailsPrunePending, podStatus())
+
+	// nor while the deployment is rolled out
+	syncCtx.comparison.Resources[0].LiveState = fmt.Sprintf(deployment, "")
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationRunning, syncCtx.opState.Phase)
+	assert.Contains(t, syncCtx.opState.Message, "waiting for Healthy state to prune resources")
+	assert.Equal(t, v1alpha1.ResourceDetailsPrunePending, podStatus())
+
+	syncCtx.comparison.Resources[0].LiveState = fmt.Sprintf(deployment, `,"status":{"observedGeneration":1,"replicas":1,"updatedReplicas":1,"availableReplicas":1}`)
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+	assert.Equal(t, v1alpha1.ResourceDetailsSyncedAndPruned, podStatus())
+}
+
+func TestSyncReportsManifestNamespace(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.pruneLast = true
+	syncCtx.comparison = &v1alpha1.ComparisonResult{
+		Resources: []v1alpha1.ResourceState{{
+			LiveState:   "",
+			TargetState: `{"kind":"pod","metadata":{"name":"guestbook","namespace":"other"}}`,
+		}, {
+			LiveState:   `{"kind":"pod","metadata":{"name":"guestbook","namespace":"test-namespace"}}`,
+			TargetState: "",
+		}},
+	}
+	syncCtx.sync()
+	// the pod of the manifest does not replace the pending pruning of the pod of the destination namespace
+	statuses := make(map[string]v1alpha1.ResourceSyncStatus)
+	for _, res := range syncCtx.syncRes.Resources {
+		statuses[res.Namespace] = res.Status
+	}
+	assert.Equal(t, map[string]v1alpha1.ResourceSyncStatus{
+		"other":          v1alpha1.ResourceDetailsSynced,
+		"test-namespace": v1alpha1.ResourceDetailsPrunePending,
+	}, statuses)
+}
+
+func TestIsPrunePending(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	newObj := func(apiVersion, namespace string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind("Service")
+		obj.SetNamespace(namespace)
+		obj.SetName("guestbook")
+		return obj
+	}
+	pending := newObj("v1", "default")
+	syncCtx.setResourceDetails(&v1alpha1.ResourceDetails{
+		Group:     "",
+		Kind:      "Service",
+		Namespace: "default",
+		Name:      "guestbook",
+		Status:    v1alpha1.ResourceDetailsPrunePending,
+	})
+	// a resource with the same kind and name in another namespace or group is synced independently
+	syncCtx.setResourceDetails(&v1alpha1.ResourceDetails{
+		Group:     "",
+		Kind:      "Service",
+		Namespace: "other",
+		Name:      "guestbook",
+		Status:    v1alpha1.ResourceDetailsSynced,
+	})
+
+	assert.True(t, syncCtx.isPrunePending(pending))
+	assert.False(t, syncCtx.isPrunePending(newObj("v1", "other")))
+	assert.False(t, syncCtx.isPrunePending(newObj("serving.knative.dev/v1alpha1", "default")))
+}
+
+func TestSyncFailFast(t *testing.T) {
+	for _, failFast := range []bool{false, true} {
+		syncCtx := newTestSyncCtx()
+		syncCtx.kubectl = mockKubectlCmd{
+			commands: map[string]kubectlOutput{
+				"invalid-pod": {err: fmt.Errorf("invalid pod")},
+			},
+		}
+		syncCtx.failFast = failFast
+		syncCtx.comparison = &v1alpha1.ComparisonResult{
+			Resources: []v1alpha1.ResourceState{{
+				TargetState: "{\"kind\":\"pod\", \"metadata\":{\"name\":\"invalid-pod\"}}",
+			}, {
+				TargetState: "{\"kind\":\"service\", \"metadata\":{\"name\":\"service\"}}",
+			}},
+		}
+		syncTasks, successful := syncCtx.generateSyncTasks()
+		assert.True(t, successful)
+		assert.False(t, syncCtx.doApplySync(syncTasks, false, false, true))
+		if failFast {
+			// the service, which is applied after the pods, is not applied anymore
+			assert.Len(t, syncCtx.syncRes.Resources, 1)
+		} else {
+			assert.Len(t, syncCtx.syncRes.Resources, 2)
+		}
+		for _, res := range syncCtx.syncRes.Resources {
+			if res.Name == "invalid-pod" {
+				assert.Equal(t, v1alpha1.ResourceDetailsSyncFailed, res.Status)
+			} else {
+				assert.Equal(t, v1alpha1.ResourceDetailsSynced, res.Status)
+			}
+		}
+	}
+}
+
+func TestSyncCreateNamespace(t *testing.T) {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []v1.APIResource{{Kind: "Namespace", Namespaced: false}},
+	})
+	syncCtx.kubectl = mockKubectlCmd{}
+	syncCtx.managedNamespace = newManagedNamespace(syncCtx.namespace, &v1alpha1.ResourceMetadata{
+		Labels: map[string]string{"istio-injection": "enabled"},
+	})
+	syncCtx.comparison = &v1alpha1.ComparisonResult{
+		Resources: []v1alpha1.ResourceState{{
+			TargetState: "{\"kind\":\"pod\", \"metadata\":{\"name\":\"my-pod\"}}",
+		}},
+	}
+	syncTasks, successful := syncCtx.generateSyncTasks()
+	assert.True(t, successful)
+	assert.Len(t, syncTasks, 2)
+	assert.Equal(t, "Namespace", syncTasks[1].targetObj.GetKind())
+	assert.Equal(t, "test-namespace", syncTasks[1].targetObj.GetName())
+	assert.Equal(t, map[string]string{"istio-injection": "enabled"}, syncTasks[1].targetObj.GetLabels())
+
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 2)
+	for _, res := range syncCtx.syncRes.Resources {
+		assert.Equal(t, v1alpha1.ResourceDetailsSynced, res.Status)
+	}
+
+	// the namespace is not applied twice when it is a resource of the application
+	syncCtx.comparison.Resources = append(syncCtx.comparison.Resources, v1alpha1.ResourceState{
+		TargetState: "{\"apiVersion\":\"v1\", \"kind\":\"Namespace\", \"metadata\":{\"name\":\"test-namespace\"}}",
+	})
+	syncTasks, successful = syncCtx.generateSyncTasks()
+	assert.True(t, successful)
+	assert.Len(t, syncTasks, 2)
+}
+
+func TestSyncApplyOutOfSyncOnly(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	// the synced service would fail to apply
+	syncCtx.kubectl = mockKubectlCmd{
+		commands: map[string]kubectlOutput{
+			"synced": {err: fmt.Errorf("error: unexpected apply")},
+		},
+	}
+	syncCtx.applyOutOfSyncOnly = true
+	syncCtx.comparison = &v1alpha1.ComparisonResult{
+		Resources: []v1alpha1.ResourceState{{
+			LiveState:   "{\"kind\":\"service\", \"metadata\":{\"name\":\"synced\"}}",
+			TargetState: "{\"kind\":\"service\", \"metadata\":{\"name\":\"synced\"}}",
+			Status:      v1alpha1.ComparisonStatusSynced,
+		}, {
+			LiveState:   "{\"kind\":\"service\", \"metadata\":{\"name\":\"changed\"}}",
+			TargetState: "{\"kind\":\"service\", \"metadata\":{\"name\":\"changed\"}}",
+			Status:      v1alpha1.ComparisonStatusOutOfSync,
+		}},
+	}
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 2)
+	for _, res := range syncCtx.syncRes.Resources {
+		assert.Equal(t, v1alpha1.ResourceDetailsSynced, res.Status)
+		if res.Name == "synced" {
+			assert.Equal(t, "skipped (already synced)", res.Message)
+		}
+	}
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+}
+
+func TestRunHookPersistsStatusBeforeDeletion(t *testing.T) {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "apps/v1",
+		APIResources: []v1.APIResource{
+			{Name: "replicasets", Kind: "ReplicaSet", Group: "apps", Version: "v1", Namespaced: true},
+		},
+	})
+	syncCtx.kubectl = mockKubectlCmd{}
+	hook := &unstructured.Unstructured{}
+	hook.SetAPIVersion("apps/v1")
+	hook.SetKind("ReplicaSet")
+	hook.SetNamespace(syncCtx.namespace)
+	hook.SetName("pre-sync")
+	hook.SetAnnotations(map[string]string{
+		"argocd.argoproj.io/hook":               string(v1alpha1.HookTypePreSync),
+		"argocd.argoproj.io/hook-delete-policy": string(v1alpha1.HookDeletePolicyHookSucceeded),
+	})
+	dynamicIf := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), hook.DeepCopy())
+	syncCtx.dynamicIf = dynamicIf
+	resIf := dynamicIf.Resource(schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}).Namespace(syncCtx.namespace)
+
+	var persisted []*v1alpha1.OperationState
+	syncCtx.persistState = func(state *v1alpha1.OperationState) error {
+		// the hook must still exist when its completion is persisted
+		_, err := resIf.Get(hook.GetName(), v1.GetOptions{})
+		assert.NoError(t, err)
+		persisted = append(persisted, state.DeepCopy())
+		return nil
+	}
+	updated, err := syncCtx.runHook(hook, v1alpha1.HookTypePreSync)
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	assert.Len(t, persisted, 1)
+	assert.Len(t, persisted[0].SyncResult.Hooks, 1)
+	assert.Equal(t, v1alpha1.OperationSucceeded, persisted[0].SyncResult.Hooks[0].Status)
+	_, err = resIf.Get(hook.GetName(), v1.GetOptions{})
+	assert.Error(t, err)
+
+	// the hook is not run again once its completion was recorded
+	updated, err = syncCtx.runHook(hook, v1alpha1.HookTypePreSync)
+	assert.NoError(t, err)
+	assert.False(t, updated)
+	assert.Len(t, persisted, 1)
+
+	// the hook is kept if its completion could not be persisted
+	syncCtx.syncRes.Hooks = nil
+	_, err = resIf.Create(hook.DeepCopy(), v1.CreateOptions{})
+	assert.NoError(t, err)
+	syncCtx.persistState = func(state *v1alpha1.OperationState) error {
+		return fmt.Errorf("conflict")
+	}
+	_, err = syncCtx.runHook(hook, v1alpha1.HookTypePreSync)
+	assert.Error(t, err)
+	_, err = resIf.Get(hook.GetName(), v1.GetOptions{})
+	assert.NoError(t, err)
+}
+
 func TestRunWorkflows(t *testing.T) {
 	// syncCtx := newTestSyncCtx()
 	// syncCtx.doWorkflowSync(nil, nil)
@@ -400,3 +777,37 @@ func TestSortManifestHandleNil(t *testing.T) {
 	assert.Nil(t, manifest[1].targetObj)
 
 }
+
+func TestGetImpersonationConfig(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		Spec: v1alpha1.AppProjectSpec{
+			DestinationServiceAccounts: []v1alpha1.DestinationServiceAccount{
+				{Server: "https://cluster-api.com", Namespace: "*", ServiceAccount: "deployer"},
+			},
+		},
+	}
+	clst := &v1alpha1.Cluster{Name: "in-cluster", Server: "https://cluster-api.com"}
+
+	t.Run("DestinationByServer", func(t *testing.T) {
+		config, err := getImpersonationConfig(proj, clst, v1alpha1.ApplicationDestination{Server: "https://cluster-api.com", Namespace: "guestbook"})
+		assert.Nil(t, err)
+		assert.Equal(t, "system:serviceaccount:guestbook:deployer", config.UserName)
+	})
+
+	t.Run("DestinationByName", func(t *testing.T) {
+		config, err := getImpersonationConfig(proj, clst, v1alpha1.ApplicationDestination{Name: "in-cluster", Namespace: "guestbook"})
+		assert.Nil(t, err)
+		assert.Equal(t, "system:serviceaccount:guestbook:deployer", config.UserName)
+	})
+
+	t.Run("NoServiceAccount", func(t *testing.T) {
+		config, err := getImpersonationConfig(proj, &v1alpha1.Cluster{Server: "https://other-cluster"}, v1alpha1.ApplicationDestination{Server: "https://other-cluster", Namespace: "guestbook"})
+		assert.Nil(t, err)
+		assert.Equal(t, rest.ImpersonationConfig{}, config)
+	})
+
+	t.Run("NoNamespace", func(t *testing.T) {
+		_, err := getImpersonationConfig(proj, clst, v1alpha1.ApplicationDestination{Name: "in-cluster"})
+		assert.NotNil(t, err)
+	})
+}
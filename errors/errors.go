@@ -1,12 +1,48 @@
 package errors
 
 import (
+	"os"
+
 	log "github.com/sirupsen/logrus"
+
+	grpc_util "github.com/argoproj/argo-cd/util/grpc"
+)
+
+const (
+	// ErrorGeneric is the exit code of errors without a machine-readable reason
+	ErrorGeneric = 1
+	// ErrorRepoAuthFailed is the exit code of errors caused by a git repository which cannot be accessed
+	ErrorRepoAuthFailed = 20
+	// ErrorProjectViolation is the exit code of errors caused by a violation of the restrictions of a project
+	ErrorProjectViolation = 21
+	// ErrorClusterUnreachable is the exit code of errors caused by a cluster which cannot be reached
+	ErrorClusterUnreachable = 22
+	// ErrorManifestError is the exit code of errors caused by manifests which cannot be generated
+	ErrorManifestError = 23
+	// ErrorInvalidSpec is the exit code of the other errors caused by an invalid application spec
+	ErrorInvalidSpec = 24
 )
 
-// CheckError is a convenience function to exit if an error is non-nil and exit if it was
+// exitCodes are the exit codes of the reasons of API errors, so that scripts can branch on the type of
+// the errors
+var exitCodes = map[grpc_util.ErrorReason]int{
+	grpc_util.ErrorReasonRepoAuthFailed:     ErrorRepoAuthFailed,
+	grpc_util.ErrorReasonProjectViolation:   ErrorProjectViolation,
+	grpc_util.ErrorReasonClusterUnreachable: ErrorClusterUnreachable,
+	grpc_util.ErrorReasonManifestError:      ErrorManifestError,
+	grpc_util.ErrorReasonInvalidSpec:        ErrorInvalidSpec,
+}
+
+// CheckError is a convenience function to exit if an error is non-nil and exit if it was. API errors
+// with a machine-readable reason exit with the code of their first reason
 func CheckError(err error) {
 	if err != nil {
+		if details := grpc_util.GetErrorDetails(err); len(details) > 0 {
+			if code, ok := exitCodes[details[0].Reason]; ok {
+				log.WithField("reason", details[0].Reason).Error(err)
+				os.Exit(code)
+			}
+		}
 		log.Fatal(err)
 	}
 }
@@ -99,7 +99,20 @@ collect_swagger() {
     "description": "Description of all APIs",
     "version": "version not set"
   },
-  "paths": {}
+  "paths": {},
+  "securityDefinitions": {
+    "bearer": {
+      "description": "Argo CD auth token, formatted as: Bearer <token>",
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "bearer": []
+    }
+  ]
 }
 EOF
 
@@ -5,7 +5,9 @@ import (
 	fmt "fmt"
 	"path/filepath"
 	"reflect"
+	"strconv"
 	"strings"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -50,6 +52,36 @@ type SyncOperation struct {
 	ParameterOverrides ParameterOverrides `json:"parameterOverrides" protobuf:"bytes,5,opt,name=parameterOverrides"`
 	// Resources describes which resources to sync
 	Resources []SyncOperationResource `json:"resources,omitempty" protobuf:"bytes,6,opt,name=resources"`
+	// SyncOptions provide per-sync options, in addition to the sync options of the sync policy of the application
+	SyncOptions SyncOptions `json:"syncOptions,omitempty" protobuf:"bytes,7,opt,name=syncOptions"`
+	// Source is the application source to sync, e.g. the source of a deployment being rolled back to.
+	// If nil, uses the source of the application
+	Source *ApplicationSource `json:"source,omitempty" protobuf:"bytes,8,opt,name=source"`
+}
+
+const (
+	// SyncOptionPruneLast defers the pruning of resources until all the other resources are synced and healthy
+	SyncOptionPruneLast = "PruneLast=true"
+	// SyncOptionApplyOutOfSyncOnly skips the apply of the resources which are already synced
+	SyncOptionApplyOutOfSyncOnly = "ApplyOutOfSyncOnly=true"
+	// SyncOptionFailFast stops the sync at the first resource which fails to sync
+	SyncOptionFailFast = "FailFast=true"
+	// SyncOptionCreateNamespace creates the destination namespace if it does not exist, and updates its
+	// managed metadata on every sync
+	SyncOptionCreateNamespace = "CreateNamespace=true"
+)
+
+// SyncOptions are options of the sync, formatted as KEY=VALUE (e.g. PruneLast=true)
+type SyncOptions []string
+
+// HasOption returns whether the given option is set
+func (o SyncOptions) HasOption(option string) bool {
+	for _, i := range o {
+		if i == option {
+			return true
+		}
+	}
+	return false
 }
 
 // ParameterOverrides masks the value so protobuf can generate
@@ -63,7 +95,24 @@ func (po ParameterOverrides) String() string {
 
 // Operation contains requested operation parameters.
 type Operation struct {
-	Sync *SyncOperation `json:"sync,omitempty" protobuf:"bytes,1,opt,name=sync"`
+	Sync        *SyncOperation     `json:"sync,omitempty" protobuf:"bytes,1,opt,name=sync"`
+	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,2,opt,name=initiatedBy"`
+}
+
+// OperationInitiator holds information about the operation initiator
+type OperationInitiator struct {
+	// Username is the name of the user who started the operation
+	Username string `json:"username,omitempty" protobuf:"bytes,1,opt,name=username"`
+	// Automated is set to true if the operation was initiated automatically by the sync policy
+	Automated bool `json:"automated,omitempty" protobuf:"bytes,2,opt,name=automated"`
+}
+
+// OperationLock records the operation which holds the lock serializing the operations of an application
+type OperationLock struct {
+	// Holder describes the initiator of the operation holding the lock
+	Holder string `json:"holder" protobuf:"bytes,1,opt,name=holder"`
+	// AcquiredAt is the time the lock was acquired
+	AcquiredAt metav1.Time `json:"acquiredAt" protobuf:"bytes,2,opt,name=acquiredAt"`
 }
 
 type OperationPhase string
@@ -74,11 +123,13 @@ const (
 	OperationFailed      OperationPhase = "Failed"
 	OperationError       OperationPhase = "Error"
 	OperationSucceeded   OperationPhase = "Succeeded"
+	// OperationTerminated is the phase of an operation terminated at the request of a user
+	OperationTerminated OperationPhase = "Terminated"
 )
 
 func (os OperationPhase) Completed() bool {
 	switch os {
-	case OperationFailed, OperationError, OperationSucceeded:
+	case OperationFailed, OperationError, OperationSucceeded, OperationTerminated:
 		return true
 	}
 	return false
@@ -108,12 +159,20 @@ type OperationState struct {
 type SyncPolicy struct {
 	// Automated will keep an application synced to the target revision
 	Automated *SyncPolicyAutomated `json:"automated,omitempty" protobuf:"bytes,1,opt,name=automated"`
+	// SyncOptions are the options of every sync of the application, automated or not
+	SyncOptions SyncOptions `json:"syncOptions,omitempty" protobuf:"bytes,2,opt,name=syncOptions"`
+	// ManagedNamespaceMetadata holds the labels and annotations of the destination namespace, when it is
+	// created and updated by syncs with the CreateNamespace sync option
+	ManagedNamespaceMetadata *ResourceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,3,opt,name=managedNamespaceMetadata"`
 }
 
 // SyncPolicyAutomated controls the behavior of an automated sync
 type SyncPolicyAutomated struct {
 	// Prune will prune resources automatically as part of automated sync (default: false)
 	Prune bool `json:"prune,omitempty" protobuf:"bytes,1,opt,name=prune"`
+	// Schedule is an optional cron schedule (e.g. "0 2 * * *"). If set, automated sync is only
+	// performed on the first refresh after a scheduled time
+	Schedule string `json:"schedule,omitempty" protobuf:"bytes,2,opt,name=schedule"`
 }
 
 // SyncStrategy controls the manner in which a sync is performed
@@ -184,6 +243,8 @@ type SyncOperationResult struct {
 	Revision string `json:"revision" protobuf:"bytes,2,opt,name=revision"`
 	// Hooks contains list of hook resource statuses associated with this operation
 	Hooks []*HookStatus `json:"hooks,omitempty" protobuf:"bytes,3,opt,name=hooks"`
+	// Source records the application source the sync was performed with
+	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,4,opt,name=source"`
 }
 
 type ResourceSyncStatus string
@@ -193,6 +254,9 @@ const (
 	ResourceDetailsSyncFailed      ResourceSyncStatus = "SyncFailed"
 	ResourceDetailsSyncedAndPruned ResourceSyncStatus = "SyncedAndPruned"
 	ResourceDetailsPruningRequired ResourceSyncStatus = "PruningRequired"
+	// ResourceDetailsPrunePending is the status of the resources whose pruning is deferred until the other
+	// resources are synced and healthy
+	ResourceDetailsPrunePending ResourceSyncStatus = "PrunePending"
 )
 
 func (s ResourceSyncStatus) Successful() bool {
@@ -205,6 +269,8 @@ type ResourceDetails struct {
 	Namespace string             `json:"namespace" protobuf:"bytes,3,opt,name=namespace"`
 	Message   string             `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
 	Status    ResourceSyncStatus `json:"status,omitempty" protobuf:"bytes,5,opt,name=status"`
+	// Group is the API group of the resource
+	Group string `json:"group,omitempty" protobuf:"bytes,6,opt,name=group"`
 }
 
 // DeploymentInfo contains information relevant to an application deployment
@@ -213,6 +279,14 @@ type DeploymentInfo struct {
 	ComponentParameterOverrides []ComponentParameter `json:"componentParameterOverrides,omitempty" protobuf:"bytes,3,opt,name=componentParameterOverrides"`
 	DeployedAt                  metav1.Time          `json:"deployedAt" protobuf:"bytes,4,opt,name=deployedAt"`
 	ID                          int64                `json:"id" protobuf:"bytes,5,opt,name=id"`
+	// DeployStartedAt holds the time the deployment operation started
+	DeployStartedAt *metav1.Time `json:"deployStartedAt,omitempty" protobuf:"bytes,6,opt,name=deployStartedAt"`
+	// InitiatedBy holds information about who initiated the deployment
+	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,7,opt,name=initiatedBy"`
+	// Source is the application source which was deployed
+	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,8,opt,name=source"`
+	// Parameters holds the resolved parameters, including overrides, the manifests were generated with
+	Parameters []ComponentParameter `json:"parameters,omitempty" protobuf:"bytes,9,opt,name=parameters"`
 }
 
 // Application is a definition of Application resource.
@@ -257,6 +331,16 @@ type ApplicationSpec struct {
 	Project string `json:"project" protobuf:"bytes,3,name=project"`
 	// SyncPolicy controls when a sync will be performed
 	SyncPolicy *SyncPolicy `json:"syncPolicy,omitempty" protobuf:"bytes,4,name=syncPolicy"`
+	// ResourceMetadata contains labels and annotations added to every resource deployed by the application
+	ResourceMetadata *ResourceMetadata `json:"resourceMetadata,omitempty" protobuf:"bytes,5,opt,name=resourceMetadata"`
+}
+
+// ResourceMetadata holds labels and annotations stamped onto the resources of an application
+type ResourceMetadata struct {
+	// Labels are added to every resource and to the pod templates of workloads
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,1,rep,name=labels"`
+	// Annotations are added to every resource
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,2,rep,name=annotations"`
 }
 
 // ComponentParameter contains information about component parameter value
@@ -283,6 +367,27 @@ type ApplicationSource struct {
 	ValuesFiles []string `json:"valuesFiles,omitempty" protobuf:"bytes,6,opt,name=valuesFiles"`
 	// NamePrefix is a prefix appended to resources for helm and kustomize apps
 	NamePrefix string `json:"namePrefix" protobuf:"bytes,7,opt,name=namePrefix"`
+	// Directory marks the path as a plain directory of YAML/JSON manifests, skipping tool detection
+	Directory *ApplicationSourceDirectory `json:"directory,omitempty" protobuf:"bytes,8,opt,name=directory"`
+	// Override is a set of key/value pairs passed to the tool rendering the application: helm values,
+	// kustomize image tags or jsonnet external variables
+	Override map[string]string `json:"override,omitempty" protobuf:"bytes,9,rep,name=override"`
+}
+
+// ApplicationSourceDirectory holds options for applications sourced from a plain directory of manifests
+type ApplicationSourceDirectory struct {
+	// Jsonnet enables evaluation of .jsonnet files. Otherwise only YAML and JSON files are applied
+	Jsonnet bool `json:"jsonnet,omitempty" protobuf:"bytes,1,opt,name=jsonnet"`
+	// ExtVars is a list of external variables made available to .jsonnet files via std.extVar()
+	ExtVars []JsonnetVar `json:"extVars,omitempty" protobuf:"bytes,2,rep,name=extVars"`
+}
+
+// JsonnetVar is a jsonnet external variable
+type JsonnetVar struct {
+	Name  string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	Value string `json:"value" protobuf:"bytes,2,opt,name=value"`
+	// Code marks the value as a jsonnet expression to evaluate, instead of a plain string
+	Code bool `json:"code,omitempty" protobuf:"bytes,3,opt,name=code"`
 }
 
 // ApplicationDestination contains deployment destination information
@@ -291,6 +396,9 @@ type ApplicationDestination struct {
 	Server string `json:"server,omitempty" protobuf:"bytes,1,opt,name=server"`
 	// Namespace overrides the environment namespace value in the ksonnet app.yaml
 	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// Name is the name of a registered cluster. If set, it takes precedence over Server, and the
+	// server is resolved from the cluster of that name
+	Name string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
 }
 
 // ComparisonStatus is a type which represents possible comparison results
@@ -311,8 +419,74 @@ type ApplicationStatus struct {
 	Health           HealthStatus           `json:"health,omitempty" protobuf:"bytes,4,opt,name=health"`
 	OperationState   *OperationState        `json:"operationState,omitempty" protobuf:"bytes,5,opt,name=operationState"`
 	Conditions       []ApplicationCondition `json:"conditions,omitempty" protobuf:"bytes,6,opt,name=conditions"`
+	Resources        []ResourceStatus       `json:"resources,omitempty" protobuf:"bytes,7,opt,name=resources"`
+	// ReconciledAt indicates when the application state was last reconciled by the controller
+	ReconciledAt *metav1.Time `json:"reconciledAt,omitempty" protobuf:"bytes,8,opt,name=reconciledAt"`
+	// ObservedDestination is the destination in which the controller manages application resources.
+	// It differs from spec.destination while a destination change is pending
+	ObservedDestination *ApplicationDestination `json:"observedDestination,omitempty" protobuf:"bytes,9,opt,name=observedDestination"`
+	// OperationLock is set while an operation is in progress
+	OperationLock *OperationLock `json:"operationLock,omitempty" protobuf:"bytes,10,opt,name=operationLock"`
+	// QueuedOperations are the operations started, in order, once the operation in progress completes
+	QueuedOperations []Operation `json:"queuedOperations,omitempty" protobuf:"bytes,11,rep,name=queuedOperations"`
 }
 
+// RefreshType specifies how thoroughly an application should be refreshed
+type RefreshType string
+
+const (
+	// RefreshTypeNormal re-compares the application using any cached manifests
+	RefreshTypeNormal RefreshType = "normal"
+	// RefreshTypeHard regenerates manifests, bypassing any caches
+	RefreshTypeHard RefreshType = "hard"
+)
+
+// ReconciliationPause specifies which part of the reconciliation of applications is paused
+type ReconciliationPause string
+
+const (
+	// ReconciliationPauseNone pauses nothing
+	ReconciliationPauseNone ReconciliationPause = ""
+	// ReconciliationPauseSync pauses the automated syncs, while the applications are still compared
+	ReconciliationPauseSync ReconciliationPause = "sync"
+	// ReconciliationPauseAll pauses the automated syncs and the comparisons
+	ReconciliationPauseAll ReconciliationPause = "all"
+)
+
+// ParseReconciliationPause returns the reconciliation pause of the given pause-reconciliation
+// annotation value, or false if the value is invalid
+func ParseReconciliationPause(value string) (ReconciliationPause, bool) {
+	switch pause := ReconciliationPause(value); pause {
+	case ReconciliationPauseNone, ReconciliationPauseSync, ReconciliationPauseAll:
+		return pause, true
+	}
+	return ReconciliationPauseNone, false
+}
+
+// GetReconciliationPause returns the reconciliation pause set by the pause-reconciliation annotation in
+// the given annotations. Invalid values pause nothing
+func GetReconciliationPause(annotations map[string]string) ReconciliationPause {
+	pause, _ := ParseReconciliationPause(annotations[common.AnnotationPauseReconciliation])
+	return pause
+}
+
+// Includes returns true if the pause pauses at least what the other pause pauses
+func (p ReconciliationPause) Includes(other ReconciliationPause) bool {
+	return p == other || p == ReconciliationPauseAll || other == ReconciliationPauseNone
+}
+
+// PreviousDestinationPolicy specifies how resources at the previous destination of an application
+// are handled after the application destination was changed
+type PreviousDestinationPolicy string
+
+const (
+	// PreviousDestinationPolicyPrune deletes the application resources at the previous destination
+	PreviousDestinationPolicyPrune PreviousDestinationPolicy = "prune"
+	// PreviousDestinationPolicyOrphan leaves the application resources at the previous destination
+	// untouched, and stops managing them
+	PreviousDestinationPolicyOrphan PreviousDestinationPolicy = "orphan"
+)
+
 // ApplicationConditionType represents type of application condition. Type name has following convention:
 // prefix "Error" means error condition
 // prefix "Warning" means warning condition
@@ -328,10 +502,18 @@ const (
 	ApplicationConditionComparisonError = "ComparisonError"
 	// ApplicationConditionSyncError indicates controller failed to automatically sync the application
 	ApplicationConditionSyncError = "SyncError"
+	// ApplicationConditionResourceLimitError indicates that application manifests exceed the limits of its project
+	ApplicationConditionResourceLimitError = "ResourceLimitError"
 	// ApplicationConditionUnknownError indicates an unknown controller error
 	ApplicationConditionUnknownError = "UnknownError"
 	// ApplicationConditionSharedResourceWarning indicates that controller detected resources which belongs to more than one application
 	ApplicationConditionSharedResourceWarning = "SharedResourceWarning"
+	// ApplicationConditionDestinationChangedWarning indicates that application destination was changed and resources at the previous destination have not been pruned or orphaned yet
+	ApplicationConditionDestinationChangedWarning = "DestinationChangedWarning"
+	// ApplicationConditionReconciliationPausedWarning indicates that the automated syncs, and possibly the comparisons, of the application are paused
+	ApplicationConditionReconciliationPausedWarning = "ReconciliationPausedWarning"
+	// ApplicationConditionDeprecatedAPIWarning indicates that the manifests use API versions deprecated or removed in the version of the destination cluster or in the next minor version
+	ApplicationConditionDeprecatedAPIWarning = "DeprecatedAPIWarning"
 )
 
 // ApplicationCondition contains details about current application condition
@@ -340,6 +522,8 @@ type ApplicationCondition struct {
 	Type ApplicationConditionType `json:"type" protobuf:"bytes,1,opt,name=type"`
 	// Message contains human-readable message indicating details about condition
 	Message string `json:"message" protobuf:"bytes,2,opt,name=message"`
+	// LastTransitionTime is the time the condition was first observed
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,3,opt,name=lastTransitionTime"`
 }
 
 // ComparisonResult is a comparison result of application spec and deployed application.
@@ -349,6 +533,12 @@ type ComparisonResult struct {
 	Status     ComparisonStatus  `json:"status" protobuf:"bytes,5,opt,name=status,casttype=ComparisonStatus"`
 	Resources  []ResourceState   `json:"resources" protobuf:"bytes,6,opt,name=resources"`
 	Revision   string            `json:"revision" protobuf:"bytes,7,opt,name=revision"`
+	// StateHash is the hash of the target and live states the comparison was made with. The comparison
+	// is reused while they do not change, including after the controller restarts
+	StateHash string `json:"stateHash,omitempty" protobuf:"bytes,8,opt,name=stateHash"`
+	// TargetHash is the hash of the source, destination and resolved revision the target manifests were
+	// generated from. The manifests are not generated again while they do not change
+	TargetHash string `json:"targetHash,omitempty" protobuf:"bytes,9,opt,name=targetHash"`
 }
 
 type HealthStatus struct {
@@ -379,6 +569,33 @@ type ResourceState struct {
 	Status             ComparisonStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 	ChildLiveResources []ResourceNode   `json:"childLiveResources,omitempty" protobuf:"bytes,4,opt,name=childLiveResources"`
 	Health             HealthStatus     `json:"health,omitempty" protobuf:"bytes,5,opt,name=health"`
+	Diff               ResourceDiff     `json:"diff,omitempty" protobuf:"bytes,6,opt,name=diff"`
+}
+
+// ResourceStatus holds the sync and health status of a single resource managed by the application
+type ResourceStatus struct {
+	Group     string           `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Version   string           `json:"version,omitempty" protobuf:"bytes,2,opt,name=version"`
+	Kind      string           `json:"kind,omitempty" protobuf:"bytes,3,opt,name=kind"`
+	Namespace string           `json:"namespace,omitempty" protobuf:"bytes,4,opt,name=namespace"`
+	Name      string           `json:"name,omitempty" protobuf:"bytes,5,opt,name=name"`
+	Status    ComparisonStatus `json:"status,omitempty" protobuf:"bytes,6,opt,name=status"`
+	Health    HealthStatus     `json:"health,omitempty" protobuf:"bytes,7,opt,name=health"`
+	// Hook indicates the resource is a hook, which is executed during a sync rather than compared
+	Hook bool `json:"hook,omitempty" protobuf:"bytes,8,opt,name=hook"`
+}
+
+// ResourceDiff holds the normalized target and live states of a resource, as well as a summary of
+// the differences between them, so that clients do not need to re-implement diffing
+type ResourceDiff struct {
+	// NormalizedTargetState is the JSON of the state the live resource is expected to have once synced
+	NormalizedTargetState string `json:"normalizedTargetState,omitempty" protobuf:"bytes,1,opt,name=normalizedTargetState"`
+	// NormalizedLiveState is the JSON of the live resource, stripped of fields ignored during comparison
+	NormalizedLiveState string `json:"normalizedLiveState,omitempty" protobuf:"bytes,2,opt,name=normalizedLiveState"`
+	// Modified indicates the normalized target and live states differ
+	Modified bool `json:"modified,omitempty" protobuf:"bytes,3,opt,name=modified"`
+	// ChangedPaths lists the paths of fields which differ, e.g. spec.replicas
+	ChangedPaths []string `json:"changedPaths,omitempty" protobuf:"bytes,4,opt,name=changedPaths"`
 }
 
 // ConnectionStatus represents connection status
@@ -410,6 +627,38 @@ type Cluster struct {
 
 	// ConnectionState contains information about cluster connection state
 	ConnectionState ConnectionState `json:"connectionState,omitempty" protobuf:"bytes,4,opt,name=connectionState"`
+
+	// Namespaces restricts Argo CD to the listed namespaces of the cluster. If empty, the whole cluster is managed
+	Namespaces []string `json:"namespaces,omitempty" protobuf:"bytes,5,rep,name=namespaces"`
+
+	// CacheInfo contains information about the resync state of the resources of the cluster
+	CacheInfo ClusterCacheInfo `json:"cacheInfo,omitempty" protobuf:"bytes,6,opt,name=cacheInfo"`
+}
+
+// ClusterCacheInfo contains information about the resync state of the resources of a cluster. The
+// controller resyncs a cluster, refreshing all its applications, whenever it (re)starts watching it
+type ClusterCacheInfo struct {
+	// LastResyncAt is the time of the last successful full resync of the cluster
+	LastResyncAt *metav1.Time `json:"lastResyncAt,omitempty" protobuf:"bytes,1,opt,name=lastResyncAt"`
+	// ResyncRequestedAt is the time of the last forced resync request of the cluster
+	ResyncRequestedAt *metav1.Time `json:"resyncRequestedAt,omitempty" protobuf:"bytes,2,opt,name=resyncRequestedAt"`
+	// HandledResyncRequestedAt is the ResyncRequestedAt of the last forced resync request handled by the controller
+	HandledResyncRequestedAt *metav1.Time `json:"handledResyncRequestedAt,omitempty" protobuf:"bytes,3,opt,name=handledResyncRequestedAt"`
+}
+
+// Age returns the time elapsed since the last successful full resync, or 0 if the cluster was never resynced
+func (c *ClusterCacheInfo) Age() time.Duration {
+	if c.LastResyncAt == nil {
+		return 0
+	}
+	return time.Since(c.LastResyncAt.Time)
+}
+
+// IsResyncRequested returns whether a forced resync was requested and not handled by the controller yet.
+// The request is compared with the request handled by the controller, rather than with the time of the
+// last resync, since the clocks of the API server and of the controller may differ
+func (c *ClusterCacheInfo) IsResyncRequested() bool {
+	return c.ResyncRequestedAt != nil && (c.HandledResyncRequestedAt == nil || !c.HandledResyncRequestedAt.Equal(c.ResyncRequestedAt))
 }
 
 // ClusterList is a collection of Clusters.
@@ -473,6 +722,8 @@ type Repository struct {
 	Password        string          `json:"password,omitempty" protobuf:"bytes,3,opt,name=password"`
 	SSHPrivateKey   string          `json:"sshPrivateKey,omitempty" protobuf:"bytes,4,opt,name=sshPrivateKey"`
 	ConnectionState ConnectionState `json:"connectionState,omitempty" protobuf:"bytes,5,opt,name=connectionState"`
+	// ApplicationCount is the number of applications sourced from the repository. Only populated when listing repositories.
+	ApplicationCount int64 `json:"applicationCount,omitempty" protobuf:"varint,6,opt,name=applicationCount"`
 }
 
 // RepositoryList is a collection of Repositories.
@@ -481,6 +732,28 @@ type RepositoryList struct {
 	Items           []Repository `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+// HelmRepository is a Helm chart repository, which the dependencies of charts can be downloaded from
+type HelmRepository struct {
+	// Name is the name the repository is referred to in the requirements of charts (e.g. "@stable")
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// URL is the URL of the repository
+	URL      string `json:"url" protobuf:"bytes,2,opt,name=url"`
+	Username string `json:"username,omitempty" protobuf:"bytes,3,opt,name=username"`
+	Password string `json:"password,omitempty" protobuf:"bytes,4,opt,name=password"`
+}
+
+// HelmChart is a chart of a Helm repository
+type HelmChart struct {
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Versions are the versions of the chart, as ordered in the index of the repository (latest first)
+	Versions []string `json:"versions" protobuf:"bytes,2,rep,name=versions"`
+}
+
+// HelmChartList is a collection of Helm charts
+type HelmChartList struct {
+	Items []HelmChart `json:"items" protobuf:"bytes,1,rep,name=items"`
+}
+
 // AppProjectList is list of AppProject resources
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 type AppProjectList struct {
@@ -528,6 +801,73 @@ type AppProjectSpec struct {
 
 	// NamespaceResourceBlacklist contains list of blacklisted namespace level resources
 	NamespaceResourceBlacklist []metav1.GroupKind `json:"namespaceResourceBlacklist,omitempty" protobuf:"bytes,6,opt,name=namespaceResourceBlacklist"`
+
+	// Maintainers contains optional list of people or teams responsible for the project
+	Maintainers []string `json:"maintainers,omitempty" protobuf:"bytes,7,rep,name=maintainers"`
+
+	// SyncPolicy is the sync policy of applications of the project which don't define their own
+	SyncPolicy *SyncPolicy `json:"syncPolicy,omitempty" protobuf:"bytes,8,opt,name=syncPolicy"`
+
+	// SyncOptions contains default and required options of syncs of applications of the project
+	SyncOptions *ProjectSyncOptions `json:"syncOptions,omitempty" protobuf:"bytes,9,opt,name=syncOptions"`
+
+	// DestinationServiceAccounts maps destinations to the service account impersonated when syncing to them
+	DestinationServiceAccounts []DestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,10,rep,name=destinationServiceAccounts"`
+
+	// DeleteProtection prevents applications of the project from being deleted without a forced override
+	DeleteProtection bool `json:"deleteProtection,omitempty" protobuf:"varint,11,opt,name=deleteProtection"`
+
+	// ApplicationLimits limits the resources each application of the project may deploy
+	ApplicationLimits *ApplicationLimits `json:"applicationLimits,omitempty" protobuf:"bytes,12,opt,name=applicationLimits"`
+
+	// ApplicationTemplate holds the defaults applied to the applications of the project when they are created
+	ApplicationTemplate *ApplicationTemplate `json:"applicationTemplate,omitempty" protobuf:"bytes,13,opt,name=applicationTemplate"`
+}
+
+// ApplicationTemplate holds defaults of the applications of a project, which are applied by the API server
+// when the applications are created. Values set by the applications are kept
+type ApplicationTemplate struct {
+	// Labels are added to the applications
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,1,rep,name=labels"`
+	// Annotations are added to the applications
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,2,rep,name=annotations"`
+	// Finalizers are added to the applications, e.g. resources-finalizer.argocd.argoproj.io to delete the
+	// resources of the applications when they are deleted
+	Finalizers []string `json:"finalizers,omitempty" protobuf:"bytes,3,rep,name=finalizers"`
+	// Destination holds the server and namespace of the applications which don't set them
+	Destination ApplicationDestination `json:"destination,omitempty" protobuf:"bytes,4,opt,name=destination"`
+	// SyncPolicy is the sync policy of the applications which don't define one. Unlike the default sync
+	// policy of the project, it is copied into the applications, which may change it afterwards
+	SyncPolicy *SyncPolicy `json:"syncPolicy,omitempty" protobuf:"bytes,5,opt,name=syncPolicy"`
+}
+
+// ApplicationLimits holds the limits of the manifests an application may deploy. Syncs of applications
+// exceeding them are rejected. A zero value means no limit
+type ApplicationLimits struct {
+	// MaxResources is the maximum number of resources of an application
+	MaxResources int64 `json:"maxResources,omitempty" protobuf:"varint,1,opt,name=maxResources"`
+	// MaxManifestSize is the maximum total size, in bytes, of the manifests of an application
+	MaxManifestSize int64 `json:"maxManifestSize,omitempty" protobuf:"varint,2,opt,name=maxManifestSize"`
+}
+
+// DestinationServiceAccount holds the service account impersonated by the controller when applying
+// resources to a destination. The service account is looked up in the namespace of the application
+type DestinationServiceAccount struct {
+	// Server is the destination cluster URL, or "*" to match any cluster
+	Server string `json:"server" protobuf:"bytes,1,opt,name=server"`
+	// Namespace is the destination namespace, or "*" to match any namespace
+	Namespace string `json:"namespace" protobuf:"bytes,2,opt,name=namespace"`
+	// ServiceAccount is the name of the service account to impersonate
+	ServiceAccount string `json:"serviceAccount" protobuf:"bytes,3,opt,name=serviceAccount"`
+}
+
+// ProjectSyncOptions holds the sync options inherited by applications of a project
+type ProjectSyncOptions struct {
+	// DefaultStrategy is the strategy of syncs which don't specify one
+	DefaultStrategy *SyncStrategy `json:"defaultStrategy,omitempty" protobuf:"bytes,1,opt,name=defaultStrategy"`
+	// Prune, if set, is enforced on all syncs, manual or automated. Setting it to false prevents
+	// resources from being pruned
+	Prune *bool `json:"prune,omitempty" protobuf:"varint,2,opt,name=prune"`
 }
 
 // ProjectRole represents a role that has access to a project
@@ -594,6 +934,16 @@ func (source ApplicationSource) Equals(other ApplicationSource) bool {
 	return reflect.DeepEqual(source, other)
 }
 
+// HasCluster returns true if the destination references a cluster, either by server or by name
+func (dest ApplicationDestination) HasCluster() bool {
+	return dest.Server != "" || dest.Name != ""
+}
+
+// Equals compares two instances of ApplicationDestination and return true if instances are equal.
+func (dest ApplicationDestination) Equals(other ApplicationDestination) bool {
+	return dest == other
+}
+
 func (spec ApplicationSpec) BelongsToDefaultProject() bool {
 	return spec.GetProject() == common.DefaultAppProjectName
 }
@@ -653,6 +1003,121 @@ func (proj AppProject) IsDestinationPermitted(dst ApplicationDestination) bool {
 	return false
 }
 
+// GetDestinationServiceAccount returns the service account impersonated when syncing to the
+// destination, or an empty string if the project does not map one. The first match wins
+func (proj AppProject) GetDestinationServiceAccount(dst ApplicationDestination) string {
+	for _, item := range proj.Spec.DestinationServiceAccounts {
+		if item.Server == dst.Server || item.Server == "*" {
+			if item.Namespace == dst.Namespace || item.Namespace == "*" {
+				return item.ServiceAccount
+			}
+		}
+	}
+	return ""
+}
+
+// IsDeleteProtected returns true if the application can only be deleted with a forced override, either
+// because of its delete-protection annotation or the policy of the project
+func (proj AppProject) IsDeleteProtected(app *Application) bool {
+	if proj.Spec.DeleteProtection {
+		return true
+	}
+	protected, _ := strconv.ParseBool(app.Annotations[common.AnnotationDeleteProtection])
+	return protected
+}
+
+// GetSyncPolicy returns the sync policy of the application, or the default sync policy of the project
+// if the application does not define one
+func (proj AppProject) GetSyncPolicy(spec *ApplicationSpec) *SyncPolicy {
+	if spec.SyncPolicy != nil {
+		return spec.SyncPolicy
+	}
+	return proj.Spec.SyncPolicy
+}
+
+// ApplyApplicationTemplate sets the defaults of the application template of the project on the fields
+// of a new application which are unset
+func (proj AppProject) ApplyApplicationTemplate(app *Application) {
+	tmpl := proj.Spec.ApplicationTemplate
+	if tmpl == nil {
+		return
+	}
+	for key, value := range tmpl.Labels {
+		if _, ok := app.Labels[key]; !ok {
+			if app.Labels == nil {
+				app.Labels = make(map[string]string)
+			}
+			app.Labels[key] = value
+		}
+	}
+	for key, value := range tmpl.Annotations {
+		if _, ok := app.Annotations[key]; !ok {
+			if app.Annotations == nil {
+				app.Annotations = make(map[string]string)
+			}
+			app.Annotations[key] = value
+		}
+	}
+	for _, finalizer := range tmpl.Finalizers {
+		if app.getFinalizerIndex(finalizer) == -1 {
+			app.Finalizers = append(app.Finalizers, finalizer)
+		}
+	}
+	// the cluster is either referenced by server or by name: the template only sets it if the application
+	// references none
+	if app.Spec.Destination.Server == "" && app.Spec.Destination.Name == "" {
+		app.Spec.Destination.Server = tmpl.Destination.Server
+		app.Spec.Destination.Name = tmpl.Destination.Name
+	}
+	if app.Spec.Destination.Namespace == "" {
+		app.Spec.Destination.Namespace = tmpl.Destination.Namespace
+	}
+	if app.Spec.SyncPolicy == nil && tmpl.SyncPolicy != nil {
+		app.Spec.SyncPolicy = tmpl.SyncPolicy.DeepCopy()
+	}
+}
+
+// IsAutomatedSync returns true if the application is synced automatically, either because of its own
+// sync policy or the default sync policy of the project
+func (proj AppProject) IsAutomatedSync(spec *ApplicationSpec) bool {
+	policy := proj.GetSyncPolicy(spec)
+	return policy != nil && policy.Automated != nil
+}
+
+// ApplySyncOptions sets the default sync strategy of the project on a sync operation which does not
+// specify one, and enforces the required sync options of the project
+func (proj AppProject) ApplySyncOptions(op *SyncOperation) {
+	opts := proj.Spec.SyncOptions
+	if opts == nil {
+		return
+	}
+	if op.SyncStrategy == nil && opts.DefaultStrategy != nil {
+		op.SyncStrategy = opts.DefaultStrategy.DeepCopy()
+	}
+	if opts.Prune != nil {
+		op.Prune = *opts.Prune
+	}
+}
+
+// IsNamespaced returns true if Argo CD is restricted to a list of namespaces of the cluster
+func (c *Cluster) IsNamespaced() bool {
+	return len(c.Namespaces) > 0
+}
+
+// IsNamespacePermitted returns true if resources of the given namespace may be managed in the cluster.
+// An empty namespace denotes a cluster-scoped resource, which is never permitted in a namespaced cluster
+func (c *Cluster) IsNamespacePermitted(namespace string) bool {
+	if !c.IsNamespaced() {
+		return true
+	}
+	for _, ns := range c.Namespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
 // RESTConfig returns a go-client REST config from cluster
 func (c *Cluster) RESTConfig() *rest.Config {
 	if c.Server == common.KubernetesInternalAPIServerAddr && c.Config.Username == "" && c.Config.Password == "" && c.Config.BearerToken == "" {
@@ -732,6 +1197,45 @@ func UnmarshalToUnstructured(resource string) (*unstructured.Unstructured, error
 	return &obj, nil
 }
 
+// GetRefreshType returns the type of refresh requested for the application, if any
+func (app *Application) GetRefreshType() (RefreshType, bool) {
+	refreshType := RefreshTypeNormal
+	annotations := app.GetAnnotations()
+	if annotations == nil {
+		return refreshType, false
+	}
+	typeStr, ok := annotations[common.AnnotationKeyRefreshType]
+	if !ok {
+		return refreshType, false
+	}
+	if typeStr == string(RefreshTypeHard) {
+		refreshType = RefreshTypeHard
+	}
+	return refreshType, true
+}
+
+// GetPreviousDestinationPolicy returns how resources at the previous destination should be handled,
+// if requested
+func (app *Application) GetPreviousDestinationPolicy() (PreviousDestinationPolicy, bool) {
+	policyStr, ok := app.GetAnnotations()[common.AnnotationKeyPreviousDestinationPolicy]
+	if !ok {
+		return "", false
+	}
+	switch policy := PreviousDestinationPolicy(policyStr); policy {
+	case PreviousDestinationPolicyPrune, PreviousDestinationPolicyOrphan:
+		return policy, true
+	}
+	return "", false
+}
+
+// IsDestinationChangePending returns true if the application destination was changed after
+// resources had been deployed, and the resources at the previous destination were neither pruned
+// nor orphaned yet
+func (app *Application) IsDestinationChangePending() bool {
+	observed := app.Status.ObservedDestination
+	return observed != nil && len(app.Status.History) > 0 && !observed.Equals(app.Spec.Destination)
+}
+
 func (r ResourceState) LiveObject() (*unstructured.Unstructured, error) {
 	return UnmarshalToUnstructured(r.LiveState)
 }
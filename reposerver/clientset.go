@@ -1,34 +1,79 @@
 package reposerver
 
 import (
+	"context"
 	"crypto/tls"
+	"fmt"
+	"time"
 
 	"github.com/argoproj/argo-cd/reposerver/repository"
 	"github.com/argoproj/argo-cd/util"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// healthCheckTimeout is the maximum duration of a repo server health check
+	healthCheckTimeout = 5 * time.Second
 )
 
 // Clientset represets repository server api clients
 type Clientset interface {
 	NewRepositoryClient() (util.Closer, repository.RepositoryServiceClient, error)
+	NewHealthClient() (util.Closer, grpc_health_v1.HealthClient, error)
 }
 
 type clientSet struct {
 	address string
 }
 
-func (c *clientSet) NewRepositoryClient() (util.Closer, repository.RepositoryServiceClient, error) {
+func (c *clientSet) dial() (*grpc.ClientConn, error) {
 	conn, err := grpc.Dial(c.address, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})))
 	if err != nil {
 		log.Errorf("Unable to connect to repository service with address %s", c.address)
+		return nil, err
+	}
+	return conn, nil
+}
+
+func (c *clientSet) NewRepositoryClient() (util.Closer, repository.RepositoryServiceClient, error) {
+	conn, err := c.dial()
+	if err != nil {
 		return nil, nil, err
 	}
 	return conn, repository.NewRepositoryServiceClient(conn), nil
 }
 
+func (c *clientSet) NewHealthClient() (util.Closer, grpc_health_v1.HealthClient, error) {
+	conn, err := c.dial()
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, grpc_health_v1.NewHealthClient(conn), nil
+}
+
 // NewRepositoryServerClientset creates new instance of repo server Clientset
 func NewRepositoryServerClientset(address string) Clientset {
 	return &clientSet{address: address}
 }
+
+// CheckHealth returns an error unless the repo server is reachable and reports it is serving requests
+func CheckHealth(clientset Clientset) error {
+	conn, healthClient, err := clientset.NewHealthClient()
+	if err != nil {
+		return err
+	}
+	defer util.Close(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+	res, err := healthClient.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		return fmt.Errorf("repo server health check failed: %v", err)
+	}
+	if res.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		return fmt.Errorf("repo server is not serving: %s", res.Status)
+	}
+	return nil
+}
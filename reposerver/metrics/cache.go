@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"strings"
+
+	"github.com/argoproj/argo-cd/util/cache"
+)
+
+// cacheNames are the names of the caches of the repo server, by prefix of their keys
+var cacheNames = map[string]string{
+	"mfst":   "manifest",
+	"mfail":  "manifest-failure",
+	"ldir":   "list-dir",
+	"gfile":  "get-file",
+	"ksapp":  "ksonnet-app-details",
+	"hchart": "helm-charts",
+	"gref":   "git-refs",
+	"lapp":   "list-apps",
+	"rmeta":  "revision-metadata",
+}
+
+// cacheName returns the name of the cache the key belongs to
+func cacheName(key string) string {
+	prefix := strings.SplitN(key, "|", 2)[0]
+	if name, ok := cacheNames[prefix]; ok {
+		return name
+	}
+	return prefix
+}
+
+// metricsCache is a cache which records its hits, misses and evictions
+type metricsCache struct {
+	cache.Cache
+	metrics *MetricsServer
+}
+
+// NewCache returns a cache which records the hits, misses and evictions of the given cache. Evictions
+// are only recorded if the cache is a cache.EvictionNotifier.
+func NewCache(c cache.Cache, metrics *MetricsServer) cache.Cache {
+	if notifier, ok := c.(cache.EvictionNotifier); ok {
+		notifier.OnEvicted(func(key string) {
+			metrics.IncCacheEviction(cacheName(key))
+		})
+	}
+	return &metricsCache{Cache: c, metrics: metrics}
+}
+
+func (c *metricsCache) Get(key string, obj interface{}) error {
+	err := c.Cache.Get(key, obj)
+	switch err {
+	case nil:
+		c.metrics.IncCacheHit(cacheName(key))
+	case cache.ErrCacheMiss:
+		c.metrics.IncCacheMiss(cacheName(key))
+	}
+	return err
+}
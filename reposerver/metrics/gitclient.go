@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/argoproj/argo-cd/util/git"
+)
+
+// gitClientFactory is a git client factory whose clients record the git requests they perform
+type gitClientFactory struct {
+	factory git.ClientFactory
+	metrics *MetricsServer
+}
+
+// NewGitClientFactory returns a git client factory which records the requests of the clients created
+// by the given factory
+func NewGitClientFactory(factory git.ClientFactory, metrics *MetricsServer) git.ClientFactory {
+	return &gitClientFactory{factory: factory, metrics: metrics}
+}
+
+func (f *gitClientFactory) NewClient(repoURL, path, username, password, sshPrivateKey string) (git.Client, error) {
+	client, err := f.factory.NewClient(repoURL, path, username, password, sshPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &gitClient{Client: client, repoURL: repoURL, metrics: f.metrics}, nil
+}
+
+// gitClient is a git client which records the requests it performs
+type gitClient struct {
+	git.Client
+	repoURL string
+	metrics *MetricsServer
+}
+
+func (c *gitClient) Fetch(ctx context.Context) error {
+	startTime := time.Now()
+	err := c.Client.Fetch(ctx)
+	c.metrics.ObserveGitRequest(c.repoURL, GitRequestTypeFetch, time.Since(startTime), err)
+	return err
+}
+
+func (c *gitClient) Checkout(ctx context.Context, revision string) error {
+	startTime := time.Now()
+	err := c.Client.Checkout(ctx, revision)
+	c.metrics.ObserveGitRequest(c.repoURL, GitRequestTypeCheckout, time.Since(startTime), err)
+	return err
+}
+
+func (c *gitClient) LsRemote(ctx context.Context, revision string) (string, error) {
+	// commit SHAs are resolved without contacting the remote
+	if git.IsCommitSHA(revision) {
+		return c.Client.LsRemote(ctx, revision)
+	}
+	startTime := time.Now()
+	commitSHA, err := c.Client.LsRemote(ctx, revision)
+	c.metrics.ObserveGitRequest(c.repoURL, GitRequestTypeLsRemote, time.Since(startTime), err)
+	return commitSHA, err
+}
+
+func (c *gitClient) LsRefs(ctx context.Context) (*git.Refs, error) {
+	startTime := time.Now()
+	refs, err := c.Client.LsRefs(ctx)
+	c.metrics.ObserveGitRequest(c.repoURL, GitRequestTypeLsRemote, time.Since(startTime), err)
+	return refs, err
+}
@@ -0,0 +1,137 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	// MetricsPath is the endpoint to collect repo server metrics
+	MetricsPath = "/metrics"
+)
+
+// GitRequestType is the type of a git request performed by the repo server
+type GitRequestType string
+
+const (
+	GitRequestTypeLsRemote GitRequestType = "ls-remote"
+	GitRequestTypeFetch    GitRequestType = "fetch"
+	GitRequestTypeCheckout GitRequestType = "checkout"
+)
+
+// MetricsServer holds the metrics of the git requests, cache lookups and manifest generations performed
+// by the repo server
+type MetricsServer struct {
+	registry             *prometheus.Registry
+	gitRequestCounter    *prometheus.CounterVec
+	gitRequestHistogram  *prometheus.HistogramVec
+	cacheHitCounter      *prometheus.CounterVec
+	cacheMissCounter     *prometheus.CounterVec
+	cacheEvictionCounter *prometheus.CounterVec
+	manifestGenHistogram *prometheus.HistogramVec
+}
+
+// NewMetricsServer returns a new metrics server of the repo server
+func NewMetricsServer() *MetricsServer {
+	gitRequestCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_git_request_total",
+			Help: "Number of git requests performed by repo server.",
+		},
+		[]string{"repo", "request", "result"},
+	)
+	gitRequestHistogram := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_git_request_duration_seconds",
+			Help:    "Git requests performance.",
+			Buckets: []float64{0.1, 0.25, 0.5, 1, 2, 4, 10, 20},
+		},
+		[]string{"repo", "request", "result"},
+	)
+	cacheHitCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_repo_cache_hit_total",
+			Help: "Number of repo server cache lookups which found the item.",
+		},
+		[]string{"cache"},
+	)
+	cacheMissCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_repo_cache_miss_total",
+			Help: "Number of repo server cache lookups which did not find the item.",
+		},
+		[]string{"cache"},
+	)
+	cacheEvictionCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_repo_cache_eviction_total",
+			Help: "Number of items expired or deleted from the repo server cache.",
+		},
+		[]string{"cache"},
+	)
+	manifestGenHistogram := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_repo_manifest_generation_duration_seconds",
+			Help:    "Duration of the manifest generations performed by repo server.",
+			Buckets: []float64{0.1, 0.25, 0.5, 1, 2, 4, 10, 20, 60},
+		},
+		[]string{"repo", "tool"},
+	)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(gitRequestCounter)
+	registry.MustRegister(gitRequestHistogram)
+	registry.MustRegister(cacheHitCounter)
+	registry.MustRegister(cacheMissCounter)
+	registry.MustRegister(cacheEvictionCounter)
+	registry.MustRegister(manifestGenHistogram)
+	return &MetricsServer{
+		registry:             registry,
+		gitRequestCounter:    gitRequestCounter,
+		gitRequestHistogram:  gitRequestHistogram,
+		cacheHitCounter:      cacheHitCounter,
+		cacheMissCounter:     cacheMissCounter,
+		cacheEvictionCounter: cacheEvictionCounter,
+		manifestGenHistogram: manifestGenHistogram,
+	}
+}
+
+// ServeMetrics registers the metrics endpoint on the given mux. The endpoint also exposes the metrics
+// of the given collectors
+func (m *MetricsServer) ServeMetrics(mux *http.ServeMux, collectors ...prometheus.Collector) {
+	m.registry.MustRegister(collectors...)
+	mux.Handle(MetricsPath, promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+}
+
+// ObserveGitRequest records a git request to the repository, its duration and whether it failed
+func (m *MetricsServer) ObserveGitRequest(repo string, request GitRequestType, duration time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	m.gitRequestCounter.WithLabelValues(repo, string(request), result).Inc()
+	m.gitRequestHistogram.WithLabelValues(repo, string(request), result).Observe(duration.Seconds())
+}
+
+// IncCacheHit increments the number of lookups of the cache which found the item
+func (m *MetricsServer) IncCacheHit(cache string) {
+	m.cacheHitCounter.WithLabelValues(cache).Inc()
+}
+
+// IncCacheMiss increments the number of lookups of the cache which did not find the item
+func (m *MetricsServer) IncCacheMiss(cache string) {
+	m.cacheMissCounter.WithLabelValues(cache).Inc()
+}
+
+// IncCacheEviction increments the number of items expired or deleted from the cache
+func (m *MetricsServer) IncCacheEviction(cache string) {
+	m.cacheEvictionCounter.WithLabelValues(cache).Inc()
+}
+
+// ObserveManifestGeneration records the duration of a manifest generation of the repository, performed
+// with the given config management tool (e.g. helm or kustomize)
+func (m *MetricsServer) ObserveManifestGeneration(repo string, tool string, duration time.Duration) {
+	m.manifestGenHistogram.WithLabelValues(repo, tool).Observe(duration.Seconds())
+}
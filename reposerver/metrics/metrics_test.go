@@ -0,0 +1,123 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/util/cache"
+	"github.com/argoproj/argo-cd/util/git"
+)
+
+type fakeGitClient struct {
+	git.Client
+	fetchErr error
+}
+
+func (c *fakeGitClient) Fetch(ctx context.Context) error {
+	return c.fetchErr
+}
+
+func (c *fakeGitClient) LsRemote(ctx context.Context, revision string) (string, error) {
+	return "a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9", nil
+}
+
+type fakeGitClientFactory struct {
+	client *fakeGitClient
+}
+
+func (f *fakeGitClientFactory) NewClient(repoURL, path, username, password, sshPrivateKey string) (git.Client, error) {
+	return f.client, nil
+}
+
+func TestGitRequestMetrics(t *testing.T) {
+	repoURL := "https://github.com/argoproj/argocd-example-apps.git"
+	fakeClient := &fakeGitClient{}
+	metricsServ := NewMetricsServer()
+	factory := NewGitClientFactory(&fakeGitClientFactory{client: fakeClient}, metricsServ)
+	client, err := factory.NewClient(repoURL, "/tmp/repo", "", "", "")
+	assert.NoError(t, err)
+
+	assert.NoError(t, client.Fetch(context.Background()))
+	fakeClient.fetchErr = errors.New("fetch failed")
+	assert.Error(t, client.Fetch(context.Background()))
+	_, err = client.LsRemote(context.Background(), "HEAD")
+	assert.NoError(t, err)
+	// commit SHAs are not resolved remotely, so are not recorded
+	_, err = client.LsRemote(context.Background(), "a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9")
+	assert.NoError(t, err)
+
+	mux := http.NewServeMux()
+	metricsServ.ServeMetrics(mux)
+	req, err := http.NewRequest("GET", MetricsPath, nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	assert.Contains(t, body, `argocd_git_request_total{repo="`+repoURL+`",request="fetch",result="error"} 1`)
+	assert.Contains(t, body, `argocd_git_request_total{repo="`+repoURL+`",request="fetch",result="success"} 1`)
+	assert.Contains(t, body, `argocd_git_request_total{repo="`+repoURL+`",request="ls-remote",result="success"} 1`)
+	assert.Contains(t, body, `argocd_git_request_duration_seconds_count{repo="`+repoURL+`",request="ls-remote",result="success"} 1`)
+}
+
+type fakeCache struct {
+	cache.Cache
+	onEvicted func(key string)
+}
+
+func (c *fakeCache) OnEvicted(f func(key string)) {
+	c.onEvicted = f
+}
+
+func TestCacheMetrics(t *testing.T) {
+	fake := &fakeCache{Cache: cache.NewInMemoryCache(time.Hour)}
+	metricsServ := NewMetricsServer()
+	c := NewCache(fake, metricsServ)
+
+	var manifest string
+	assert.Equal(t, cache.ErrCacheMiss, c.Get("mfst|guestbook", &manifest))
+	assert.NoError(t, c.Set(&cache.Item{Key: "mfst|guestbook", Object: "manifest"}))
+	assert.NoError(t, c.Get("mfst|guestbook", &manifest))
+	assert.NoError(t, c.Get("mfst|guestbook", &manifest))
+	assert.Equal(t, cache.ErrCacheMiss, c.Get("ldir|guestbook", &manifest))
+	fake.onEvicted("mfst|guestbook")
+
+	mux := http.NewServeMux()
+	metricsServ.ServeMetrics(mux)
+	req, err := http.NewRequest("GET", MetricsPath, nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	assert.Contains(t, body, `argocd_repo_cache_hit_total{cache="manifest"} 2`)
+	assert.Contains(t, body, `argocd_repo_cache_miss_total{cache="manifest"} 1`)
+	assert.Contains(t, body, `argocd_repo_cache_miss_total{cache="list-dir"} 1`)
+	assert.Contains(t, body, `argocd_repo_cache_eviction_total{cache="manifest"} 1`)
+}
+
+func TestManifestGenerationMetrics(t *testing.T) {
+	repoURL := "https://github.com/argoproj/argocd-example-apps.git"
+	metricsServ := NewMetricsServer()
+	metricsServ.ObserveManifestGeneration(repoURL, "helm", 3*time.Second)
+	metricsServ.ObserveManifestGeneration(repoURL, "helm", 5*time.Second)
+	metricsServ.ObserveManifestGeneration(repoURL, "kustomize", time.Second)
+
+	mux := http.NewServeMux()
+	metricsServ.ServeMetrics(mux)
+	req, err := http.NewRequest("GET", MetricsPath, nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	assert.Contains(t, body, `argocd_repo_manifest_generation_duration_seconds_count{repo="`+repoURL+`",tool="helm"} 2`)
+	assert.Contains(t, body, `argocd_repo_manifest_generation_duration_seconds_sum{repo="`+repoURL+`",tool="helm"} 8`)
+	assert.Contains(t, body, `argocd_repo_manifest_generation_duration_seconds_count{repo="`+repoURL+`",tool="kustomize"} 1`)
+}
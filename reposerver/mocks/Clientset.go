@@ -1,6 +1,7 @@
 // Code generated by mockery v1.0.0
 package mocks
 
+import grpc_health_v1 "google.golang.org/grpc/health/grpc_health_v1"
 import mock "github.com/stretchr/testify/mock"
 
 import repository "github.com/argoproj/argo-cd/reposerver/repository"
@@ -42,3 +43,35 @@ func (_m *Clientset) NewRepositoryClient() (util.Closer, repository.RepositorySe
 
 	return r0, r1, r2
 }
+
+// NewHealthClient provides a mock function with given fields:
+func (_m *Clientset) NewHealthClient() (util.Closer, grpc_health_v1.HealthClient, error) {
+	ret := _m.Called()
+
+	var r0 util.Closer
+	if rf, ok := ret.Get(0).(func() util.Closer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(util.Closer)
+		}
+	}
+
+	var r1 grpc_health_v1.HealthClient
+	if rf, ok := ret.Get(1).(func() grpc_health_v1.HealthClient); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(grpc_health_v1.HealthClient)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func() error); ok {
+		r2 = rf()
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
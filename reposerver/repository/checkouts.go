@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/util"
+)
+
+// checkoutsDirName is the name of the subdirectory of the root directory holding the checkouts. The
+// checkouts are kept in a dedicated subdirectory, so that removing the files which are not checkouts
+// does not remove the other files of the root directory
+const checkoutsDirName = "checkouts"
+
+// CheckoutOptions holds the location and the limits of the repository checkouts of the repo server
+type CheckoutOptions struct {
+	// RootDir is the directory the repositories are checked out in, in its checkouts subdirectory
+	RootDir string
+	// MaxCheckouts is the maximum number of checkouts, or 0 for no limit
+	MaxCheckouts int
+	// MaxDiskUsage is the maximum disk usage of the checkouts in bytes, or 0 for no limit
+	MaxDiskUsage int64
+}
+
+// Checkouts tracks the repository checkouts of the repo server, and evicts the least recently used
+// ones when the checkouts exceed their limits
+type Checkouts struct {
+	opts     CheckoutOptions
+	repoLock *util.KeyLock
+	lock     sync.Mutex
+	// lastUsed holds the time each checkout was last used, by checkout path
+	lastUsed map[string]time.Time
+}
+
+// NewCheckouts returns new repository checkouts with the given options
+func NewCheckouts(opts CheckoutOptions) *Checkouts {
+	return &Checkouts{
+		opts:     opts,
+		repoLock: util.NewKeyLock(),
+		lastUsed: make(map[string]time.Time),
+	}
+}
+
+// Path returns the checkout path of the repository
+func (c *Checkouts) Path(repo string) string {
+	return filepath.Join(c.dir(), strings.Replace(repo, "/", "_", -1))
+}
+
+// dir returns the directory holding the checkouts
+func (c *Checkouts) dir() string {
+	return filepath.Join(c.opts.RootDir, checkoutsDirName)
+}
+
+// Lock locks the checkout at the given path, which is not evicted until it is unlocked
+func (c *Checkouts) Lock(path string) {
+	c.repoLock.Lock(path)
+	c.touch(path)
+}
+
+// Unlock unlocks the checkout at the given path
+func (c *Checkouts) Unlock(path string) {
+	c.touch(path)
+	c.repoLock.Unlock(path)
+}
+
+func (c *Checkouts) touch(path string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.lastUsed[path] = time.Now()
+}
+
+// CleanOrphans creates the directory of the checkouts, and removes its files which are not checkouts,
+// such as the leftovers of interrupted clones. The remaining checkouts are tracked as if they were last
+// used when they were last modified
+func (c *Checkouts) CleanOrphans() error {
+	err := os.MkdirAll(c.dir(), 0755)
+	if err != nil {
+		return err
+	}
+	files, err := ioutil.ReadDir(c.dir())
+	if err != nil {
+		return err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, file := range files {
+		path := filepath.Join(c.dir(), file.Name())
+		if gitDir, err := os.Stat(filepath.Join(path, ".git")); err == nil && file.IsDir() && gitDir.IsDir() {
+			if _, ok := c.lastUsed[path]; !ok {
+				c.lastUsed[path] = file.ModTime()
+			}
+			continue
+		}
+		log.Infof("Removing orphaned checkout %s", path)
+		err = os.RemoveAll(path)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run evicts the checkouts exceeding the limits at the given interval, until the stop channel is closed.
+// Checkouts are not evicted periodically if the interval is not positive
+func (c *Checkouts) Run(interval time.Duration, stopCh <-chan struct{}) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			c.Evict()
+		}
+	}
+}
+
+// checkout is a tracked checkout and its disk usage
+type checkout struct {
+	path     string
+	lastUsed time.Time
+	size     int64
+}
+
+// Evict removes the least recently used checkouts, until the checkouts do not exceed the limits.
+// Checkouts used while the eviction is running are not removed
+func (c *Checkouts) Evict() {
+	if c.opts.MaxCheckouts <= 0 && c.opts.MaxDiskUsage <= 0 {
+		return
+	}
+	c.lock.Lock()
+	checkouts := make([]checkout, 0, len(c.lastUsed))
+	for path, lastUsed := range c.lastUsed {
+		checkouts = append(checkouts, checkout{path: path, lastUsed: lastUsed})
+	}
+	c.lock.Unlock()
+	sort.Slice(checkouts, func(i, j int) bool {
+		return checkouts[i].lastUsed.Before(checkouts[j].lastUsed)
+	})
+	var diskUsage int64
+	for i := range checkouts {
+		checkouts[i].size = diskUsageOf(checkouts[i].path)
+		diskUsage += checkouts[i].size
+	}
+	count := len(checkouts)
+	for _, item := range checkouts {
+		if (c.opts.MaxCheckouts <= 0 || count <= c.opts.MaxCheckouts) && (c.opts.MaxDiskUsage <= 0 || diskUsage <= c.opts.MaxDiskUsage) {
+			break
+		}
+		if c.evict(item) {
+			count--
+			diskUsage -= item.size
+		}
+	}
+}
+
+// evict removes the checkout, unless it was used since it was listed for eviction
+func (c *Checkouts) evict(item checkout) bool {
+	c.repoLock.Lock(item.path)
+	defer c.repoLock.Unlock(item.path)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if lastUsed, ok := c.lastUsed[item.path]; !ok || !lastUsed.Equal(item.lastUsed) {
+		return false
+	}
+	log.Infof("Evicting checkout %s (%d bytes, last used at %s)", item.path, item.size, item.lastUsed.Format(time.RFC3339))
+	err := os.RemoveAll(item.path)
+	if err != nil {
+		log.Warnf("Failed to evict checkout %s: %v", item.path, err)
+		return false
+	}
+	delete(c.lastUsed, item.path)
+	return true
+}
+
+// diskUsageOf returns the total size of the files in the given directory. Files removed while the
+// directory is walked are ignored
+func diskUsageOf(dir string) int64 {
+	var size int64
+	_ = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
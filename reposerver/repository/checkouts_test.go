@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestCheckout creates a checkout holding a file of the given size, last used at the given time
+func newTestCheckout(t *testing.T, c *Checkouts, repo string, size int, lastUsed time.Time) string {
+	path := c.Path(repo)
+	assert.NoError(t, os.MkdirAll(filepath.Join(path, ".git"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(path, "manifest.yaml"), make([]byte, size), 0644))
+	c.lastUsed[path] = lastUsed
+	return path
+}
+
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func TestCleanOrphans(t *testing.T) {
+	rootDir, err := ioutil.TempDir("", "checkouts")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(rootDir) }()
+	c := NewCheckouts(CheckoutOptions{RootDir: rootDir})
+	checkoutPath := newTestCheckout(t, c, "https://github.com/argoproj/argocd-example-apps", 10, time.Now())
+	delete(c.lastUsed, checkoutPath)
+	// interrupted clone
+	orphanPath := c.Path("https://github.com/argoproj/argo-cd")
+	assert.NoError(t, os.MkdirAll(orphanPath, 0755))
+	filePath := filepath.Join(rootDir, checkoutsDirName, "file")
+	assert.NoError(t, ioutil.WriteFile(filePath, []byte("file"), 0644))
+	// files outside of the checkouts directory are not removed
+	otherFilePath := filepath.Join(rootDir, "file")
+	assert.NoError(t, ioutil.WriteFile(otherFilePath, []byte("file"), 0644))
+
+	assert.NoError(t, c.CleanOrphans())
+	assert.True(t, exists(checkoutPath))
+	assert.False(t, exists(orphanPath))
+	assert.False(t, exists(filePath))
+	assert.True(t, exists(otherFilePath))
+	assert.Contains(t, c.lastUsed, checkoutPath)
+}
+
+func TestEvict(t *testing.T) {
+	rootDir, err := ioutil.TempDir("", "checkouts")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(rootDir) }()
+	now := time.Now()
+
+	c := NewCheckouts(CheckoutOptions{RootDir: rootDir, MaxCheckouts: 2})
+	oldest := newTestCheckout(t, c, "repo-1", 10, now.Add(-2*time.Hour))
+	older := newTestCheckout(t, c, "repo-2", 10, now.Add(-time.Hour))
+	newest := newTestCheckout(t, c, "repo-3", 10, now)
+	c.Evict()
+	assert.False(t, exists(oldest))
+	assert.True(t, exists(older))
+	assert.True(t, exists(newest))
+	assert.NotContains(t, c.lastUsed, oldest)
+
+	c = NewCheckouts(CheckoutOptions{RootDir: rootDir, MaxDiskUsage: 100})
+	newTestCheckout(t, c, "repo-1", 100, now.Add(-2*time.Hour))
+	newTestCheckout(t, c, "repo-2", 100, now.Add(-time.Hour))
+	newTestCheckout(t, c, "repo-3", 10, now)
+	c.Evict()
+	assert.False(t, exists(oldest))
+	assert.False(t, exists(older))
+	assert.True(t, exists(newest))
+
+	// checkouts are kept without limits
+	c = NewCheckouts(CheckoutOptions{RootDir: rootDir})
+	newTestCheckout(t, c, "repo-1", 100, now.Add(-2*time.Hour))
+	c.Evict()
+	assert.True(t, exists(oldest))
+}
+
+func TestEvictUsedCheckout(t *testing.T) {
+	rootDir, err := ioutil.TempDir("", "checkouts")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(rootDir) }()
+
+	c := NewCheckouts(CheckoutOptions{RootDir: rootDir, MaxCheckouts: 1})
+	path := newTestCheckout(t, c, "repo-1", 10, time.Now().Add(-time.Hour))
+	item := checkout{path: path, lastUsed: c.lastUsed[path]}
+	// the checkout is used after it was listed for eviction
+	c.Lock(path)
+	c.Unlock(path)
+	assert.False(t, c.evict(item))
+	assert.True(t, exists(path))
+}
+
+func TestRunWithoutInterval(t *testing.T) {
+	c := NewCheckouts(CheckoutOptions{RootDir: os.TempDir(), MaxCheckouts: 1})
+	done := make(chan struct{})
+	go func() {
+		c.Run(0, make(chan struct{}))
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("periodic eviction was not disabled")
+	}
+}
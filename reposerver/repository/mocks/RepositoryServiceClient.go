@@ -5,6 +5,7 @@ import context "context"
 import grpc "google.golang.org/grpc"
 import mock "github.com/stretchr/testify/mock"
 import repository "github.com/argoproj/argo-cd/reposerver/repository"
+import v1alpha1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 
 // RepositoryServiceClient is an autogenerated mock type for the RepositoryServiceClient type
 type RepositoryServiceClient struct {
@@ -71,6 +72,36 @@ func (_m *RepositoryServiceClient) GetFile(ctx context.Context, in *repository.G
 	return r0, r1
 }
 
+// GetKsonnetAppDetails provides a mock function with given fields: ctx, in, opts
+func (_m *RepositoryServiceClient) GetKsonnetAppDetails(ctx context.Context, in *repository.KsonnetAppDetailsRequest, opts ...grpc.CallOption) (*repository.KsonnetAppDetailsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *repository.KsonnetAppDetailsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *repository.KsonnetAppDetailsRequest, ...grpc.CallOption) *repository.KsonnetAppDetailsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.KsonnetAppDetailsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *repository.KsonnetAppDetailsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListDir provides a mock function with given fields: ctx, in, opts
 func (_m *RepositoryServiceClient) ListDir(ctx context.Context, in *repository.ListDirRequest, opts ...grpc.CallOption) (*repository.FileList, error) {
 	_va := make([]interface{}, len(opts))
@@ -100,3 +131,123 @@ func (_m *RepositoryServiceClient) ListDir(ctx context.Context, in *repository.L
 
 	return r0, r1
 }
+
+// ListHelmCharts provides a mock function with given fields: ctx, in, opts
+func (_m *RepositoryServiceClient) ListHelmCharts(ctx context.Context, in *repository.ListHelmChartsRequest, opts ...grpc.CallOption) (*v1alpha1.HelmChartList, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *v1alpha1.HelmChartList
+	if rf, ok := ret.Get(0).(func(context.Context, *repository.ListHelmChartsRequest, ...grpc.CallOption) *v1alpha1.HelmChartList); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.HelmChartList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *repository.ListHelmChartsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRefs provides a mock function with given fields: ctx, in, opts
+func (_m *RepositoryServiceClient) ListRefs(ctx context.Context, in *repository.ListRefsRequest, opts ...grpc.CallOption) (*repository.Refs, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *repository.Refs
+	if rf, ok := ret.Get(0).(func(context.Context, *repository.ListRefsRequest, ...grpc.CallOption) *repository.Refs); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.Refs)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *repository.ListRefsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListApps provides a mock function with given fields: ctx, in, opts
+func (_m *RepositoryServiceClient) ListApps(ctx context.Context, in *repository.ListAppsRequest, opts ...grpc.CallOption) (*repository.AppList, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *repository.AppList
+	if rf, ok := ret.Get(0).(func(context.Context, *repository.ListAppsRequest, ...grpc.CallOption) *repository.AppList); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.AppList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *repository.ListAppsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRevisionMetadata provides a mock function with given fields: ctx, in, opts
+func (_m *RepositoryServiceClient) GetRevisionMetadata(ctx context.Context, in *repository.RevisionMetadataRequest, opts ...grpc.CallOption) (*repository.RevisionMetadata, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *repository.RevisionMetadata
+	if rf, ok := ret.Get(0).(func(context.Context, *repository.RevisionMetadataRequest, ...grpc.CallOption) *repository.RevisionMetadata); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.RevisionMetadata)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *repository.RevisionMetadataRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
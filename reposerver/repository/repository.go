@@ -8,21 +8,26 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/ghodss/yaml"
 	"github.com/google/go-jsonnet"
+	"github.com/google/go-jsonnet/ast"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
-	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/reposerver/metrics"
 	"github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/git"
+	grpc_util "github.com/argoproj/argo-cd/util/grpc"
 	"github.com/argoproj/argo-cd/util/helm"
 	"github.com/argoproj/argo-cd/util/ksonnet"
 	"github.com/argoproj/argo-cd/util/kube"
@@ -32,8 +37,28 @@ import (
 const (
 	// DefaultRepoCacheExpiration is the duration for items to live in the repo cache
 	DefaultRepoCacheExpiration = 24 * time.Hour
+	// ManifestFailureBackoff is the duration during which a failed manifest generation is not retried
+	// after the first failure. It doubles with each consecutive failure
+	ManifestFailureBackoff = 10 * time.Second
+	// MaxManifestFailureBackoff is the maximum duration during which a failed manifest generation is
+	// not retried
+	MaxManifestFailureBackoff = 5 * time.Minute
+	// HelmChartsCacheExpiration is the duration for the charts of a helm repository to live in the repo
+	// cache, after which the index of the repository is downloaded again
+	HelmChartsCacheExpiration = 5 * time.Minute
+	// RefsCacheExpiration is the duration for the branches and tags of a repository to live in the repo
+	// cache
+	RefsCacheExpiration = time.Minute
 )
 
+// manifestFailure is the cached failure of a manifest generation
+type manifestFailure struct {
+	Code     codes.Code
+	Message  string
+	Failures int
+	RetryAt  time.Time
+}
+
 type AppSourceType string
 
 const (
@@ -45,23 +70,25 @@ const (
 
 // Service implements ManifestService interface
 type Service struct {
-	repoLock   *util.KeyLock
-	gitFactory git.ClientFactory
-	cache      cache.Cache
+	checkouts     *Checkouts
+	gitFactory    git.ClientFactory
+	cache         cache.Cache
+	metricsServer *metrics.MetricsServer
 }
 
 // NewService returns a new instance of the Manifest service
-func NewService(gitFactory git.ClientFactory, cache cache.Cache) *Service {
+func NewService(gitFactory git.ClientFactory, cache cache.Cache, metricsServer *metrics.MetricsServer, checkouts *Checkouts) *Service {
 	return &Service{
-		repoLock:   util.NewKeyLock(),
-		gitFactory: gitFactory,
-		cache:      cache,
+		checkouts:     checkouts,
+		gitFactory:    gitFactory,
+		cache:         cache,
+		metricsServer: metricsServer,
 	}
 }
 
 // ListDir lists the contents of a GitHub repo
 func (s *Service) ListDir(ctx context.Context, q *ListDirRequest) (*FileList, error) {
-	gitClient, commitSHA, err := s.newClientResolveRevision(q.Repo, q.Revision)
+	gitClient, commitSHA, err := s.newClientResolveRevision(ctx, q.Repo, q.Revision)
 	if err != nil {
 		return nil, err
 	}
@@ -73,14 +100,14 @@ func (s *Service) ListDir(ctx context.Context, q *ListDirRequest) (*FileList, er
 		return &res, nil
 	}
 
-	s.repoLock.Lock(gitClient.Root())
-	defer s.repoLock.Unlock(gitClient.Root())
-	commitSHA, err = checkoutRevision(gitClient, commitSHA)
+	s.checkouts.Lock(gitClient.Root())
+	defer s.checkouts.Unlock(gitClient.Root())
+	commitSHA, err = checkoutRevision(ctx, gitClient, commitSHA)
 	if err != nil {
 		return nil, err
 	}
 
-	lsFiles, err := gitClient.LsFiles(q.Path)
+	lsFiles, err := gitClient.LsFiles(ctx, q.Path)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +127,7 @@ func (s *Service) ListDir(ctx context.Context, q *ListDirRequest) (*FileList, er
 }
 
 func (s *Service) GetFile(ctx context.Context, q *GetFileRequest) (*GetFileResponse, error) {
-	gitClient, commitSHA, err := s.newClientResolveRevision(q.Repo, q.Revision)
+	gitClient, commitSHA, err := s.newClientResolveRevision(ctx, q.Repo, q.Revision)
 	if err != nil {
 		return nil, err
 	}
@@ -112,9 +139,9 @@ func (s *Service) GetFile(ctx context.Context, q *GetFileRequest) (*GetFileRespo
 		return &res, nil
 	}
 
-	s.repoLock.Lock(gitClient.Root())
-	defer s.repoLock.Unlock(gitClient.Root())
-	commitSHA, err = checkoutRevision(gitClient, commitSHA)
+	s.checkouts.Lock(gitClient.Root())
+	defer s.checkouts.Unlock(gitClient.Root())
+	commitSHA, err = checkoutRevision(ctx, gitClient, commitSHA)
 	if err != nil {
 		return nil, err
 	}
@@ -136,37 +163,291 @@ func (s *Service) GetFile(ctx context.Context, q *GetFileRequest) (*GetFileRespo
 	return &res, nil
 }
 
-func (s *Service) GenerateManifest(c context.Context, q *ManifestRequest) (*ManifestResponse, error) {
-	gitClient, commitSHA, err := s.newClientResolveRevision(q.Repo, q.Revision)
+// GetKsonnetAppDetails returns the environments of a ksonnet app along with their destinations and parameters
+func (s *Service) GetKsonnetAppDetails(ctx context.Context, q *KsonnetAppDetailsRequest) (*KsonnetAppDetailsResponse, error) {
+	gitClient, commitSHA, err := s.newClientResolveRevision(ctx, q.Repo, q.Revision)
 	if err != nil {
 		return nil, err
 	}
-	cacheKey := manifestCacheKey(commitSHA, q)
-	var res ManifestResponse
+	cacheKey := ksonnetAppDetailsCacheKey(commitSHA, q)
+	var res KsonnetAppDetailsResponse
 	err = s.cache.Get(cacheKey, &res)
 	if err == nil {
-		log.Infof("manifest cache hit: %s", cacheKey)
+		log.Infof("ksonnet app details cache hit: %s", cacheKey)
 		return &res, nil
 	}
-	if err != cache.ErrCacheMiss {
-		log.Warnf("manifest cache error %s: %v", cacheKey, err)
-	} else {
-		log.Infof("manifest cache miss: %s", cacheKey)
-	}
 
-	s.repoLock.Lock(gitClient.Root())
-	defer s.repoLock.Unlock(gitClient.Root())
-	commitSHA, err = checkoutRevision(gitClient, commitSHA)
+	s.checkouts.Lock(gitClient.Root())
+	defer s.checkouts.Unlock(gitClient.Root())
+	commitSHA, err = checkoutRevision(ctx, gitClient, commitSHA)
 	if err != nil {
 		return nil, err
 	}
 	appPath := filepath.Join(gitClient.Root(), q.Path)
+	if IdentifyAppSourceTypeByAppDir(appPath) != AppSourceKsonnet {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is not a ksonnet app", q.Path)
+	}
+	ksApp, err := ksonnet.NewKsonnetApp(appPath)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "unable to load application from %s: %v", appPath, err)
+	}
+	envs, err := ksApp.ListEnvs()
+	if err != nil {
+		return nil, err
+	}
+	res = KsonnetAppDetailsResponse{
+		Environments: make([]*KsonnetEnvironmentDetails, len(envs)),
+	}
+	for i, env := range envs {
+		dest, err := ksApp.Destination(env)
+		if err != nil {
+			return nil, err
+		}
+		params, err := ksApp.ListEnvParams(env)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Failed to list ksonnet app params: %v", err)
+		}
+		res.Environments[i] = &KsonnetEnvironmentDetails{
+			Name:        env,
+			Destination: dest,
+			Params:      params,
+		}
+	}
+	err = s.cache.Set(&cache.Item{
+		Key:        ksonnetAppDetailsCacheKey(commitSHA, q),
+		Object:     &res,
+		Expiration: DefaultRepoCacheExpiration,
+	})
+	if err != nil {
+		log.Warnf("ksonnet app details cache set error %s: %v", cacheKey, err)
+	}
+	return &res, nil
+}
+
+// ListHelmCharts returns the charts of a helm repository, along with their versions
+func (s *Service) ListHelmCharts(ctx context.Context, q *ListHelmChartsRequest) (*v1alpha1.HelmChartList, error) {
+	if q.Repo == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "helm repository is required")
+	}
+	cacheKey := helmChartsCacheKey(q)
+	var res v1alpha1.HelmChartList
+	err := s.cache.Get(cacheKey, &res)
+	if err == nil {
+		log.Infof("helm charts cache hit: %s", cacheKey)
+		return &res, nil
+	}
+
+	index, err := helm.GetIndex(q.Repo.URL, q.Repo.Username, q.Repo.Password)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "unable to get charts of %s: %v", q.Repo.URL, err)
+	}
+	res = v1alpha1.HelmChartList{
+		Items: make([]v1alpha1.HelmChart, 0, len(index.Entries)),
+	}
+	for name, entries := range index.Entries {
+		chart := v1alpha1.HelmChart{
+			Name:     name,
+			Versions: make([]string, len(entries)),
+		}
+		for i, entry := range entries {
+			chart.Versions[i] = entry.Version
+		}
+		res.Items = append(res.Items, chart)
+	}
+	sort.Slice(res.Items, func(i, j int) bool {
+		return res.Items[i].Name < res.Items[j].Name
+	})
+	err = s.cache.Set(&cache.Item{
+		Key:        cacheKey,
+		Object:     &res,
+		Expiration: HelmChartsCacheExpiration,
+	})
+	if err != nil {
+		log.Warnf("helm charts cache set error %s: %v", cacheKey, err)
+	}
+	return &res, nil
+}
+
+// ListRefs returns the branches and tags of a repository
+func (s *Service) ListRefs(ctx context.Context, q *ListRefsRequest) (*Refs, error) {
+	cacheKey := listRefsCacheKey(q)
+	var res Refs
+	err := s.cache.Get(cacheKey, &res)
+	if err == nil {
+		log.Infof("list refs cache hit: %s", cacheKey)
+		return &res, nil
+	}
+
+	gitClient, err := s.gitFactory.NewClient(q.Repo.Repo, s.checkouts.Path(q.Repo.Repo), q.Repo.Username, q.Repo.Password, q.Repo.SSHPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	refs, err := gitClient.LsRefs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res = Refs{
+		Branches: refs.Branches,
+		Tags:     refs.Tags,
+	}
+	err = s.cache.Set(&cache.Item{
+		Key:        cacheKey,
+		Object:     &res,
+		Expiration: RefsCacheExpiration,
+	})
+	if err != nil {
+		log.Warnf("list refs cache set error %s: %v", cacheKey, err)
+	}
+	return &res, nil
+}
+
+// ListApps returns the paths of the applications of a repository, along with their source type
+func (s *Service) ListApps(ctx context.Context, q *ListAppsRequest) (*AppList, error) {
+	gitClient, commitSHA, err := s.newClientResolveRevision(ctx, q.Repo, q.Revision)
+	if err != nil {
+		return nil, err
+	}
+	cacheKey := listAppsCacheKey(commitSHA)
+	var res AppList
+	err = s.cache.Get(cacheKey, &res)
+	if err == nil {
+		log.Infof("list apps cache hit: %s", cacheKey)
+		return &res, nil
+	}
+
+	s.checkouts.Lock(gitClient.Root())
+	defer s.checkouts.Unlock(gitClient.Root())
+	commitSHA, err = checkoutRevision(ctx, gitClient, commitSHA)
+	if err != nil {
+		return nil, err
+	}
+	apps, err := findApps(gitClient.Root())
+	if err != nil {
+		return nil, err
+	}
+	res = AppList{
+		Apps: apps,
+	}
+	err = s.cache.Set(&cache.Item{
+		Key:        listAppsCacheKey(commitSHA),
+		Object:     &res,
+		Expiration: DefaultRepoCacheExpiration,
+	})
+	if err != nil {
+		log.Warnf("list apps cache set error %s: %v", cacheKey, err)
+	}
+	return &res, nil
+}
+
+// GetRevisionMetadata returns the metadata of a commit of a repository. The repository is only fetched
+// if the commit is not known yet
+func (s *Service) GetRevisionMetadata(ctx context.Context, q *RevisionMetadataRequest) (*RevisionMetadata, error) {
+	if !git.IsCommitSHA(q.Revision) {
+		return nil, status.Errorf(codes.InvalidArgument, "revision %s is not a commit SHA", q.Revision)
+	}
+	cacheKey := revisionMetadataCacheKey(q)
+	var res RevisionMetadata
+	err := s.cache.Get(cacheKey, &res)
+	if err == nil {
+		log.Infof("revision metadata cache hit: %s", cacheKey)
+		return &res, nil
+	}
+
+	gitClient, commitSHA, err := s.newClientResolveRevision(ctx, q.Repo, q.Revision)
+	if err != nil {
+		return nil, err
+	}
+	s.checkouts.Lock(gitClient.Root())
+	defer s.checkouts.Unlock(gitClient.Root())
+	err = gitClient.Init()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to initialize git repo: %v", err)
+	}
+	metadata, err := gitClient.RevisionMetadata(ctx, commitSHA)
+	if err != nil {
+		err = gitClient.Fetch(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to fetch git repo: %v", err)
+		}
+		metadata, err = gitClient.RevisionMetadata(ctx, commitSHA)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "Failed to get the metadata of %s: %v", commitSHA, err)
+		}
+	}
+	commitTime := metav1.NewTime(metadata.Date)
+	res = RevisionMetadata{
+		CommitTime:      &commitTime,
+		Author:          metadata.Author,
+		Message:         metadata.Message,
+		Tags:            metadata.Tags,
+		SignatureStatus: metadata.SignatureStatus,
+	}
+	err = s.cache.Set(&cache.Item{
+		Key:        cacheKey,
+		Object:     &res,
+		Expiration: DefaultRepoCacheExpiration,
+	})
+	if err != nil {
+		log.Warnf("revision metadata cache set error %s: %v", cacheKey, err)
+	}
+	return &res, nil
+}
+
+func (s *Service) GenerateManifest(ctx context.Context, q *ManifestRequest) (*ManifestResponse, error) {
+	gitClient, commitSHA, err := s.newClientResolveRevision(ctx, q.Repo, q.Revision)
+	if err != nil {
+		return nil, err
+	}
+	cacheKey := manifestCacheKey(commitSHA, q)
+	var res ManifestResponse
+	if q.NoCache {
+		log.Infof("manifest cache bypassed: %s", cacheKey)
+	} else {
+		err = s.cache.Get(cacheKey, &res)
+		if err == nil {
+			log.Infof("manifest cache hit: %s", cacheKey)
+			return &res, nil
+		}
+		if err != cache.ErrCacheMiss {
+			log.Warnf("manifest cache error %s: %v", cacheKey, err)
+		} else {
+			log.Infof("manifest cache miss: %s", cacheKey)
+		}
+	}
+
+	// a failed generation is not retried until its backoff expires, unless the cache is bypassed
+	failure := s.getManifestFailure(cacheKey)
+	if !q.NoCache && failure.Failures > 0 && time.Now().Before(failure.RetryAt) {
+		log.Infof("manifest failure cache hit: %s", cacheKey)
+		return nil, grpc_util.NewReasonError(failure.Code, grpc_util.ErrorReasonManifestError, q.Path, "%s (retrying after %s)", failure.Message, failure.RetryAt.UTC().Format(time.RFC3339))
+	}
 
-	genRes, err := generateManifests(appPath, q)
+	s.checkouts.Lock(gitClient.Root())
+	defer s.checkouts.Unlock(gitClient.Root())
+	commitSHA, err = checkoutRevision(ctx, gitClient, commitSHA)
 	if err != nil {
 		return nil, err
 	}
-	res = *genRes
+	appPath := filepath.Join(gitClient.Root(), q.Path)
+
+	if prevRes, ok := s.getUnchangedManifests(ctx, gitClient, commitSHA, q); ok {
+		res = *prevRes
+	} else {
+		startTime := time.Now()
+		genRes, err := generateManifests(appPath, q)
+		s.metricsServer.ObserveManifestGeneration(q.Repo.Repo, string(getAppSourceType(appPath, q)), time.Since(startTime))
+		if err != nil {
+			s.setManifestFailure(cacheKey, failure, err)
+			// only the failures of the generation itself are reported as manifest errors, unlike the
+			// failures to resolve or check out the revision
+			st := status.Convert(err)
+			return nil, grpc_util.NewReasonError(st.Code(), grpc_util.ErrorReasonManifestError, q.Path, "%s", st.Message())
+		}
+		if failure.Failures > 0 {
+			s.setManifestFailure(cacheKey, failure, nil)
+		}
+		res = *genRes
+	}
 	res.Revision = commitSHA
 	err = s.cache.Set(&cache.Item{
 		Key:        manifestCacheKey(commitSHA, q),
@@ -179,21 +460,89 @@ func (s *Service) GenerateManifest(c context.Context, q *ManifestRequest) (*Mani
 	return &res, nil
 }
 
+// getUnchangedManifests returns the cached manifests of the previous revision of the application, if
+// none of the manifest generate paths of the request changed between the previous and the given revision
+func (s *Service) getUnchangedManifests(ctx context.Context, gitClient git.Client, commitSHA string, q *ManifestRequest) (*ManifestResponse, bool) {
+	if q.NoCache || len(q.ManifestGeneratePaths) == 0 || q.PreviousRevision == "" || q.PreviousRevision == commitSHA {
+		return nil, false
+	}
+	var res ManifestResponse
+	prevCacheKey := manifestCacheKey(q.PreviousRevision, q)
+	if err := s.cache.Get(prevCacheKey, &res); err != nil {
+		return nil, false
+	}
+	changedFiles, err := gitClient.ChangedFiles(ctx, q.PreviousRevision, commitSHA)
+	if err != nil {
+		log.Warnf("Failed to list files changed since %s: %v", q.PreviousRevision, err)
+		return nil, false
+	}
+	if git.IsChangedInPaths(changedFiles, q.ManifestGeneratePaths) {
+		return nil, false
+	}
+	log.Infof("manifest generate paths unchanged since %s: %s", q.PreviousRevision, prevCacheKey)
+	return &res, true
+}
+
+// getManifestFailure returns the cached failure of the manifest generation with the given cache key.
+// Returns an empty failure if the last generation did not fail
+func (s *Service) getManifestFailure(cacheKey string) manifestFailure {
+	var failure manifestFailure
+	err := s.cache.Get(manifestFailureCacheKey(cacheKey), &failure)
+	if err != nil && err != cache.ErrCacheMiss {
+		log.Warnf("manifest failure cache error %s: %v", cacheKey, err)
+	}
+	return failure
+}
+
+// setManifestFailure caches the failure of the manifest generation with the given cache key, backing
+// off exponentially on consecutive failures. A nil error resets the failure
+func (s *Service) setManifestFailure(cacheKey string, prev manifestFailure, genErr error) {
+	failure := manifestFailure{}
+	if genErr != nil {
+		failure.Failures = prev.Failures + 1
+		failure.Code = status.Code(genErr)
+		failure.Message = status.Convert(genErr).Message()
+		failure.RetryAt = time.Now().Add(manifestFailureBackoff(failure.Failures))
+	}
+	err := s.cache.Set(&cache.Item{
+		Key:        manifestFailureCacheKey(cacheKey),
+		Object:     failure,
+		Expiration: DefaultRepoCacheExpiration,
+	})
+	if err != nil {
+		log.Warnf("manifest failure cache set error %s: %v", cacheKey, err)
+	}
+}
+
+// manifestFailureBackoff returns the duration during which a manifest generation is not retried after
+// the given number of consecutive failures
+func manifestFailureBackoff(failures int) time.Duration {
+	backoff := ManifestFailureBackoff
+	for i := 1; i < failures && backoff < MaxManifestFailureBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > MaxManifestFailureBackoff {
+		backoff = MaxManifestFailureBackoff
+	}
+	return backoff
+}
+
 // generateManifests generates manifests from a path
 func generateManifests(appPath string, q *ManifestRequest) (*ManifestResponse, error) {
 	var targetObjs []*unstructured.Unstructured
+	// sources holds the file each target object was read from, if known
+	var sources []string
 	var params []*v1alpha1.ComponentParameter
 	var dest *v1alpha1.ApplicationDestination
 	var err error
 
-	appSourceType := IdentifyAppSourceTypeByAppDir(appPath)
-	switch appSourceType {
+	switch getAppSourceType(appPath, q) {
 	case AppSourceKsonnet:
 		targetObjs, params, dest, err = ksShow(appPath, q.Environment, q.ComponentParameterOverrides)
 	case AppSourceHelm:
 		// TODO: Add prefix
 		h := helm.NewHelmApp(appPath)
-		err = h.DependencyBuild()
+		err = helmDependencyBuild(h, q.HelmRepos)
 		if err != nil {
 			return nil, err
 		}
@@ -201,7 +550,7 @@ func generateManifests(appPath string, q *ManifestRequest) (*ManifestResponse, e
 		if q.NamePrefix != "" {
 			appName = q.NamePrefix + q.AppLabel
 		}
-		targetObjs, err = h.Template(appName, q.Namespace, q.ValueFiles, q.ComponentParameterOverrides)
+		targetObjs, err = h.Template(appName, q.Namespace, q.ValueFiles, toolParams(q))
 		if err != nil {
 			return nil, err
 		}
@@ -211,16 +560,23 @@ func generateManifests(appPath string, q *ManifestRequest) (*ManifestResponse, e
 		}
 	case AppSourceKustomize:
 		k := kustomize.NewKustomizeApp(appPath)
-		targetObjs, params, err = k.Build(q.Namespace, q.NamePrefix, q.ComponentParameterOverrides)
+		targetObjs, params, err = k.Build(q.Namespace, q.NamePrefix, toolParams(q))
 	case AppSourceDirectory:
-		targetObjs, err = findManifests(appPath)
+		targetObjs, sources, err = findManifests(appPath, q.Directory == nil || q.Directory.Jsonnet, jsonnetExtVars(q))
 	}
 	if err != nil {
 		return nil, err
 	}
 
 	manifests := make([]string, 0)
-	for _, obj := range targetObjs {
+	var sourceFiles []string
+	definedIn := make(map[string][]string)
+	var duplicates []string
+	for i, obj := range targetObjs {
+		source := ""
+		if i < len(sources) {
+			source = sources[i]
+		}
 		var targets []*unstructured.Unstructured
 		if obj.IsList() {
 			err = obj.EachListItem(func(object runtime.Object) error {
@@ -240,8 +596,22 @@ func generateManifests(appPath string, q *ManifestRequest) (*ManifestResponse, e
 		}
 
 		for _, target := range targets {
+			// resources with generated names (e.g. hooks) cannot conflict
+			if target.GetName() != "" {
+				key := resourceKey(target, q.Namespace)
+				if len(definedIn[key]) == 1 {
+					duplicates = append(duplicates, key)
+				}
+				definedIn[key] = append(definedIn[key], source)
+			}
+			if q.ResourceMetadata != nil && !kube.IsCRD(target) {
+				err = setResourceMetadata(target, q.ResourceMetadata)
+				if err != nil {
+					return nil, err
+				}
+			}
 			if q.AppLabel != "" && !kube.IsCRD(target) {
-				err = kube.SetLabel(target, common.LabelApplicationName, q.AppLabel)
+				err = kube.SetLabel(target, common.LabelApplicationName, kube.TruncateLabelValue(q.AppLabel))
 				if err != nil {
 					return nil, err
 				}
@@ -251,12 +621,27 @@ func generateManifests(appPath string, q *ManifestRequest) (*ManifestResponse, e
 				return nil, err
 			}
 			manifests = append(manifests, string(manifestStr))
+			if len(sources) > 0 {
+				sourceFiles = append(sourceFiles, source)
+			}
 		}
 	}
 
+	if len(duplicates) > 0 {
+		messages := make([]string, len(duplicates))
+		for i, key := range duplicates {
+			messages[i] = key
+			if len(sources) > 0 {
+				messages[i] += fmt.Sprintf(" (in %s)", strings.Join(definedIn[key], ", "))
+			}
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, "Resources are defined more than once: %s", strings.Join(messages, "; "))
+	}
+
 	res := ManifestResponse{
-		Manifests: manifests,
-		Params:    params,
+		Manifests:   manifests,
+		Params:      params,
+		SourceFiles: sourceFiles,
 	}
 	if dest != nil {
 		res.Namespace = dest.Namespace
@@ -265,9 +650,44 @@ func generateManifests(appPath string, q *ManifestRequest) (*ManifestResponse, e
 	return &res, nil
 }
 
-// tempRepoPath returns a formulated temporary directory location to clone a repository
-func tempRepoPath(repo string) string {
-	return filepath.Join(os.TempDir(), strings.Replace(repo, "/", "_", -1))
+// helmDependencyBuild downloads the dependencies of the chart, if it declares any. The helm repositories
+// are added, with their credentials, to a temporary helm home which the dependencies are downloaded
+// with. If the chart has a requirements.lock file, the dependencies are verified against it
+func helmDependencyBuild(h helm.Helm, helmRepos []*v1alpha1.HelmRepository) error {
+	hasDependencies, err := h.HasDependencies()
+	if err != nil || !hasDependencies {
+		return err
+	}
+	helmHome, err := ioutil.TempDir("", "helm")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(helmHome) }()
+	h.SetHome(helmHome)
+	err = h.Init()
+	if err != nil {
+		return err
+	}
+	for _, repo := range helmRepos {
+		err = h.RepoAdd(repo.Name, repo.URL, repo.Username, repo.Password)
+		if err != nil {
+			return fmt.Errorf("failed to add helm repository %s: %v", repo.Name, err)
+		}
+	}
+	err = h.DependencyBuild()
+	if err != nil {
+		return fmt.Errorf("failed to build chart dependencies: %v", err)
+	}
+	return nil
+}
+
+// getAppSourceType returns the source type of the application, which is a directory if the request
+// configures one, or is identified from the files of the application directory otherwise
+func getAppSourceType(appPath string, q *ManifestRequest) AppSourceType {
+	if q.Directory != nil {
+		return AppSourceDirectory
+	}
+	return IdentifyAppSourceTypeByAppDir(appPath)
 }
 
 // IdentifyAppSourceTypeByAppDir examines a directory and determines its application source type
@@ -284,6 +704,76 @@ func IdentifyAppSourceTypeByAppDir(appDirPath string) AppSourceType {
 	return AppSourceDirectory
 }
 
+// findApps returns the source type of the applications found in the given directory, by path of the
+// file identifying the application relative to the directory: the app.yaml of ksonnet applications, the
+// Chart.yaml of helm charts, the kustomization.yaml of kustomize applications, and the first manifest
+// of directory applications. The directories of ksonnet, helm and kustomize applications are not
+// searched any further, while other directories are directory applications if they hold a jsonnet file
+// or a YAML/JSON file declaring a Kubernetes resource (i.e. with an apiVersion and a kind)
+func findApps(root string) (map[string]string, error) {
+	apps := make(map[string]string)
+	appDirs := make(map[string]bool)
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			if appDir := filepath.Dir(relPath); !appDirs[appDir] && isResourceManifest(path) {
+				apps[relPath] = string(AppSourceDirectory)
+				appDirs[appDir] = true
+			}
+			return nil
+		}
+		if path != root && strings.HasPrefix(info.Name(), ".") {
+			return filepath.SkipDir
+		}
+		var appFile string
+		var appSourceType AppSourceType
+		switch {
+		case pathExists(path, "app.yaml") && pathExists(path, "components", "params.libsonnet"):
+			appFile, appSourceType = "app.yaml", AppSourceKsonnet
+		case pathExists(path, "Chart.yaml"):
+			appFile, appSourceType = "Chart.yaml", AppSourceHelm
+		case pathExists(path, "kustomization.yaml"):
+			appFile, appSourceType = "kustomization.yaml", AppSourceKustomize
+		default:
+			return nil
+		}
+		apps[filepath.Join(relPath, appFile)] = string(appSourceType)
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apps, nil
+}
+
+// isResourceManifest returns whether the file is a jsonnet file, or a YAML/JSON file declaring at least
+// one Kubernetes resource
+func isResourceManifest(path string) bool {
+	if !manifestFile.MatchString(path) {
+		return false
+	}
+	if strings.HasSuffix(path, ".jsonnet") {
+		return true
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	objs, _ := kube.SplitYAML(string(data))
+	for _, obj := range objs {
+		if obj.GetAPIVersion() != "" && obj.GetKind() != "" {
+			return true
+		}
+	}
+	return false
+}
+
 // IdentifyAppSourceTypeByAppPath determines application source type by app file path
 func IdentifyAppSourceTypeByAppPath(appFilePath string) AppSourceType {
 	if strings.HasSuffix(appFilePath, "app.yaml") {
@@ -300,26 +790,38 @@ func IdentifyAppSourceTypeByAppPath(appFilePath string) AppSourceType {
 
 // checkoutRevision is a convenience function to initialize a repo, fetch, and checkout a revision
 // Returns the 40 character commit SHA after the checkout has been performed
-func checkoutRevision(gitClient git.Client, commitSHA string) (string, error) {
+func checkoutRevision(ctx context.Context, gitClient git.Client, commitSHA string) (string, error) {
 	err := gitClient.Init()
 	if err != nil {
 		return "", status.Errorf(codes.Internal, "Failed to initialize git repo: %v", err)
 	}
-	err = gitClient.Fetch()
+	err = gitClient.Fetch(ctx)
 	if err != nil {
 		return "", status.Errorf(codes.Internal, "Failed to fetch git repo: %v", err)
 	}
-	err = gitClient.Checkout(commitSHA)
+	err = gitClient.Checkout(ctx, commitSHA)
 	if err != nil {
 		return "", status.Errorf(codes.Internal, "Failed to checkout %s: %v", commitSHA, err)
 	}
-	return gitClient.CommitSHA()
+	return gitClient.CommitSHA(ctx)
 }
 
 func manifestCacheKey(commitSHA string, q *ManifestRequest) string {
 	pStr, _ := json.Marshal(q.ComponentParameterOverrides)
 	valuesFiles := strings.Join(q.ValueFiles, ",")
-	return fmt.Sprintf("mfst|%s|%s|%s|%s|%s|%s|%s|%s", q.AppLabel, q.Path, q.Environment, commitSHA, string(pStr), valuesFiles, q.Namespace, q.NamePrefix)
+	dStr, _ := json.Marshal(q.Directory)
+	oStr, _ := json.Marshal(overrideParams(q.Override))
+	mStr, _ := json.Marshal(q.ResourceMetadata)
+	// the chart dependencies depend on the helm repositories, whose credentials are not part of the key
+	helmRepoURLs := make([]string, len(q.HelmRepos))
+	for i, repo := range q.HelmRepos {
+		helmRepoURLs[i] = repo.Name + "=" + repo.URL
+	}
+	return fmt.Sprintf("mfst|%s|%s|%s|%s|%s|%s|%s|%s|%s|%s|%s|%s", q.AppLabel, q.Path, q.Environment, commitSHA, string(pStr), valuesFiles, q.Namespace, q.NamePrefix, string(dStr), string(oStr), string(mStr), strings.Join(helmRepoURLs, ","))
+}
+
+func manifestFailureCacheKey(manifestCacheKey string) string {
+	return "mfail|" + manifestCacheKey
 }
 
 func listDirCacheKey(commitSHA string, q *ListDirRequest) string {
@@ -330,6 +832,26 @@ func getFileCacheKey(commitSHA string, q *GetFileRequest) string {
 	return fmt.Sprintf("gfile|%s|%s", q.Path, commitSHA)
 }
 
+func ksonnetAppDetailsCacheKey(commitSHA string, q *KsonnetAppDetailsRequest) string {
+	return fmt.Sprintf("ksapp|%s|%s", q.Path, commitSHA)
+}
+
+func listAppsCacheKey(commitSHA string) string {
+	return fmt.Sprintf("lapp|%s", commitSHA)
+}
+
+func listRefsCacheKey(q *ListRefsRequest) string {
+	return fmt.Sprintf("gref|%s", q.Repo.Repo)
+}
+
+func revisionMetadataCacheKey(q *RevisionMetadataRequest) string {
+	return fmt.Sprintf("rmeta|%s|%s", q.Repo.Repo, q.Revision)
+}
+
+func helmChartsCacheKey(q *ListHelmChartsRequest) string {
+	return fmt.Sprintf("hchart|%s", q.Repo.URL)
+}
+
 // ksShow runs `ks show` in an app directory after setting any component parameter overrides
 func ksShow(appPath, envName string, overrides []*v1alpha1.ComponentParameter) ([]*unstructured.Unstructured, []*v1alpha1.ComponentParameter, *v1alpha1.ApplicationDestination, error) {
 	ksApp, err := ksonnet.NewKsonnetApp(appPath)
@@ -361,36 +883,174 @@ func ksShow(appPath, envName string, overrides []*v1alpha1.ComponentParameter) (
 
 var manifestFile = regexp.MustCompile(`^.*\.(yaml|yml|json|jsonnet)$`)
 
-// findManifests looks at all yaml files in a directory and unmarshals them into a list of unstructured objects
-func findManifests(appPath string) ([]*unstructured.Unstructured, error) {
+// resourceKey returns the group/kind/namespace/name identifying the resource, with resources without
+// namespace considered to be in the default namespace
+func resourceKey(obj *unstructured.Unstructured, defaultNamespace string) string {
+	namespace := obj.GetNamespace()
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+	gvk := obj.GroupVersionKind()
+	return fmt.Sprintf("%s/%s/%s/%s", gvk.Group, gvk.Kind, namespace, obj.GetName())
+}
+
+// jsonnetExtVars returns the external variables available to jsonnet files: the application name and
+// destination namespace, followed by the variables from the application spec
+func jsonnetExtVars(q *ManifestRequest) []v1alpha1.JsonnetVar {
+	extVars := []v1alpha1.JsonnetVar{
+		{Name: "ARGOCD_APP_NAME", Value: q.AppLabel},
+		{Name: "ARGOCD_APP_NAMESPACE", Value: q.Namespace},
+	}
+	if q.Directory != nil {
+		extVars = append(extVars, q.Directory.ExtVars...)
+	}
+	for _, p := range overrideParams(q.Override) {
+		extVars = append(extVars, v1alpha1.JsonnetVar{Name: p.Name, Value: p.Value})
+	}
+	return extVars
+}
+
+// setResourceMetadata adds the given labels and annotations to the metadata of the resource. They are
+// not added to the pod templates of workloads, which are immutable for some kinds (e.g. Jobs) and whose
+// changes roll out new pods
+func setResourceMetadata(target *unstructured.Unstructured, metadata *v1alpha1.ResourceMetadata) error {
+	if len(metadata.Labels) > 0 {
+		labels := target.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		for key, val := range metadata.Labels {
+			labels[key] = val
+		}
+		target.SetLabels(labels)
+	}
+	if len(metadata.Annotations) > 0 {
+		annotations := target.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		for key, val := range metadata.Annotations {
+			annotations[key] = val
+		}
+		target.SetAnnotations(annotations)
+	}
+	return nil
+}
+
+// toolParams returns the parameter overrides of the request followed by its overrides, in a new slice so
+// that the parameter overrides of the request are not modified
+func toolParams(q *ManifestRequest) []*v1alpha1.ComponentParameter {
+	overrides := overrideParams(q.Override)
+	params := make([]*v1alpha1.ComponentParameter, 0, len(q.ComponentParameterOverrides)+len(overrides))
+	params = append(params, q.ComponentParameterOverrides...)
+	return append(params, overrides...)
+}
+
+// overrideParams converts the source override map to parameters, sorted by name
+func overrideParams(override map[string]string) []*v1alpha1.ComponentParameter {
+	params := make([]*v1alpha1.ComponentParameter, 0, len(override))
+	for name, value := range override {
+		params = append(params, &v1alpha1.ComponentParameter{Name: name, Value: value})
+	}
+	sort.Slice(params, func(i, j int) bool {
+		return params[i].Name < params[j].Name
+	})
+	return params
+}
+
+var yamlSeparator = regexp.MustCompile(`\n---`)
+
+// jsonnetNativeFuncs are the native functions available to jsonnet files via std.native()
+var jsonnetNativeFuncs = []*jsonnet.NativeFunction{
+	{
+		Name:   "parseJson",
+		Params: ast.Identifiers{"json"},
+		Func: func(args []interface{}) (interface{}, error) {
+			data, ok := args[0].(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected type %T for argument 'json' of parseJson", args[0])
+			}
+			var res interface{}
+			err := json.Unmarshal([]byte(data), &res)
+			return res, err
+		},
+	},
+	{
+		Name:   "parseYaml",
+		Params: ast.Identifiers{"yaml"},
+		Func: func(args []interface{}) (interface{}, error) {
+			data, ok := args[0].(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected type %T for argument 'yaml' of parseYaml", args[0])
+			}
+			docs := make([]interface{}, 0)
+			for _, part := range yamlSeparator.Split(data, -1) {
+				var doc interface{}
+				err := yaml.Unmarshal([]byte(part), &doc)
+				if err != nil {
+					return nil, err
+				}
+				if doc != nil {
+					docs = append(docs, doc)
+				}
+			}
+			return docs, nil
+		},
+	},
+}
+
+// newJsonnetVM returns a jsonnet VM which resolves imports relative to the app path
+func newJsonnetVM(appPath string, extVars []v1alpha1.JsonnetVar) *jsonnet.VM {
+	vm := jsonnet.MakeVM()
+	vm.Importer(&jsonnet.FileImporter{
+		JPaths: []string{appPath},
+	})
+	for _, extVar := range extVars {
+		if extVar.Code {
+			vm.ExtCode(extVar.Name, extVar.Value)
+		} else {
+			vm.ExtVar(extVar.Name, extVar.Value)
+		}
+	}
+	for _, f := range jsonnetNativeFuncs {
+		vm.NativeFunction(f)
+	}
+	return vm
+}
+
+// findManifests looks at all yaml files in a directory and unmarshals them into a list of unstructured objects.
+// Jsonnet files are evaluated only if jsonnetEnabled is set. Also returns the name of the file each object
+// was read from.
+func findManifests(appPath string, jsonnetEnabled bool, extVars []v1alpha1.JsonnetVar) ([]*unstructured.Unstructured, []string, error) {
 	files, err := ioutil.ReadDir(appPath)
 	if err != nil {
-		return nil, status.Errorf(codes.FailedPrecondition, "Failed to read dir %s: %v", appPath, err)
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "Failed to read dir %s: %v", appPath, err)
 	}
 	var objs []*unstructured.Unstructured
+	var sources []string
 	for _, f := range files {
 		if f.IsDir() || !manifestFile.MatchString(f.Name()) {
 			continue
 		}
+		if !jsonnetEnabled && strings.HasSuffix(f.Name(), ".jsonnet") {
+			continue
+		}
 		out, err := ioutil.ReadFile(filepath.Join(appPath, f.Name()))
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		if strings.HasSuffix(f.Name(), ".json") {
 			var obj unstructured.Unstructured
 			err = json.Unmarshal(out, &obj)
 			if err != nil {
-				return nil, status.Errorf(codes.FailedPrecondition, "Failed to unmarshal %q: %v", f.Name(), err)
+				return nil, nil, status.Errorf(codes.FailedPrecondition, "Failed to unmarshal %q: %v", f.Name(), err)
 			}
 			objs = append(objs, &obj)
 		} else if strings.HasSuffix(f.Name(), ".jsonnet") {
-			vm := jsonnet.MakeVM()
-			vm.Importer(&jsonnet.FileImporter{
-				JPaths: []string{appPath},
-			})
+			vm := newJsonnetVM(appPath, extVars)
 			jsonStr, err := vm.EvaluateSnippet(f.Name(), string(out))
 			if err != nil {
-				return nil, status.Errorf(codes.FailedPrecondition, "Failed to evaluate jsonnet %q: %v", f.Name(), err)
+				return nil, nil, status.Errorf(codes.FailedPrecondition, "Failed to evaluate jsonnet %q: %v", f.Name(), err)
 			}
 
 			// attempt to unmarshal either array or single object
@@ -402,7 +1062,7 @@ func findManifests(appPath string) ([]*unstructured.Unstructured, error) {
 				var jsonObj unstructured.Unstructured
 				err = json.Unmarshal([]byte(jsonStr), &jsonObj)
 				if err != nil {
-					return nil, status.Errorf(codes.FailedPrecondition, "Failed to unmarshal generated json %q: %v", f.Name(), err)
+					return nil, nil, status.Errorf(codes.FailedPrecondition, "Failed to unmarshal generated json %q: %v", f.Name(), err)
 				}
 				objs = append(objs, &jsonObj)
 			}
@@ -413,15 +1073,18 @@ func findManifests(appPath string) ([]*unstructured.Unstructured, error) {
 					// If we get here, we had a multiple objects in a single YAML file which had some
 					// valid k8s objects, but errors parsing others (within the same file). It's very
 					// likely the user messed up a portion of the YAML, so report on that.
-					return nil, status.Errorf(codes.FailedPrecondition, "Failed to unmarshal %q: %v", f.Name(), err)
+					return nil, nil, status.Errorf(codes.FailedPrecondition, "Failed to unmarshal %q: %v", f.Name(), err)
 				}
 				// Otherwise, it might be a unrelated YAML file which we will ignore
 				continue
 			}
 			objs = append(objs, yamlObjs...)
 		}
+		for len(sources) < len(objs) {
+			sources = append(sources, f.Name())
+		}
 	}
-	return objs, nil
+	return objs, sources, nil
 }
 
 // pathExists reports whether the file or directory at the named concatenation of paths exists.
@@ -437,13 +1100,13 @@ func pathExists(ss ...string) bool {
 
 // newClientResolveRevision is a helper to perform the common task of instantiating a git client
 // and resolving a revision to a commit SHA
-func (s *Service) newClientResolveRevision(repo *v1alpha1.Repository, revision string) (git.Client, string, error) {
-	appRepoPath := tempRepoPath(repo.Repo)
+func (s *Service) newClientResolveRevision(ctx context.Context, repo *v1alpha1.Repository, revision string) (git.Client, string, error) {
+	appRepoPath := s.checkouts.Path(repo.Repo)
 	gitClient, err := s.gitFactory.NewClient(repo.Repo, appRepoPath, repo.Username, repo.Password, repo.SSHPrivateKey)
 	if err != nil {
 		return nil, "", err
 	}
-	commitSHA, err := gitClient.LsRemote(revision)
+	commitSHA, err := gitClient.LsRemote(ctx, revision)
 	if err != nil {
 		return nil, "", err
 	}
@@ -1,9 +1,24 @@
 package repository
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/reposerver/metrics"
+	"github.com/argoproj/argo-cd/util/cache"
+	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/helm"
 )
 
 func TestGenerateYamlManifestInDir(t *testing.T) {
@@ -27,3 +42,377 @@ func TestGenerateJsonnetManifestInDir(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, len(res1.Manifests), 2)
 }
+
+func TestGenerateJsonnetManifestWithExtVars(t *testing.T) {
+	q := ManifestRequest{
+		AppLabel:  "my-app",
+		Namespace: "my-namespace",
+		Directory: &v1alpha1.ApplicationSourceDirectory{
+			Jsonnet: true,
+			ExtVars: []v1alpha1.JsonnetVar{
+				{Name: "environment", Value: "staging"},
+				{Name: "replicas", Value: "1 + 2", Code: true},
+			},
+		},
+	}
+	res1, err := generateManifests("./testdata/jsonnet-extvars", &q)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(res1.Manifests))
+
+	obj, err := v1alpha1.UnmarshalToUnstructured(res1.Manifests[0])
+	assert.Nil(t, err)
+	assert.Equal(t, "my-app", obj.GetName())
+	assert.Equal(t, "my-namespace", obj.GetNamespace())
+	data, _, _ := unstructured.NestedStringMap(obj.Object, "data")
+	assert.Equal(t, map[string]string{"environment": "staging", "replicas": "3", "logLevel": "debug"}, data)
+}
+
+func TestGenerateJsonnetManifestWithOverride(t *testing.T) {
+	q := ManifestRequest{
+		AppLabel:  "my-app",
+		Namespace: "my-namespace",
+		Directory: &v1alpha1.ApplicationSourceDirectory{
+			Jsonnet: true,
+			ExtVars: []v1alpha1.JsonnetVar{
+				{Name: "environment", Value: "staging"},
+				{Name: "replicas", Value: "1", Code: true},
+			},
+		},
+		Override: map[string]string{"environment": "production"},
+	}
+	res1, err := generateManifests("./testdata/jsonnet-extvars", &q)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(res1.Manifests))
+
+	obj, err := v1alpha1.UnmarshalToUnstructured(res1.Manifests[0])
+	assert.Nil(t, err)
+	data, _, _ := unstructured.NestedStringMap(obj.Object, "data")
+	assert.Equal(t, "production", data["environment"])
+}
+
+func TestOverrideParams(t *testing.T) {
+	params := overrideParams(map[string]string{"image.tag": "v1.2", "replicas": "2", "debug": "true"})
+	assert.Equal(t, []*v1alpha1.ComponentParameter{
+		{Name: "debug", Value: "true"},
+		{Name: "image.tag", Value: "v1.2"},
+		{Name: "replicas", Value: "2"},
+	}, params)
+	assert.Empty(t, overrideParams(nil))
+}
+
+func TestToolParams(t *testing.T) {
+	paramOverrides := make([]*v1alpha1.ComponentParameter, 1, 2)
+	paramOverrides[0] = &v1alpha1.ComponentParameter{Name: "replicas", Value: "1"}
+	q := &ManifestRequest{ComponentParameterOverrides: paramOverrides, Override: map[string]string{"image.tag": "v1.2"}}
+	assert.Equal(t, []*v1alpha1.ComponentParameter{
+		{Name: "replicas", Value: "1"},
+		{Name: "image.tag", Value: "v1.2"},
+	}, toolParams(q))
+	// the spare capacity of the parameter overrides of the request is not written to
+	assert.Nil(t, paramOverrides[:2][1])
+}
+
+func TestSetResourceMetadata(t *testing.T) {
+	obj, err := v1alpha1.UnmarshalToUnstructured(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"my-deploy","labels":{"app":"my-deploy"},"annotations":{"foo":"bar"}},"spec":{"selector":{"matchLabels":{"app":"my-deploy"}},"template":{"metadata":{"labels":{"app":"my-deploy"}}}}}`)
+	assert.Nil(t, err)
+	err = setResourceMetadata(obj, &v1alpha1.ResourceMetadata{
+		Labels:      map[string]string{"team": "a"},
+		Annotations: map[string]string{"cost-center": "1234"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"app": "my-deploy", "team": "a"}, obj.GetLabels())
+	assert.Equal(t, map[string]string{"foo": "bar", "cost-center": "1234"}, obj.GetAnnotations())
+	// the pod template is not modified
+	templateLabels, _, _ := unstructured.NestedStringMap(obj.Object, "spec", "template", "metadata", "labels")
+	assert.Equal(t, map[string]string{"app": "my-deploy"}, templateLabels)
+	selector, _, _ := unstructured.NestedStringMap(obj.Object, "spec", "selector", "matchLabels")
+	assert.Equal(t, map[string]string{"app": "my-deploy"}, selector)
+}
+
+func TestGenerateManifestInDirWithDuplicates(t *testing.T) {
+	q := ManifestRequest{Namespace: "default"}
+	_, err := generateManifests("./testdata/duplicates", &q)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "/ConfigMap/default/my-config (in a.yaml, b.yaml)")
+	assert.NotContains(t, err.Error(), "other-namespace")
+}
+
+func TestGenerateManifestInDirWithJsonnetDisabled(t *testing.T) {
+	q := ManifestRequest{Directory: &v1alpha1.ApplicationSourceDirectory{}}
+	res1, err := generateManifests("./testdata/jsonnet", &q)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(res1.Manifests))
+}
+
+func TestGenerateManifestInDirSkipsToolDetection(t *testing.T) {
+	q := ManifestRequest{Directory: &v1alpha1.ApplicationSourceDirectory{}}
+	res1, err := generateManifests("./testdata/stray-chart", &q)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(res1.Manifests))
+}
+
+func TestManifestFailureBackoff(t *testing.T) {
+	assert.Equal(t, ManifestFailureBackoff, manifestFailureBackoff(1))
+	assert.Equal(t, 2*ManifestFailureBackoff, manifestFailureBackoff(2))
+	assert.Equal(t, 4*ManifestFailureBackoff, manifestFailureBackoff(3))
+	assert.Equal(t, MaxManifestFailureBackoff, manifestFailureBackoff(100))
+}
+
+func TestGetAppSourceType(t *testing.T) {
+	assert.Equal(t, AppSourceHelm, getAppSourceType("./testdata/stray-chart", &ManifestRequest{}))
+	assert.Equal(t, AppSourceDirectory, getAppSourceType("./testdata/stray-chart", &ManifestRequest{Directory: &v1alpha1.ApplicationSourceDirectory{}}))
+	assert.Equal(t, AppSourceDirectory, getAppSourceType("./testdata/jsonnet", &ManifestRequest{}))
+}
+
+func TestManifestFailureCache(t *testing.T) {
+	s := newTestService(&fakeGitClient{})
+	cacheKey := "mfst|my-app"
+	assert.Equal(t, 0, s.getManifestFailure(cacheKey).Failures)
+
+	genErr := status.Errorf(codes.FailedPrecondition, "helm template failed")
+	s.setManifestFailure(cacheKey, s.getManifestFailure(cacheKey), genErr)
+	s.setManifestFailure(cacheKey, s.getManifestFailure(cacheKey), genErr)
+	failure := s.getManifestFailure(cacheKey)
+	assert.Equal(t, 2, failure.Failures)
+	assert.Equal(t, codes.FailedPrecondition, failure.Code)
+	assert.Equal(t, "helm template failed", failure.Message)
+	assert.True(t, failure.RetryAt.After(time.Now().Add(ManifestFailureBackoff)))
+
+	// a successful generation resets the failure
+	s.setManifestFailure(cacheKey, failure, nil)
+	assert.Equal(t, 0, s.getManifestFailure(cacheKey).Failures)
+}
+
+// fakeGitClient is a git client of a repository with the given refs, changed files and commit metadata.
+// The metadata of the commit is only known once the repository is fetched
+type fakeGitClient struct {
+	git.Client
+	refs          *git.Refs
+	changedFiles  []string
+	metadata      *git.RevisionMetadata
+	fetched       bool
+	lsRefsCalls   int
+	metadataCalls int
+}
+
+func (c *fakeGitClient) Root() string {
+	return os.TempDir()
+}
+
+func (c *fakeGitClient) Init() error {
+	return nil
+}
+
+func (c *fakeGitClient) Fetch(ctx context.Context) error {
+	c.fetched = true
+	return nil
+}
+
+func (c *fakeGitClient) LsRemote(ctx context.Context, revision string) (string, error) {
+	return revision, nil
+}
+
+func (c *fakeGitClient) LsRefs(ctx context.Context) (*git.Refs, error) {
+	c.lsRefsCalls++
+	return c.refs, nil
+}
+
+func (c *fakeGitClient) ChangedFiles(ctx context.Context, revision string, targetRevision string) ([]string, error) {
+	return c.changedFiles, nil
+}
+
+func (c *fakeGitClient) RevisionMetadata(ctx context.Context, revision string) (*git.RevisionMetadata, error) {
+	c.metadataCalls++
+	if !c.fetched {
+		return nil, fmt.Errorf("unknown revision %s", revision)
+	}
+	return c.metadata, nil
+}
+
+// fakeGitClientFactory returns the given git client for all repositories
+type fakeGitClientFactory struct {
+	client *fakeGitClient
+}
+
+func (f *fakeGitClientFactory) NewClient(repoURL, path, username, password, sshPrivateKey string) (git.Client, error) {
+	return f.client, nil
+}
+
+// newTestService returns a service with an in-memory cache, whose repositories are accessed with the given git client
+func newTestService(client *fakeGitClient) *Service {
+	return NewService(&fakeGitClientFactory{client: client}, cache.NewInMemoryCache(time.Hour), metrics.NewMetricsServer(), NewCheckouts(CheckoutOptions{RootDir: os.TempDir()}))
+}
+
+func TestGetUnchangedManifests(t *testing.T) {
+	s := newTestService(&fakeGitClient{})
+	q := &ManifestRequest{
+		AppLabel:              "my-app",
+		Path:                  "apps/my-app",
+		PreviousRevision:      "prev-sha",
+		ManifestGeneratePaths: []string{"apps/my-app", "bases"},
+	}
+	err := s.cache.Set(&cache.Item{
+		Key:    manifestCacheKey("prev-sha", q),
+		Object: ManifestResponse{Manifests: []string{"{}"}, Revision: "prev-sha"},
+	})
+	assert.NoError(t, err)
+
+	res, ok := s.getUnchangedManifests(context.Background(), &fakeGitClient{changedFiles: []string{"apps/other-app/deployment.yaml"}}, "new-sha", q)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"{}"}, res.Manifests)
+
+	_, ok = s.getUnchangedManifests(context.Background(), &fakeGitClient{changedFiles: []string{"bases/service.yaml"}}, "new-sha", q)
+	assert.False(t, ok)
+
+	// the manifests are regenerated when the cache is bypassed or without manifest generate paths
+	_, ok = s.getUnchangedManifests(context.Background(), &fakeGitClient{}, "new-sha", &ManifestRequest{AppLabel: "my-app", Path: "apps/my-app", PreviousRevision: "prev-sha"})
+	assert.False(t, ok)
+	q.NoCache = true
+	_, ok = s.getUnchangedManifests(context.Background(), &fakeGitClient{}, "new-sha", q)
+	assert.False(t, ok)
+}
+
+// fakeHelm is a helm wrapper recording the commands it runs
+type fakeHelm struct {
+	helm.Helm
+	hasDependencies bool
+	commands        []string
+}
+
+func (h *fakeHelm) HasDependencies() (bool, error) {
+	return h.hasDependencies, nil
+}
+
+func (h *fakeHelm) SetHome(home string) {
+	h.commands = append(h.commands, "home")
+}
+
+func (h *fakeHelm) Init() error {
+	h.commands = append(h.commands, "init")
+	return nil
+}
+
+func (h *fakeHelm) RepoAdd(name, url, username, password string) error {
+	h.commands = append(h.commands, fmt.Sprintf("repo add %s %s %s", name, url, username))
+	return nil
+}
+
+func (h *fakeHelm) DependencyBuild() error {
+	h.commands = append(h.commands, "dependency build")
+	return nil
+}
+
+func TestHelmDependencyBuild(t *testing.T) {
+	helmRepos := []*v1alpha1.HelmRepository{{Name: "private", URL: "https://charts.example.com", Username: "admin", Password: "secret"}}
+
+	h := &fakeHelm{hasDependencies: true}
+	assert.NoError(t, helmDependencyBuild(h, helmRepos))
+	assert.Equal(t, []string{"home", "init", "repo add private https://charts.example.com admin", "dependency build"}, h.commands)
+
+	// charts without dependencies are templated directly
+	h = &fakeHelm{}
+	assert.NoError(t, helmDependencyBuild(h, helmRepos))
+	assert.Empty(t, h.commands)
+}
+
+func TestManifestCacheKeyHelmRepos(t *testing.T) {
+	q := &ManifestRequest{Path: "wordpress", HelmRepos: []*v1alpha1.HelmRepository{{Name: "private", URL: "https://charts.example.com", Password: "secret"}}}
+	key := manifestCacheKey("sha", q)
+	assert.NotContains(t, key, "secret")
+
+	other := *q
+	other.HelmRepos = []*v1alpha1.HelmRepository{{Name: "private", URL: "https://other-charts.example.com", Password: "secret"}}
+	assert.NotEqual(t, key, manifestCacheKey("sha", &other))
+}
+
+func TestListHelmCharts(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`entries:
+  wordpress:
+  - version: 5.9.0
+  redis:
+  - version: 9.0.1
+  - version: 8.1.0
+`))
+	}))
+	defer server.Close()
+	s := newTestService(&fakeGitClient{})
+	q := &ListHelmChartsRequest{Repo: &v1alpha1.HelmRepository{Name: "stable", URL: server.URL}}
+
+	charts, err := s.ListHelmCharts(context.Background(), q)
+	assert.NoError(t, err)
+	assert.Equal(t, []v1alpha1.HelmChart{
+		{Name: "redis", Versions: []string{"9.0.1", "8.1.0"}},
+		{Name: "wordpress", Versions: []string{"5.9.0"}},
+	}, charts.Items)
+
+	// the index is cached
+	_, err = s.ListHelmCharts(context.Background(), q)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, requests)
+
+	_, err = s.ListHelmCharts(context.Background(), &ListHelmChartsRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListRefs(t *testing.T) {
+	client := &fakeGitClient{refs: &git.Refs{Branches: []string{"master"}, Tags: []string{"v1.0.0"}}}
+	s := newTestService(client)
+	q := &ListRefsRequest{Repo: &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps"}}
+
+	refs, err := s.ListRefs(context.Background(), q)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"master"}, refs.Branches)
+	assert.Equal(t, []string{"v1.0.0"}, refs.Tags)
+
+	// the refs are cached
+	_, err = s.ListRefs(context.Background(), q)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, client.lsRefsCalls)
+}
+
+func TestGetRevisionMetadata(t *testing.T) {
+	date := time.Now().Truncate(time.Second)
+	client := &fakeGitClient{metadata: &git.RevisionMetadata{Author: "argo-cd", Date: date, Message: "Update guestbook", Tags: []string{"v1.0.0"}}}
+	s := newTestService(client)
+	q := &RevisionMetadataRequest{Repo: &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps"}, Revision: "9d921f65f3c5373b682e2eb4b37afba6592e8f8b"}
+
+	// the repository is fetched since the commit is unknown
+	metadata, err := s.GetRevisionMetadata(context.Background(), q)
+	assert.NoError(t, err)
+	assert.True(t, client.fetched)
+	assert.Equal(t, "argo-cd", metadata.Author)
+	assert.Equal(t, date, metadata.CommitTime.Time)
+	assert.Equal(t, "Update guestbook", metadata.Message)
+	assert.Equal(t, []string{"v1.0.0"}, metadata.Tags)
+
+	// the metadata is cached
+	_, err = s.GetRevisionMetadata(context.Background(), q)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, client.metadataCalls)
+
+	_, err = s.GetRevisionMetadata(context.Background(), &RevisionMetadataRequest{Repo: q.Repo, Revision: "master"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestFindApps(t *testing.T) {
+	apps, err := findApps("./testdata")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"concatenated/concatenated.yaml":    "directory",
+		"duplicates/a.yaml":                 "directory",
+		"jsonnet/guestbook-ui.jsonnet":      "directory",
+		"jsonnet-extvars/configmap.jsonnet": "directory",
+		"stray-chart/Chart.yaml":            "helm",
+	}, apps)
+
+	apps, err = findApps("../../util/ksonnet/testdata")
+	assert.NoError(t, err)
+	assert.Equal(t, "ksonnet", apps["test-app/app.yaml"])
+
+	// YAML files which do not declare Kubernetes resources are not manifests
+	assert.False(t, isResourceManifest("./testdata/jsonnet-extvars/data.yaml"))
+	assert.True(t, isResourceManifest("./testdata/duplicates/a.yaml"))
+}
@@ -3,6 +3,7 @@ package reposerver
 import (
 	"crypto/tls"
 
+	"github.com/argoproj/argo-cd/reposerver/metrics"
 	"github.com/argoproj/argo-cd/reposerver/repository"
 	"github.com/argoproj/argo-cd/server/version"
 	"github.com/argoproj/argo-cd/util/cache"
@@ -14,19 +15,23 @@ import (
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
 // ArgoCDRepoServer is the repo server implementation
 type ArgoCDRepoServer struct {
-	log        *log.Entry
-	gitFactory git.ClientFactory
-	cache      cache.Cache
-	opts       []grpc.ServerOption
+	log           *log.Entry
+	gitFactory    git.ClientFactory
+	cache         cache.Cache
+	metricsServer *metrics.MetricsServer
+	checkouts     *repository.Checkouts
+	opts          []grpc.ServerOption
 }
 
 // NewServer returns a new instance of the Argo CD Repo server
-func NewServer(gitFactory git.ClientFactory, cache cache.Cache, tlsConfCustomizer tlsutil.ConfigCustomizer) (*ArgoCDRepoServer, error) {
+func NewServer(gitFactory git.ClientFactory, cache cache.Cache, metricsServer *metrics.MetricsServer, checkouts *repository.Checkouts, tlsConfCustomizer tlsutil.ConfigCustomizer) (*ArgoCDRepoServer, error) {
 	// generate TLS cert
 	hosts := []string{
 		"localhost",
@@ -48,10 +53,12 @@ func NewServer(gitFactory git.ClientFactory, cache cache.Cache, tlsConfCustomize
 	opts := []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsConfig))}
 
 	return &ArgoCDRepoServer{
-		log:        log.NewEntry(log.New()),
-		gitFactory: gitFactory,
-		cache:      cache,
-		opts:       opts,
+		log:           log.NewEntry(log.New()),
+		gitFactory:    gitFactory,
+		cache:         cache,
+		metricsServer: metricsServer,
+		checkouts:     checkouts,
+		opts:          opts,
 	}, nil
 }
 
@@ -69,9 +76,16 @@ func (a *ArgoCDRepoServer) CreateGRPC() *grpc.Server {
 			)))...,
 	)
 	version.RegisterVersionServiceServer(server, &version.Server{})
-	manifestService := repository.NewService(a.gitFactory, a.cache)
+	manifestService := repository.NewService(a.gitFactory, a.cache, a.metricsServer, a.checkouts)
 	repository.RegisterRepositoryServiceServer(server, manifestService)
 
+	// Register the standard gRPC health service, so that clients and probes can check the server is
+	// ready to serve requests
+	healthService := health.NewServer()
+	healthService.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+	healthService.SetServingStatus("repository.RepositoryService", grpc_health_v1.HealthCheckResponse_SERVING)
+	grpc_health_v1.RegisterHealthServer(server, healthService)
+
 	// Register reflection service on gRPC server.
 	reflection.Register(server)
 
@@ -0,0 +1,56 @@
+package reposerver
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/argoproj/argo-cd/reposerver/metrics"
+	"github.com/argoproj/argo-cd/reposerver/repository"
+	"github.com/argoproj/argo-cd/util/cache"
+	"github.com/argoproj/argo-cd/util/git"
+)
+
+func TestHealthCheck(t *testing.T) {
+	server, err := NewServer(git.NewFactory(), cache.NewInMemoryCache(repository.DefaultRepoCacheExpiration), metrics.NewMetricsServer(), repository.NewCheckouts(repository.CheckoutOptions{RootDir: os.TempDir()}), func(config *tls.Config) {})
+	assert.NoError(t, err)
+	grpcServer := server.CreateGRPC()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	go func() { _ = grpcServer.Serve(listener) }()
+	defer grpcServer.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})))
+	assert.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	healthClient := grpc_health_v1.NewHealthClient(conn)
+	res, err := healthClient.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, res.Status)
+
+	res, err = healthClient.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "repository.RepositoryService"})
+	assert.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, res.Status)
+}
+
+func TestCheckHealth(t *testing.T) {
+	server, err := NewServer(git.NewFactory(), cache.NewInMemoryCache(repository.DefaultRepoCacheExpiration), metrics.NewMetricsServer(), repository.NewCheckouts(repository.CheckoutOptions{RootDir: os.TempDir()}), func(config *tls.Config) {})
+	assert.NoError(t, err)
+	grpcServer := server.CreateGRPC()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	go func() { _ = grpcServer.Serve(listener) }()
+
+	assert.NoError(t, CheckHealth(NewRepositoryServerClientset(listener.Addr().String())))
+
+	grpcServer.Stop()
+	assert.Error(t, CheckHealth(NewRepositoryServerClientset(listener.Addr().String())))
+}
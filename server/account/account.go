@@ -1,6 +1,8 @@
 package account
 
 import (
+	"fmt"
+	"regexp"
 	"time"
 
 	jwt "github.com/dgrijalva/jwt-go"
@@ -9,8 +11,10 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/util/grpc"
 	jwtutil "github.com/argoproj/argo-cd/util/jwt"
 	"github.com/argoproj/argo-cd/util/password"
+	"github.com/argoproj/argo-cd/util/rbac"
 	"github.com/argoproj/argo-cd/util/session"
 	"github.com/argoproj/argo-cd/util/settings"
 )
@@ -19,22 +23,30 @@ import (
 type Server struct {
 	sessionMgr  *session.SessionManager
 	settingsMgr *settings.SettingsManager
+	enf         *rbac.Enforcer
 }
 
 // NewServer returns a new instance of the Session service
-func NewServer(sessionMgr *session.SessionManager, settingsMgr *settings.SettingsManager) *Server {
+func NewServer(sessionMgr *session.SessionManager, settingsMgr *settings.SettingsManager, enf *rbac.Enforcer) *Server {
 	return &Server{
 		sessionMgr:  sessionMgr,
 		settingsMgr: settingsMgr,
+		enf:         enf,
 	}
 
 }
 
-// UpdatePassword updates the password of the local admin superuser.
+// UpdatePassword updates the password of a local user. Users changing their own password have to
+// confirm their current password, while the password of other users can be changed by the users
+// allowed to update their account.
 func (s *Server) UpdatePassword(ctx context.Context, q *UpdatePasswordRequest) (*UpdatePasswordResponse, error) {
 	username := getAuthenticatedUser(ctx)
-	if username != common.ArgoCDAdminUsername {
-		return nil, status.Errorf(codes.InvalidArgument, "password can only be changed for local users, not user %q", username)
+	name := q.Name
+	if name == "" {
+		name = username
+	}
+	if name != common.ArgoCDAdminUsername {
+		return nil, status.Errorf(codes.InvalidArgument, "password can only be changed for local users, not user %q", name)
 	}
 
 	cdSettings, err := s.settingsMgr.GetSettings()
@@ -42,28 +54,87 @@ func (s *Server) UpdatePassword(ctx context.Context, q *UpdatePasswordRequest) (
 		return nil, err
 	}
 
-	err = s.sessionMgr.VerifyUsernamePassword(username, q.CurrentPassword)
+	if name == username {
+		err = s.sessionMgr.VerifyUsernamePassword(username, q.CurrentPassword)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "current password does not match")
+		}
+	} else if !s.enf.EnforceClaims(ctx.Value("claims"), "accounts", "update", name) {
+		return nil, grpc.ErrPermissionDenied
+	}
+
+	err = validatePassword(q.NewPassword, cdSettings.GetPasswordPattern())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "current password does not match")
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	hashedPassword, err := password.HashPassword(q.NewPassword)
+	hashedPassword, err := password.HashPasswordWithCost(q.NewPassword, cdSettings.PasswordBcryptCost)
 	if err != nil {
 		return nil, err
 	}
 
 	cdSettings.AdminPasswordHash = hashedPassword
 	cdSettings.AdminPasswordMtime = time.Now().UTC()
+	cdSettings.AdminPasswordChangeRequired = false
 
 	err = s.settingsMgr.SaveSettings(cdSettings)
 	if err != nil {
 		return nil, err
 	}
 
+	// the initial admin password is no longer valid
+	err = s.settingsMgr.DeleteInitialAdminPassword()
+	if err != nil {
+		return nil, err
+	}
+
+	// the sessions opened with the previous password, or by someone who knew it, are no longer valid
+	err = s.sessionMgr.InvalidateSessions(cdSettings.AdminPasswordMtime)
+	if err != nil {
+		return nil, err
+	}
+
 	return &UpdatePasswordResponse{}, nil
 
 }
 
+// validatePassword returns an error if the password does not match the pattern of the passwords
+func validatePassword(newPassword string, pattern string) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return fmt.Errorf("invalid password pattern '%s': %v", pattern, err)
+	}
+	if !re.MatchString(newPassword) {
+		return fmt.Errorf("new password does not match the password pattern '%s'", pattern)
+	}
+	return nil
+}
+
+// RevokeToken revokes an auth token. Any user holding a valid token may revoke it
+func (s *Server) RevokeToken(ctx context.Context, q *RevokeTokenRequest) (*RevokeTokenResponse, error) {
+	if q.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "no token supplied")
+	}
+	err := s.sessionMgr.RevokeToken(q.Token)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to revoke token: %v", err)
+	}
+	return &RevokeTokenResponse{}, nil
+}
+
+// InvalidateSessions invalidates all the auth tokens issued so far, including the token of the caller
+func (s *Server) InvalidateSessions(ctx context.Context, q *InvalidateSessionsRequest) (*InvalidateSessionsResponse, error) {
+	username := getAuthenticatedUser(ctx)
+	if username != common.ArgoCDAdminUsername {
+		return nil, status.Errorf(codes.PermissionDenied, "sessions can only be invalidated by the local admin user, not user %q", username)
+	}
+	err := s.sessionMgr.InvalidateSessions(time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	return &InvalidateSessionsResponse{}, nil
+}
+
 // getAuthenticatedUser returns the currently authenticated user (via JWT 'sub' field)
 func getAuthenticatedUser(ctx context.Context) string {
 	claimsIf := ctx.Value("claims")
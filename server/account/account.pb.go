@@ -26,8 +26,11 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type UpdatePasswordRequest struct {
-	NewPassword          string   `protobuf:"bytes,1,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
-	CurrentPassword      string   `protobuf:"bytes,2,opt,name=currentPassword,proto3" json:"currentPassword,omitempty"`
+	NewPassword string `protobuf:"bytes,1,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	// currentPassword is required to change the password of the account of the caller
+	CurrentPassword string `protobuf:"bytes,2,opt,name=currentPassword,proto3" json:"currentPassword,omitempty"`
+	// name is the name of the account, which defaults to the account of the caller
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -37,7 +40,7 @@ func (m *UpdatePasswordRequest) Reset()         { *m = UpdatePasswordRequest{} }
 func (m *UpdatePasswordRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdatePasswordRequest) ProtoMessage()    {}
 func (*UpdatePasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_account_c227670d8e34bf5f, []int{0}
+	return fileDescriptor_account_c85321e5aa3c5c2b, []int{0}
 }
 func (m *UpdatePasswordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -80,6 +83,13 @@ func (m *UpdatePasswordRequest) GetCurrentPassword() string {
 	return ""
 }
 
+func (m *UpdatePasswordRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 type UpdatePasswordResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -90,7 +100,7 @@ func (m *UpdatePasswordResponse) Reset()         { *m = UpdatePasswordResponse{}
 func (m *UpdatePasswordResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdatePasswordResponse) ProtoMessage()    {}
 func (*UpdatePasswordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_account_c227670d8e34bf5f, []int{1}
+	return fileDescriptor_account_c85321e5aa3c5c2b, []int{1}
 }
 func (m *UpdatePasswordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -119,9 +129,179 @@ func (m *UpdatePasswordResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdatePasswordResponse proto.InternalMessageInfo
 
+// RevokeTokenRequest is a request to revoke an auth token
+type RevokeTokenRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeTokenRequest) Reset()         { *m = RevokeTokenRequest{} }
+func (m *RevokeTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeTokenRequest) ProtoMessage()    {}
+func (*RevokeTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_c85321e5aa3c5c2b, []int{2}
+}
+func (m *RevokeTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokeTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokeTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RevokeTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeTokenRequest.Merge(dst, src)
+}
+func (m *RevokeTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokeTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeTokenRequest proto.InternalMessageInfo
+
+func (m *RevokeTokenRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type RevokeTokenResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeTokenResponse) Reset()         { *m = RevokeTokenResponse{} }
+func (m *RevokeTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeTokenResponse) ProtoMessage()    {}
+func (*RevokeTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_c85321e5aa3c5c2b, []int{3}
+}
+func (m *RevokeTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokeTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokeTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RevokeTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeTokenResponse.Merge(dst, src)
+}
+func (m *RevokeTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokeTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeTokenResponse proto.InternalMessageInfo
+
+// InvalidateSessionsRequest is a request to invalidate all the auth tokens issued so far
+type InvalidateSessionsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InvalidateSessionsRequest) Reset()         { *m = InvalidateSessionsRequest{} }
+func (m *InvalidateSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*InvalidateSessionsRequest) ProtoMessage()    {}
+func (*InvalidateSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_c85321e5aa3c5c2b, []int{4}
+}
+func (m *InvalidateSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvalidateSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvalidateSessionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *InvalidateSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvalidateSessionsRequest.Merge(dst, src)
+}
+func (m *InvalidateSessionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvalidateSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvalidateSessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvalidateSessionsRequest proto.InternalMessageInfo
+
+type InvalidateSessionsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InvalidateSessionsResponse) Reset()         { *m = InvalidateSessionsResponse{} }
+func (m *InvalidateSessionsResponse) String() string { return proto.CompactTextString(m) }
+func (*InvalidateSessionsResponse) ProtoMessage()    {}
+func (*InvalidateSessionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_c85321e5aa3c5c2b, []int{5}
+}
+func (m *InvalidateSessionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvalidateSessionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvalidateSessionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *InvalidateSessionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvalidateSessionsResponse.Merge(dst, src)
+}
+func (m *InvalidateSessionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvalidateSessionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvalidateSessionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvalidateSessionsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*UpdatePasswordRequest)(nil), "account.UpdatePasswordRequest")
 	proto.RegisterType((*UpdatePasswordResponse)(nil), "account.UpdatePasswordResponse")
+	proto.RegisterType((*RevokeTokenRequest)(nil), "account.RevokeTokenRequest")
+	proto.RegisterType((*RevokeTokenResponse)(nil), "account.RevokeTokenResponse")
+	proto.RegisterType((*InvalidateSessionsRequest)(nil), "account.InvalidateSessionsRequest")
+	proto.RegisterType((*InvalidateSessionsResponse)(nil), "account.InvalidateSessionsResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -137,6 +317,10 @@ const _ = grpc.SupportPackageIsVersion4
 type AccountServiceClient interface {
 	// UpdatePassword updates an account's password to a new value
 	UpdatePassword(ctx context.Context, in *UpdatePasswordRequest, opts ...grpc.CallOption) (*UpdatePasswordResponse, error)
+	// RevokeToken revokes an auth token, which is rejected until it expires
+	RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...grpc.CallOption) (*RevokeTokenResponse, error)
+	// InvalidateSessions invalidates all the auth tokens issued so far, including the tokens of the SSO users
+	InvalidateSessions(ctx context.Context, in *InvalidateSessionsRequest, opts ...grpc.CallOption) (*InvalidateSessionsResponse, error)
 }
 
 type accountServiceClient struct {
@@ -156,11 +340,33 @@ func (c *accountServiceClient) UpdatePassword(ctx context.Context, in *UpdatePas
 	return out, nil
 }
 
+func (c *accountServiceClient) RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...grpc.CallOption) (*RevokeTokenResponse, error) {
+	out := new(RevokeTokenResponse)
+	err := c.cc.Invoke(ctx, "/account.AccountService/RevokeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) InvalidateSessions(ctx context.Context, in *InvalidateSessionsRequest, opts ...grpc.CallOption) (*InvalidateSessionsResponse, error) {
+	out := new(InvalidateSessionsResponse)
+	err := c.cc.Invoke(ctx, "/account.AccountService/InvalidateSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AccountService service
 
 type AccountServiceServer interface {
 	// UpdatePassword updates an account's password to a new value
 	UpdatePassword(context.Context, *UpdatePasswordRequest) (*UpdatePasswordResponse, error)
+	// RevokeToken revokes an auth token, which is rejected until it expires
+	RevokeToken(context.Context, *RevokeTokenRequest) (*RevokeTokenResponse, error)
+	// InvalidateSessions invalidates all the auth tokens issued so far, including the tokens of the SSO users
+	InvalidateSessions(context.Context, *InvalidateSessionsRequest) (*InvalidateSessionsResponse, error)
 }
 
 func RegisterAccountServiceServer(s *grpc.Server, srv AccountServiceServer) {
@@ -185,6 +391,42 @@ func _AccountService_UpdatePassword_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_RevokeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).RevokeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.AccountService/RevokeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).RevokeToken(ctx, req.(*RevokeTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_InvalidateSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).InvalidateSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.AccountService/InvalidateSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).InvalidateSessions(ctx, req.(*InvalidateSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AccountService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "account.AccountService",
 	HandlerType: (*AccountServiceServer)(nil),
@@ -193,6 +435,14 @@ var _AccountService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePassword",
 			Handler:    _AccountService_UpdatePassword_Handler,
 		},
+		{
+			MethodName: "RevokeToken",
+			Handler:    _AccountService_RevokeToken_Handler,
+		},
+		{
+			MethodName: "InvalidateSessions",
+			Handler:    _AccountService_InvalidateSessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/account/account.proto",
@@ -225,6 +475,12 @@ func (m *UpdatePasswordRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintAccount(dAtA, i, uint64(len(m.CurrentPassword)))
 		i += copy(dAtA[i:], m.CurrentPassword)
 	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -252,6 +508,96 @@ func (m *UpdatePasswordResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *RevokeTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Token)))
+		i += copy(dAtA[i:], m.Token)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RevokeTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *InvalidateSessionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvalidateSessionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *InvalidateSessionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvalidateSessionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func encodeVarintAccount(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -272,13 +618,57 @@ func (m *UpdatePasswordRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAccount(uint64(l))
 	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdatePasswordResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevokeTokenRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevokeTokenResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InvalidateSessionsRequest) Size() (n int) {
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *UpdatePasswordResponse) Size() (n int) {
+func (m *InvalidateSessionsResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
@@ -387,6 +777,35 @@ func (m *UpdatePasswordRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.CurrentPassword = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccount(dAtA[iNdEx:])
@@ -460,6 +879,239 @@ func (m *UpdatePasswordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RevokeTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevokeTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevokeTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevokeTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevokeTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevokeTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvalidateSessionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvalidateSessionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvalidateSessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvalidateSessionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvalidateSessionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvalidateSessionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAccount(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -566,26 +1218,35 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/account/account.proto", fileDescriptor_account_c227670d8e34bf5f)
-}
-
-var fileDescriptor_account_c227670d8e34bf5f = []byte{
-	// 268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x4e, 0x2d, 0x2a,
-	0x4b, 0x2d, 0xd2, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x81, 0xd1, 0x7a, 0x05, 0x45, 0xf9,
-	0x25, 0xf9, 0x42, 0xec, 0x50, 0xae, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58, 0x4c, 0x1f, 0xc4,
-	0x82, 0x48, 0x4b, 0xc9, 0xa4, 0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x27, 0x16, 0x64, 0xea, 0x27,
-	0xe6, 0xe5, 0xe5, 0x97, 0x24, 0x96, 0x64, 0xe6, 0xe7, 0x15, 0x43, 0x64, 0x95, 0x92, 0xb9, 0x44,
-	0x43, 0x0b, 0x52, 0x12, 0x4b, 0x52, 0x03, 0x12, 0x8b, 0x8b, 0xcb, 0xf3, 0x8b, 0x52, 0x82, 0x52,
-	0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x84, 0x14, 0xb8, 0xb8, 0xf3, 0x52, 0xcb, 0x61, 0xa2, 0x12, 0x8c,
-	0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0xc8, 0x42, 0x42, 0x1a, 0x5c, 0xfc, 0xc9, 0xa5, 0x45, 0x45, 0xa9,
-	0x79, 0x25, 0x70, 0x55, 0x4c, 0x60, 0x55, 0xe8, 0xc2, 0x4a, 0x12, 0x5c, 0x62, 0xe8, 0x96, 0x14,
-	0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x1a, 0x75, 0x30, 0x72, 0xf1, 0x39, 0x42, 0x9c, 0x1f, 0x9c, 0x5a,
-	0x54, 0x96, 0x99, 0x9c, 0x2a, 0x54, 0xc6, 0xc5, 0x87, 0xaa, 0x58, 0x48, 0x4e, 0x0f, 0xe6, 0x61,
-	0xac, 0x4e, 0x95, 0x92, 0xc7, 0x29, 0x0f, 0xb1, 0x45, 0x49, 0xb9, 0xe9, 0xf2, 0x93, 0xc9, 0x4c,
-	0xb2, 0x52, 0x12, 0xe0, 0x40, 0x28, 0x33, 0x84, 0x07, 0x64, 0x01, 0x54, 0xa5, 0x15, 0xa3, 0x96,
-	0x93, 0xc5, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x18, 0xa5,
-	0x95, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x58, 0x04, 0x0e, 0xd6,
-	0x2c, 0x30, 0x43, 0x37, 0x39, 0x45, 0x1f, 0x35, 0x3a, 0x92, 0xd8, 0xc0, 0x41, 0x69, 0x0c, 0x08,
-	0x00, 0x00, 0xff, 0xff, 0xd2, 0x60, 0x1a, 0x8e, 0xa7, 0x01, 0x00, 0x00,
+	proto.RegisterFile("server/account/account.proto", fileDescriptor_account_c85321e5aa3c5c2b)
+}
+
+var fileDescriptor_account_c85321e5aa3c5c2b = []byte{
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xbd, 0xce, 0xd3, 0x30,
+	0x14, 0x95, 0xbf, 0xf2, 0x23, 0x5c, 0xa9, 0x48, 0xa6, 0x45, 0x21, 0x0d, 0xa1, 0xb8, 0x03, 0x55,
+	0xa4, 0x26, 0x02, 0x16, 0xd4, 0x0d, 0x36, 0x36, 0xd4, 0xc2, 0xc2, 0xe6, 0xa6, 0x57, 0x21, 0xb4,
+	0xb5, 0x53, 0xdb, 0x49, 0x77, 0x56, 0xc4, 0xc4, 0x4b, 0x31, 0x22, 0xf1, 0x02, 0xa8, 0xe2, 0x41,
+	0x50, 0x1c, 0x27, 0x6a, 0xd3, 0xf6, 0x9b, 0x62, 0xdf, 0x73, 0x7c, 0xcf, 0xf1, 0xb9, 0x0e, 0xf6,
+	0x14, 0xc8, 0x02, 0x64, 0xc4, 0xe2, 0x58, 0xe4, 0x5c, 0xd7, 0xdf, 0x30, 0x93, 0x42, 0x0b, 0x72,
+	0xdf, 0x6e, 0xdd, 0x7e, 0x22, 0x12, 0x61, 0x6a, 0x51, 0xb9, 0xaa, 0x60, 0xd7, 0x4b, 0x84, 0x48,
+	0x36, 0x10, 0xb1, 0x2c, 0x8d, 0x18, 0xe7, 0x42, 0x33, 0x9d, 0x0a, 0xae, 0x2a, 0x94, 0xee, 0xf1,
+	0xe0, 0x53, 0xb6, 0x62, 0x1a, 0x3e, 0x30, 0xa5, 0xf6, 0x42, 0xae, 0xe6, 0xb0, 0xcb, 0x41, 0x69,
+	0x32, 0xc2, 0x5d, 0x0e, 0xfb, 0xba, 0xea, 0xa0, 0x11, 0x9a, 0x3c, 0x98, 0x1f, 0x97, 0xc8, 0x04,
+	0x3f, 0x8c, 0x73, 0x29, 0x81, 0xeb, 0x86, 0x75, 0x63, 0x58, 0xed, 0x32, 0x21, 0xf8, 0x0e, 0x67,
+	0x5b, 0x70, 0x3a, 0x06, 0x36, 0x6b, 0xea, 0xe0, 0xc7, 0x6d, 0x61, 0x95, 0x09, 0xae, 0x80, 0x06,
+	0x98, 0xcc, 0xa1, 0x10, 0x6b, 0xf8, 0x28, 0xd6, 0xc0, 0x6b, 0x3f, 0x7d, 0x7c, 0x57, 0x97, 0x7b,
+	0xeb, 0xa4, 0xda, 0xd0, 0x01, 0x7e, 0x74, 0xc2, 0xb5, 0x2d, 0x86, 0xf8, 0xc9, 0x7b, 0x5e, 0xb0,
+	0x4d, 0x5a, 0x0a, 0x2c, 0x40, 0xa9, 0xf2, 0xc6, 0xb6, 0x13, 0xf5, 0xb0, 0x7b, 0x09, 0xac, 0x8e,
+	0xbe, 0xfa, 0xde, 0xc1, 0xbd, 0xb7, 0x55, 0xa0, 0x0b, 0x90, 0x45, 0x1a, 0x03, 0x29, 0x70, 0xef,
+	0xd4, 0x2a, 0xf1, 0xc3, 0x7a, 0x04, 0x17, 0xc3, 0x73, 0x9f, 0x5d, 0xc5, 0xad, 0xc1, 0xf1, 0xb7,
+	0x3f, 0xff, 0x7e, 0xde, 0x3c, 0x75, 0x1d, 0x33, 0x96, 0xe2, 0x65, 0x33, 0xda, 0xcc, 0x32, 0x67,
+	0x28, 0x20, 0x3b, 0xdc, 0x3d, 0xba, 0x1c, 0x19, 0x36, 0x4d, 0xcf, 0xe3, 0x71, 0xbd, 0xcb, 0xa0,
+	0x95, 0x7b, 0x61, 0xe4, 0x9e, 0x53, 0xaf, 0x2d, 0x27, 0x0d, 0x79, 0x6a, 0xc2, 0x2c, 0x25, 0x7f,
+	0x20, 0x4c, 0xce, 0xc3, 0x21, 0xb4, 0xe9, 0x7e, 0x35, 0x56, 0x77, 0x7c, 0x2b, 0xc7, 0x1a, 0x09,
+	0x8d, 0x91, 0x09, 0x1d, 0xb7, 0x8d, 0xa4, 0xcd, 0x99, 0xa9, 0xb2, 0x87, 0x66, 0x28, 0x78, 0xf7,
+	0xe6, 0xd7, 0xc1, 0x47, 0xbf, 0x0f, 0x3e, 0xfa, 0x7b, 0xf0, 0xd1, 0xe7, 0x20, 0x49, 0xf5, 0x97,
+	0x7c, 0x19, 0xc6, 0x62, 0x1b, 0x31, 0x69, 0xde, 0xfa, 0x57, 0xb3, 0x98, 0xc6, 0xab, 0xe8, 0xf4,
+	0x1f, 0x59, 0xde, 0x33, 0xef, 0xfb, 0xf5, 0xff, 0x01, 0x00, 0x00, 0x18, 0xb4, 0x48, 0x3c, 0x03,
+	0x00, 0x00,
 }
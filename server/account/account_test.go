@@ -0,0 +1,100 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	testcore "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/util/password"
+	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/session"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+const testNamespace = "default"
+
+// newTestServer returns an account server whose admin password is "password" and which only allows
+// the sso-admin user to update other accounts
+func newTestServer(t *testing.T) (*Server, *settings.SettingsManager) {
+	hash, err := password.HashPassword("password")
+	assert.Nil(t, err)
+	kubeclientset := fake.NewSimpleClientset(
+		&apiv1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: testNamespace}},
+		&apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDSecretName, Namespace: testNamespace},
+			Data: map[string][]byte{
+				"admin.password":   []byte(hash),
+				"server.secretkey": []byte("test"),
+			},
+		},
+	)
+	// the API server merges the string data of secrets into their data, which the fake clientset does not
+	trackerChain := kubeclientset.ReactionChain
+	kubeclientset.PrependReactor("update", "secrets", func(action testcore.Action) (bool, runtime.Object, error) {
+		secret := action.(testcore.UpdateAction).GetObject().(*apiv1.Secret)
+		if secret.Data == nil {
+			secret.Data = make(map[string][]byte)
+		}
+		for k, v := range secret.StringData {
+			secret.Data[k] = []byte(v)
+		}
+		secret.StringData = nil
+		update := testcore.NewUpdateAction(action.GetResource(), action.GetNamespace(), secret)
+		for _, reactor := range trackerChain {
+			if reactor.Handles(update) {
+				return reactor.React(update)
+			}
+		}
+		return false, nil, nil
+	})
+	settingsMgr := settings.NewSettingsManager(kubeclientset, testNamespace)
+	cdSettings, err := settingsMgr.GetSettings()
+	assert.Nil(t, err)
+	sessionMgr := session.NewSessionManager(cdSettings, session.NewTokenDenylist(kubeclientset, testNamespace))
+	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	enforcer.SetClaimsEnforcerFunc(func(rvals ...interface{}) bool {
+		claims, ok := rvals[0].(jwt.MapClaims)
+		return ok && claims["sub"] == "sso-admin"
+	})
+	return NewServer(sessionMgr, settingsMgr, enforcer), settingsMgr
+}
+
+func userContext(sub string) context.Context {
+	return context.WithValue(context.Background(), "claims", jwt.MapClaims{"sub": sub})
+}
+
+func TestUpdatePassword(t *testing.T) {
+	t.Run("AdminUpdatesOtherAccount", func(t *testing.T) {
+		s, settingsMgr := newTestServer(t)
+		_, err := s.UpdatePassword(userContext("sso-admin"), &UpdatePasswordRequest{Name: common.ArgoCDAdminUsername, NewPassword: "new-password"})
+		assert.Nil(t, err)
+		cdSettings, err := settingsMgr.GetSettings()
+		assert.Nil(t, err)
+		valid, _ := password.VerifyPassword("new-password", cdSettings.AdminPasswordHash)
+		assert.True(t, valid)
+	})
+
+	t.Run("UpdateOtherAccountDenied", func(t *testing.T) {
+		s, _ := newTestServer(t)
+		_, err := s.UpdatePassword(userContext("sso-user"), &UpdatePasswordRequest{Name: common.ArgoCDAdminUsername, NewPassword: "new-password"})
+		assert.Equal(t, codes.PermissionDenied, grpc.Code(err))
+	})
+
+	t.Run("UpdateOwnPasswordRequiresCurrentPassword", func(t *testing.T) {
+		s, _ := newTestServer(t)
+		_, err := s.UpdatePassword(userContext(common.ArgoCDAdminUsername), &UpdatePasswordRequest{NewPassword: "new-password"})
+		assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+		_, err = s.UpdatePassword(userContext(common.ArgoCDAdminUsername), &UpdatePasswordRequest{CurrentPassword: "password", NewPassword: "new-password"})
+		assert.Nil(t, err)
+	})
+}
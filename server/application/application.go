@@ -1,13 +1,23 @@
 package application
 
 import (
+	"archive/tar"
 	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"io"
+	"path"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/ghodss/yaml"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
@@ -17,9 +27,17 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/client-go/util/retry"
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/controller"
@@ -31,10 +49,33 @@ import (
 	"github.com/argoproj/argo-cd/util/argo"
 	argoutil "github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/git"
 	"github.com/argoproj/argo-cd/util/grpc"
 	"github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/lua"
 	"github.com/argoproj/argo-cd/util/rbac"
 	"github.com/argoproj/argo-cd/util/session"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+// DefaultBulkParallelism is the number of applications processed concurrently by bulk operations
+// which do not set a parallelism
+const DefaultBulkParallelism = 10
+
+const (
+	// parameterChangesQPS and parameterChangesBurst limit the rate at which each user can change the
+	// parameter overrides of applications, so that the audit records of the changes cannot be flooded
+	parameterChangesQPS   = 0.5
+	parameterChangesBurst = 20
+	// paramLimiterIdleTimeout is the time after which the rate limiter of a user is full again, so that
+	// it can be dropped and recreated on the next change of the user
+	paramLimiterIdleTimeout = time.Duration(parameterChangesBurst/parameterChangesQPS) * time.Second
+	// maxParameterOverrideRecords is the number of most recent parameter override records kept for each
+	// application
+	maxParameterOverrideRecords = 100
+	// parameterOverrideRecordsKey is the key of the config maps of the parameter override records which
+	// holds the records
+	parameterOverrideRecordsKey = "records"
 )
 
 // Server provides a Application service
@@ -49,6 +90,13 @@ type Server struct {
 	enf           *rbac.Enforcer
 	projectLock   *util.KeyLock
 	auditLogger   *argo.AuditLogger
+	// paramLimiters holds the rate limiters of the parameter override changes, by user. The limiters
+	// which are idle are dropped at most once every paramLimiterIdleTimeout
+	paramLimiters      map[string]*paramLimiter
+	paramLimitersLock  sync.Mutex
+	paramLimitersSwept time.Time
+	// kubeClientMetrics records the requests made to the clusters, and limits their rate per cluster
+	kubeClientMetrics *kube.ClientMetrics
 }
 
 // NewServer returns a new instance of the Application service
@@ -61,19 +109,22 @@ func NewServer(
 	db db.ArgoDB,
 	enf *rbac.Enforcer,
 	projectLock *util.KeyLock,
+	kubeClientMetrics *kube.ClientMetrics,
 ) ApplicationServiceServer {
 
 	return &Server{
-		ns:            namespace,
-		appclientset:  appclientset,
-		kubeclientset: kubeclientset,
-		db:            db,
-		repoClientset: repoClientset,
-		kubectl:       kubectl,
-		appComparator: controller.NewAppStateManager(db, appclientset, repoClientset, namespace, kubectl),
-		enf:           enf,
-		projectLock:   projectLock,
-		auditLogger:   argo.NewAuditLogger(namespace, kubeclientset, "argocd-server"),
+		ns:                namespace,
+		appclientset:      appclientset,
+		kubeclientset:     kubeclientset,
+		db:                db,
+		repoClientset:     repoClientset,
+		kubectl:           kubectl,
+		kubeClientMetrics: kubeClientMetrics,
+		appComparator:     controller.NewAppStateManager(db, appclientset, repoClientset, namespace, kubectl, kubeClientMetrics),
+		enf:               enf,
+		projectLock:       projectLock,
+		auditLogger:       argo.NewAuditLogger(namespace, kubeclientset, "argocd-server"),
+		paramLimiters:     make(map[string]*paramLimiter),
 	}
 }
 
@@ -130,39 +181,360 @@ func hideNodesSecrets(nodes []appv1.ResourceNode) {
 	}
 }
 
+// hideResourceStateSecrets hides the data of secrets in the target, live and diff states of a resource
+func hideResourceStateSecrets(res *appv1.ResourceState) {
+	var data map[string]interface{}
+	res.LiveState, data = hideSecretData(res.LiveState, nil)
+	res.TargetState, _ = hideSecretData(res.TargetState, data)
+	res.Diff.NormalizedLiveState, data = hideSecretData(res.Diff.NormalizedLiveState, nil)
+	res.Diff.NormalizedTargetState, _ = hideSecretData(res.Diff.NormalizedTargetState, data)
+	hideNodesSecrets(res.ChildLiveResources)
+}
+
 func hideAppSecrets(app *appv1.Application) {
 	for i := range app.Status.ComparisonResult.Resources {
-		res := app.Status.ComparisonResult.Resources[i]
-		var data map[string]interface{}
-		res.LiveState, data = hideSecretData(res.LiveState, nil)
-		res.TargetState, _ = hideSecretData(res.TargetState, data)
-		hideNodesSecrets(res.ChildLiveResources)
-		app.Status.ComparisonResult.Resources[i] = res
+		hideResourceStateSecrets(&app.Status.ComparisonResult.Resources[i])
+	}
+}
+
+// excludeResourceStates removes the resource states from the comparison result of the application if the
+// query excludes them. Their statuses are still held by the status of the application
+func excludeResourceStates(app *appv1.Application, q *ApplicationQuery) {
+	if q.ExcludeResourceStates {
+		app.Status.ComparisonResult.Resources = nil
 	}
 }
 
 // List returns list of applications
 func (s *Server) List(ctx context.Context, q *ApplicationQuery) (*appv1.ApplicationList, error) {
-	appList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(metav1.ListOptions{})
+	appList, err := s.listApps(ctx, q)
 	if err != nil {
 		return nil, err
 	}
+	sort.Slice(appList.Items, func(i, j int) bool {
+		return appList.Items[i].Name < appList.Items[j].Name
+	})
 	newItems := make([]appv1.Application, 0)
-	for _, a := range appList.Items {
-		if s.enf.EnforceClaims(ctx.Value("claims"), "applications", "get", appRBACName(a)) {
-			newItems = append(newItems, a)
+	for i := range appList.Items {
+		app := appList.Items[i]
+		// the continue token is the name of the first application of the next page
+		if q.Continue != "" && app.Name < q.Continue {
+			continue
 		}
-	}
-	newItems = argoutil.FilterByProjects(newItems, q.Projects)
-	for i := range newItems {
-		app := newItems[i]
+		if q.Limit > 0 && int64(len(newItems)) == q.Limit {
+			appList.Continue = app.Name
+			break
+		}
+		excludeResourceStates(&app, q)
 		hideAppSecrets(&app)
-		newItems[i] = app
+		newItems = append(newItems, app)
 	}
 	appList.Items = newItems
 	return appList, nil
 }
 
+// listApps returns the applications the user is allowed to get, which match the filters of the query
+func (s *Server) listApps(ctx context.Context, q *ApplicationQuery) (*appv1.ApplicationList, error) {
+	if _, err := labels.Parse(q.Selector); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid selector '%s': %v", q.Selector, err)
+	}
+	if _, err := path.Match(q.Search, ""); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid search pattern '%s': %v", q.Search, err)
+	}
+	appList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(metav1.ListOptions{LabelSelector: q.Selector})
+	if err != nil {
+		return nil, err
+	}
+	newItems := make([]appv1.Application, 0)
+	for _, a := range appList.Items {
+		if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "get", appRBACName(a)) {
+			continue
+		}
+		if q.Search != "" {
+			if matched, _ := path.Match(q.Search, a.Name); !matched {
+				continue
+			}
+		}
+		if len(q.SyncStatus) > 0 && !containsString(q.SyncStatus, string(appSyncStatus(a))) {
+			continue
+		}
+		if len(q.HealthStatus) > 0 && !containsString(q.HealthStatus, appHealthStatus(a)) {
+			continue
+		}
+		newItems = append(newItems, a)
+	}
+	appList.Items = argoutil.FilterByProjects(newItems, q.Projects)
+	return appList, nil
+}
+
+// appSyncStatus returns the sync status of the application, which is unknown until it is compared
+func appSyncStatus(a appv1.Application) appv1.ComparisonStatus {
+	if a.Status.ComparisonResult.Status == "" {
+		return appv1.ComparisonStatusUnknown
+	}
+	return a.Status.ComparisonResult.Status
+}
+
+// appHealthStatus returns the health status of the application, which is unknown until it is assessed
+func appHealthStatus(a appv1.Application) string {
+	if a.Status.Health.Status == "" {
+		return appv1.HealthStatusUnknown
+	}
+	return a.Status.Health.Status
+}
+
+func containsString(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
+
+// Summary returns the number of applications by sync status, health status and project
+func (s *Server) Summary(ctx context.Context, q *ApplicationQuery) (*ApplicationSummary, error) {
+	appList, err := s.listApps(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	summary := ApplicationSummary{
+		SyncStatus:   make(map[string]int64),
+		HealthStatus: make(map[string]int64),
+		Projects:     make(map[string]int64),
+	}
+	for _, a := range appList.Items {
+		syncStatus := string(appSyncStatus(a))
+		healthStatus := appHealthStatus(a)
+		project := a.Spec.Project
+		if a.Spec.BelongsToDefaultProject() {
+			project = common.DefaultAppProjectName
+		}
+		summary.Total++
+		summary.SyncStatus[syncStatus]++
+		summary.HealthStatus[healthStatus]++
+		summary.Projects[project]++
+	}
+	return &summary, nil
+}
+
+// DefaultDeploymentMetricsDays is the number of days the deployment metrics are computed over when the
+// query does not set it
+const DefaultDeploymentMetricsDays = 30
+
+// DeploymentMetrics returns the deployment frequency and lead time of the applications the user is allowed
+// to get, and of their projects. The metrics are computed from the deployment history of the applications,
+// which only holds their last deployments
+func (s *Server) DeploymentMetrics(ctx context.Context, q *DeploymentMetricsQuery) (*DeploymentMetricsResponse, error) {
+	days := q.Days
+	if days == 0 {
+		days = DefaultDeploymentMetricsDays
+	}
+	if days < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "days must be positive")
+	}
+	appList, err := s.listApps(ctx, &ApplicationQuery{Projects: q.Projects, Selector: q.Selector})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(appList.Items, func(i, j int) bool {
+		return appList.Items[i].Name < appList.Items[j].Name
+	})
+	now := time.Now()
+	since := now.Add(-time.Duration(days) * 24 * time.Hour)
+	revisions := make(map[deployedRevision]bool)
+	for _, a := range appList.Items {
+		_, history := appDeploymentHistory(a, since)
+		for _, info := range history {
+			if git.IsCommitSHA(info.Revision) {
+				revisions[deployedRevision{repoURL: deploymentRepoURL(a, info), revision: info.Revision}] = true
+			}
+		}
+	}
+	commitTimes, err := s.getCommitTimes(ctx, revisions)
+	if err != nil {
+		return nil, err
+	}
+
+	res := DeploymentMetricsResponse{}
+	projects := make(map[string]*deploymentStats)
+	var projectNames []string
+	for _, a := range appList.Items {
+		stats := appDeploymentStats(a, since, commitTimes)
+		res.Applications = append(res.Applications, stats.metrics(a.Name, now))
+		project := a.Spec.Project
+		if a.Spec.BelongsToDefaultProject() {
+			project = common.DefaultAppProjectName
+		}
+		projectStats, ok := projects[project]
+		if !ok {
+			projectStats = &deploymentStats{periodStart: stats.periodStart}
+			projects[project] = projectStats
+			projectNames = append(projectNames, project)
+		}
+		projectStats.add(stats)
+	}
+	sort.Strings(projectNames)
+	for _, project := range projectNames {
+		res.Projects = append(res.Projects, projects[project].metrics(project, now))
+	}
+	return &res, nil
+}
+
+// deployedRevision is a revision of a repository which was deployed
+type deployedRevision struct {
+	repoURL  string
+	revision string
+}
+
+// getCommitTimes returns the commit times of the given revisions, which are retrieved concurrently.
+// The commit time of a revision is nil if it could not be retrieved
+func (s *Server) getCommitTimes(ctx context.Context, revisions map[deployedRevision]bool) (map[deployedRevision]*time.Time, error) {
+	commitTimes := make(map[deployedRevision]*time.Time)
+	if len(revisions) == 0 {
+		return commitTimes, nil
+	}
+	conn, client, err := s.repoClientset.NewRepositoryClient()
+	if err != nil {
+		return nil, err
+	}
+	defer util.Close(conn)
+	repos := make(map[string]*appv1.Repository)
+	for r := range revisions {
+		if _, ok := repos[r.repoURL]; !ok {
+			repos[r.repoURL] = s.getRepo(ctx, r.repoURL)
+		}
+	}
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, DefaultBulkParallelism)
+	for r := range revisions {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(r deployedRevision) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			var t *time.Time
+			meta, err := client.GetRevisionMetadata(ctx, &repository.RevisionMetadataRequest{Repo: repos[r.repoURL], Revision: r.revision})
+			if err != nil {
+				log.Warnf("Failed to get the commit time of revision %s of %s: %v", r.revision, r.repoURL, err)
+			} else if meta.CommitTime != nil {
+				t = &meta.CommitTime.Time
+			}
+			lock.Lock()
+			commitTimes[r] = t
+			lock.Unlock()
+		}(r)
+	}
+	wg.Wait()
+	return commitTimes, nil
+}
+
+// deployment is a deployment of an application, and its lead time if the commit time of the deployed
+// revision is known
+type deployment struct {
+	deployedAt time.Time
+	leadTime   *time.Duration
+}
+
+// deploymentStats holds the deployments of applications since the start of a period
+type deploymentStats struct {
+	periodStart time.Time
+	deployments []deployment
+}
+
+// appDeploymentHistory returns the start of the period covered by the deployment history of the application
+// since the given time, and the deployments of that period. When the history of the application is full, the
+// period starts with its oldest deployment, since older deployments are lost
+func appDeploymentHistory(a appv1.Application, since time.Time) (time.Time, []appv1.DeploymentInfo) {
+	periodStart := since
+	history := a.Status.History
+	if len(history) >= controller.MaxHistoryCount && history[0].DeployedAt.Time.After(since) {
+		periodStart = history[0].DeployedAt.Time
+	}
+	var deployments []appv1.DeploymentInfo
+	for _, info := range history {
+		if !info.DeployedAt.Time.Before(periodStart) {
+			deployments = append(deployments, info)
+		}
+	}
+	return periodStart, deployments
+}
+
+// deploymentRepoURL returns the URL of the repository of a deployment of the application
+func deploymentRepoURL(a appv1.Application, info appv1.DeploymentInfo) string {
+	if info.Source.RepoURL != "" {
+		return info.Source.RepoURL
+	}
+	return a.Spec.Source.RepoURL
+}
+
+// appDeploymentStats returns the deployments of the application since the given time, with their lead time
+// if the commit time of their revision is known
+func appDeploymentStats(a appv1.Application, since time.Time, commitTimes map[deployedRevision]*time.Time) *deploymentStats {
+	periodStart, history := appDeploymentHistory(a, since)
+	stats := deploymentStats{periodStart: periodStart}
+	for _, info := range history {
+		d := deployment{deployedAt: info.DeployedAt.Time}
+		if committedAt := commitTimes[deployedRevision{repoURL: deploymentRepoURL(a, info), revision: info.Revision}]; committedAt != nil {
+			leadTime := info.DeployedAt.Time.Sub(*committedAt)
+			if leadTime < 0 {
+				leadTime = 0
+			}
+			d.leadTime = &leadTime
+		}
+		stats.deployments = append(stats.deployments, d)
+	}
+	return &stats
+}
+
+// add adds the deployments of an application to the stats of its project. The period of the project
+// starts with the earliest period of its applications, so that the deployments of all its applications
+// are counted
+func (s *deploymentStats) add(other *deploymentStats) {
+	if other.periodStart.Before(s.periodStart) {
+		s.periodStart = other.periodStart
+	}
+	s.deployments = append(s.deployments, other.deployments...)
+}
+
+// metrics returns the metrics of the deployments since the start of the period
+func (s *deploymentStats) metrics(name string, now time.Time) *DeploymentMetrics {
+	metrics := DeploymentMetrics{
+		Name:        name,
+		PeriodStart: &metav1.Time{Time: s.periodStart},
+	}
+	var leadTimes []time.Duration
+	for _, d := range s.deployments {
+		if d.deployedAt.Before(s.periodStart) {
+			continue
+		}
+		metrics.Deployments++
+		if d.leadTime != nil {
+			leadTimes = append(leadTimes, *d.leadTime)
+		}
+	}
+	// periods shorter than a day would overstate the deployment frequency
+	days := now.Sub(s.periodStart).Hours() / 24
+	if days < 1 {
+		days = 1
+	}
+	metrics.DeploymentsPerDay = float64(metrics.Deployments) / days
+	if len(leadTimes) > 0 {
+		sort.Slice(leadTimes, func(i, j int) bool {
+			return leadTimes[i] < leadTimes[j]
+		})
+		median := leadTimes[len(leadTimes)/2]
+		if len(leadTimes)%2 == 0 {
+			median = (leadTimes[len(leadTimes)/2-1] + median) / 2
+		}
+		metrics.LeadTimeSeconds = int64(median.Seconds())
+	}
+	return &metrics
+}
+
 // Create creates an application
 func (s *Server) Create(ctx context.Context, q *ApplicationCreateRequest) (*appv1.Application, error) {
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "create", appRBACName(q.Application)) {
@@ -173,40 +545,100 @@ func (s *Server) Create(ctx context.Context, q *ApplicationCreateRequest) (*appv
 	defer s.projectLock.Unlock(q.Application.Spec.Project)
 
 	a := q.Application
-	err := s.validateApp(ctx, &a.Spec)
+	proj, err := argo.GetAppProject(&a.Spec, s.appclientset, s.ns)
 	if err != nil {
+		if apierr.IsNotFound(err) {
+			return nil, status.Errorf(codes.InvalidArgument, "application referencing project %s which does not exist", a.Spec.Project)
+		}
 		return nil, err
 	}
-	out, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Create(&a)
-	if apierr.IsAlreadyExists(err) {
-		// act idempotent if existing spec matches new spec
-		existing, getErr := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(a.Name, metav1.GetOptions{})
-		if getErr != nil {
-			return nil, status.Errorf(codes.Internal, "unable to check existing application details: %v", getErr)
+	proj.ApplyApplicationTemplate(&a)
+	err = s.validateApp(ctx, &a.Spec)
+	if err != nil {
+		return nil, err
+	}
+	err = s.validateTrackingLabel(a.Name)
+	if err != nil {
+		return nil, err
+	}
+	// the rate of the parameter override changes is only checked once the request is known to change
+	// the application, since creating an existing application with the same spec changes nothing
+	existing, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(a.Name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		paramChanges := diffParameterOverrides(nil, &a.Spec.Source)
+		if err = s.checkParameterChangesRate(ctx, paramChanges); err != nil {
+			return nil, err
 		}
-		if q.Upsert != nil && *q.Upsert {
-			if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "update", appRBACName(a)) {
-				return nil, grpc.ErrPermissionDenied
-			}
-			existing.Spec = a.Spec
-			out, err = s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Update(existing)
-		} else {
-			if reflect.DeepEqual(existing.Spec, a.Spec) {
-				return existing, nil
-			} else {
-				return nil, status.Errorf(codes.InvalidArgument, "existing application spec is different, use upsert flag to force update")
-			}
+		out, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Create(&a)
+		if err == nil {
+			s.logEvent(out, ctx, argo.EventReasonResourceCreated, "created application")
+			s.logParameterChanges(out, ctx, paramChanges)
+			hideAppSecrets(out)
+			return out, nil
+		}
+		if !apierr.IsAlreadyExists(err) {
+			return nil, err
 		}
+		// the application was created concurrently
+		existing, err = s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(a.Name, metav1.GetOptions{})
 	}
-
-	if err == nil {
-		s.logEvent(out, ctx, argo.EventReasonResourceCreated, "created application")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to check existing application details: %v", err)
+	}
+	// act idempotent if existing spec matches new spec
+	if q.Upsert == nil || !*q.Upsert {
+		if reflect.DeepEqual(existing.Spec, a.Spec) {
+			return existing, nil
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "existing application spec is different, use upsert flag to force update")
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "update", appRBACName(a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	paramChanges := diffParameterOverrides(&existing.Spec.Source, &a.Spec.Source)
+	if err = s.checkParameterChangesRate(ctx, paramChanges); err != nil {
+		return nil, err
 	}
+	existing.Spec = a.Spec
+	out, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Update(existing)
+	if err != nil {
+		return nil, err
+	}
+	s.logEvent(out, ctx, argo.EventReasonResourceCreated, "created application")
+	s.logParameterChanges(out, ctx, paramChanges)
 	hideAppSecrets(out)
-	return out, err
+	return out, nil
 }
 
-// GetManifests returns application manifests
+// RevisionMetadata returns the metadata of a commit of the repository of an application. The commit is
+// looked up in the repository it was deployed from if it is in the history of the application
+func (s *Server) RevisionMetadata(ctx context.Context, q *RevisionMetadataQuery) (*repository.RevisionMetadata, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "get", appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	repoURL := a.Spec.Source.RepoURL
+	for _, info := range a.Status.History {
+		if info.Revision == *q.Revision && info.Source.RepoURL != "" {
+			repoURL = info.Source.RepoURL
+		}
+	}
+	conn, repoClient, err := s.repoClientset.NewRepositoryClient()
+	if err != nil {
+		return nil, err
+	}
+	defer util.Close(conn)
+	return repoClient.GetRevisionMetadata(ctx, &repository.RevisionMetadataRequest{
+		Repo:     s.getRepo(ctx, repoURL),
+		Revision: *q.Revision,
+	})
+}
+
+// GetManifests returns application manifests, generated at the revision of the query if it is set, so
+// that the manifests of another branch or tag can be previewed without changing the application
 func (s *Server) GetManifests(ctx context.Context, q *ApplicationManifestQuery) (*repository.ManifestResponse, error) {
 	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
 	if err != nil {
@@ -216,6 +648,10 @@ func (s *Server) GetManifests(ctx context.Context, q *ApplicationManifestQuery)
 		return nil, grpc.ErrPermissionDenied
 	}
 	repo := s.getRepo(ctx, a.Spec.Source.RepoURL)
+	helmRepos, err := s.db.ListHelmRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	conn, repoClient, err := s.repoClientset.NewRepositoryClient()
 	if err != nil {
@@ -234,7 +670,7 @@ func (s *Server) GetManifests(ctx context.Context, q *ApplicationManifestQuery)
 	if q.Revision != "" {
 		revision = q.Revision
 	}
-	manifestInfo, err := repoClient.GenerateManifest(context.Background(), &repository.ManifestRequest{
+	manifestInfo, err := repoClient.GenerateManifest(ctx, &repository.ManifestRequest{
 		Repo:                        repo,
 		Environment:                 a.Spec.Source.Environment,
 		Path:                        a.Spec.Source.Path,
@@ -244,14 +680,247 @@ func (s *Server) GetManifests(ctx context.Context, q *ApplicationManifestQuery)
 		ValueFiles:                  a.Spec.Source.ValuesFiles,
 		Namespace:                   a.Spec.Destination.Namespace,
 		NamePrefix:                  a.Spec.Source.NamePrefix,
+		Directory:                   a.Spec.Source.Directory,
+		Override:                    a.Spec.Source.Override,
+		ResourceMetadata:            a.Spec.ResourceMetadata,
+		HelmRepos:                   helmRepos,
 	})
 	if err != nil {
+		// the failures of the generation are reported by the repo server with the ManifestError reason
 		return nil, err
 	}
 
 	return manifestInfo, nil
 }
 
+// DefaultResourceStatesLimit is the number of resource states returned by ListResourceStates when the query
+// does not limit it
+const DefaultResourceStatesLimit = 100
+
+// ListResourceStates returns a page of the target, live and diff states of the resources of an application,
+// so that the states of applications with many resources can be loaded lazily. The continue token is the
+// index of the first resource of the next page in the comparison result of the application
+func (s *Server) ListResourceStates(ctx context.Context, q *ApplicationResourceStatesQuery) (*ApplicationResourceStatesResponse, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "get", appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	offset := 0
+	if q.Continue != "" {
+		offset, err = strconv.Atoi(q.Continue)
+		if err != nil || offset < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid continue token '%s'", q.Continue)
+		}
+	}
+	limit := q.Limit
+	if limit <= 0 {
+		limit = DefaultResourceStatesLimit
+	}
+	res := ApplicationResourceStatesResponse{Items: make([]ApplicationResourceState, 0)}
+	resources := a.Status.ComparisonResult.Resources
+	for i := offset; i < len(resources); i++ {
+		resStatus, err := resourceStatusOf(resources[i], a.Spec.Destination.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		if (q.Group != "" && resStatus.Group != q.Group) || (q.Kind != "" && resStatus.Kind != q.Kind) ||
+			(q.Namespace != "" && resStatus.Namespace != q.Namespace) || (q.ResourceName != "" && resStatus.Name != q.ResourceName) {
+			continue
+		}
+		if int64(len(res.Items)) == limit {
+			res.Continue = strconv.Itoa(i)
+			break
+		}
+		state := resources[i]
+		hideResourceStateSecrets(&state)
+		res.Items = append(res.Items, ApplicationResourceState{Resource: *resStatus, State: state})
+	}
+	return &res, nil
+}
+
+// resourceStatusOf returns the identity and the sync and health status of a resource of the comparison
+// result of an application. The resource is identified by its target state, or by its live state if it
+// is extraneous
+func resourceStatusOf(resState appv1.ResourceState, defaultNamespace string) (*appv1.ResourceStatus, error) {
+	obj, err := resState.TargetObject()
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		obj, err = resState.LiveObject()
+		if err != nil {
+			return nil, err
+		}
+	}
+	resStatus := appv1.ResourceStatus{Status: resState.Status, Health: resState.Health}
+	if obj != nil {
+		gvk := obj.GroupVersionKind()
+		resStatus.Group = gvk.Group
+		resStatus.Version = gvk.Version
+		resStatus.Kind = gvk.Kind
+		resStatus.Namespace = obj.GetNamespace()
+		if resStatus.Namespace == "" {
+			resStatus.Namespace = defaultNamespace
+		}
+		resStatus.Name = obj.GetName()
+	}
+	return &resStatus, nil
+}
+
+// Diff compares the target state of an application, generated at the revision of the query if it is set,
+// to its live state, so that the changes of syncing another branch or tag can be previewed
+func (s *Server) Diff(ctx context.Context, q *ApplicationDiffQuery) (*ApplicationDiffResponse, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "get", appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	comparison, _, conditions, err := s.appComparator.CompareAppState(ctx, a, q.Revision, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	if comparison.Status == appv1.ComparisonStatusUnknown {
+		messages := make([]string, 0)
+		for _, condition := range conditions {
+			if condition.Type == appv1.ApplicationConditionComparisonError {
+				messages = append(messages, condition.Message)
+			}
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to compare application %s: %s", a.Name, strings.Join(messages, "; "))
+	}
+	res := ApplicationDiffResponse{
+		Revision: comparison.Revision,
+		Status:   string(comparison.Status),
+		Items:    make([]ResourceDiffResult, 0),
+	}
+	for _, resState := range comparison.Resources {
+		item, err := newResourceDiffResult(resState, a.Spec.Destination.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		res.Items = append(res.Items, *item)
+	}
+	return &res, nil
+}
+
+// newResourceDiffResult returns the difference between the target and live state of a resource, with the
+// data of secrets hidden
+func newResourceDiffResult(resState appv1.ResourceState, defaultNamespace string) (*ResourceDiffResult, error) {
+	resStatus, err := resourceStatusOf(resState, defaultNamespace)
+	if err != nil {
+		return nil, err
+	}
+	hideResourceStateSecrets(&resState)
+	return &ResourceDiffResult{
+		Group:     resStatus.Group,
+		Kind:      resStatus.Kind,
+		Namespace: resStatus.Namespace,
+		Name:      resStatus.Name,
+		Status:    string(resState.Status),
+		Diff:      resState.Diff,
+	}, nil
+}
+
+// GetManifestsArchive returns application manifests as a gzipped tarball. Manifests read from a known
+// file are grouped by that file, others are written to one file per resource.
+func (s *Server) GetManifestsArchive(ctx context.Context, q *ApplicationManifestQuery) (*ManifestsArchiveResponse, error) {
+	manifestInfo, err := s.GetManifests(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	data, err := manifestsArchive(*q.Name, manifestInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &ManifestsArchiveResponse{Data: data}, nil
+}
+
+// manifestsArchive writes the given manifests as YAML into a gzipped tarball rooted at a directory named after the app
+func manifestsArchive(appName string, manifestInfo *repository.ManifestResponse) ([]byte, error) {
+	var fileNames []string
+	files := make(map[string][]string)
+	for i, mfst := range manifestInfo.Manifests {
+		obj, err := appv1.UnmarshalToUnstructured(mfst)
+		if err != nil {
+			return nil, err
+		}
+		yamlBytes, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, err
+		}
+		var fileName string
+		if i < len(manifestInfo.SourceFiles) && manifestInfo.SourceFiles[i] != "" {
+			fileName = manifestInfo.SourceFiles[i]
+			if ext := path.Ext(fileName); ext != ".yaml" && ext != ".yml" {
+				fileName += ".yaml"
+			}
+		} else {
+			fileName = path.Join(obj.GetNamespace(), fmt.Sprintf("%s-%s.yaml", strings.ToLower(obj.GetKind()), obj.GetName()))
+		}
+		if _, ok := files[fileName]; !ok {
+			fileNames = append(fileNames, fileName)
+		}
+		files[fileName] = append(files[fileName], string(yamlBytes))
+	}
+
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+	now := time.Now()
+	for _, fileName := range fileNames {
+		content := []byte(strings.Join(files[fileName], "---\n"))
+		err := tw.WriteHeader(&tar.Header{
+			Name:    path.Join(appName, fileName),
+			Mode:    0644,
+			Size:    int64(len(content)),
+			ModTime: now,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err = tw.Write(content); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gzw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GetKsonnetAppDetails returns the environments of a ksonnet app, so that they can be chosen
+// from when creating an application
+func (s *Server) GetKsonnetAppDetails(ctx context.Context, q *KsonnetAppDetailsQuery) (*repository.KsonnetAppDetailsResponse, error) {
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "repositories", "get", *q.RepoURL) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	repo := s.getRepo(ctx, *q.RepoURL)
+
+	conn, repoClient, err := s.repoClientset.NewRepositoryClient()
+	if err != nil {
+		return nil, err
+	}
+	defer util.Close(conn)
+
+	revision := q.Revision
+	if revision == "" {
+		revision = "HEAD"
+	}
+	return repoClient.GetKsonnetAppDetails(ctx, &repository.KsonnetAppDetailsRequest{
+		Repo:     repo,
+		Revision: revision,
+		Path:     *q.Path,
+	})
+}
+
 // Get returns an application by name
 func (s *Server) Get(ctx context.Context, q *ApplicationQuery) (*appv1.Application, error) {
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
@@ -262,8 +931,12 @@ func (s *Server) Get(ctx context.Context, q *ApplicationQuery) (*appv1.Applicati
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "get", appRBACName(*a)) {
 		return nil, grpc.ErrPermissionDenied
 	}
-	if q.Refresh {
-		_, err = argoutil.RefreshApp(appIf, *q.Name)
+	if q.Refresh || q.HardRefresh {
+		refreshType := appv1.RefreshTypeNormal
+		if q.HardRefresh {
+			refreshType = appv1.RefreshTypeHard
+		}
+		_, err = argoutil.RefreshApp(appIf, *q.Name, refreshType)
 		if err != nil {
 			return nil, err
 		}
@@ -272,10 +945,34 @@ func (s *Server) Get(ctx context.Context, q *ApplicationQuery) (*appv1.Applicati
 			return nil, err
 		}
 	}
+	excludeResourceStates(a, q)
 	hideAppSecrets(a)
 	return a, nil
 }
 
+// GetApplicationSyncStatus returns the sync status, health and operation phase of an application.
+// It is intended for frequent polling, so returns none of the spec and resources of the application
+func (s *Server) GetApplicationSyncStatus(ctx context.Context, q *ApplicationSyncStatusQuery) (*ApplicationSyncStatus, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "get", appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	syncStatus := ApplicationSyncStatus{
+		Name:                a.Name,
+		SyncStatus:          string(a.Status.ComparisonResult.Status),
+		Revision:            a.Status.ComparisonResult.Revision,
+		HealthStatus:        a.Status.Health.Status,
+		OperationInProgress: a.Operation != nil,
+	}
+	if a.Status.OperationState != nil {
+		syncStatus.OperationPhase = string(a.Status.OperationState.Phase)
+	}
+	return &syncStatus, nil
+}
+
 // ListResourceEvents returns a list of event resources
 func (s *Server) ListResourceEvents(ctx context.Context, q *ApplicationResourceEventsQuery) (*v1.EventList, error) {
 	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
@@ -323,6 +1020,147 @@ func (s *Server) ListResourceEvents(ctx context.Context, q *ApplicationResourceE
 	return kubeClientset.CoreV1().Events(namespace).List(opts)
 }
 
+// ListEvents returns the events of an application and of all the resources of its resource tree,
+// sorted by the time they were last seen
+func (s *Server) ListEvents(ctx context.Context, q *ApplicationEventsQuery) (*v1.EventList, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "get", appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	appEvents, err := s.kubeclientset.CoreV1().Events(a.Namespace).List(metav1.ListOptions{
+		FieldSelector: fields.SelectorFromSet(map[string]string{
+			"involvedObject.name":      a.Name,
+			"involvedObject.uid":       string(a.UID),
+			"involvedObject.namespace": a.Namespace,
+		}).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	config, _, err := s.getApplicationClusterConfig(*q.Name)
+	if err != nil {
+		return nil, err
+	}
+	kubeClientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	resourceEvents, err := listResourceTreeEvents(kubeClientset, a)
+	if err != nil {
+		return nil, err
+	}
+	events := append(appEvents.Items, resourceEvents...)
+	sort.Slice(events, func(i, j int) bool {
+		return eventTime(events[i]).Before(eventTime(events[j]))
+	})
+	return &v1.EventList{Items: events}, nil
+}
+
+// resourceEventsParallelism is the number of resources whose events are listed concurrently
+const resourceEventsParallelism = 10
+
+// listResourceTreeEvents returns the events of the live resources of the resource tree of the application.
+// The events are listed per resource, selected by the UID of the resource, so that the other events of
+// the namespaces of the resources are not retrieved. At most resourceEventsParallelism lists run concurrently.
+func listResourceTreeEvents(kubeClientset kubernetes.Interface, a *appv1.Application) ([]v1.Event, error) {
+	objs := make([]*unstructured.Unstructured, 0)
+	listed := make(map[types.UID]bool)
+	for _, obj := range appLiveObjects(a) {
+		uid := obj.GetUID()
+		if uid == "" || listed[uid] {
+			continue
+		}
+		listed[uid] = true
+		objs = append(objs, obj)
+	}
+	eventsByObj := make([][]v1.Event, len(objs))
+	errs := make([]error, len(objs))
+	sem := make(chan struct{}, resourceEventsParallelism)
+	var wg sync.WaitGroup
+	for i := range objs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			uid := objs[i].GetUID()
+			// the events of cluster-scoped resources are created in the default namespace
+			namespace := objs[i].GetNamespace()
+			if namespace == "" {
+				namespace = metav1.NamespaceDefault
+			}
+			eventList, err := kubeClientset.CoreV1().Events(namespace).List(metav1.ListOptions{
+				FieldSelector: fields.OneTermEqualSelector("involvedObject.uid", string(uid)).String(),
+			})
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			for _, event := range eventList.Items {
+				if event.InvolvedObject.UID == uid {
+					eventsByObj[i] = append(eventsByObj[i], event)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	events := make([]v1.Event, 0)
+	for i := range objs {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		events = append(events, eventsByObj[i]...)
+	}
+	return events, nil
+}
+
+// appLiveObjects returns the live objects of the resource tree of the application
+func appLiveObjects(a *appv1.Application) []*unstructured.Unstructured {
+	objs := make([]*unstructured.Unstructured, 0)
+	var addNodes func(nodes []appv1.ResourceNode)
+	addNodes = func(nodes []appv1.ResourceNode) {
+		for _, node := range nodes {
+			var obj unstructured.Unstructured
+			err := json.Unmarshal([]byte(node.State), &obj)
+			if err != nil {
+				log.Warnf("Failed to unmarshal child live object: %v", err)
+				continue
+			}
+			objs = append(objs, &obj)
+			addNodes(node.Children)
+		}
+	}
+	for _, res := range a.Status.ComparisonResult.Resources {
+		liveObj, err := res.LiveObject()
+		if err != nil {
+			log.Warnf("Failed to unmarshal live object: %v", err)
+			continue
+		}
+		if liveObj == nil {
+			continue
+		}
+		objs = append(objs, liveObj)
+		addNodes(res.ChildLiveResources)
+	}
+	return objs
+}
+
+// eventTime returns the time an event was last seen
+func eventTime(event v1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	if !event.FirstTimestamp.IsZero() {
+		return event.FirstTimestamp.Time
+	}
+	return event.CreationTimestamp.Time
+}
+
 // Update updates an application
 func (s *Server) Update(ctx context.Context, q *ApplicationUpdateRequest) (*appv1.Application, error) {
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "update", appRBACName(*q.Application)) {
@@ -337,12 +1175,22 @@ func (s *Server) Update(ctx context.Context, q *ApplicationUpdateRequest) (*appv
 	if err != nil {
 		return nil, err
 	}
+	existing, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(a.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	paramChanges := diffParameterOverrides(&existing.Spec.Source, &a.Spec.Source)
+	err = s.checkParameterChangesRate(ctx, paramChanges)
+	if err != nil {
+		return nil, err
+	}
 	out, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Update(a)
 	if out != nil {
 		hideAppSecrets(out)
 	}
 	if err == nil {
 		s.logEvent(a, ctx, argo.EventReasonResourceUpdated, "updated application")
+		s.logParameterChanges(out, ctx, paramChanges)
 	}
 	return out, err
 }
@@ -375,6 +1223,237 @@ func (s *Server) removeInvalidOverrides(a *appv1.Application, q *ApplicationUpda
 	return q, nil
 }
 
+// diffParameterOverrides returns the changes from the old to the new parameter overrides and key/value
+// overrides of a source, sorted by component and name. The old source is nil for new applications
+func diffParameterOverrides(oldSource, newSource *appv1.ApplicationSource) []*ParameterOverrideChange {
+	type paramKey struct {
+		sourceOverride bool
+		component      string
+		name           string
+	}
+	oldValues := make(map[paramKey]string)
+	if oldSource != nil {
+		for _, p := range oldSource.ComponentParameterOverrides {
+			oldValues[paramKey{false, p.Component, p.Name}] = p.Value
+		}
+		for name, value := range oldSource.Override {
+			oldValues[paramKey{true, "", name}] = value
+		}
+	}
+	newValues := make(map[paramKey]string)
+	for _, p := range newSource.ComponentParameterOverrides {
+		newValues[paramKey{false, p.Component, p.Name}] = p.Value
+	}
+	for name, value := range newSource.Override {
+		newValues[paramKey{true, "", name}] = value
+	}
+	changes := make([]*ParameterOverrideChange, 0)
+	for key := range newValues {
+		value := newValues[key]
+		oldValue, ok := oldValues[key]
+		if ok && oldValue == value {
+			continue
+		}
+		change := &ParameterOverrideChange{Component: key.component, Name: key.name, Value: &value, SourceOverride: key.sourceOverride}
+		if ok {
+			change.PreviousValue = &oldValue
+		}
+		changes = append(changes, change)
+	}
+	for key := range oldValues {
+		if _, ok := newValues[key]; ok {
+			continue
+		}
+		oldValue := oldValues[key]
+		changes = append(changes, &ParameterOverrideChange{Component: key.component, Name: key.name, PreviousValue: &oldValue, SourceOverride: key.sourceOverride})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].SourceOverride != changes[j].SourceOverride {
+			return !changes[i].SourceOverride
+		}
+		if changes[i].Component != changes[j].Component {
+			return changes[i].Component < changes[j].Component
+		}
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
+
+// paramLimiter is the rate limiter of the parameter override changes of a user
+type paramLimiter struct {
+	flowcontrol.RateLimiter
+	lastUsed time.Time
+}
+
+// checkParameterChangesRate returns an error if the user of the context changes parameter overrides
+// faster than allowed
+func (s *Server) checkParameterChangesRate(ctx context.Context, changes []*ParameterOverrideChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	user := session.Username(ctx)
+	now := time.Now()
+	s.paramLimitersLock.Lock()
+	if now.Sub(s.paramLimitersSwept) > paramLimiterIdleTimeout {
+		for name, limiter := range s.paramLimiters {
+			if now.Sub(limiter.lastUsed) > paramLimiterIdleTimeout {
+				delete(s.paramLimiters, name)
+			}
+		}
+		s.paramLimitersSwept = now
+	}
+	limiter, ok := s.paramLimiters[user]
+	if !ok {
+		limiter = &paramLimiter{RateLimiter: flowcontrol.NewTokenBucketRateLimiter(parameterChangesQPS, parameterChangesBurst)}
+		s.paramLimiters[user] = limiter
+	}
+	limiter.lastUsed = now
+	s.paramLimitersLock.Unlock()
+	if !limiter.TryAccept() {
+		return status.Errorf(codes.ResourceExhausted, "too many parameter override changes, retry later")
+	}
+	return nil
+}
+
+// logParameterChanges records the parameter override changes made by the user of the context in the
+// parameter override records of the application, and as an audit event of the application
+func (s *Server) logParameterChanges(a *appv1.Application, ctx context.Context, changes []*ParameterOverrideChange) {
+	if len(changes) == 0 {
+		return
+	}
+	user := session.Username(ctx)
+	if user == "" {
+		user = "Unknown user"
+	}
+	err := s.appendParameterOverrideRecord(a, &ParameterOverrideRecord{User: user, Time: metav1.Now(), Changes: changes})
+	if err != nil {
+		log.Warnf("Failed to record parameter override changes of application '%s': %v", a.Name, err)
+	}
+	descriptions := make([]string, len(changes))
+	for i, c := range changes {
+		name := c.Name
+		if c.SourceOverride {
+			name = fmt.Sprintf("override %s", c.Name)
+		} else if c.Component != "" {
+			name = fmt.Sprintf("%s.%s", c.Component, c.Name)
+		}
+		if c.Value == nil {
+			descriptions[i] = fmt.Sprintf("unset %s", name)
+		} else {
+			descriptions[i] = fmt.Sprintf("set %s=%s", name, *c.Value)
+		}
+	}
+	data, err := json.Marshal(changes)
+	if err != nil {
+		log.Warnf("Failed to marshal parameter override changes: %v", err)
+		return
+	}
+	message := fmt.Sprintf("%s changed parameter overrides: %s", user, strings.Join(descriptions, ", "))
+	s.auditLogger.LogAppEventWithAnnotations(a, argo.EventInfo{Type: v1.EventTypeNormal, Reason: argo.EventReasonParametersChanged}, message, map[string]string{
+		common.AnnotationAuditUser:        user,
+		common.AnnotationParameterChanges: string(data),
+	})
+}
+
+// parameterOverrideRecordsName returns the name of the config map holding the parameter override records of
+// an application
+func parameterOverrideRecordsName(appName string) string {
+	name := common.ParameterOverrideRecordsPrefix + appName
+	if len(name) > validation.DNS1123SubdomainMaxLength {
+		name = fmt.Sprintf("%s%x", common.ParameterOverrideRecordsPrefix, sha256.Sum256([]byte(appName)))
+	}
+	return name
+}
+
+// getParameterOverrideRecords returns the parameter override records held by a config map, oldest first
+func getParameterOverrideRecords(cm *v1.ConfigMap) ([]*ParameterOverrideRecord, error) {
+	records := make([]*ParameterOverrideRecord, 0)
+	if data, ok := cm.Data[parameterOverrideRecordsKey]; ok {
+		if err := json.Unmarshal([]byte(data), &records); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the parameter override records of config map %s: %v", cm.Name, err)
+		}
+	}
+	return records, nil
+}
+
+// appendParameterOverrideRecord appends a record to the parameter override records of an application,
+// which are kept in a config map owned by the application, so that they outlive the audit events and are
+// deleted with the application. Only the last maxParameterOverrideRecords records are kept
+func (s *Server) appendParameterOverrideRecord(a *appv1.Application, record *ParameterOverrideRecord) error {
+	configMaps := s.kubeclientset.CoreV1().ConfigMaps(a.Namespace)
+	name := parameterOverrideRecordsName(a.Name)
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		cm, err := configMaps.Get(name, metav1.GetOptions{})
+		exists := err == nil
+		if apierr.IsNotFound(err) {
+			cm = &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{common.LabelKeyParameterOverrideRecords: a.Name},
+			}}
+			if a.UID != "" {
+				cm.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(a, appv1.ApplicationSchemaGroupVersionKind)}
+			}
+		} else if err != nil {
+			return err
+		}
+		records, err := getParameterOverrideRecords(cm)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+		if len(records) > maxParameterOverrideRecords {
+			records = records[len(records)-maxParameterOverrideRecords:]
+		}
+		data, err := json.Marshal(records)
+		if err != nil {
+			return err
+		}
+		if cm.Data == nil {
+			cm.Data = make(map[string]string)
+		}
+		cm.Data[parameterOverrideRecordsKey] = string(data)
+		if !exists {
+			_, err = configMaps.Create(cm)
+			if apierr.IsAlreadyExists(err) {
+				// the config map was created concurrently
+				return apierr.NewConflict(v1.Resource("configmaps"), name, err)
+			}
+			return err
+		}
+		_, err = configMaps.Update(cm)
+		return err
+	})
+}
+
+// ListParameterOverrideRecords returns the audit records of the parameter override changes of an application
+func (s *Server) ListParameterOverrideRecords(ctx context.Context, q *ApplicationParameterAuditQuery) (*ParameterOverrideRecordList, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "get", appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	records := make([]*ParameterOverrideRecord, 0)
+	cm, err := s.kubeclientset.CoreV1().ConfigMaps(a.Namespace).Get(parameterOverrideRecordsName(a.Name), metav1.GetOptions{})
+	if err != nil && !apierr.IsNotFound(err) {
+		return nil, err
+	}
+	// the records of a deleted application with the same name are ignored
+	if err == nil && (len(cm.OwnerReferences) == 0 || cm.OwnerReferences[0].UID == a.UID) {
+		allRecords, err := getParameterOverrideRecords(cm)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range allRecords {
+			if q.User == "" || record.User == q.User {
+				records = append(records, record)
+			}
+		}
+	}
+	return &ParameterOverrideRecordList{Items: records}, nil
+}
+
 // UpdateSpec updates an application spec and filters out any invalid parameter overrides
 func (s *Server) UpdateSpec(ctx context.Context, q *ApplicationUpdateSpecRequest) (*appv1.ApplicationSpec, error) {
 	s.projectLock.Lock(q.Spec.Project)
@@ -395,11 +1474,17 @@ func (s *Server) UpdateSpec(ctx context.Context, q *ApplicationUpdateSpecRequest
 	if err != nil {
 		return nil, err
 	}
+	err = s.checkParameterChangesRate(ctx, diffParameterOverrides(&a.Spec.Source, &q.Spec.Source))
+	if err != nil {
+		return nil, err
+	}
 	for {
+		paramChanges := diffParameterOverrides(&a.Spec.Source, &q.Spec.Source)
 		a.Spec = q.Spec
 		_, err = s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Update(a)
 		if err == nil {
 			s.logEvent(a, ctx, argo.EventReasonResourceUpdated, "updated application spec")
+			s.logParameterChanges(a, ctx, paramChanges)
 			return &q.Spec, nil
 		}
 		if !apierr.IsConflict(err) {
@@ -426,6 +1511,24 @@ func (s *Server) Delete(ctx context.Context, q *ApplicationDeleteRequest) (*Appl
 		return nil, grpc.ErrPermissionDenied
 	}
 
+	proj, err := argo.GetAppProject(&a.Spec, s.appclientset, s.ns)
+	if err != nil {
+		if !apierr.IsNotFound(err) {
+			return nil, err
+		}
+		// the project no longer exists, so only the annotation of the application applies
+		proj = &appv1.AppProject{}
+	}
+	protected := proj.IsDeleteProtected(a)
+	if protected {
+		if !q.Force {
+			return nil, status.Errorf(codes.FailedPrecondition, "application '%s' is protected from deletion: use force with a reason to delete it", a.Name)
+		}
+		if strings.TrimSpace(q.Reason) == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "a reason is required to force the deletion of protected application '%s'", a.Name)
+		}
+	}
+
 	patchFinalizer := false
 	if q.Cascade == nil || *q.Cascade {
 		if !a.CascadedDeletion() {
@@ -462,7 +1565,11 @@ func (s *Server) Delete(ctx context.Context, q *ApplicationDeleteRequest) (*Appl
 		return nil, err
 	}
 
-	s.logEvent(a, ctx, argo.EventReasonResourceDeleted, "deleted application")
+	if protected {
+		s.logEvent(a, ctx, argo.EventReasonResourceDeleted, fmt.Sprintf("deleted protected application: %s", q.Reason))
+	} else {
+		s.logEvent(a, ctx, argo.EventReasonResourceDeleted, "deleted application")
+	}
 	return &ApplicationResponse{}, nil
 }
 
@@ -481,6 +1588,7 @@ func (s *Server) Watch(q *ApplicationQuery, ws ApplicationService_WatchServer) e
 					// do not emit apps user does not have accessing
 					continue
 				}
+				excludeResourceStates(&a, q)
 				hideAppSecrets(&a)
 				err = ws.Send(&appv1.ApplicationWatchEvent{
 					Type:        next.Type,
@@ -502,6 +1610,152 @@ func (s *Server) Watch(q *ApplicationQuery, ws ApplicationService_WatchServer) e
 	return nil
 }
 
+// Types of the steps in the progress of an operation
+const (
+	OperationProgressStarted         = "Started"
+	OperationProgressResourceResult  = "ResourceResult"
+	OperationProgressHookStatus      = "HookStatus"
+	OperationProgressPhaseTransition = "PhaseTransition"
+	OperationProgressCompleted       = "Completed"
+)
+
+// WatchOperation streams the progress of the current operation of an application, until it is completed
+func (s *Server) WatchOperation(q *OperationWatchQuery, ws ApplicationService_WatchOperationServer) error {
+	// start watching before getting the application, so that no update is missed
+	w, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Watch(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	defer w.Stop()
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if !s.enf.EnforceClaims(ws.Context().Value("claims"), "applications", "get", appRBACName(*a)) {
+		return grpc.ErrPermissionDenied
+	}
+
+	var prevState *appv1.OperationState
+	// sendProgress sends the progress of the operation since the last update of the application, and
+	// returns true once the operation is completed
+	sendProgress := func(app *appv1.Application) (bool, error) {
+		opState := app.Status.OperationState
+		if app.Operation != nil && opState != nil && opState.Phase.Completed() {
+			// the state is the one of the previous operation: the requested one is not started yet
+			return false, nil
+		}
+		for _, event := range operationProgress(prevState, opState) {
+			if err := ws.Send(event); err != nil {
+				return false, err
+			}
+		}
+		prevState = opState
+		return opState != nil && opState.Phase.Completed(), nil
+	}
+
+	done, err := sendProgress(a)
+	if err != nil || done {
+		return err
+	}
+	for {
+		select {
+		case <-ws.Context().Done():
+			return nil
+		case next, ok := <-w.ResultChan():
+			if !ok {
+				return nil
+			}
+			app, ok := next.Object.(*appv1.Application)
+			if !ok || app.Name != a.Name {
+				continue
+			}
+			if next.Type == watch.Deleted {
+				return status.Errorf(codes.NotFound, "application '%s' was deleted", a.Name)
+			}
+			done, err := sendProgress(app)
+			if err != nil || done {
+				return err
+			}
+		}
+	}
+}
+
+// operationProgress returns the steps in the progress of an operation between two of its states. All
+// steps are returned if there is no previous state, or if it is the one of another operation
+func operationProgress(prevState, opState *appv1.OperationState) []*OperationProgressEvent {
+	if opState == nil {
+		return nil
+	}
+	if prevState != nil && !prevState.StartedAt.Equal(&opState.StartedAt) {
+		prevState = nil
+	}
+	var events []*OperationProgressEvent
+	newEvent := func(eventType string) *OperationProgressEvent {
+		event := &OperationProgressEvent{Type: eventType, OperationState: *opState}
+		events = append(events, event)
+		return event
+	}
+	if prevState == nil {
+		newEvent(OperationProgressStarted)
+	}
+	if phase := syncPhase(opState); phase != "" && phase != syncPhase(prevState) {
+		newEvent(OperationProgressPhaseTransition).SyncPhase = phase
+	}
+	if opState.SyncResult != nil {
+		prevResources := make(map[string]*appv1.ResourceDetails)
+		prevHooks := make(map[string]*appv1.HookStatus)
+		if prevState != nil && prevState.SyncResult != nil {
+			for _, res := range prevState.SyncResult.Resources {
+				prevResources[fmt.Sprintf("%s/%s/%s", res.Kind, res.Namespace, res.Name)] = res
+			}
+			for _, hook := range prevState.SyncResult.Hooks {
+				prevHooks[fmt.Sprintf("%s/%s", hook.Kind, hook.Name)] = hook
+			}
+		}
+		for _, res := range opState.SyncResult.Resources {
+			prev, ok := prevResources[fmt.Sprintf("%s/%s/%s", res.Kind, res.Namespace, res.Name)]
+			if !ok || prev.Status != res.Status || prev.Message != res.Message {
+				newEvent(OperationProgressResourceResult).Resource = res
+			}
+		}
+		for _, hook := range opState.SyncResult.Hooks {
+			prev, ok := prevHooks[fmt.Sprintf("%s/%s", hook.Kind, hook.Name)]
+			if !ok || prev.Status != hook.Status || prev.Message != hook.Message {
+				newEvent(OperationProgressHookStatus).Hook = hook
+			}
+		}
+	}
+	if opState.Phase.Completed() && (prevState == nil || !prevState.Phase.Completed()) {
+		newEvent(OperationProgressCompleted)
+	}
+	return events
+}
+
+// syncPhase returns the furthest phase reached by the sync of an operation, or an empty string if the
+// sync has no result yet
+func syncPhase(opState *appv1.OperationState) string {
+	if opState == nil || opState.SyncResult == nil {
+		return ""
+	}
+	phase := ""
+	if len(opState.SyncResult.Resources) > 0 {
+		phase = string(appv1.HookTypeSync)
+	}
+	for _, hook := range opState.SyncResult.Hooks {
+		switch hook.Type {
+		case appv1.HookTypePostSync:
+			return string(appv1.HookTypePostSync)
+		case appv1.HookTypeSync:
+			phase = string(appv1.HookTypeSync)
+		case appv1.HookTypePreSync:
+			if phase == "" {
+				phase = string(appv1.HookTypePreSync)
+			}
+		}
+	}
+	return phase
+}
+
 func (s *Server) validateApp(ctx context.Context, spec *appv1.ApplicationSpec) error {
 	proj, err := argo.GetAppProject(spec, s.appclientset, s.ns)
 	if err != nil {
@@ -513,30 +1767,49 @@ func (s *Server) validateApp(ctx context.Context, spec *appv1.ApplicationSpec) e
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "projects", "get", proj.Name) {
 		return status.Errorf(codes.PermissionDenied, "permission denied for project %s", proj.Name)
 	}
-	conditions, err := argo.GetSpecErrors(ctx, spec, proj, s.repoClientset, s.db)
+	details, err := argo.GetSpecErrorDetails(ctx, spec, proj, s.repoClientset, s.db)
 	if err != nil {
 		return err
 	}
-	if len(conditions) > 0 {
-		return status.Errorf(codes.InvalidArgument, "application spec is invalid: %s", argo.FormatAppConditions(conditions))
+	if len(details) > 0 {
+		return argo.InvalidSpecError(details)
+	}
+	return nil
+}
+
+// validateTrackingLabel ensures that resources of the application are not tracked with the same label
+// value as resources of another application, which is possible if long names are truncated
+func (s *Server) validateTrackingLabel(appName string) error {
+	labelValue := kube.TruncateLabelValue(appName)
+	if labelValue == appName {
+		return nil
+	}
+	apps, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, other := range apps.Items {
+		if other.Name != appName && kube.TruncateLabelValue(other.Name) == labelValue {
+			return status.Errorf(codes.InvalidArgument, "application name %s collides with the name of application %s when truncated to label value %s", appName, other.Name, labelValue)
+		}
 	}
 	return nil
 }
 
 func (s *Server) getApplicationClusterConfig(applicationName string) (*rest.Config, string, error) {
-	server, namespace, err := s.getApplicationDestination(context.Background(), applicationName)
+	dest, err := s.getApplicationDestination(context.Background(), applicationName)
 	if err != nil {
 		return nil, "", err
 	}
-	clst, err := s.db.GetCluster(context.Background(), server)
+	clst, err := argo.GetDestinationCluster(context.Background(), *dest, s.db)
 	if err != nil {
 		return nil, "", err
 	}
-	config := clst.RESTConfig()
-	return config, namespace, err
+	config := s.kubeClientMetrics.WrapClusterConfig(clst.RESTConfig())
+	return config, dest.Namespace, err
 }
 
-func (s *Server) ensurePodBelongsToApp(applicationName string, podName, namespace string, kubeClientset *kubernetes.Clientset) error {
+func (s *Server) ensurePodBelongsToApp(applicationName string, podName, namespace string, kubeClientset kubernetes.Interface) error {
 	pod, err := kubeClientset.CoreV1().Pods(namespace).Get(podName, metav1.GetOptions{})
 	if err != nil {
 		return err
@@ -545,12 +1818,34 @@ func (s *Server) ensurePodBelongsToApp(applicationName string, podName, namespac
 	if pod.Labels == nil {
 		return wrongPodError
 	}
-	if value, ok := pod.Labels[common.LabelApplicationName]; !ok || value != applicationName {
+	if value, ok := pod.Labels[common.LabelApplicationName]; !ok || value != kube.TruncateLabelValue(applicationName) {
 		return wrongPodError
 	}
 	return nil
 }
 
+// ensureAppPod returns an error unless the pod belongs to the application. Pods of the resource tree
+// belong to the application. Pods which are not in the resource tree yet (e.g. pods which were just
+// created) belong to it if they are labeled with its name, provided their namespace is a destination
+// permitted by the project, since the label can be set by anyone allowed to create pods
+func (s *Server) ensureAppPod(ctx context.Context, a *appv1.Application, podName, namespace string, kubeClientset kubernetes.Interface) error {
+	if findAppPod(a, podName, namespace) != nil {
+		return nil
+	}
+	proj, err := argo.GetAppProject(&a.Spec, s.appclientset, s.ns)
+	if err != nil {
+		return err
+	}
+	clst, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, s.db)
+	if err != nil {
+		return err
+	}
+	if !proj.IsDestinationPermitted(appv1.ApplicationDestination{Server: clst.Server, Namespace: namespace}) {
+		return status.Errorf(codes.PermissionDenied, "namespace %s of pod %s is not a destination permitted by project %s", namespace, podName, proj.Name)
+	}
+	return s.ensurePodBelongsToApp(a.Name, podName, namespace, kubeClientset)
+}
+
 func (s *Server) DeleteResource(ctx context.Context, q *ApplicationDeleteResourceRequest) (*ApplicationResponse, error) {
 	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
 	if err != nil {
@@ -559,7 +1854,7 @@ func (s *Server) DeleteResource(ctx context.Context, q *ApplicationDeleteResourc
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "delete", appRBACName(*a)) {
 		return nil, grpc.ErrPermissionDenied
 	}
-	found := findResource(a, q)
+	found := findResource(a, q.ResourceName, q.APIVersion, q.Kind)
 	if found == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s %s %s not found as part of application %s", q.Kind, q.APIVersion, q.ResourceName, *q.Name)
 	}
@@ -567,7 +1862,7 @@ func (s *Server) DeleteResource(ctx context.Context, q *ApplicationDeleteResourc
 	if err != nil {
 		return nil, err
 	}
-	err = s.kubectl.DeleteResource(config, found, namespace)
+	err = s.kubectl.DeleteResource(ctx, config, found, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -575,7 +1870,7 @@ func (s *Server) DeleteResource(ctx context.Context, q *ApplicationDeleteResourc
 	return &ApplicationResponse{}, nil
 }
 
-func findResource(a *appv1.Application, q *ApplicationDeleteResourceRequest) *unstructured.Unstructured {
+func findResource(a *appv1.Application, resourceName, apiVersion, kind string) *unstructured.Unstructured {
 	for _, res := range a.Status.ComparisonResult.Resources {
 		liveObj, err := res.LiveObject()
 		if err != nil {
@@ -585,10 +1880,10 @@ func findResource(a *appv1.Application, q *ApplicationDeleteResourceRequest) *un
 		if liveObj == nil {
 			continue
 		}
-		if q.ResourceName == liveObj.GetName() && q.APIVersion == liveObj.GetAPIVersion() && q.Kind == liveObj.GetKind() {
+		if resourceName == liveObj.GetName() && apiVersion == liveObj.GetAPIVersion() && kind == liveObj.GetKind() {
 			return liveObj
 		}
-		liveObj = recurseResourceNode(q.ResourceName, q.APIVersion, q.Kind, res.ChildLiveResources)
+		liveObj = recurseResourceNode(resourceName, apiVersion, kind, res.ChildLiveResources)
 		if liveObj != nil {
 			return liveObj
 		}
@@ -596,7 +1891,97 @@ func findResource(a *appv1.Application, q *ApplicationDeleteResourceRequest) *un
 	return nil
 }
 
+// resourceActions returns the custom actions of the given resource, defined in the argocd-cm configmap
+func (s *Server) resourceActions(obj *unstructured.Unstructured) ([]settings.ResourceAction, error) {
+	gvk := obj.GroupVersionKind()
+	return settings.NewSettingsManager(s.kubeclientset, s.ns).GetResourceActions(gvk.Group, gvk.Kind)
+}
+
+func (s *Server) ListResourceActions(ctx context.Context, q *ApplicationResourceActionsQuery) (*ResourceActionsListResponse, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "get", appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	found := findResource(a, q.ResourceName, q.APIVersion, q.Kind)
+	if found == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s %s %s not found as part of application %s", q.Kind, q.APIVersion, q.ResourceName, *q.Name)
+	}
+	actions, err := s.resourceActions(found)
+	if err != nil {
+		return nil, err
+	}
+	res := &ResourceActionsListResponse{Actions: make([]*ResourceAction, 0)}
+	for _, action := range actions {
+		res.Actions = append(res.Actions, &ResourceAction{Name: action.Name})
+	}
+	return res, nil
+}
+
+// resourceActionRBACName returns the RBAC action of the custom action of resources of the given kind
+func resourceActionRBACName(gvk schema.GroupVersionKind, action string) string {
+	return fmt.Sprintf("action/%s/%s/%s", gvk.Group, gvk.Kind, action)
+}
+
+func (s *Server) RunResourceAction(ctx context.Context, q *ApplicationRunResourceActionRequest) (*ApplicationResponse, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	gvk := schema.FromAPIVersionAndKind(q.APIVersion, q.Kind)
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", resourceActionRBACName(gvk, q.Action), appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	found := findResource(a, q.ResourceName, q.APIVersion, q.Kind)
+	if found == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s %s %s not found as part of application %s", q.Kind, q.APIVersion, q.ResourceName, *q.Name)
+	}
+	actions, err := s.resourceActions(found)
+	if err != nil {
+		return nil, err
+	}
+	var action *settings.ResourceAction
+	for i := range actions {
+		if actions[i].Name == q.Action {
+			action = &actions[i]
+			break
+		}
+	}
+	if action == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "action %s is not defined for %s %s", q.Action, q.Kind, q.APIVersion)
+	}
+	config, _, err := s.getApplicationClusterConfig(*q.Name)
+	if err != nil {
+		return nil, err
+	}
+	// the action is run on the current state of the resource, rather than on the state of the last comparison
+	liveObj, err := s.kubectl.GetResource(ctx, config, gvk, found.GetName(), found.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	newObj, err := lua.ExecuteAction(liveObj, action.Lua, lua.DefaultActionTimeout)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	_, err = s.kubectl.UpdateResource(ctx, config, newObj, found.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	s.logEvent(a, ctx, argo.EventReasonResourceActionRan, fmt.Sprintf("ran action %s on resource %s/%s '%s'", q.Action, q.APIVersion, q.Kind, q.ResourceName))
+	return &ApplicationResponse{}, nil
+}
+
 func recurseResourceNode(name, apiVersion, kind string, nodes []appv1.ResourceNode) *unstructured.Unstructured {
+	return findResourceNode(nodes, func(obj *unstructured.Unstructured) bool {
+		return name == obj.GetName() && apiVersion == obj.GetAPIVersion() && kind == obj.GetKind()
+	})
+}
+
+// findResourceNode returns the first live object of the resource nodes, or of their children, which
+// matches the given function
+func findResourceNode(nodes []appv1.ResourceNode, match func(obj *unstructured.Unstructured) bool) *unstructured.Unstructured {
 	for _, node := range nodes {
 		var childObj unstructured.Unstructured
 		err := json.Unmarshal([]byte(node.State), &childObj)
@@ -604,10 +1989,10 @@ func recurseResourceNode(name, apiVersion, kind string, nodes []appv1.ResourceNo
 			log.Warnf("Failed to unmarshal child live object: %v", err)
 			continue
 		}
-		if name == childObj.GetName() && apiVersion == childObj.GetAPIVersion() && kind == childObj.GetKind() {
+		if match(&childObj) {
 			return &childObj
 		}
-		recurseChildObj := recurseResourceNode(name, apiVersion, kind, node.Children)
+		recurseChildObj := findResourceNode(node.Children, match)
 		if recurseChildObj != nil {
 			return recurseChildObj
 		}
@@ -615,6 +2000,27 @@ func recurseResourceNode(name, apiVersion, kind string, nodes []appv1.ResourceNo
 	return nil
 }
 
+// findAppPod returns the pod with the given name and namespace of the resource tree of the application
+func findAppPod(a *appv1.Application, podName, namespace string) *unstructured.Unstructured {
+	isPod := func(obj *unstructured.Unstructured) bool {
+		return obj.GetAPIVersion() == "v1" && obj.GetKind() == kube.PodKind && obj.GetName() == podName && obj.GetNamespace() == namespace
+	}
+	for _, res := range a.Status.ComparisonResult.Resources {
+		liveObj, err := res.LiveObject()
+		if err != nil {
+			log.Warnf("Failed to unmarshal live object: %v", err)
+			continue
+		}
+		if liveObj != nil && isPod(liveObj) {
+			return liveObj
+		}
+		if pod := findResourceNode(res.ChildLiveResources, isPod); pod != nil {
+			return pod
+		}
+	}
+	return nil
+}
+
 func (s *Server) PodLogs(q *ApplicationPodLogsQuery, ws ApplicationService_PodLogsServer) error {
 	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
 	if err != nil {
@@ -627,11 +2033,14 @@ func (s *Server) PodLogs(q *ApplicationPodLogsQuery, ws ApplicationService_PodLo
 	if err != nil {
 		return err
 	}
+	if q.Namespace != "" {
+		namespace = q.Namespace
+	}
 	kubeClientset, err := kubernetes.NewForConfig(config)
 	if err != nil {
 		return err
 	}
-	err = s.ensurePodBelongsToApp(*q.Name, *q.PodName, namespace, kubeClientset)
+	err = s.ensureAppPod(ws.Context(), a, *q.PodName, namespace, kubeClientset)
 	if err != nil {
 		return err
 	}
@@ -654,7 +2063,8 @@ func (s *Server) PodLogs(q *ApplicationPodLogsQuery, ws ApplicationService_PodLo
 	if err != nil {
 		return err
 	}
-	done := make(chan bool)
+	// the channel is buffered so that the scanning goroutine completes if the stream is closed first
+	done := make(chan bool, 1)
 	go func() {
 		scanner := bufio.NewScanner(stream)
 		for scanner.Scan() {
@@ -688,13 +2098,183 @@ func (s *Server) PodLogs(q *ApplicationPodLogsQuery, ws ApplicationService_PodLo
 	return nil
 }
 
-func (s *Server) getApplicationDestination(ctx context.Context, name string) (string, string, error) {
+// defaultExecCommand is the command run by the exec sessions which do not set one
+var defaultExecCommand = []string{"/bin/sh"}
+
+// Exec runs a command in a pod of the application, and bridges the terminal of the command to the stream
+func (s *Server) Exec(ws ApplicationService_ExecServer) error {
+	q, err := ws.Recv()
+	if err != nil {
+		return err
+	}
+	if q.Name == nil || q.PodName == nil {
+		return status.Errorf(codes.InvalidArgument, "the first message of the stream must set the application and the pod")
+	}
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if !s.enf.EnforceClaims(ws.Context().Value("claims"), "applications", "exec", appRBACName(*a)) {
+		return grpc.ErrPermissionDenied
+	}
+	config, namespace, err := s.getApplicationClusterConfig(*q.Name)
+	if err != nil {
+		return err
+	}
+	if q.Namespace != "" {
+		namespace = q.Namespace
+	}
+	kubeClientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	err = s.ensureAppPod(ws.Context(), a, *q.PodName, namespace, kubeClientset)
+	if err != nil {
+		return err
+	}
+
+	command := q.Command
+	if len(command) == 0 {
+		command = defaultExecCommand
+	}
+	req := kubeClientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(*q.PodName).
+		Namespace(namespace).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: q.Container,
+			Command:   command,
+			Stdin:     true,
+			Stdout:    true,
+			Stderr:    !q.Tty,
+			TTY:       q.Tty,
+		}, scheme.ParameterCodec)
+	executor, err := remotecommand.NewSPDYExecutor(config, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+	s.logEvent(a, ws.Context(), argo.EventReasonExecSession, fmt.Sprintf("started exec session in pod %s: %s", *q.PodName, strings.Join(command, " ")))
+
+	stdin, stdinWriter := io.Pipe()
+	sizes := make(terminalSizeQueue, 1)
+	if q.TerminalSize != nil {
+		sizes <- remotecommand.TerminalSize{Width: uint16(q.TerminalSize.Width), Height: uint16(q.TerminalSize.Height)}
+	}
+	go forwardExecInput(ws, stdinWriter, sizes)
+	out := &execOutput{ws: ws}
+	opts := remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: execWriter(out.sendStdout),
+		Tty:    q.Tty,
+	}
+	if q.Tty {
+		opts.TerminalSizeQueue = sizes
+	} else {
+		opts.Stderr = execWriter(out.sendStderr)
+	}
+	err = executor.Stream(opts)
+	// unblocks the forwarding of the input if the command exited first
+	util.Close(stdin)
+	if err != nil {
+		s.logEvent(a, ws.Context(), argo.EventReasonExecSession, fmt.Sprintf("ended exec session in pod %s: %v", *q.PodName, err))
+	} else {
+		s.logEvent(a, ws.Context(), argo.EventReasonExecSession, fmt.Sprintf("ended exec session in pod %s", *q.PodName))
+	}
+	return err
+}
+
+// forwardExecInput forwards the input and the terminal resizes of an exec stream to the command, until
+// the stream ends
+func forwardExecInput(ws ApplicationService_ExecServer, stdin *io.PipeWriter, sizes terminalSizeQueue) {
+	defer close(sizes)
+	for {
+		q, err := ws.Recv()
+		if err == io.EOF {
+			util.Close(stdin)
+			return
+		}
+		if err != nil {
+			_ = stdin.CloseWithError(err)
+			return
+		}
+		if q.TerminalSize != nil {
+			sizes.push(remotecommand.TerminalSize{Width: uint16(q.TerminalSize.Width), Height: uint16(q.TerminalSize.Height)})
+		}
+		if len(q.Stdin) > 0 {
+			if _, err = stdin.Write(q.Stdin); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// terminalSizeQueue holds the pending terminal resize of an exec session
+type terminalSizeQueue chan remotecommand.TerminalSize
+
+// push queues the given size, replacing the pending one which was not applied yet
+func (q terminalSizeQueue) push(size remotecommand.TerminalSize) {
+	for {
+		select {
+		case q <- size:
+			return
+		default:
+			select {
+			case <-q:
+			default:
+			}
+		}
+	}
+}
+
+// Next returns the next terminal size, or nil once the stream has ended
+func (q terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	size, ok := <-q
+	if !ok {
+		return nil
+	}
+	return &size
+}
+
+// execOutput sends the output of the command of an exec session to the stream. The output is written
+// concurrently to stdout and stderr, while a stream only supports a single sender
+type execOutput struct {
+	ws   ApplicationService_ExecServer
+	lock sync.Mutex
+}
+
+func (o *execOutput) sendStdout(p []byte) error {
+	return o.send(&ApplicationExecOutput{Stdout: p})
+}
+
+func (o *execOutput) sendStderr(p []byte) error {
+	return o.send(&ApplicationExecOutput{Stderr: p})
+}
+
+func (o *execOutput) send(out *ApplicationExecOutput) error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	return o.ws.Send(out)
+}
+
+// execWriter is a writer sending the written bytes with the given function
+type execWriter func(p []byte) error
+
+func (w execWriter) Write(p []byte) (int, error) {
+	// the buffer is reused by the caller once written
+	err := w(append([]byte(nil), p...))
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (s *Server) getApplicationDestination(ctx context.Context, name string) (*appv1.ApplicationDestination, error) {
 	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(name, metav1.GetOptions{})
 	if err != nil {
-		return "", "", err
+		return nil, err
 	}
-	server, namespace := a.Spec.Destination.Server, a.Spec.Destination.Namespace
-	return server, namespace, nil
+	return &a.Spec.Destination, nil
 }
 
 func (s *Server) getRepo(ctx context.Context, repoURL string) *appv1.Repository {
@@ -716,7 +2296,17 @@ func (s *Server) Sync(ctx context.Context, syncReq *ApplicationSyncRequest) (*ap
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "sync", appRBACName(*a)) {
 		return nil, grpc.ErrPermissionDenied
 	}
-	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.Automated != nil {
+	if err := checkNoPendingDestinationChange(a); err != nil {
+		return nil, err
+	}
+	proj, err := argo.GetAppProject(&a.Spec, s.appclientset, s.ns)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSyncOptions(proj, syncReq.Prune); err != nil {
+		return nil, err
+	}
+	if proj.IsAutomatedSync(&a.Spec) {
 		if syncReq.Revision != "" && syncReq.Revision != a.Spec.Source.TargetRevision {
 			return nil, status.Errorf(codes.FailedPrecondition, "Cannot sync to %s: auto-sync currently set to %s", syncReq.Revision, a.Spec.Source.TargetRevision)
 		}
@@ -750,20 +2340,223 @@ func (s *Server) Sync(ctx context.Context, syncReq *ApplicationSyncRequest) (*ap
 			SyncStrategy:       syncReq.Strategy,
 			ParameterOverrides: parameterOverrides,
 			Resources:          syncReq.Resources,
+			SyncOptions:        syncReq.SyncOptions,
 		},
+		InitiatedBy: appv1.OperationInitiator{Username: session.Username(ctx)},
+	}
+	proj.ApplySyncOptions(op.Sync)
+	queued := false
+	if syncReq.Queue {
+		a, queued, err = argo.QueueAppOperation(ctx, appIf, s.auditLogger, *syncReq.Name, &op)
+	} else {
+		a, err = argo.SetAppOperation(ctx, appIf, s.auditLogger, *syncReq.Name, &op)
 	}
-	a, err = argo.SetAppOperation(ctx, appIf, s.auditLogger, *syncReq.Name, &op)
 	if err == nil {
 		rev := syncReq.Revision
 		if syncReq.Revision == "" {
 			rev = a.Spec.Source.TargetRevision
 		}
 		message := fmt.Sprintf("initiated sync to %s", rev)
+		if queued {
+			message = fmt.Sprintf("queued sync to %s", rev)
+		}
 		s.logEvent(a, ctx, argo.EventReasonOperationStarted, message)
 	}
 	return a, err
 }
 
+// checkNoPendingDestinationChange returns an error if resources at the previous destination of the
+// application have to be pruned or orphaned before the application can be synced
+func checkNoPendingDestinationChange(a *appv1.Application) error {
+	if a.IsDestinationChangePending() {
+		return status.Errorf(codes.FailedPrecondition, "Destination of application %s changed: resources at the previous destination must be pruned or orphaned first", a.Name)
+	}
+	return nil
+}
+
+// checkSyncOptions returns an error if a sync requests pruning while the project of the application
+// forbids it
+func checkSyncOptions(proj *appv1.AppProject, prune bool) error {
+	if prune && proj.Spec.SyncOptions != nil && proj.Spec.SyncOptions.Prune != nil && !*proj.Spec.SyncOptions.Prune {
+		return grpc.NewReasonError(codes.FailedPrecondition, grpc.ErrorReasonProjectViolation, proj.Name, "Cannot prune resources: pruning is disabled in project %s", proj.Name)
+	}
+	return nil
+}
+
+// BulkSync syncs all the applications of the given projects which match the given label selector. The
+// request is rejected if any application fails the permission, destination, operation or sync option
+// checks. The syncs are then initiated independently on a best effort basis: an application may still
+// fail to be synced, e.g. if it was modified concurrently, which is reported in its result
+func (s *Server) BulkSync(ctx context.Context, q *ApplicationBulkSyncRequest) (*ApplicationBulkResponse, error) {
+	apps, err := s.listBulkApps(q.Projects, q.Selector, q.Parallelism)
+	if err != nil {
+		return nil, err
+	}
+	for i := range apps {
+		a := &apps[i]
+		if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "sync", appRBACName(*a)) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied to sync application %s", a.Name)
+		}
+		if err := checkNoPendingDestinationChange(a); err != nil {
+			return nil, err
+		}
+		if a.Operation != nil || len(a.Status.QueuedOperations) > 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "another operation is already in progress for application %s", a.Name)
+		}
+		proj, err := argo.GetAppProject(&a.Spec, s.appclientset, s.ns)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkSyncOptions(proj, q.Prune); err != nil {
+			return nil, err
+		}
+	}
+	return runBulk(apps, q.Parallelism, func(a *appv1.Application) error {
+		_, err := s.Sync(ctx, &ApplicationSyncRequest{
+			Name:     &a.Name,
+			DryRun:   q.DryRun,
+			Prune:    q.Prune,
+			Strategy: q.Strategy,
+		})
+		return err
+	}), nil
+}
+
+// BulkRefresh refreshes all the applications of the given projects which match the given label
+// selector. Unlike Get, it does not wait for the applications to be refreshed
+func (s *Server) BulkRefresh(ctx context.Context, q *ApplicationBulkRefreshRequest) (*ApplicationBulkResponse, error) {
+	apps, err := s.listBulkApps(q.Projects, q.Selector, q.Parallelism)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range apps {
+		if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "get", appRBACName(a)) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied to refresh application %s", a.Name)
+		}
+	}
+	refreshType := appv1.RefreshTypeNormal
+	if q.HardRefresh {
+		refreshType = appv1.RefreshTypeHard
+	}
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
+	return runBulk(apps, q.Parallelism, func(a *appv1.Application) error {
+		_, err := argoutil.RefreshApp(appIf, a.Name, refreshType)
+		return err
+	}), nil
+}
+
+// GarbageCollect removes the deployments beyond the history limit, the resource results of the operations
+// completed before the retention period and the orphaned hook records from the status of the applications
+// of the given projects which match the given label selector, or of all the applications if none is given.
+// Applications with an operation in progress are skipped. Garbage collections require the gc action on
+// all applications, in addition to the update action on the collected applications
+func (s *Server) GarbageCollect(ctx context.Context, q *ApplicationGarbageCollectRequest) (*ApplicationBulkResponse, error) {
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "gc", "*/*") {
+		return nil, grpc.ErrPermissionDenied
+	}
+	if q.OperationRetention < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "operation retention must not be negative")
+	}
+	apps, err := s.listSelectedApps(q.Projects, q.Selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range apps {
+		if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "update", appRBACName(a)) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied to garbage collect application %s", a.Name)
+		}
+	}
+	policy := argoutil.GCPolicy{MaxHistory: controller.MaxHistoryCount, OperationRetention: argoutil.DefaultOperationRetention}
+	if q.OperationRetention > 0 {
+		policy.OperationRetention = time.Duration(q.OperationRetention) * time.Second
+	}
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
+	resp := ApplicationBulkResponse{Results: make([]ApplicationBulkResult, len(apps))}
+	for i := range apps {
+		result := ApplicationBulkResult{Name: apps[i].Name, Succeeded: true}
+		gcResult, err := argoutil.GarbageCollectApp(appIf, &apps[i], policy, q.DryRun)
+		if err != nil {
+			result.Succeeded = false
+			result.Message = err.Error()
+			resp.Failed++
+		} else {
+			result.Message = gcResult.String()
+			resp.Succeeded++
+			if !gcResult.IsEmpty() && !q.DryRun {
+				s.logEvent(&apps[i], ctx, argo.EventReasonResourceUpdated, "garbage collected application status: "+gcResult.String())
+			}
+		}
+		resp.Results[i] = result
+	}
+	return &resp, nil
+}
+
+// listBulkApps returns the applications of the given projects which match the given label selector,
+// sorted by name
+func (s *Server) listBulkApps(projects []string, selector string, parallelism int64) ([]appv1.Application, error) {
+	if len(projects) == 0 && selector == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "a project or a label selector is required")
+	}
+	if parallelism < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "parallelism must not be negative")
+	}
+	return s.listSelectedApps(projects, selector)
+}
+
+// listSelectedApps returns the applications of the given projects, or of all the projects if none is
+// given, which match the given label selector, sorted by name
+func (s *Server) listSelectedApps(projects []string, selector string) ([]appv1.Application, error) {
+	if _, err := labels.Parse(selector); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid label selector '%s': %v", selector, err)
+	}
+	appList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	apps := argoutil.FilterByProjects(appList.Items, projects)
+	sort.Slice(apps, func(i, j int) bool {
+		return apps[i].Name < apps[j].Name
+	})
+	return apps, nil
+}
+
+// runBulk runs the given action on each application, with at most parallelism actions running
+// concurrently, and aggregates their results
+func runBulk(apps []appv1.Application, parallelism int64, action func(a *appv1.Application) error) *ApplicationBulkResponse {
+	if parallelism == 0 {
+		parallelism = DefaultBulkParallelism
+	}
+	results := make([]ApplicationBulkResult, len(apps))
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i := range apps {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			result := ApplicationBulkResult{Name: apps[i].Name, Succeeded: true}
+			if err := action(&apps[i]); err != nil {
+				result.Succeeded = false
+				result.Message = status.Convert(err).Message()
+			}
+			results[i] = result
+		}(i)
+	}
+	wg.Wait()
+	resp := ApplicationBulkResponse{Results: results}
+	for _, result := range results {
+		if result.Succeeded {
+			resp.Succeeded++
+		} else {
+			resp.Failed++
+		}
+	}
+	return &resp
+}
+
 func (s *Server) Rollback(ctx context.Context, rollbackReq *ApplicationRollbackRequest) (*appv1.Application, error) {
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
 	a, err := appIf.Get(*rollbackReq.Name, metav1.GetOptions{})
@@ -773,8 +2566,22 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *ApplicationRollbackR
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "sync", appRBACName(*a)) {
 		return nil, grpc.ErrPermissionDenied
 	}
-	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.Automated != nil {
-		return nil, status.Errorf(codes.FailedPrecondition, "Rollback cannot be initiated when auto-sync is enabled")
+	proj, err := argo.GetAppProject(&a.Spec, s.appclientset, s.ns)
+	if err != nil {
+		return nil, err
+	}
+	// automated syncs would redeploy the target revision once the rollback completes, so they are disabled
+	// until they are enabled again, e.g. once the change rolled back is reverted in the repository. They
+	// are deliberately not restored when the rollback completes, since they would undo it right away
+	disableAutoSync := proj.IsAutomatedSync(&a.Spec) && !rollbackReq.DryRun
+	if disableAutoSync && !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "update", appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	if err := checkNoPendingDestinationChange(a); err != nil {
+		return nil, err
+	}
+	if err := checkSyncOptions(proj, rollbackReq.Prune); err != nil {
+		return nil, err
 	}
 
 	var deploymentInfo *appv1.DeploymentInfo
@@ -787,6 +2594,12 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *ApplicationRollbackR
 	if deploymentInfo == nil {
 		return nil, fmt.Errorf("application %s does not have deployment with id %v", a.Name, rollbackReq.ID)
 	}
+	// Restore the parameters the deployment was generated with. Older history entries only recorded
+	// the parameter overrides.
+	parameterOverrides := deploymentInfo.Parameters
+	if len(parameterOverrides) == 0 {
+		parameterOverrides = deploymentInfo.ComponentParameterOverrides
+	}
 	// Rollback is just a convenience around Sync
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
@@ -794,14 +2607,40 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *ApplicationRollbackR
 			DryRun:             rollbackReq.DryRun,
 			Prune:              rollbackReq.Prune,
 			SyncStrategy:       &appv1.SyncStrategy{Apply: &appv1.SyncStrategyApply{}},
-			ParameterOverrides: deploymentInfo.ComponentParameterOverrides,
+			ParameterOverrides: parameterOverrides,
 		},
+		InitiatedBy: appv1.OperationInitiator{Username: session.Username(ctx)},
+	}
+	// Restore the source the deployment was generated from, e.g. its path and values files. Older
+	// history entries did not record it, so rolling back to them keeps the current source of the app.
+	if deploymentInfo.Source.RepoURL != "" {
+		op.Sync.Source = deploymentInfo.Source.DeepCopy()
+	}
+	proj.ApplySyncOptions(op.Sync)
+	// automated syncs are disabled before the rollback starts, so that the rollback never runs with them
+	prevSyncPolicy := a.Spec.SyncPolicy
+	if disableAutoSync {
+		a, err = argo.DisableAutomatedSync(appIf, a.Name, proj)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to disable auto-sync for rollback to %d: %v", rollbackReq.ID, err)
+		}
+		s.logEvent(a, ctx, argo.EventReasonResourceUpdated, fmt.Sprintf("disabled auto-sync for rollback to %d", rollbackReq.ID))
 	}
 	a, err = argo.SetAppOperation(ctx, appIf, s.auditLogger, *rollbackReq.Name, &op)
-	if err == nil {
-		s.logEvent(a, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("initiated rollback to %d", rollbackReq.ID))
+	if err != nil {
+		if disableAutoSync {
+			// the rollback did not start, so the automated syncs are enabled again
+			restored, restoreErr := argo.SetSyncPolicy(appIf, *rollbackReq.Name, prevSyncPolicy)
+			if restoreErr != nil {
+				log.Warnf("Failed to restore the sync policy of app '%s' after a failed rollback: %v", *rollbackReq.Name, restoreErr)
+			} else {
+				s.logEvent(restored, ctx, argo.EventReasonResourceUpdated, fmt.Sprintf("enabled auto-sync after failing to roll back to %d", rollbackReq.ID))
+			}
+		}
+		return nil, err
 	}
-	return a, err
+	s.logEvent(a, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("initiated rollback to %d", rollbackReq.ID))
+	return a, nil
 }
 
 func (s *Server) TerminateOperation(ctx context.Context, termOpReq *OperationTerminateRequest) (*OperationTerminateResponse, error) {
@@ -818,8 +2657,9 @@ func (s *Server) TerminateOperation(ctx context.Context, termOpReq *OperationTer
 			return nil, status.Errorf(codes.InvalidArgument, "Unable to terminate operation. No operation is in progress")
 		}
 		a.Status.OperationState.Phase = appv1.OperationTerminating
-		_, err = s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Update(a)
+		updated, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Update(a)
 		if err == nil {
+			s.logEvent(updated, ctx, argo.EventReasonResourceUpdated, "terminated running operation")
 			return &OperationTerminateResponse{}, nil
 		}
 		if !apierr.IsConflict(err) {
@@ -830,8 +2670,6 @@ func (s *Server) TerminateOperation(ctx context.Context, termOpReq *OperationTer
 		a, err = s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*termOpReq.Name, metav1.GetOptions{})
 		if err != nil {
 			return nil, err
-		} else {
-			s.logEvent(a, ctx, argo.EventReasonResourceUpdated, "terminated running operation")
 		}
 	}
 	return nil, status.Errorf(codes.Internal, "Failed to terminate app. Too many conflicts")
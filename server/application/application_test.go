@@ -1,15 +1,32 @@
 package application
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"strconv"
 	"testing"
+	"time"
 
+	jwt "github.com/dgrijalva/jwt-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/fake"
+	testcore "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/util/flowcontrol"
 
 	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/controller"
 	"github.com/argoproj/argo-cd/errors"
 	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	apps "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
@@ -18,6 +35,7 @@ import (
 	mockreposerver "github.com/argoproj/argo-cd/reposerver/repository/mocks"
 	"github.com/argoproj/argo-cd/test"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/rbac"
@@ -28,6 +46,9 @@ const (
 	fakeRepoURL   = "https://git.com/repo.git"
 )
 
+// fakeCommitTime is the commit time of the revisions of the fake repository
+var fakeCommitTime = metav1.NewTime(time.Now().Add(-48 * time.Hour).Truncate(time.Second))
+
 type fakeCloser struct{}
 
 func (f fakeCloser) Close() error {
@@ -93,6 +114,7 @@ func newTestAppServer() ApplicationServiceServer {
 	mockRepoServiceClient := mockreposerver.RepositoryServiceClient{}
 	mockRepoServiceClient.On("GetFile", mock.Anything, mock.Anything).Return(fakeFileResponse(), nil)
 	mockRepoServiceClient.On("ListDir", mock.Anything, mock.Anything).Return(fakeListDirResponse(), nil)
+	mockRepoServiceClient.On("GetRevisionMetadata", mock.Anything, mock.Anything).Return(&repository.RevisionMetadata{CommitTime: &fakeCommitTime}, nil)
 
 	mockRepoClient := &mockrepo.Clientset{}
 	mockRepoClient.On("NewRepositoryClient").Return(&fakeCloser{}, &mockRepoServiceClient, nil)
@@ -114,28 +136,1053 @@ func newTestAppServer() ApplicationServiceServer {
 		db,
 		enforcer,
 		util.NewKeyLock(),
+		nil,
 	)
 }
 
+// newTestApp returns an application of the default project, deployed from the fake repository to
+// the fake cluster, modified by the given options
+func newTestApp(name string, opts ...func(app *appsv1.Application)) *appsv1.Application {
+	app := appsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: appsv1.ApplicationSpec{
+			Source: appsv1.ApplicationSource{
+				RepoURL:        fakeRepoURL,
+				Path:           "some/path",
+				Environment:    "default",
+				TargetRevision: "HEAD",
+			},
+			Destination: appsv1.ApplicationDestination{
+				Server:    "https://cluster-api.com",
+				Namespace: "default",
+			},
+		},
+	}
+	for _, opt := range opts {
+		opt(&app)
+	}
+	return &app
+}
+
 func TestCreateApp(t *testing.T) {
 	appServer := newTestAppServer()
-	createReq := ApplicationCreateRequest{
-		Application: appsv1.Application{
-			Spec: appsv1.ApplicationSpec{
-				Source: appsv1.ApplicationSource{
-					RepoURL:        fakeRepoURL,
-					Path:           "some/path",
-					Environment:    "default",
-					TargetRevision: "HEAD",
-				},
-				Destination: appsv1.ApplicationDestination{
-					Server:    "https://cluster-api.com",
-					Namespace: "default",
-				},
+	createReq := ApplicationCreateRequest{Application: *newTestApp("")}
+	app, err := appServer.Create(context.Background(), &createReq)
+	assert.Nil(t, err)
+	assert.Equal(t, app.Spec.Project, "default")
+}
+
+func TestCreateAppWithTemplate(t *testing.T) {
+	appServer := newTestAppServer().(*Server)
+	proj, err := appServer.appclientset.ArgoprojV1alpha1().AppProjects(testNamespace).Get("default", metav1.GetOptions{})
+	assert.NoError(t, err)
+	proj.Spec.ApplicationTemplate = &appsv1.ApplicationTemplate{
+		Labels:      map[string]string{"team": "platform", "tier": "backend"},
+		Finalizers:  []string{common.ResourcesFinalizerName},
+		Destination: appsv1.ApplicationDestination{Server: "https://cluster-api.com", Namespace: "default"},
+		SyncPolicy:  &appsv1.SyncPolicy{Automated: &appsv1.SyncPolicyAutomated{}},
+	}
+	_, err = appServer.appclientset.ArgoprojV1alpha1().AppProjects(testNamespace).Update(proj)
+	assert.NoError(t, err)
+
+	createReq := ApplicationCreateRequest{Application: *newTestApp("guestbook", func(app *appsv1.Application) {
+		app.Labels = map[string]string{"tier": "frontend"}
+		app.Spec.Destination = appsv1.ApplicationDestination{Namespace: "guestbook"}
+	})}
+	app, err := appServer.Create(context.Background(), &createReq)
+	assert.Nil(t, err)
+	// values set by the application are kept
+	assert.Equal(t, map[string]string{"team": "platform", "tier": "frontend"}, app.Labels)
+	assert.Equal(t, appsv1.ApplicationDestination{Server: "https://cluster-api.com", Namespace: "guestbook"}, app.Spec.Destination)
+	assert.True(t, app.CascadedDeletion())
+	assert.NotNil(t, app.Spec.SyncPolicy.Automated)
+
+	// the server of the template is not set on the applications referencing their cluster by name
+	createReq = ApplicationCreateRequest{Application: *newTestApp("guestbook-by-name", func(app *appsv1.Application) {
+		app.Spec.Destination = appsv1.ApplicationDestination{Name: "fake-cluster"}
+	})}
+	app, err = appServer.Create(context.Background(), &createReq)
+	assert.Nil(t, err)
+	assert.Equal(t, appsv1.ApplicationDestination{Name: "fake-cluster", Namespace: "default"}, app.Spec.Destination)
+}
+
+func TestGetManifestsAtRevision(t *testing.T) {
+	appServer := newTestAppServer().(*Server)
+	createReq := ApplicationCreateRequest{Application: *newTestApp("guestbook")}
+	_, err := appServer.Create(context.Background(), &createReq)
+	assert.Nil(t, err)
+
+	mockRepoServiceClient := mockreposerver.RepositoryServiceClient{}
+	for _, revision := range []string{"HEAD", "feature"} {
+		revision := revision
+		mockRepoServiceClient.On("GenerateManifest", mock.Anything, mock.MatchedBy(func(q *repository.ManifestRequest) bool {
+			return q.Revision == revision && q.Path == "some/path"
+		})).Return(&repository.ManifestResponse{Revision: revision}, nil)
+	}
+	mockRepoClient := &mockrepo.Clientset{}
+	mockRepoClient.On("NewRepositoryClient").Return(&fakeCloser{}, &mockRepoServiceClient, nil)
+	appServer.repoClientset = mockRepoClient
+
+	appName := "guestbook"
+	res, err := appServer.GetManifests(context.Background(), &ApplicationManifestQuery{Name: &appName, Revision: "feature"})
+	assert.Nil(t, err)
+	assert.Equal(t, "feature", res.Revision)
+	// the target revision of the application is used by default
+	res, err = appServer.GetManifests(context.Background(), &ApplicationManifestQuery{Name: &appName})
+	assert.Nil(t, err)
+	assert.Equal(t, "HEAD", res.Revision)
+}
+
+// fakeAppComparator compares applications to the given comparison result, generated at the given revisions
+type fakeAppComparator struct {
+	controller.AppStateManager
+	revisions  map[string]*appsv1.ComparisonResult
+	conditions []appsv1.ApplicationCondition
+}
+
+func (f *fakeAppComparator) CompareAppState(ctx context.Context, app *appsv1.Application, revision string, overrides []appsv1.ComponentParameter, noCache bool) (
+	*appsv1.ComparisonResult, *repository.ManifestResponse, []appsv1.ApplicationCondition, error) {
+	if revision == "" {
+		revision = app.Spec.Source.TargetRevision
+	}
+	return f.revisions[revision], nil, f.conditions, nil
+}
+
+func TestDiff(t *testing.T) {
+	appServer := newTestAppServer().(*Server)
+	createReq := ApplicationCreateRequest{Application: *newTestApp("guestbook")}
+	_, err := appServer.Create(context.Background(), &createReq)
+	assert.Nil(t, err)
+
+	svc := `{"apiVersion":"v1","kind":"Service","metadata":{"name":"svc","namespace":"default"}}`
+	target := `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"secret","namespace":"default"},"data":{"password":"bmV3","user":"YWRtaW4="}}`
+	live := `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"secret","namespace":"default"},"data":{"password":"b2xk","user":"YWRtaW4="}}`
+	appServer.appComparator = &fakeAppComparator{revisions: map[string]*appsv1.ComparisonResult{
+		"HEAD": {
+			Revision: "head-sha",
+			Status:   appsv1.ComparisonStatusSynced,
+			Resources: []appsv1.ResourceState{
+				{TargetState: svc, LiveState: svc, Status: appsv1.ComparisonStatusSynced},
+			},
+		},
+		"feature": {
+			Revision: "feature-sha",
+			Status:   appsv1.ComparisonStatusOutOfSync,
+			Resources: []appsv1.ResourceState{
+				{TargetState: "null", LiveState: svc, Status: appsv1.ComparisonStatusOutOfSync, Diff: appsv1.ResourceDiff{NormalizedLiveState: svc}},
+				{TargetState: target, LiveState: live, Status: appsv1.ComparisonStatusOutOfSync, Diff: appsv1.ResourceDiff{
+					NormalizedTargetState: target,
+					NormalizedLiveState:   live,
+					Modified:              true,
+					ChangedPaths:          []string{"data.password"},
+				}},
 			},
 		},
+	}}
+
+	appName := "guestbook"
+	res, err := appServer.Diff(context.Background(), &ApplicationDiffQuery{Name: &appName, Revision: "feature"})
+	assert.Nil(t, err)
+	assert.Equal(t, "feature-sha", res.Revision)
+	assert.Equal(t, string(appsv1.ComparisonStatusOutOfSync), res.Status)
+	if assert.Len(t, res.Items, 2) {
+		// extraneous resources are identified by their live state
+		assert.Equal(t, "Service", res.Items[0].Kind)
+		assert.Equal(t, "svc", res.Items[0].Name)
+		assert.Equal(t, "", res.Items[0].Diff.NormalizedTargetState)
+		assert.Equal(t, "Secret", res.Items[1].Kind)
+		assert.Equal(t, "default", res.Items[1].Namespace)
+		assert.True(t, res.Items[1].Diff.Modified)
+		assert.Equal(t, []string{"data.password"}, res.Items[1].Diff.ChangedPaths)
+		// secret data is hidden, changed keys are marked with an extra star
+		assert.NotContains(t, res.Items[1].Diff.NormalizedTargetState, "bmV3")
+		assert.NotContains(t, res.Items[1].Diff.NormalizedLiveState, "b2xk")
+		assert.Contains(t, res.Items[1].Diff.NormalizedTargetState, `"password":"*********"`)
+		assert.Contains(t, res.Items[1].Diff.NormalizedTargetState, `"user":"********"`)
+	}
+
+	// the target revision of the application is used by default
+	res, err = appServer.Diff(context.Background(), &ApplicationDiffQuery{Name: &appName})
+	assert.Nil(t, err)
+	assert.Equal(t, "head-sha", res.Revision)
+	assert.Equal(t, string(appsv1.ComparisonStatusSynced), res.Status)
+	assert.Len(t, res.Items, 1)
+
+	appServer.appComparator = &fakeAppComparator{
+		revisions: map[string]*appsv1.ComparisonResult{"missing": {Status: appsv1.ComparisonStatusUnknown}},
+		conditions: []appsv1.ApplicationCondition{
+			{Type: appsv1.ApplicationConditionComparisonError, Message: "unable to resolve 'missing' to a commit SHA"},
+		},
 	}
+	_, err = appServer.Diff(context.Background(), &ApplicationDiffQuery{Name: &appName, Revision: "missing"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "unable to resolve")
+}
+
+func TestListResourceStates(t *testing.T) {
+	appServer := newTestAppServer().(*Server)
+	createReq := ApplicationCreateRequest{Application: *newTestApp("guestbook")}
 	app, err := appServer.Create(context.Background(), &createReq)
 	assert.Nil(t, err)
-	assert.Equal(t, app.Spec.Project, "default")
+	secret := `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"secret"},"data":{"password":"c2VjcmV0"}}`
+	app.Status.ComparisonResult.Resources = []appsv1.ResourceState{
+		{TargetState: `{"apiVersion":"v1","kind":"Service","metadata":{"name":"svc"}}`, Status: appsv1.ComparisonStatusSynced},
+		{TargetState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"deploy","namespace":"other"}}`, Status: appsv1.ComparisonStatusOutOfSync},
+		{TargetState: "null", LiveState: secret, Status: appsv1.ComparisonStatusOutOfSync, Diff: appsv1.ResourceDiff{NormalizedLiveState: secret}},
+	}
+	_, err = appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Update(app)
+	assert.Nil(t, err)
+
+	appName := "guestbook"
+	ctx := context.Background()
+	res, err := appServer.ListResourceStates(ctx, &ApplicationResourceStatesQuery{Name: &appName, Limit: 2})
+	assert.Nil(t, err)
+	if assert.Len(t, res.Items, 2) {
+		assert.Equal(t, appsv1.ResourceStatus{Version: "v1", Kind: "Service", Namespace: "default", Name: "svc", Status: appsv1.ComparisonStatusSynced}, res.Items[0].Resource)
+		assert.Equal(t, "apps", res.Items[1].Resource.Group)
+		assert.Equal(t, "other", res.Items[1].Resource.Namespace)
+	}
+	assert.Equal(t, "2", res.Continue)
+	res, err = appServer.ListResourceStates(ctx, &ApplicationResourceStatesQuery{Name: &appName, Limit: 2, Continue: res.Continue})
+	assert.Nil(t, err)
+	if assert.Len(t, res.Items, 1) {
+		// extraneous resources are identified by their live state, and secret data is hidden
+		assert.Equal(t, "Secret", res.Items[0].Resource.Kind)
+		assert.NotContains(t, res.Items[0].State.LiveState, "c2VjcmV0")
+		assert.NotContains(t, res.Items[0].State.Diff.NormalizedLiveState, "c2VjcmV0")
+	}
+	assert.Equal(t, "", res.Continue)
+
+	res, err = appServer.ListResourceStates(ctx, &ApplicationResourceStatesQuery{Name: &appName, Group: "apps", Kind: "Deployment", ResourceName: "deploy"})
+	assert.Nil(t, err)
+	if assert.Len(t, res.Items, 1) {
+		assert.Equal(t, "deploy", res.Items[0].Resource.Name)
+	}
+
+	_, err = appServer.ListResourceStates(ctx, &ApplicationResourceStatesQuery{Name: &appName, Continue: "invalid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	app, err = appServer.Get(ctx, &ApplicationQuery{Name: &appName, ExcludeResourceStates: true})
+	assert.Nil(t, err)
+	assert.Len(t, app.Status.ComparisonResult.Resources, 0)
+	app, err = appServer.Get(ctx, &ApplicationQuery{Name: &appName})
+	assert.Nil(t, err)
+	assert.Len(t, app.Status.ComparisonResult.Resources, 3)
+}
+
+func TestManifestsArchive(t *testing.T) {
+	data, err := manifestsArchive("my-app", &repository.ManifestResponse{
+		Manifests: []string{
+			`{"apiVersion":"v1","kind":"Service","metadata":{"name":"svc","namespace":"default"}}`,
+			`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"deploy","namespace":"default"}}`,
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cm","namespace":"default"}}`,
+			`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"ns"}}`,
+		},
+		SourceFiles: []string{"app.yaml", "app.yaml", "config.jsonnet", ""},
+	})
+	assert.Nil(t, err)
+
+	gzr, err := gzip.NewReader(bytes.NewReader(data))
+	assert.Nil(t, err)
+	tr := tar.NewReader(gzr)
+	files := make(map[string]string)
+	var names []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(t, err)
+		content, err := ioutil.ReadAll(tr)
+		assert.Nil(t, err)
+		names = append(names, hdr.Name)
+		files[hdr.Name] = string(content)
+	}
+	assert.Equal(t, []string{"my-app/app.yaml", "my-app/config.jsonnet.yaml", "my-app/namespace-ns.yaml"}, names)
+	assert.Contains(t, files["my-app/app.yaml"], "kind: Service")
+	assert.Contains(t, files["my-app/app.yaml"], "---\napiVersion: apps/v1\nkind: Deployment")
+	assert.Contains(t, files["my-app/config.jsonnet.yaml"], "kind: ConfigMap")
+	assert.Contains(t, files["my-app/namespace-ns.yaml"], "kind: Namespace")
+}
+
+func TestCheckSyncOptions(t *testing.T) {
+	proj := &appsv1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "production"}}
+	assert.Nil(t, checkSyncOptions(proj, true))
+
+	prune := false
+	proj.Spec.SyncOptions = &appsv1.ProjectSyncOptions{Prune: &prune}
+	assert.Nil(t, checkSyncOptions(proj, false))
+	assert.NotNil(t, checkSyncOptions(proj, true))
+
+	op := appsv1.SyncOperation{Prune: true}
+	proj.ApplySyncOptions(&op)
+	assert.False(t, op.Prune)
+}
+
+func TestDeleteProtectedApp(t *testing.T) {
+	appServer := newTestAppServer()
+	createReq := ApplicationCreateRequest{Application: *newTestApp("protected", func(app *appsv1.Application) {
+		app.Annotations = map[string]string{common.AnnotationDeleteProtection: "true"}
+	})}
+	app, err := appServer.Create(context.Background(), &createReq)
+	assert.Nil(t, err)
+
+	_, err = appServer.Delete(context.Background(), &ApplicationDeleteRequest{Name: &app.Name})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = appServer.Delete(context.Background(), &ApplicationDeleteRequest{Name: &app.Name, Force: true})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = appServer.Delete(context.Background(), &ApplicationDeleteRequest{Name: &app.Name, Force: true, Reason: "decommissioned"})
+	assert.Nil(t, err)
+}
+
+func TestOperationProgress(t *testing.T) {
+	startedAt := metav1.Now()
+	running := &appsv1.OperationState{
+		Phase:     appsv1.OperationRunning,
+		StartedAt: startedAt,
+		SyncResult: &appsv1.SyncOperationResult{
+			Hooks: []*appsv1.HookStatus{{Kind: "Job", Name: "migrate", Type: appsv1.HookTypePreSync, Status: appsv1.OperationRunning}},
+		},
+	}
+	events := operationProgress(nil, running)
+	assert.Len(t, events, 3)
+	assert.Equal(t, OperationProgressStarted, events[0].Type)
+	assert.Equal(t, OperationProgressPhaseTransition, events[1].Type)
+	assert.Equal(t, "PreSync", events[1].SyncPhase)
+	assert.Equal(t, OperationProgressHookStatus, events[2].Type)
+
+	// no progress
+	assert.Len(t, operationProgress(running, running), 0)
+
+	completed := &appsv1.OperationState{
+		Phase:     appsv1.OperationSucceeded,
+		StartedAt: startedAt,
+		SyncResult: &appsv1.SyncOperationResult{
+			Resources: []*appsv1.ResourceDetails{{Kind: "Service", Namespace: "default", Name: "guestbook", Status: appsv1.ResourceDetailsSynced}},
+			Hooks:     []*appsv1.HookStatus{{Kind: "Job", Name: "migrate", Type: appsv1.HookTypePreSync, Status: appsv1.OperationSucceeded}},
+		},
+	}
+	events = operationProgress(running, completed)
+	assert.Len(t, events, 4)
+	assert.Equal(t, OperationProgressPhaseTransition, events[0].Type)
+	assert.Equal(t, "Sync", events[0].SyncPhase)
+	assert.Equal(t, OperationProgressResourceResult, events[1].Type)
+	assert.Equal(t, "guestbook", events[1].Resource.Name)
+	assert.Equal(t, OperationProgressHookStatus, events[2].Type)
+	assert.Equal(t, appsv1.OperationSucceeded, events[2].Hook.Status)
+	assert.Equal(t, OperationProgressCompleted, events[3].Type)
+}
+
+type fakeWatchOperationServer struct {
+	grpc.ServerStream
+	events []*OperationProgressEvent
+}
+
+func (s *fakeWatchOperationServer) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeWatchOperationServer) Send(event *OperationProgressEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestWatchCompletedOperation(t *testing.T) {
+	appServer := newTestAppServer()
+	createReq := ApplicationCreateRequest{Application: *newTestApp("guestbook", func(app *appsv1.Application) {
+		app.Status.OperationState = &appsv1.OperationState{Phase: appsv1.OperationFailed, StartedAt: metav1.Now()}
+	})}
+	app, err := appServer.Create(context.Background(), &createReq)
+	assert.Nil(t, err)
+
+	ws := &fakeWatchOperationServer{}
+	err = appServer.WatchOperation(&OperationWatchQuery{Name: &app.Name}, ws)
+	assert.Nil(t, err)
+	assert.Len(t, ws.events, 2)
+	assert.Equal(t, OperationProgressStarted, ws.events[0].Type)
+	assert.Equal(t, OperationProgressCompleted, ws.events[1].Type)
+}
+
+func TestBulkSync(t *testing.T) {
+	appServer := newTestAppServer()
+	for _, appName := range []string{"guestbook-1", "guestbook-2", "other"} {
+		createReq := ApplicationCreateRequest{Application: *newTestApp(appName, func(app *appsv1.Application) {
+			app.Labels = map[string]string{"release": "guestbook"}
+		})}
+		if appName == "other" {
+			createReq.Application.Labels = nil
+		}
+		_, err := appServer.Create(context.Background(), &createReq)
+		assert.Nil(t, err)
+	}
+
+	_, err := appServer.BulkSync(context.Background(), &ApplicationBulkSyncRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = appServer.BulkSync(context.Background(), &ApplicationBulkSyncRequest{Selector: "release in (", Parallelism: 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := appServer.BulkSync(context.Background(), &ApplicationBulkSyncRequest{Selector: "release=guestbook", Parallelism: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), resp.Succeeded)
+	assert.Equal(t, int64(0), resp.Failed)
+	assert.Equal(t, []ApplicationBulkResult{{Name: "guestbook-1", Succeeded: true}, {Name: "guestbook-2", Succeeded: true}}, resp.Results)
+	otherName := "other"
+	app, err := appServer.Get(context.Background(), &ApplicationQuery{Name: &otherName})
+	assert.Nil(t, err)
+	assert.Nil(t, app.Operation)
+
+	// the operations of the previous sync are still in progress, so no application is synced
+	_, err = appServer.BulkSync(context.Background(), &ApplicationBulkSyncRequest{Projects: []string{"default"}})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	app, err = appServer.Get(context.Background(), &ApplicationQuery{Name: &otherName})
+	assert.Nil(t, err)
+	assert.Nil(t, app.Operation)
+
+	resp, err = appServer.BulkRefresh(context.Background(), &ApplicationBulkRefreshRequest{Projects: []string{"default"}})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), resp.Succeeded)
+}
+
+func TestGarbageCollect(t *testing.T) {
+	appServer := newTestAppServer()
+	createReq := ApplicationCreateRequest{Application: *newTestApp("guestbook")}
+	for i := 0; i < controller.MaxHistoryCount+2; i++ {
+		createReq.Application.Status.History = append(createReq.Application.Status.History, appsv1.DeploymentInfo{ID: int64(i)})
+	}
+	_, err := appServer.Create(context.Background(), &createReq)
+	assert.Nil(t, err)
+
+	_, err = appServer.GarbageCollect(context.Background(), &ApplicationGarbageCollectRequest{OperationRetention: -1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := appServer.GarbageCollect(context.Background(), &ApplicationGarbageCollectRequest{DryRun: true})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), resp.Succeeded)
+	assert.Equal(t, []ApplicationBulkResult{{Name: "guestbook", Succeeded: true, Message: "removed 2 deployment(s) from history"}}, resp.Results)
+
+	resp, err = appServer.GarbageCollect(context.Background(), &ApplicationGarbageCollectRequest{Projects: []string{"other"}})
+	assert.Nil(t, err)
+	assert.Empty(t, resp.Results)
+}
+
+func TestGarbageCollectRequiresAdmin(t *testing.T) {
+	appServer := newTestAppServer().(*Server)
+	_, err := appServer.Create(context.Background(), &ApplicationCreateRequest{Application: *newTestApp("guestbook")})
+	assert.Nil(t, err)
+	appServer.enf.SetDefaultRole("role:readonly")
+	appServer.enf.SetClaimsEnforcerFunc(func(rvals ...interface{}) bool {
+		claims := rvals[0].(jwt.MapClaims)
+		return appServer.enf.Enforce(append([]interface{}{claims["sub"]}, rvals[1:]...)...)
+	})
+	// updating all the applications is not enough
+	assert.NoError(t, appServer.enf.SetUserPolicy("p, role:app-admin, applications, *, default/*, allow\ng, app-admin, role:app-admin"))
+
+	for _, user := range []string{"readonly", "app-admin"} {
+		ctx := context.WithValue(context.Background(), "claims", jwt.MapClaims{"sub": user})
+		_, err = appServer.GarbageCollect(ctx, &ApplicationGarbageCollectRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), user)
+	}
+
+	ctx := context.WithValue(context.Background(), "claims", jwt.MapClaims{"sub": "admin"})
+	_, err = appServer.GarbageCollect(ctx, &ApplicationGarbageCollectRequest{DryRun: true})
+	assert.Nil(t, err)
+}
+
+func TestGetApplicationSyncStatus(t *testing.T) {
+	appServer := newTestAppServer()
+	createReq := ApplicationCreateRequest{Application: *newTestApp("guestbook", func(app *appsv1.Application) {
+		app.Status = appsv1.ApplicationStatus{
+			ComparisonResult: appsv1.ComparisonResult{Status: appsv1.ComparisonStatusSynced, Revision: "abc123"},
+			Health:           appsv1.HealthStatus{Status: appsv1.HealthStatusHealthy},
+			OperationState:   &appsv1.OperationState{Phase: appsv1.OperationSucceeded},
+		}
+	})}
+	app, err := appServer.Create(context.Background(), &createReq)
+	assert.Nil(t, err)
+
+	syncStatus, err := appServer.GetApplicationSyncStatus(context.Background(), &ApplicationSyncStatusQuery{Name: &app.Name})
+	assert.Nil(t, err)
+	assert.Equal(t, ApplicationSyncStatus{
+		Name:           "guestbook",
+		SyncStatus:     "Synced",
+		Revision:       "abc123",
+		HealthStatus:   "Healthy",
+		OperationPhase: "Succeeded",
+	}, *syncStatus)
+
+	_, err = appServer.Sync(context.Background(), &ApplicationSyncRequest{Name: &app.Name})
+	assert.Nil(t, err)
+	syncStatus, err = appServer.GetApplicationSyncStatus(context.Background(), &ApplicationSyncStatusQuery{Name: &app.Name})
+	assert.Nil(t, err)
+	assert.True(t, syncStatus.OperationInProgress)
+	assert.Equal(t, "", syncStatus.OperationPhase)
+}
+
+func TestSummary(t *testing.T) {
+	appServer := newTestAppServer()
+	for _, appName := range []string{"guestbook", "guestbook-synced"} {
+		createReq := ApplicationCreateRequest{Application: *newTestApp(appName)}
+		if appName == "guestbook-synced" {
+			createReq.Application.Status.ComparisonResult.Status = appsv1.ComparisonStatusSynced
+			createReq.Application.Status.Health.Status = appsv1.HealthStatusHealthy
+		}
+		_, err := appServer.Create(context.Background(), &createReq)
+		assert.Nil(t, err)
+	}
+
+	summary, err := appServer.Summary(context.Background(), &ApplicationQuery{})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), summary.Total)
+	assert.Equal(t, map[string]int64{"Synced": 1, "Unknown": 1}, summary.SyncStatus)
+	assert.Equal(t, map[string]int64{"Healthy": 1, "Unknown": 1}, summary.HealthStatus)
+	assert.Equal(t, map[string]int64{"default": 2}, summary.Projects)
+
+	summary, err = appServer.Summary(context.Background(), &ApplicationQuery{Projects: []string{"other"}})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), summary.Total)
+}
+
+func TestDeploymentMetrics(t *testing.T) {
+	appServer := newTestAppServer()
+	sha := "9d921f65f3c5373b682e2eb4b37afba6592e8f8b"
+	deployedAt := func(ago time.Duration) metav1.Time {
+		return metav1.NewTime(fakeCommitTime.Add(48*time.Hour - ago))
+	}
+	histories := map[string][]appsv1.DeploymentInfo{
+		// deployed 2 and 4 hours after the commit, and once more before the period
+		"guestbook": {
+			{ID: 1, Revision: sha, DeployedAt: deployedAt(60 * 24 * time.Hour)},
+			{ID: 2, Revision: sha, DeployedAt: deployedAt(46 * time.Hour)},
+			{ID: 3, Revision: sha, DeployedAt: deployedAt(44 * time.Hour)},
+		},
+		// deployed from a branch, whose commit time is unknown
+		"other": {
+			{ID: 1, Revision: "master", DeployedAt: deployedAt(time.Hour)},
+		},
+	}
+	for appName, history := range histories {
+		createReq := ApplicationCreateRequest{Application: *newTestApp(appName, func(app *appsv1.Application) {
+			app.Status.History = history
+		})}
+		_, err := appServer.Create(context.Background(), &createReq)
+		assert.Nil(t, err)
+	}
+
+	res, err := appServer.DeploymentMetrics(context.Background(), &DeploymentMetricsQuery{})
+	assert.Nil(t, err)
+	if assert.Len(t, res.Applications, 2) {
+		guestbook := res.Applications[0]
+		assert.Equal(t, "guestbook", guestbook.Name)
+		assert.Equal(t, int64(2), guestbook.Deployments)
+		assert.InDelta(t, 2.0/30, guestbook.DeploymentsPerDay, 0.001)
+		assert.Equal(t, int64(3*60*60), guestbook.LeadTimeSeconds)
+		other := res.Applications[1]
+		assert.Equal(t, int64(1), other.Deployments)
+		assert.Equal(t, int64(0), other.LeadTimeSeconds)
+	}
+	if assert.Len(t, res.Projects, 1) {
+		assert.Equal(t, "default", res.Projects[0].Name)
+		assert.Equal(t, int64(3), res.Projects[0].Deployments)
+	}
+
+	_, err = appServer.DeploymentMetrics(context.Background(), &DeploymentMetricsQuery{Days: -1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRevisionMetadata(t *testing.T) {
+	appServer := newTestAppServer()
+	createReq := ApplicationCreateRequest{Application: *newTestApp("guestbook")}
+	_, err := appServer.Create(context.Background(), &createReq)
+	assert.Nil(t, err)
+
+	appName := "guestbook"
+	revision := "9d921f65f3c5373b682e2eb4b37afba6592e8f8b"
+	metadata, err := appServer.RevisionMetadata(context.Background(), &RevisionMetadataQuery{Name: &appName, Revision: &revision})
+	assert.Nil(t, err)
+	assert.Equal(t, fakeCommitTime, *metadata.CommitTime)
+
+	appName = "unknown"
+	_, err = appServer.RevisionMetadata(context.Background(), &RevisionMetadataQuery{Name: &appName, Revision: &revision})
+	assert.Error(t, err)
+}
+
+func TestRollbackDisablesAutoSync(t *testing.T) {
+	appServer := newTestAppServer().(*Server)
+	createReq := ApplicationCreateRequest{Application: *newTestApp("guestbook", func(app *appsv1.Application) {
+		app.Spec.SyncPolicy = &appsv1.SyncPolicy{Automated: &appsv1.SyncPolicyAutomated{}}
+	})}
+	app, err := appServer.Create(context.Background(), &createReq)
+	assert.Nil(t, err)
+	app.Status.History = []appsv1.DeploymentInfo{
+		{ID: 1, Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"},
+		{ID: 2, Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"},
+	}
+	_, err = appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Update(app)
+	assert.Nil(t, err)
+
+	appName := "guestbook"
+	// dry runs keep the automated sync enabled
+	app, err = appServer.Rollback(context.Background(), &ApplicationRollbackRequest{Name: &appName, ID: 1, DryRun: true})
+	assert.Nil(t, err)
+	assert.NotNil(t, app.Spec.SyncPolicy.Automated)
+	app.Operation = nil
+	app.Status.OperationLock = nil
+	_, err = appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Update(app)
+	assert.Nil(t, err)
+
+	app, err = appServer.Rollback(context.Background(), &ApplicationRollbackRequest{Name: &appName, ID: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", app.Operation.Sync.Revision)
+	// the deployment did not record its source, so the current source of the app is synced
+	assert.Nil(t, app.Operation.Sync.Source)
+	assert.NotNil(t, app.Spec.SyncPolicy)
+	assert.Nil(t, app.Spec.SyncPolicy.Automated)
+
+	// the automated sync is enabled again if the rollback cannot start
+	app.Spec.SyncPolicy = &appsv1.SyncPolicy{Automated: &appsv1.SyncPolicyAutomated{}}
+	_, err = appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Update(app)
+	assert.Nil(t, err)
+	_, err = appServer.Rollback(context.Background(), &ApplicationRollbackRequest{Name: &appName, ID: 2})
+	assert.True(t, argo.IsOperationLockedError(err))
+	app, err = appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Get(appName, metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.NotNil(t, app.Spec.SyncPolicy.Automated)
+
+	_, err = appServer.Rollback(context.Background(), &ApplicationRollbackRequest{Name: &appName, ID: 3})
+	assert.Error(t, err)
+}
+
+func TestRollbackRestoresSource(t *testing.T) {
+	appServer := newTestAppServer().(*Server)
+	app, err := appServer.Create(context.Background(), &ApplicationCreateRequest{Application: *newTestApp("guestbook")})
+	assert.Nil(t, err)
+	source := app.Spec.Source.DeepCopy()
+	source.Path = "old-path"
+	source.ValuesFiles = []string{"values-old.yaml"}
+	app.Status.History = []appsv1.DeploymentInfo{{ID: 1, Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Source: *source}}
+	_, err = appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Update(app)
+	assert.Nil(t, err)
+
+	appName := "guestbook"
+	app, err = appServer.Rollback(context.Background(), &ApplicationRollbackRequest{Name: &appName, ID: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, source, app.Operation.Sync.Source)
+	// the spec of the app is left untouched
+	assert.NotEqual(t, "old-path", app.Spec.Source.Path)
+}
+
+func TestAppDeploymentStats(t *testing.T) {
+	now := time.Now()
+	var history []appsv1.DeploymentInfo
+	for i := 0; i < controller.MaxHistoryCount; i++ {
+		history = append(history, appsv1.DeploymentInfo{ID: int64(i), DeployedAt: metav1.NewTime(now.Add(time.Duration(i-10) * time.Hour))})
+	}
+	app := appsv1.Application{Status: appsv1.ApplicationStatus{History: history}}
+	// older deployments were dropped from the full history
+	stats := appDeploymentStats(app, now.Add(-30*24*time.Hour), nil)
+	assert.Equal(t, history[0].DeployedAt.Time, stats.periodStart)
+	metrics := stats.metrics("guestbook", now)
+	assert.Equal(t, int64(controller.MaxHistoryCount), metrics.Deployments)
+	// the frequency of periods shorter than a day is computed over a day
+	assert.Equal(t, float64(controller.MaxHistoryCount), metrics.DeploymentsPerDay)
+
+	// the period of the project covers the deployments of its other applications before that period
+	projectStats := &deploymentStats{periodStart: stats.periodStart}
+	projectStats.add(stats)
+	projectStats.add(&deploymentStats{
+		periodStart: now.Add(-30 * 24 * time.Hour),
+		deployments: []deployment{{deployedAt: now.Add(-24 * time.Hour)}, {deployedAt: now.Add(-time.Hour)}},
+	})
+	assert.Equal(t, now.Add(-30*24*time.Hour), projectStats.periodStart)
+	assert.Equal(t, int64(controller.MaxHistoryCount+2), projectStats.metrics("default", now).Deployments)
+}
+
+func TestListApps(t *testing.T) {
+	appServer := newTestAppServer()
+	for _, appName := range []string{"guestbook-b", "guestbook-a", "other", "guestbook-c"} {
+		createReq := ApplicationCreateRequest{Application: *newTestApp(appName, func(app *appsv1.Application) {
+			app.Labels = map[string]string{"team": "guestbook"}
+		})}
+		if appName == "other" {
+			createReq.Application.Labels["team"] = "other"
+		}
+		if appName == "guestbook-c" {
+			createReq.Application.Status.ComparisonResult.Status = appsv1.ComparisonStatusSynced
+			createReq.Application.Status.Health.Status = appsv1.HealthStatusHealthy
+		}
+		_, err := appServer.Create(context.Background(), &createReq)
+		assert.Nil(t, err)
+	}
+	appNames := func(apps *appsv1.ApplicationList) []string {
+		var names []string
+		for _, a := range apps.Items {
+			names = append(names, a.Name)
+		}
+		return names
+	}
+
+	apps, err := appServer.List(context.Background(), &ApplicationQuery{Limit: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"guestbook-a", "guestbook-b"}, appNames(apps))
+	assert.Equal(t, "guestbook-c", apps.Continue)
+	apps, err = appServer.List(context.Background(), &ApplicationQuery{Limit: 2, Continue: apps.Continue})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"guestbook-c", "other"}, appNames(apps))
+	assert.Equal(t, "", apps.Continue)
+
+	apps, err = appServer.List(context.Background(), &ApplicationQuery{Selector: "team=guestbook", Search: "*-[ab]"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"guestbook-a", "guestbook-b"}, appNames(apps))
+
+	apps, err = appServer.List(context.Background(), &ApplicationQuery{SyncStatus: []string{"Synced"}})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"guestbook-c"}, appNames(apps))
+	apps, err = appServer.List(context.Background(), &ApplicationQuery{HealthStatus: []string{"Unknown"}, Projects: []string{"default"}})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"guestbook-a", "guestbook-b", "other"}, appNames(apps))
+
+	summary, err := appServer.Summary(context.Background(), &ApplicationQuery{Search: "guestbook-*"})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), summary.Total)
+
+	_, err = appServer.List(context.Background(), &ApplicationQuery{Selector: "team in (guestbook"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestFindAppPod(t *testing.T) {
+	app := appsv1.Application{Status: appsv1.ApplicationStatus{ComparisonResult: appsv1.ComparisonResult{
+		Resources: []appsv1.ResourceState{{
+			LiveState: `{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "guestbook", "namespace": "default"}}`,
+			ChildLiveResources: []appsv1.ResourceNode{{
+				State: `{"apiVersion": "apps/v1", "kind": "ReplicaSet", "metadata": {"name": "guestbook-5b9c", "namespace": "default"}}`,
+				Children: []appsv1.ResourceNode{{
+					State: `{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "guestbook-5b9c-x2v7q", "namespace": "default"}}`,
+				}},
+			}},
+		}, {
+			LiveState: `{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "migration", "namespace": "jobs"}}`,
+		}},
+	}}}
+
+	assert.NotNil(t, findAppPod(&app, "guestbook-5b9c-x2v7q", "default"))
+	assert.NotNil(t, findAppPod(&app, "migration", "jobs"))
+	assert.Nil(t, findAppPod(&app, "migration", "default"))
+	assert.Nil(t, findAppPod(&app, "guestbook-5b9c", "default"))
+}
+
+func TestEnsurePodBelongsToApp(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(&apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook-pod", Namespace: "default", Labels: map[string]string{common.LabelApplicationName: "guestbook"}},
+	}, &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-pod", Namespace: "default"},
+	})
+	s := &Server{}
+	assert.NoError(t, s.ensurePodBelongsToApp("guestbook", "guestbook-pod", "default", kubeclientset))
+	err := s.ensurePodBelongsToApp("guestbook", "other-pod", "default", kubeclientset)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestEnsureAppPod(t *testing.T) {
+	appServer := newTestAppServer().(*Server)
+	proj, err := appServer.appclientset.ArgoprojV1alpha1().AppProjects(testNamespace).Get("default", metav1.GetOptions{})
+	assert.NoError(t, err)
+	proj.Spec.Destinations = []appsv1.ApplicationDestination{{Server: "https://cluster-api.com", Namespace: "default"}}
+	_, err = appServer.appclientset.ArgoprojV1alpha1().AppProjects(testNamespace).Update(proj)
+	assert.NoError(t, err)
+	labels := map[string]string{common.LabelApplicationName: "guestbook"}
+	kubeclientset := fake.NewSimpleClientset(&apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook-pod", Namespace: "default", Labels: labels},
+	}, &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook-pod", Namespace: "kube-system", Labels: labels},
+	})
+	app := &appsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: testNamespace},
+		Spec: appsv1.ApplicationSpec{
+			Destination: appsv1.ApplicationDestination{Name: "fake-cluster", Namespace: "default"},
+		},
+		Status: appsv1.ApplicationStatus{ComparisonResult: appsv1.ComparisonResult{
+			Resources: []appsv1.ResourceState{{
+				LiveState: `{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "migration", "namespace": "jobs"}}`,
+			}},
+		}},
+	}
+
+	// pods of the resource tree belong to the application in any namespace
+	assert.NoError(t, appServer.ensureAppPod(context.Background(), app, "migration", "jobs", kubeclientset))
+	assert.NoError(t, appServer.ensureAppPod(context.Background(), app, "guestbook-pod", "default", kubeclientset))
+	// labeled pods outside of the destinations of the project do not
+	err = appServer.ensureAppPod(context.Background(), app, "guestbook-pod", "kube-system", kubeclientset)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+type fakeExecServer struct {
+	grpc.ServerStream
+	requests []*ApplicationExecRequest
+}
+
+func (s *fakeExecServer) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeExecServer) Recv() (*ApplicationExecRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *fakeExecServer) Send(*ApplicationExecOutput) error {
+	return nil
+}
+
+func TestExecRequiresPod(t *testing.T) {
+	appServer := newTestAppServer()
+	name := "guestbook"
+	err := appServer.Exec(&fakeExecServer{requests: []*ApplicationExecRequest{{Name: &name}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestForwardExecInput(t *testing.T) {
+	ws := &fakeExecServer{requests: []*ApplicationExecRequest{
+		{Stdin: []byte("ls\n")},
+		{TerminalSize: &TerminalSize{Width: 80, Height: 24}},
+		{TerminalSize: &TerminalSize{Width: 120, Height: 40}},
+		{Stdin: []byte("exit\n")},
+	}}
+	stdin, stdinWriter := io.Pipe()
+	sizes := make(terminalSizeQueue, 1)
+	go forwardExecInput(ws, stdinWriter, sizes)
+
+	input, err := ioutil.ReadAll(stdin)
+	assert.NoError(t, err)
+	assert.Equal(t, "ls\nexit\n", string(input))
+	// only the last resize is pending
+	assert.Equal(t, &remotecommand.TerminalSize{Width: 120, Height: 40}, sizes.Next())
+	assert.Nil(t, sizes.Next())
+}
+
+func TestDiffParameterOverrides(t *testing.T) {
+	changes := diffParameterOverrides(&appsv1.ApplicationSource{
+		ComponentParameterOverrides: []appsv1.ComponentParameter{
+			{Component: "guestbook-ui", Name: "image", Value: "guestbook:v1"},
+			{Component: "guestbook-ui", Name: "replicas", Value: "1"},
+			{Component: "redis", Name: "port", Value: "6379"},
+		},
+		Override: map[string]string{"image.tag": "v1", "replicas": "1"},
+	}, &appsv1.ApplicationSource{
+		ComponentParameterOverrides: []appsv1.ComponentParameter{
+			{Component: "guestbook-ui", Name: "image", Value: "guestbook:v2"},
+			{Component: "guestbook-ui", Name: "replicas", Value: "1"},
+			{Name: "service.type", Value: "LoadBalancer"},
+		},
+		Override: map[string]string{"image.tag": "v2", "replicas": "1"},
+	})
+	assert.Len(t, changes, 4)
+	assert.Equal(t, "service.type", changes[0].Name)
+	assert.Nil(t, changes[0].PreviousValue)
+	assert.Equal(t, "LoadBalancer", *changes[0].Value)
+	assert.Equal(t, "image", changes[1].Name)
+	assert.Equal(t, "guestbook:v1", *changes[1].PreviousValue)
+	assert.Equal(t, "guestbook:v2", *changes[1].Value)
+	assert.Equal(t, "port", changes[2].Name)
+	assert.Equal(t, "6379", *changes[2].PreviousValue)
+	assert.Nil(t, changes[2].Value)
+	// the changes of the key/value overrides of the source come last
+	assert.True(t, changes[3].SourceOverride)
+	assert.Equal(t, "image.tag", changes[3].Name)
+	assert.Equal(t, "v1", *changes[3].PreviousValue)
+	assert.Equal(t, "v2", *changes[3].Value)
+
+	// all the overrides of new applications are changes
+	changes = diffParameterOverrides(nil, &appsv1.ApplicationSource{Override: map[string]string{"image.tag": "v1"}})
+	assert.Len(t, changes, 1)
+	assert.True(t, changes[0].SourceOverride)
+	assert.Nil(t, changes[0].PreviousValue)
+}
+
+func TestParameterOverrideRecords(t *testing.T) {
+	appServer := newTestAppServer()
+	app, err := appServer.Create(context.Background(), &ApplicationCreateRequest{Application: *newTestApp("guestbook", func(app *appsv1.Application) {
+		app.Spec.Source.ComponentParameterOverrides = []appsv1.ComponentParameter{{Component: "guestbook-ui", Name: "image", Value: "guestbook:v1"}}
+	})})
+	assert.NoError(t, err)
+
+	app.Spec.Source.ComponentParameterOverrides = []appsv1.ComponentParameter{{Component: "guestbook-ui", Name: "replicas", Value: "2"}}
+	_, err = appServer.Update(context.Background(), &ApplicationUpdateRequest{Application: app})
+	assert.NoError(t, err)
+	// no changes are recorded if the overrides are unchanged
+	_, err = appServer.Update(context.Background(), &ApplicationUpdateRequest{Application: app})
+	assert.NoError(t, err)
+	app.Spec.Source.Override = map[string]string{"image.tag": "v2"}
+	_, err = appServer.Update(context.Background(), &ApplicationUpdateRequest{Application: app})
+	assert.NoError(t, err)
+
+	records, err := appServer.ListParameterOverrideRecords(context.Background(), &ApplicationParameterAuditQuery{Name: &app.Name})
+	assert.NoError(t, err)
+	assert.Len(t, records.Items, 3)
+	assert.Len(t, records.Items[0].Changes, 1)
+	assert.Equal(t, "guestbook:v1", *records.Items[0].Changes[0].Value)
+	assert.Len(t, records.Items[1].Changes, 2)
+	assert.Equal(t, "image", records.Items[1].Changes[0].Name)
+	assert.Nil(t, records.Items[1].Changes[0].Value)
+	assert.Equal(t, "replicas", records.Items[1].Changes[1].Name)
+	assert.Equal(t, "2", *records.Items[1].Changes[1].Value)
+	assert.Len(t, records.Items[2].Changes, 1)
+	assert.True(t, records.Items[2].Changes[0].SourceOverride)
+	assert.Equal(t, "v2", *records.Items[2].Changes[0].Value)
+
+	// the records are kept in a config map rather than in events, which expire
+	cm, err := appServer.(*Server).kubeclientset.CoreV1().ConfigMaps(testNamespace).Get(common.ParameterOverrideRecordsPrefix+app.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, app.Name, cm.Labels[common.LabelKeyParameterOverrideRecords])
+
+	records, err = appServer.ListParameterOverrideRecords(context.Background(), &ApplicationParameterAuditQuery{Name: &app.Name, User: "other"})
+	assert.NoError(t, err)
+	assert.Len(t, records.Items, 0)
+}
+
+func TestParameterOverrideRecordsRetention(t *testing.T) {
+	s := newTestAppServer().(*Server)
+	app, err := s.Create(context.Background(), &ApplicationCreateRequest{Application: *newTestApp("guestbook")})
+	assert.NoError(t, err)
+	for i := 0; i < maxParameterOverrideRecords+5; i++ {
+		value := strconv.Itoa(i)
+		err := s.appendParameterOverrideRecord(app, &ParameterOverrideRecord{User: "admin", Changes: []*ParameterOverrideChange{{Name: "replicas", Value: &value}}})
+		assert.NoError(t, err)
+	}
+	records, err := s.ListParameterOverrideRecords(context.Background(), &ApplicationParameterAuditQuery{Name: &app.Name})
+	assert.NoError(t, err)
+	assert.Len(t, records.Items, maxParameterOverrideRecords)
+	assert.Equal(t, "5", *records.Items[0].Changes[0].Value)
+}
+
+func TestParameterChangesRate(t *testing.T) {
+	s := newTestAppServer().(*Server)
+	s.paramLimiters[""] = &paramLimiter{RateLimiter: flowcontrol.NewFakeNeverRateLimiter(), lastUsed: time.Now()}
+	changes := []*ParameterOverrideChange{{Name: "image"}}
+	err := s.checkParameterChangesRate(context.Background(), changes)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	// requests which do not change parameter overrides are not limited
+	assert.NoError(t, s.checkParameterChangesRate(context.Background(), nil))
+
+	// the limiters of the users who have been idle are dropped
+	s.paramLimiters["idle"] = &paramLimiter{RateLimiter: flowcontrol.NewFakeNeverRateLimiter(), lastUsed: time.Now().Add(-2 * paramLimiterIdleTimeout)}
+	s.paramLimitersSwept = time.Time{}
+	assert.Equal(t, codes.ResourceExhausted, status.Code(s.checkParameterChangesRate(context.Background(), changes)))
+	assert.NotContains(t, s.paramLimiters, "idle")
+	assert.Contains(t, s.paramLimiters, "")
+}
+
+func TestCreateExistingAppIsNotRateLimited(t *testing.T) {
+	s := newTestAppServer().(*Server)
+	app := newTestApp("guestbook", func(app *appsv1.Application) {
+		app.Spec.Source.Override = map[string]string{"image.tag": "v1"}
+	})
+	_, err := s.Create(context.Background(), &ApplicationCreateRequest{Application: *app})
+	assert.NoError(t, err)
+	s.paramLimiters[""] = &paramLimiter{RateLimiter: flowcontrol.NewFakeNeverRateLimiter(), lastUsed: time.Now()}
+	// creating the application again with the same spec changes nothing
+	_, err = s.Create(context.Background(), &ApplicationCreateRequest{Application: *app})
+	assert.NoError(t, err)
+}
+
+func TestResourceActions(t *testing.T) {
+	s := newTestAppServer().(*Server)
+	app, err := s.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Create(&appsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: testNamespace},
+		Spec:       appsv1.ApplicationSpec{Project: "default"},
+		Status: appsv1.ApplicationStatus{ComparisonResult: appsv1.ComparisonResult{
+			Resources: []appsv1.ResourceState{{
+				LiveState: `{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "guestbook", "namespace": "default"}}`,
+			}},
+		}},
+	})
+	assert.NoError(t, err)
+	query := &ApplicationResourceActionsQuery{Name: &app.Name, ResourceName: "guestbook", APIVersion: "apps/v1", Kind: "Deployment"}
+
+	// no actions are defined without the argocd-cm configmap
+	actions, err := s.ListResourceActions(context.Background(), query)
+	assert.NoError(t, err)
+	assert.Len(t, actions.Actions, 0)
+
+	_, err = s.kubeclientset.CoreV1().ConfigMaps(testNamespace).Create(&apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: testNamespace},
+		Data: map[string]string{"resource.actions": `
+- group: apps
+  kind: Deployment
+  name: restart
+  lua: return obj
+- group: batch
+  kind: CronJob
+  name: resume
+  lua: return obj
+`},
+	})
+	assert.NoError(t, err)
+	actions, err = s.ListResourceActions(context.Background(), query)
+	assert.NoError(t, err)
+	if assert.Len(t, actions.Actions, 1) {
+		assert.Equal(t, "restart", actions.Actions[0].Name)
+	}
+
+	query.ResourceName = "other"
+	_, err = s.ListResourceActions(context.Background(), query)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.RunResourceAction(context.Background(), &ApplicationRunResourceActionRequest{
+		Name: &app.Name, ResourceName: "guestbook", APIVersion: "apps/v1", Kind: "Deployment", Action: "resume",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListResourceTreeEvents(t *testing.T) {
+	newEvent := func(name, namespace string, uid types.UID, lastSeen time.Time) *apiv1.Event {
+		return &apiv1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: namespace},
+			InvolvedObject: apiv1.ObjectReference{UID: uid},
+			LastTimestamp:  metav1.NewTime(lastSeen),
+		}
+	}
+	now := time.Now()
+	kubeclientset := fake.NewSimpleClientset(
+		newEvent("deploy", "default", "1", now),
+		newEvent("pod", "default", "3", now.Add(-time.Minute)),
+		newEvent("namespace", "default", "4", now),
+		newEvent("other", "default", "5", now),
+		newEvent("other-namespace", "jobs", "3", now),
+	)
+	app := appsv1.Application{Status: appsv1.ApplicationStatus{ComparisonResult: appsv1.ComparisonResult{
+		Resources: []appsv1.ResourceState{{
+			LiveState: `{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "guestbook", "namespace": "default", "uid": "1"}}`,
+			ChildLiveResources: []appsv1.ResourceNode{{
+				State: `{"apiVersion": "apps/v1", "kind": "ReplicaSet", "metadata": {"name": "guestbook-5b9c", "namespace": "default", "uid": "2"}}`,
+				Children: []appsv1.ResourceNode{{
+					State: `{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "guestbook-5b9c-x2v7q", "namespace": "default", "uid": "3"}}`,
+				}},
+			}},
+		}, {
+			LiveState: `{"apiVersion": "v1", "kind": "Namespace", "metadata": {"name": "guestbook", "uid": "4"}}`,
+		}},
+	}}}
+
+	events, err := listResourceTreeEvents(kubeclientset, &app)
+	assert.NoError(t, err)
+	names := make([]string, 0)
+	for _, event := range events {
+		names = append(names, event.Name)
+	}
+	assert.ElementsMatch(t, []string{"deploy", "pod", "namespace"}, names)
+
+	// the events are selected by the UIDs of the resources
+	selectors := make([]string, 0)
+	for _, action := range kubeclientset.Actions() {
+		if listAction, ok := action.(testcore.ListAction); ok {
+			selectors = append(selectors, listAction.GetListRestrictions().Fields.String())
+		}
+	}
+	assert.ElementsMatch(t, []string{"involvedObject.uid=1", "involvedObject.uid=2", "involvedObject.uid=3", "involvedObject.uid=4"}, selectors)
 }
@@ -0,0 +1,123 @@
+package application
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ExecWebSocketPath is the path of the endpoint serving exec sessions over websockets, for the clients
+// which cannot open gRPC streams, such as browsers
+const ExecWebSocketPath = "/api/v1/stream/exec"
+
+// execWebSocketMessage is a message sent to the websocket clients of an exec session: either the output of
+// the command, or the error which ended the session
+type execWebSocketMessage struct {
+	Result *ApplicationExecOutput `json:"result,omitempty"`
+	Error  *execWebSocketError    `json:"error,omitempty"`
+}
+
+type execWebSocketError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// execWebSocketHandler bridges the websocket exec sessions to the Exec stream of the gRPC server, so that
+// they are authenticated, authorized and audited like the gRPC sessions. Each websocket message holds an
+// ApplicationExecRequest in JSON
+type execWebSocketHandler struct {
+	client ApplicationServiceClient
+}
+
+// NewExecWebSocketHandler returns the handler of the exec sessions over websockets, which runs them with
+// the given client of the gRPC server
+func NewExecWebSocketHandler(client ApplicationServiceClient) http.Handler {
+	h := &execWebSocketHandler{client: client}
+	return websocket.Server{Handshake: checkSameOrigin, Handler: h.serve}
+}
+
+// checkSameOrigin rejects the websockets opened by the pages of other origins, which would otherwise be
+// authenticated by the session cookie of the user
+func checkSameOrigin(config *websocket.Config, r *http.Request) error {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return nil
+	}
+	originURL, err := url.Parse(origin)
+	if err != nil {
+		return err
+	}
+	if originURL.Host != r.Host {
+		return fmt.Errorf("origin %s is not allowed", origin)
+	}
+	config.Origin = originURL
+	return nil
+}
+
+// serve bridges a websocket to an Exec stream until either ends
+func (h *execWebSocketHandler) serve(conn *websocket.Conn) {
+	r := conn.Request()
+	ctx, cancel := context.WithCancel(metadata.NewOutgoingContext(r.Context(), forwardedMetadata(r)))
+	defer cancel()
+	stream, err := h.client.Exec(ctx)
+	if err != nil {
+		sendExecError(conn, err)
+		return
+	}
+	go func() {
+		// the websocket has no half-close: the session ends as soon as the client stops sending
+		defer cancel()
+		for {
+			var q ApplicationExecRequest
+			if err := websocket.JSON.Receive(conn, &q); err != nil {
+				if err != io.EOF {
+					log.Debugf("exec websocket closed: %v", err)
+				}
+				return
+			}
+			if err := stream.Send(&q); err != nil {
+				return
+			}
+		}
+	}()
+	for {
+		out, err := stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				sendExecError(conn, err)
+			}
+			return
+		}
+		if err := websocket.JSON.Send(conn, execWebSocketMessage{Result: out}); err != nil {
+			return
+		}
+	}
+}
+
+// forwardedMetadata returns the credentials of the websocket request, to be authenticated by the gRPC server
+func forwardedMetadata(r *http.Request) metadata.MD {
+	md := metadata.MD{}
+	if authorization := r.Header["Authorization"]; len(authorization) > 0 {
+		md.Set("authorization", authorization...)
+	}
+	if cookies := r.Header["Cookie"]; len(cookies) > 0 {
+		md.Set("cookie", cookies...)
+	}
+	return md
+}
+
+// sendExecError sends the error which ended the exec session to the websocket
+func sendExecError(conn *websocket.Conn, err error) {
+	st := status.Convert(err)
+	_ = websocket.JSON.Send(conn, execWebSocketMessage{Error: &execWebSocketError{Code: st.Code().String(), Message: st.Message()}})
+}
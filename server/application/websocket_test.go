@@ -0,0 +1,111 @@
+package application
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type fakeExecClient struct {
+	grpc.ClientStream
+	ctx      context.Context
+	requests chan *ApplicationExecRequest
+}
+
+func (c *fakeExecClient) Send(q *ApplicationExecRequest) error {
+	c.requests <- q
+	return nil
+}
+
+func (c *fakeExecClient) Recv() (*ApplicationExecOutput, error) {
+	q := <-c.requests
+	if string(q.Stdin) == "exit\n" {
+		return nil, io.EOF
+	}
+	if q.PodName != nil {
+		md, _ := metadata.FromOutgoingContext(c.ctx)
+		if len(md["authorization"]) == 0 {
+			return nil, status.Errorf(codes.Unauthenticated, "no session information")
+		}
+		return &ApplicationExecOutput{Stdout: []byte("$ ")}, nil
+	}
+	return &ApplicationExecOutput{Stdout: q.Stdin}, nil
+}
+
+type fakeApplicationServiceClient struct {
+	ApplicationServiceClient
+}
+
+func (fakeApplicationServiceClient) Exec(ctx context.Context, opts ...grpc.CallOption) (ApplicationService_ExecClient, error) {
+	return &fakeExecClient{ctx: ctx, requests: make(chan *ApplicationExecRequest, 1)}, nil
+}
+
+func dialExecWebSocket(t *testing.T, serverURL string, origin string, authorization string) *websocket.Conn {
+	config, err := websocket.NewConfig("ws"+strings.TrimPrefix(serverURL, "http")+ExecWebSocketPath, origin)
+	assert.NoError(t, err)
+	if authorization != "" {
+		config.Header = http.Header{"Authorization": []string{authorization}}
+	}
+	conn, err := websocket.DialConfig(config)
+	assert.NoError(t, err)
+	return conn
+}
+
+func TestExecWebSocket(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle(ExecWebSocketPath, NewExecWebSocketHandler(fakeApplicationServiceClient{}))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	conn := dialExecWebSocket(t, server.URL, server.URL, "Bearer token")
+	defer conn.Close()
+	name, pod := "guestbook", "guestbook-pod"
+	var msg execWebSocketMessage
+	assert.NoError(t, websocket.JSON.Send(conn, ApplicationExecRequest{Name: &name, PodName: &pod, Tty: true}))
+	assert.NoError(t, websocket.JSON.Receive(conn, &msg))
+	assert.Equal(t, "$ ", string(msg.Result.Stdout))
+	assert.NoError(t, websocket.JSON.Send(conn, ApplicationExecRequest{Stdin: []byte("ls\n")}))
+	assert.NoError(t, websocket.JSON.Receive(conn, &msg))
+	assert.Equal(t, "ls\n", string(msg.Result.Stdout))
+	assert.NoError(t, websocket.JSON.Send(conn, ApplicationExecRequest{Stdin: []byte("exit\n")}))
+	// the websocket is closed once the session ends
+	assert.Equal(t, io.EOF, websocket.JSON.Receive(conn, &msg))
+}
+
+func TestExecWebSocketError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle(ExecWebSocketPath, NewExecWebSocketHandler(fakeApplicationServiceClient{}))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	conn := dialExecWebSocket(t, server.URL, server.URL, "")
+	defer conn.Close()
+	name, pod := "guestbook", "guestbook-pod"
+	var msg execWebSocketMessage
+	assert.NoError(t, websocket.JSON.Send(conn, ApplicationExecRequest{Name: &name, PodName: &pod}))
+	assert.NoError(t, websocket.JSON.Receive(conn, &msg))
+	assert.Nil(t, msg.Result)
+	assert.Equal(t, &execWebSocketError{Code: "Unauthenticated", Message: "no session information"}, msg.Error)
+}
+
+func TestExecWebSocketOtherOrigin(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle(ExecWebSocketPath, NewExecWebSocketHandler(fakeApplicationServiceClient{}))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config, err := websocket.NewConfig("ws"+strings.TrimPrefix(server.URL, "http")+ExecWebSocketPath, "https://evil.example.com")
+	assert.NoError(t, err)
+	_, err = websocket.DialConfig(config)
+	assert.Error(t, err)
+}
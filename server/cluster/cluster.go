@@ -1,16 +1,22 @@
 package cluster
 
 import (
+	"fmt"
 	"reflect"
+	"sort"
 
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/argoproj/argo-cd/common"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/grpc"
 	"github.com/argoproj/argo-cd/util/kube"
@@ -19,15 +25,19 @@ import (
 
 // Server provides a Cluster service
 type Server struct {
-	db  db.ArgoDB
-	enf *rbac.Enforcer
+	ns           string
+	appclientset appclientset.Interface
+	db           db.ArgoDB
+	enf          *rbac.Enforcer
 }
 
 // NewServer returns a new instance of the Cluster service
-func NewServer(db db.ArgoDB, enf *rbac.Enforcer) *Server {
+func NewServer(namespace string, appclientset appclientset.Interface, db db.ArgoDB, enf *rbac.Enforcer) *Server {
 	return &Server{
-		db:  db,
-		enf: enf,
+		ns:           namespace,
+		appclientset: appclientset,
+		db:           db,
+		enf:          enf,
 	}
 }
 
@@ -54,7 +64,7 @@ func (s *Server) Create(ctx context.Context, q *ClusterCreateRequest) (*appv1.Cl
 	c := q.Cluster
 	err := kube.TestConfig(q.Cluster.RESTConfig())
 	if err != nil {
-		return nil, err
+		return nil, grpc.NewReasonError(codes.Unavailable, grpc.ErrorReasonClusterUnreachable, q.Cluster.Server, "%v", err)
 	}
 
 	c.ConnectionState = appv1.ConnectionState{Status: appv1.ConnectionStatusSuccessful}
@@ -66,8 +76,9 @@ func (s *Server) Create(ctx context.Context, q *ClusterCreateRequest) (*appv1.Cl
 			return nil, status.Errorf(codes.Internal, "unable to check existing cluster details: %v", getErr)
 		}
 
-		// cluster ConnectionState may differ, so make consistent before testing
+		// cluster ConnectionState and CacheInfo may differ, so make consistent before testing
 		existing.ConnectionState = c.ConnectionState
+		existing.CacheInfo = c.CacheInfo
 		if reflect.DeepEqual(existing, c) {
 			clust, err = existing, nil
 		} else if q.Upsert {
@@ -142,21 +153,121 @@ func (s *Server) Update(ctx context.Context, q *ClusterUpdateRequest) (*appv1.Cl
 	}
 	err := kube.TestConfig(q.Cluster.RESTConfig())
 	if err != nil {
-		return nil, err
+		return nil, grpc.NewReasonError(codes.Unavailable, grpc.ErrorReasonClusterUnreachable, q.Cluster.Server, "%v", err)
 	}
 	clust, err := s.db.UpdateCluster(ctx, q.Cluster)
 	return redact(clust), err
 }
 
+// Resync requests the controller to resync the resources of a cluster
+func (s *Server) Resync(ctx context.Context, q *ClusterQuery) (*appv1.Cluster, error) {
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "clusters", "update", q.Server) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	clust, err := s.db.RequestClusterResync(ctx, q.Server)
+	return redact(clust), err
+}
+
 // Delete deletes a cluster by name
 func (s *Server) Delete(ctx context.Context, q *ClusterQuery) (*ClusterResponse, error) {
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "clusters", "delete", q.Server) {
 		return nil, grpc.ErrPermissionDenied
 	}
+	if !q.Force {
+		clust, err := s.db.GetCluster(ctx, q.Server)
+		if err != nil {
+			return nil, err
+		}
+		apps, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if dependents := argo.FilterByDestinationCluster(apps.Items, clust); len(dependents) > 0 {
+			return nil, argo.ReferencedByAppsError(fmt.Sprintf("cluster '%s'", q.Server), dependents)
+		}
+	}
 	err := s.db.DeleteCluster(ctx, q.Server)
 	return &ClusterResponse{}, err
 }
 
+// ListResources returns the top-level resources of a cluster, and the applications managing them
+func (s *Server) ListResources(ctx context.Context, q *ClusterResourcesQuery) (*ClusterResources, error) {
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "clusters", "get", q.Server) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	clust, err := s.db.GetCluster(ctx, q.Server)
+	if err != nil {
+		return nil, err
+	}
+	objs, err := kube.GetTopLevelResources(ctx, clust.RESTConfig(), q.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return newClusterResources(objs, q.Unmanaged), nil
+}
+
+// newClusterResources returns the inventory of the given top-level resources, sorted by namespace,
+// group, kind and name. The resources managed by an application are omitted if unmanagedOnly is set,
+// but are still counted in the namespace summaries
+func newClusterResources(objs []*unstructured.Unstructured, unmanagedOnly bool) *ClusterResources {
+	namespaces := make(map[string]*ClusterNamespaceSummary)
+	getNamespace := func(name string) *ClusterNamespaceSummary {
+		ns, ok := namespaces[name]
+		if !ok {
+			ns = &ClusterNamespaceSummary{Name: name}
+			namespaces[name] = ns
+		}
+		return ns
+	}
+	items := make([]*ClusterResource, 0)
+	for _, obj := range objs {
+		gvk := obj.GroupVersionKind()
+		res := ClusterResource{
+			Group:       gvk.Group,
+			Kind:        gvk.Kind,
+			Namespace:   obj.GetNamespace(),
+			Name:        obj.GetName(),
+			Application: obj.GetLabels()[common.LabelApplicationName],
+		}
+		if gvk.Group == "" && gvk.Kind == "Namespace" {
+			getNamespace(res.Name)
+		}
+		if res.Namespace != "" {
+			ns := getNamespace(res.Namespace)
+			if res.Application != "" {
+				ns.Managed++
+			} else {
+				ns.Unmanaged++
+			}
+		}
+		if unmanagedOnly && res.Application != "" {
+			continue
+		}
+		items = append(items, &res)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Group != b.Group {
+			return a.Group < b.Group
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Name < b.Name
+	})
+	summaries := make([]*ClusterNamespaceSummary, 0, len(namespaces))
+	for _, ns := range namespaces {
+		summaries = append(summaries, ns)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].Name < summaries[j].Name
+	})
+	return &ClusterResources{Namespaces: summaries, Items: items}
+}
+
 func redact(clust *appv1.Cluster) *appv1.Cluster {
 	if clust == nil {
 		return nil
@@ -35,7 +35,9 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 // ClusterQuery is a query for cluster resources
 type ClusterQuery struct {
-	Server               string   `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	Server string `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	// force deletes the cluster even if applications are still deployed to it
+	Force                bool     `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -45,7 +47,7 @@ func (m *ClusterQuery) Reset()         { *m = ClusterQuery{} }
 func (m *ClusterQuery) String() string { return proto.CompactTextString(m) }
 func (*ClusterQuery) ProtoMessage()    {}
 func (*ClusterQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_bf8d7367dfc95a3e, []int{0}
+	return fileDescriptor_cluster_7ecce3bf60824291, []int{0}
 }
 func (m *ClusterQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -81,6 +83,13 @@ func (m *ClusterQuery) GetServer() string {
 	return ""
 }
 
+func (m *ClusterQuery) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
 type ClusterResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -91,7 +100,7 @@ func (m *ClusterResponse) Reset()         { *m = ClusterResponse{} }
 func (m *ClusterResponse) String() string { return proto.CompactTextString(m) }
 func (*ClusterResponse) ProtoMessage()    {}
 func (*ClusterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_bf8d7367dfc95a3e, []int{1}
+	return fileDescriptor_cluster_7ecce3bf60824291, []int{1}
 }
 func (m *ClusterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -132,7 +141,7 @@ func (m *ClusterCreateRequest) Reset()         { *m = ClusterCreateRequest{} }
 func (m *ClusterCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*ClusterCreateRequest) ProtoMessage()    {}
 func (*ClusterCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_bf8d7367dfc95a3e, []int{2}
+	return fileDescriptor_cluster_7ecce3bf60824291, []int{2}
 }
 func (m *ClusterCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -189,7 +198,7 @@ func (m *ClusterCreateFromKubeConfigRequest) Reset()         { *m = ClusterCreat
 func (m *ClusterCreateFromKubeConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*ClusterCreateFromKubeConfigRequest) ProtoMessage()    {}
 func (*ClusterCreateFromKubeConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_bf8d7367dfc95a3e, []int{3}
+	return fileDescriptor_cluster_7ecce3bf60824291, []int{3}
 }
 func (m *ClusterCreateFromKubeConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -257,7 +266,7 @@ func (m *ClusterUpdateRequest) Reset()         { *m = ClusterUpdateRequest{} }
 func (m *ClusterUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*ClusterUpdateRequest) ProtoMessage()    {}
 func (*ClusterUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_bf8d7367dfc95a3e, []int{4}
+	return fileDescriptor_cluster_7ecce3bf60824291, []int{4}
 }
 func (m *ClusterUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -293,12 +302,283 @@ func (m *ClusterUpdateRequest) GetCluster() *v1alpha1.Cluster {
 	return nil
 }
 
+// ClusterResourcesQuery is a query for the top-level resources of a cluster
+type ClusterResourcesQuery struct {
+	Server string `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	// namespace restricts the query to the resources of a namespace
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// unmanaged restricts the query to the resources which are not managed by any application
+	Unmanaged            bool     `protobuf:"varint,3,opt,name=unmanaged,proto3" json:"unmanaged,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterResourcesQuery) Reset()         { *m = ClusterResourcesQuery{} }
+func (m *ClusterResourcesQuery) String() string { return proto.CompactTextString(m) }
+func (*ClusterResourcesQuery) ProtoMessage()    {}
+func (*ClusterResourcesQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_7ecce3bf60824291, []int{5}
+}
+func (m *ClusterResourcesQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterResourcesQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterResourcesQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ClusterResourcesQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterResourcesQuery.Merge(dst, src)
+}
+func (m *ClusterResourcesQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterResourcesQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterResourcesQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterResourcesQuery proto.InternalMessageInfo
+
+func (m *ClusterResourcesQuery) GetServer() string {
+	if m != nil {
+		return m.Server
+	}
+	return ""
+}
+
+func (m *ClusterResourcesQuery) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ClusterResourcesQuery) GetUnmanaged() bool {
+	if m != nil {
+		return m.Unmanaged
+	}
+	return false
+}
+
+// ClusterResource is a top-level resource of a cluster, i.e. a resource without owner
+type ClusterResource struct {
+	Group     string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Kind      string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// application is the name of the application which manages the resource, if any
+	Application          string   `protobuf:"bytes,5,opt,name=application,proto3" json:"application,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterResource) Reset()         { *m = ClusterResource{} }
+func (m *ClusterResource) String() string { return proto.CompactTextString(m) }
+func (*ClusterResource) ProtoMessage()    {}
+func (*ClusterResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_7ecce3bf60824291, []int{6}
+}
+func (m *ClusterResource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterResource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ClusterResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterResource.Merge(dst, src)
+}
+func (m *ClusterResource) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterResource proto.InternalMessageInfo
+
+func (m *ClusterResource) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *ClusterResource) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ClusterResource) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ClusterResource) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ClusterResource) GetApplication() string {
+	if m != nil {
+		return m.Application
+	}
+	return ""
+}
+
+// ClusterNamespaceSummary contains the number of managed and unmanaged top-level resources of a namespace
+type ClusterNamespaceSummary struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Managed              int64    `protobuf:"varint,2,opt,name=managed,proto3" json:"managed,omitempty"`
+	Unmanaged            int64    `protobuf:"varint,3,opt,name=unmanaged,proto3" json:"unmanaged,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterNamespaceSummary) Reset()         { *m = ClusterNamespaceSummary{} }
+func (m *ClusterNamespaceSummary) String() string { return proto.CompactTextString(m) }
+func (*ClusterNamespaceSummary) ProtoMessage()    {}
+func (*ClusterNamespaceSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_7ecce3bf60824291, []int{7}
+}
+func (m *ClusterNamespaceSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterNamespaceSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterNamespaceSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ClusterNamespaceSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterNamespaceSummary.Merge(dst, src)
+}
+func (m *ClusterNamespaceSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterNamespaceSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterNamespaceSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterNamespaceSummary proto.InternalMessageInfo
+
+func (m *ClusterNamespaceSummary) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ClusterNamespaceSummary) GetManaged() int64 {
+	if m != nil {
+		return m.Managed
+	}
+	return 0
+}
+
+func (m *ClusterNamespaceSummary) GetUnmanaged() int64 {
+	if m != nil {
+		return m.Unmanaged
+	}
+	return 0
+}
+
+// ClusterResources is the inventory of the top-level resources of a cluster
+type ClusterResources struct {
+	Namespaces           []*ClusterNamespaceSummary `protobuf:"bytes,1,rep,name=namespaces" json:"namespaces,omitempty"`
+	Items                []*ClusterResource         `protobuf:"bytes,2,rep,name=items" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ClusterResources) Reset()         { *m = ClusterResources{} }
+func (m *ClusterResources) String() string { return proto.CompactTextString(m) }
+func (*ClusterResources) ProtoMessage()    {}
+func (*ClusterResources) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_7ecce3bf60824291, []int{8}
+}
+func (m *ClusterResources) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterResources) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterResources.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ClusterResources) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterResources.Merge(dst, src)
+}
+func (m *ClusterResources) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterResources) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterResources.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterResources proto.InternalMessageInfo
+
+func (m *ClusterResources) GetNamespaces() []*ClusterNamespaceSummary {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func (m *ClusterResources) GetItems() []*ClusterResource {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ClusterQuery)(nil), "cluster.ClusterQuery")
 	proto.RegisterType((*ClusterResponse)(nil), "cluster.ClusterResponse")
 	proto.RegisterType((*ClusterCreateRequest)(nil), "cluster.ClusterCreateRequest")
 	proto.RegisterType((*ClusterCreateFromKubeConfigRequest)(nil), "cluster.ClusterCreateFromKubeConfigRequest")
 	proto.RegisterType((*ClusterUpdateRequest)(nil), "cluster.ClusterUpdateRequest")
+	proto.RegisterType((*ClusterResourcesQuery)(nil), "cluster.ClusterResourcesQuery")
+	proto.RegisterType((*ClusterResource)(nil), "cluster.ClusterResource")
+	proto.RegisterType((*ClusterNamespaceSummary)(nil), "cluster.ClusterNamespaceSummary")
+	proto.RegisterType((*ClusterResources)(nil), "cluster.ClusterResources")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -320,8 +600,12 @@ type ClusterServiceClient interface {
 	CreateFromKubeConfig(ctx context.Context, in *ClusterCreateFromKubeConfigRequest, opts ...grpc.CallOption) (*v1alpha1.Cluster, error)
 	// Get returns a cluster by server address
 	Get(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*v1alpha1.Cluster, error)
+	// ListResources returns the top-level resources of a cluster, and the applications managing them
+	ListResources(ctx context.Context, in *ClusterResourcesQuery, opts ...grpc.CallOption) (*ClusterResources, error)
 	// Update updates a cluster
 	Update(ctx context.Context, in *ClusterUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Cluster, error)
+	// Resync requests the controller to resync the resources of a cluster and to refresh all its applications
+	Resync(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*v1alpha1.Cluster, error)
 	// Delete deletes a cluster
 	Delete(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*ClusterResponse, error)
 }
@@ -370,6 +654,15 @@ func (c *clusterServiceClient) Get(ctx context.Context, in *ClusterQuery, opts .
 	return out, nil
 }
 
+func (c *clusterServiceClient) ListResources(ctx context.Context, in *ClusterResourcesQuery, opts ...grpc.CallOption) (*ClusterResources, error) {
+	out := new(ClusterResources)
+	err := c.cc.Invoke(ctx, "/cluster.ClusterService/ListResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterServiceClient) Update(ctx context.Context, in *ClusterUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Cluster, error) {
 	out := new(v1alpha1.Cluster)
 	err := c.cc.Invoke(ctx, "/cluster.ClusterService/Update", in, out, opts...)
@@ -379,6 +672,15 @@ func (c *clusterServiceClient) Update(ctx context.Context, in *ClusterUpdateRequ
 	return out, nil
 }
 
+func (c *clusterServiceClient) Resync(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*v1alpha1.Cluster, error) {
+	out := new(v1alpha1.Cluster)
+	err := c.cc.Invoke(ctx, "/cluster.ClusterService/Resync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterServiceClient) Delete(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*ClusterResponse, error) {
 	out := new(ClusterResponse)
 	err := c.cc.Invoke(ctx, "/cluster.ClusterService/Delete", in, out, opts...)
@@ -399,8 +701,12 @@ type ClusterServiceServer interface {
 	CreateFromKubeConfig(context.Context, *ClusterCreateFromKubeConfigRequest) (*v1alpha1.Cluster, error)
 	// Get returns a cluster by server address
 	Get(context.Context, *ClusterQuery) (*v1alpha1.Cluster, error)
+	// ListResources returns the top-level resources of a cluster, and the applications managing them
+	ListResources(context.Context, *ClusterResourcesQuery) (*ClusterResources, error)
 	// Update updates a cluster
 	Update(context.Context, *ClusterUpdateRequest) (*v1alpha1.Cluster, error)
+	// Resync requests the controller to resync the resources of a cluster and to refresh all its applications
+	Resync(context.Context, *ClusterQuery) (*v1alpha1.Cluster, error)
 	// Delete deletes a cluster
 	Delete(context.Context, *ClusterQuery) (*ClusterResponse, error)
 }
@@ -481,6 +787,24 @@ func _ClusterService_Get_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterService_ListResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterResourcesQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ListResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.ClusterService/ListResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ListResources(ctx, req.(*ClusterResourcesQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClusterService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ClusterUpdateRequest)
 	if err := dec(in); err != nil {
@@ -499,6 +823,24 @@ func _ClusterService_Update_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterService_Resync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).Resync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.ClusterService/Resync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).Resync(ctx, req.(*ClusterQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClusterService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ClusterQuery)
 	if err := dec(in); err != nil {
@@ -537,10 +879,18 @@ var _ClusterService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _ClusterService_Get_Handler,
 		},
+		{
+			MethodName: "ListResources",
+			Handler:    _ClusterService_ListResources_Handler,
+		},
 		{
 			MethodName: "Update",
 			Handler:    _ClusterService_Update_Handler,
 		},
+		{
+			MethodName: "Resync",
+			Handler:    _ClusterService_Resync_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _ClusterService_Delete_Handler,
@@ -571,6 +921,16 @@ func (m *ClusterQuery) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCluster(dAtA, i, uint64(len(m.Server)))
 		i += copy(dAtA[i:], m.Server)
 	}
+	if m.Force {
+		dAtA[i] = 0x10
+		i++
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -723,30 +1083,209 @@ func (m *ClusterUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintCluster(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ClusterResourcesQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *ClusterQuery) Size() (n int) {
+
+func (m *ClusterResourcesQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Server)
-	if l > 0 {
-		n += 1 + l + sovCluster(uint64(l))
+	if len(m.Server) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Server)))
+		i += copy(dAtA[i:], m.Server)
+	}
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if m.Unmanaged {
+		dAtA[i] = 0x18
+		i++
+		if m.Unmanaged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ClusterResponse) Size() (n int) {
-	var l int
+func (m *ClusterResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterResource) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Group) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Group)))
+		i += copy(dAtA[i:], m.Group)
+	}
+	if len(m.Kind) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Kind)))
+		i += copy(dAtA[i:], m.Kind)
+	}
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Application) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Application)))
+		i += copy(dAtA[i:], m.Application)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ClusterNamespaceSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterNamespaceSummary) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Managed != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCluster(dAtA, i, uint64(m.Managed))
+	}
+	if m.Unmanaged != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCluster(dAtA, i, uint64(m.Unmanaged))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ClusterResources) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterResources) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespaces) > 0 {
+		for _, msg := range m.Namespaces {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCluster(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCluster(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintCluster(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ClusterQuery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Server)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterResponse) Size() (n int) {
+	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -806,6 +1345,95 @@ func (m *ClusterUpdateRequest) Size() (n int) {
 	return n
 }
 
+func (m *ClusterResourcesQuery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Server)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if m.Unmanaged {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterResource) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	l = len(m.Application)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterNamespaceSummary) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if m.Managed != 0 {
+		n += 1 + sovCluster(uint64(m.Managed))
+	}
+	if m.Unmanaged != 0 {
+		n += 1 + sovCluster(uint64(m.Unmanaged))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterResources) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Namespaces) > 0 {
+		for _, e := range m.Namespaces {
+			l = e.Size()
+			n += 1 + l + sovCluster(uint64(l))
+		}
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovCluster(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovCluster(x uint64) (n int) {
 	for {
 		n++
@@ -877,6 +1505,26 @@ func (m *ClusterQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.Server = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCluster(dAtA[iNdEx:])
@@ -1287,6 +1935,562 @@ func (m *ClusterUpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClusterResourcesQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterResourcesQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterResourcesQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unmanaged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unmanaged = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterResource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterResource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterResource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Application = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterNamespaceSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterNamespaceSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterNamespaceSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Managed", wireType)
+			}
+			m.Managed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Managed |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unmanaged", wireType)
+			}
+			m.Unmanaged = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unmanaged |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterResources) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterResources: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterResources: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, &ClusterNamespaceSummary{})
+			if err := m.Namespaces[len(m.Namespaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &ClusterResource{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCluster(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1393,45 +2597,59 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/cluster/cluster.proto", fileDescriptor_cluster_bf8d7367dfc95a3e)
-}
-
-var fileDescriptor_cluster_bf8d7367dfc95a3e = []byte{
-	// 564 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xcd, 0x6e, 0x13, 0x31,
-	0x10, 0xc7, 0xe5, 0xb6, 0xda, 0x12, 0x83, 0xf8, 0xb0, 0x0a, 0x5a, 0xd2, 0x10, 0xa5, 0x3e, 0x54,
-	0x55, 0xa0, 0xb6, 0x12, 0x2e, 0x55, 0x8f, 0x0d, 0x2a, 0x42, 0x70, 0x21, 0x88, 0x0b, 0xaa, 0x84,
-	0x36, 0x9b, 0x61, 0xbb, 0x24, 0x5d, 0x2f, 0xb6, 0x37, 0x02, 0x21, 0x84, 0x04, 0x57, 0xc4, 0x05,
-	0xee, 0x3c, 0x02, 0xaf, 0xc1, 0x11, 0x89, 0x1b, 0x27, 0x14, 0xf1, 0x20, 0x68, 0xbd, 0x76, 0xbe,
-	0xc3, 0x85, 0x88, 0x53, 0xec, 0x19, 0x67, 0xe6, 0x37, 0x33, 0xff, 0x4c, 0x70, 0x45, 0x81, 0x1c,
-	0x80, 0xe4, 0x61, 0x3f, 0x53, 0x7a, 0xfc, 0xc9, 0x52, 0x29, 0xb4, 0x20, 0x9b, 0xf6, 0x5a, 0xde,
-	0x8a, 0x44, 0x24, 0x8c, 0x8d, 0xe7, 0xa7, 0xc2, 0x5d, 0xae, 0x44, 0x42, 0x44, 0x7d, 0xe0, 0x41,
-	0x1a, 0xf3, 0x20, 0x49, 0x84, 0x0e, 0x74, 0x2c, 0x12, 0x65, 0xbd, 0xb4, 0x77, 0xa0, 0x58, 0x2c,
-	0x8c, 0x37, 0x14, 0x12, 0xf8, 0xa0, 0xc1, 0x23, 0x48, 0x40, 0x06, 0x1a, 0xba, 0xf6, 0xcd, 0xbd,
-	0x28, 0xd6, 0xa7, 0x59, 0x87, 0x85, 0xe2, 0x8c, 0x07, 0xd2, 0xa4, 0x78, 0x6e, 0x0e, 0xfb, 0x61,
-	0x97, 0xa7, 0xbd, 0x28, 0xff, 0xb2, 0xe2, 0x41, 0x9a, 0xf6, 0xe3, 0xd0, 0x04, 0xe7, 0x83, 0x46,
-	0xd0, 0x4f, 0x4f, 0x83, 0xb9, 0x50, 0x74, 0x17, 0x5f, 0x68, 0x15, 0xb4, 0x0f, 0x33, 0x90, 0xaf,
-	0xc8, 0x35, 0xec, 0x15, 0xb5, 0xf9, 0xa8, 0x86, 0xf6, 0x4a, 0x6d, 0x7b, 0xa3, 0x57, 0xf0, 0x25,
-	0xfb, 0xae, 0x0d, 0x2a, 0x15, 0x89, 0x02, 0xfa, 0x01, 0xe1, 0x2d, 0x6b, 0x6b, 0x49, 0x08, 0x34,
-	0xb4, 0xe1, 0x45, 0x06, 0x4a, 0x93, 0x13, 0xec, 0x3a, 0x60, 0x82, 0x9c, 0x6f, 0x1e, 0xb1, 0x31,
-	0x30, 0x73, 0xc0, 0xe6, 0xf0, 0x34, 0xec, 0xb2, 0xb4, 0x17, 0xb1, 0x1c, 0x98, 0x4d, 0x00, 0x33,
-	0x07, 0xcc, 0x5c, 0x56, 0x17, 0x32, 0x27, 0xcc, 0x52, 0x05, 0x52, 0xfb, 0x6b, 0x35, 0xb4, 0x77,
-	0xae, 0x6d, 0x6f, 0xf4, 0x33, 0xc2, 0x74, 0x0a, 0xe7, 0x58, 0x8a, 0xb3, 0xfb, 0x59, 0x07, 0x5a,
-	0x22, 0x79, 0x16, 0x47, 0x0e, 0xae, 0x8a, 0x71, 0x2f, 0xeb, 0x40, 0x68, 0x8c, 0xb6, 0xc8, 0x09,
-	0x0b, 0xf1, 0xf1, 0x66, 0x28, 0x12, 0x0d, 0x2f, 0x8b, 0xf8, 0xa5, 0xb6, 0xbb, 0x4e, 0x24, 0x5e,
-	0x9f, 0x4c, 0x4c, 0x2a, 0xb8, 0x14, 0x27, 0x36, 0xb3, 0xbf, 0x61, 0x5c, 0x63, 0x03, 0xd5, 0xa3,
-	0x26, 0x3d, 0x4e, 0xbb, 0xff, 0xab, 0x49, 0xcd, 0x9f, 0x1e, 0xbe, 0x68, 0x8d, 0x8f, 0x40, 0x0e,
-	0xe2, 0x10, 0xc8, 0x5b, 0xbc, 0xf1, 0x20, 0x56, 0x9a, 0x5c, 0x65, 0x4e, 0xad, 0x93, 0x83, 0x2f,
-	0x1f, 0xff, 0x7b, 0xfa, 0x3c, 0x3c, 0xf5, 0xdf, 0xfd, 0xf8, 0xfd, 0x69, 0x8d, 0x90, 0xcb, 0x46,
-	0xc1, 0x83, 0x86, 0xfb, 0x6d, 0x28, 0xf2, 0x11, 0x61, 0xaf, 0x98, 0x0c, 0xb9, 0x31, 0xcb, 0x30,
-	0x25, 0xa0, 0xf2, 0x0a, 0x5a, 0x41, 0x77, 0x0c, 0xc7, 0x36, 0x9d, 0xe3, 0x38, 0x1c, 0x29, 0xe9,
-	0x6b, 0x2e, 0xe0, 0x05, 0x52, 0x21, 0x37, 0x17, 0xe3, 0x2d, 0x14, 0xd4, 0x4a, 0x60, 0x77, 0x0d,
-	0x6c, 0x8d, 0x6e, 0xcf, 0xc2, 0xee, 0x8f, 0x95, 0x79, 0x88, 0xea, 0xe4, 0x3d, 0xc2, 0xeb, 0x77,
-	0x61, 0xe9, 0x0c, 0x57, 0xd8, 0x37, 0x72, 0x7d, 0x16, 0x85, 0xbf, 0x2e, 0x56, 0xc1, 0x1b, 0xf2,
-	0x05, 0x61, 0xaf, 0x10, 0xf3, 0xfc, 0x20, 0xa7, 0x44, 0xbe, 0x12, 0xa0, 0xa6, 0x01, 0xba, 0x55,
-	0xde, 0x99, 0x07, 0x72, 0xb9, 0x2d, 0xd8, 0x78, 0xb2, 0x27, 0xd8, 0xbb, 0x03, 0x7d, 0xd0, 0xb0,
-	0xac, 0x53, 0xfe, 0xac, 0x79, 0xb4, 0xd5, 0x6c, 0xfd, 0xf5, 0xe5, 0xf5, 0x1f, 0x1d, 0x7c, 0x1b,
-	0x56, 0xd1, 0xf7, 0x61, 0x15, 0xfd, 0x1a, 0x56, 0xd1, 0x93, 0xfa, 0xdf, 0x96, 0xf1, 0xf4, 0xff,
-	0x44, 0xc7, 0x33, 0x4b, 0xf7, 0xf6, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x23, 0xc4, 0x8d, 0xa4,
-	0x40, 0x06, 0x00, 0x00,
+	proto.RegisterFile("server/cluster/cluster.proto", fileDescriptor_cluster_7ecce3bf60824291)
+}
+
+var fileDescriptor_cluster_7ecce3bf60824291 = []byte{
+	// 794 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xd6, 0x34, 0x69, 0xda, 0x9c, 0xde, 0x7b, 0xdb, 0x3b, 0x6a, 0xef, 0x75, 0xd3, 0xdc, 0x28,
+	0x9d, 0x2b, 0x50, 0xd4, 0x52, 0x5b, 0x0d, 0x9b, 0xaa, 0x62, 0x81, 0x5a, 0x54, 0x84, 0x40, 0x48,
+	0xb8, 0x62, 0x83, 0x2a, 0x21, 0xc7, 0x99, 0xba, 0x26, 0x89, 0xc7, 0xcc, 0xd8, 0x81, 0x0a, 0x21,
+	0x24, 0x60, 0xc9, 0xcf, 0x02, 0xf6, 0x3c, 0x02, 0xaf, 0xc1, 0x12, 0x89, 0x17, 0x40, 0x85, 0x07,
+	0x41, 0x1e, 0x8f, 0x63, 0xc7, 0x49, 0xba, 0x21, 0xb0, 0xea, 0x9c, 0x73, 0x3c, 0xe7, 0xfb, 0xce,
+	0x4f, 0xbf, 0x0c, 0x54, 0x05, 0xe5, 0x7d, 0xca, 0x0d, 0xbb, 0x1b, 0x8a, 0x20, 0xfd, 0xab, 0xfb,
+	0x9c, 0x05, 0x0c, 0xcf, 0x29, 0xb3, 0xb2, 0xec, 0x30, 0x87, 0x49, 0x9f, 0x11, 0x9d, 0xe2, 0x70,
+	0xa5, 0xea, 0x30, 0xe6, 0x74, 0xa9, 0x61, 0xf9, 0xae, 0x61, 0x79, 0x1e, 0x0b, 0xac, 0xc0, 0x65,
+	0x9e, 0x50, 0x51, 0xd2, 0xd9, 0x11, 0xba, 0xcb, 0x64, 0xd4, 0x66, 0x9c, 0x1a, 0xfd, 0x6d, 0xc3,
+	0xa1, 0x1e, 0xe5, 0x56, 0x40, 0xdb, 0xea, 0x9b, 0x1b, 0x8e, 0x1b, 0x9c, 0x84, 0x2d, 0xdd, 0x66,
+	0x3d, 0xc3, 0xe2, 0x12, 0xe2, 0x81, 0x3c, 0x6c, 0xd9, 0x6d, 0xc3, 0xef, 0x38, 0xd1, 0x65, 0x61,
+	0x58, 0xbe, 0xdf, 0x75, 0x6d, 0x99, 0xdc, 0xe8, 0x6f, 0x5b, 0x5d, 0xff, 0xc4, 0x1a, 0x49, 0x45,
+	0xae, 0xc0, 0x1f, 0xfb, 0x31, 0xdb, 0x3b, 0x21, 0xe5, 0xa7, 0xf8, 0x1f, 0x28, 0xc5, 0xb5, 0x69,
+	0xa8, 0x8e, 0x1a, 0x65, 0x53, 0x59, 0x78, 0x19, 0x66, 0x8f, 0x19, 0xb7, 0xa9, 0x36, 0x53, 0x47,
+	0x8d, 0x79, 0x33, 0x36, 0xc8, 0xdf, 0xb0, 0xa8, 0x6e, 0x9b, 0x54, 0xf8, 0xcc, 0x13, 0x94, 0xbc,
+	0x42, 0xb0, 0xac, 0x7c, 0xfb, 0x9c, 0x5a, 0x01, 0x35, 0xe9, 0xc3, 0x90, 0x8a, 0x00, 0x1f, 0x41,
+	0xd2, 0x17, 0x99, 0x7a, 0xa1, 0xb9, 0xa7, 0xa7, 0x65, 0xe8, 0x49, 0x19, 0xf2, 0x70, 0xdf, 0x6e,
+	0xeb, 0x7e, 0xc7, 0xd1, 0xa3, 0x32, 0xf4, 0x4c, 0x19, 0x7a, 0x52, 0x86, 0x9e, 0xa0, 0x26, 0x29,
+	0x23, 0xde, 0xa1, 0x2f, 0x28, 0x0f, 0x14, 0x41, 0x65, 0x91, 0xf7, 0x08, 0xc8, 0x10, 0x9d, 0x03,
+	0xce, 0x7a, 0x37, 0xc3, 0x16, 0xdd, 0x67, 0xde, 0xb1, 0xeb, 0x24, 0xe4, 0x6a, 0x00, 0x9d, 0xb0,
+	0x45, 0x6d, 0xe9, 0x54, 0xa5, 0x67, 0x3c, 0x58, 0x83, 0x39, 0x9b, 0x79, 0x01, 0x7d, 0x1c, 0xe7,
+	0x2f, 0x9b, 0x89, 0x99, 0x01, 0x2e, 0x64, 0x81, 0x71, 0x15, 0xca, 0xae, 0xa7, 0x90, 0xb5, 0xa2,
+	0x0c, 0xa5, 0x0e, 0x12, 0x0c, 0x9a, 0x74, 0xd7, 0x6f, 0xff, 0xae, 0x26, 0x91, 0x0e, 0xac, 0xa4,
+	0xe3, 0x62, 0x21, 0xb7, 0xa9, 0x38, 0x7f, 0xea, 0x55, 0x28, 0x7b, 0x56, 0x8f, 0x0a, 0xdf, 0x52,
+	0x93, 0x2f, 0x9b, 0xa9, 0x23, 0x8a, 0x86, 0x5e, 0xcf, 0xf2, 0x2c, 0x87, 0xb6, 0x55, 0xf5, 0xa9,
+	0x83, 0xbc, 0x45, 0xb0, 0x98, 0x43, 0x8b, 0xb6, 0xc8, 0xe1, 0x2c, 0xf4, 0x15, 0x4c, 0x6c, 0x60,
+	0x0c, 0xc5, 0x8e, 0xeb, 0xb5, 0x15, 0x80, 0x3c, 0x0f, 0x23, 0x17, 0xf2, 0xc8, 0x18, 0x8a, 0x91,
+	0x21, 0xfb, 0x5a, 0x36, 0xe5, 0x19, 0xd7, 0x61, 0x21, 0xd3, 0x05, 0x6d, 0x56, 0x86, 0xb2, 0x2e,
+	0x42, 0xe1, 0x5f, 0x45, 0xe8, 0x76, 0x92, 0xe9, 0x30, 0xec, 0xf5, 0x2c, 0x7e, 0x3a, 0x48, 0x88,
+	0x32, 0x09, 0x35, 0x98, 0x4b, 0x8a, 0x8b, 0x98, 0x15, 0xcc, 0xc4, 0x1c, 0x2d, 0xbc, 0x90, 0x2d,
+	0xfc, 0x25, 0x82, 0xa5, 0x7c, 0x9b, 0xf1, 0x55, 0x80, 0x01, 0x7d, 0xa1, 0xa1, 0x7a, 0xa1, 0xb1,
+	0xd0, 0xac, 0xeb, 0x89, 0x6e, 0x4c, 0xa0, 0x65, 0x66, 0xee, 0x60, 0x1d, 0x66, 0xdd, 0x80, 0xf6,
+	0x84, 0x36, 0x23, 0x2f, 0x6b, 0xf9, 0xcb, 0x09, 0x96, 0x19, 0x7f, 0xd6, 0xfc, 0x36, 0x0f, 0x7f,
+	0xa9, 0xd0, 0x21, 0xe5, 0x7d, 0xd7, 0xa6, 0xf8, 0x19, 0x14, 0x6f, 0xb9, 0x22, 0xc0, 0x2b, 0xf9,
+	0xbb, 0x72, 0x0b, 0x2a, 0x07, 0x3f, 0xbf, 0x6b, 0x51, 0x7a, 0xa2, 0x3d, 0xff, 0xf2, 0xfd, 0xdd,
+	0x0c, 0xc6, 0x4b, 0x52, 0xc4, 0xfa, 0xdb, 0x89, 0x3c, 0x0a, 0xfc, 0x06, 0x41, 0x29, 0xfe, 0x37,
+	0xc4, 0xff, 0xe5, 0x39, 0x0c, 0xa9, 0x45, 0x65, 0x0a, 0x7b, 0x4f, 0xd6, 0x25, 0x8f, 0x35, 0x32,
+	0xc2, 0x63, 0x77, 0x20, 0x1b, 0x1f, 0x23, 0xb5, 0x1a, 0xa3, 0x0b, 0x78, 0x73, 0x3c, 0xbd, 0xb1,
+	0xea, 0x31, 0x15, 0xb2, 0x17, 0x25, 0xd9, 0x3a, 0x59, 0xcb, 0x93, 0xdd, 0x4a, 0x65, 0x68, 0x17,
+	0x6d, 0xe0, 0x17, 0x08, 0x0a, 0xd7, 0xe9, 0xc4, 0x19, 0x4e, 0xb1, 0x6f, 0x78, 0x35, 0x4f, 0xc5,
+	0x78, 0x12, 0xeb, 0xc2, 0x53, 0xfc, 0x08, 0xfe, 0x8c, 0x46, 0x9d, 0xee, 0x77, 0x6d, 0xd2, 0x3a,
+	0xc6, 0x0a, 0x53, 0x59, 0x9d, 0x18, 0x27, 0x9b, 0x12, 0xee, 0x02, 0xfe, 0x7f, 0x22, 0x9c, 0xc1,
+	0x07, 0x38, 0x1f, 0x10, 0x94, 0x62, 0xc9, 0x1c, 0xdd, 0xa0, 0x21, 0x29, 0x9d, 0x4a, 0x27, 0x9a,
+	0x92, 0xda, 0xa5, 0xca, 0xfa, 0x28, 0xb5, 0x04, 0x5b, 0x51, 0x4c, 0x57, 0xea, 0x35, 0x82, 0x92,
+	0x49, 0xc5, 0xa9, 0x67, 0xff, 0xca, 0x19, 0x35, 0x24, 0x33, 0x42, 0xea, 0xe7, 0x36, 0x2d, 0x22,
+	0x71, 0x04, 0xa5, 0x6b, 0xb4, 0x4b, 0x03, 0x3a, 0x89, 0xce, 0x38, 0x25, 0x89, 0x7f, 0xcb, 0xd5,
+	0x22, 0x6c, 0x4c, 0x5e, 0x84, 0xbd, 0x9d, 0x4f, 0x67, 0x35, 0xf4, 0xf9, 0xac, 0x86, 0xbe, 0x9e,
+	0xd5, 0xd0, 0xbd, 0x8d, 0xf3, 0x1e, 0x26, 0xc3, 0x6f, 0xa6, 0x56, 0x49, 0x3e, 0x40, 0x2e, 0xff,
+	0x18, 0x00, 0x79, 0xd4, 0xe9, 0x25, 0x4c, 0x09, 0x00, 0x00,
 }
@@ -0,0 +1,118 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	apps "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/test"
+	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/rbac"
+)
+
+const testNamespace = "default"
+
+func TestNewClusterResources(t *testing.T) {
+	newObj := func(apiVersion, kind, namespace, name, appName string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		if appName != "" {
+			err := kube.SetLabel(obj, common.LabelApplicationName, appName)
+			assert.NoError(t, err)
+		}
+		return obj
+	}
+	objs := []*unstructured.Unstructured{
+		newObj("apps/v1", "Deployment", "guestbook", "guestbook-ui", "guestbook"),
+		newObj("v1", "Service", "guestbook", "guestbook-ui", "guestbook"),
+		newObj("v1", "ConfigMap", "guestbook", "debug", ""),
+		newObj("v1", "Namespace", "", "guestbook", ""),
+		newObj("v1", "Namespace", "", "empty", ""),
+		newObj("rbac.authorization.k8s.io/v1", "ClusterRole", "", "guestbook-admin", "guestbook"),
+	}
+
+	res := newClusterResources(objs, false)
+	assert.Equal(t, []*ClusterNamespaceSummary{
+		{Name: "empty"},
+		{Name: "guestbook", Managed: 2, Unmanaged: 1},
+	}, res.Namespaces)
+	assert.Equal(t, []*ClusterResource{
+		{Kind: "Namespace", Name: "empty"},
+		{Kind: "Namespace", Name: "guestbook"},
+		{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole", Name: "guestbook-admin", Application: "guestbook"},
+		{Kind: "ConfigMap", Namespace: "guestbook", Name: "debug"},
+		{Kind: "Service", Namespace: "guestbook", Name: "guestbook-ui", Application: "guestbook"},
+		{Group: "apps", Kind: "Deployment", Namespace: "guestbook", Name: "guestbook-ui", Application: "guestbook"},
+	}, res.Items)
+
+	res = newClusterResources(objs, true)
+	assert.Len(t, res.Namespaces, 2)
+	assert.Equal(t, []*ClusterResource{
+		{Kind: "Namespace", Name: "empty"},
+		{Kind: "Namespace", Name: "guestbook"},
+		{Kind: "ConfigMap", Namespace: "guestbook", Name: "debug"},
+	}, res.Items)
+}
+
+func TestDeleteClusterReferencedByApps(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset()
+	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	enforcer.SetBuiltinPolicy(test.BuiltinPolicy)
+	enforcer.SetDefaultRole("role:admin")
+	enforcer.SetClaimsEnforcerFunc(func(rvals ...interface{}) bool {
+		return true
+	})
+	db := db.NewDB(testNamespace, kubeclientset)
+	ctx := context.Background()
+	for _, server := range []string{"https://cluster-a", "https://cluster-b"} {
+		_, err := db.CreateCluster(ctx, &appv1.Cluster{Server: server})
+		assert.NoError(t, err)
+	}
+	_, err := db.CreateCluster(ctx, &appv1.Cluster{Server: "https://cluster-c", Name: "cluster-c"})
+	assert.NoError(t, err)
+	app := &appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: testNamespace},
+		Spec: appv1.ApplicationSpec{
+			Destination: appv1.ApplicationDestination{Server: "https://cluster-a", Namespace: "default"},
+		},
+	}
+	appByName := &appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook-by-name", Namespace: testNamespace},
+		Spec: appv1.ApplicationSpec{
+			Destination: appv1.ApplicationDestination{Name: "cluster-c", Namespace: "default"},
+		},
+	}
+	clusterServer := NewServer(testNamespace, apps.NewSimpleClientset(app, appByName), db, enforcer)
+
+	_, err = clusterServer.Delete(ctx, &ClusterQuery{Server: "https://cluster-a"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "referenced by 1 applications (guestbook)")
+
+	// applications referencing the cluster by name depend on it as well
+	_, err = clusterServer.Delete(ctx, &ClusterQuery{Server: "https://cluster-c"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "referenced by 1 applications (guestbook-by-name)")
+
+	_, err = clusterServer.Delete(ctx, &ClusterQuery{Server: "https://cluster-b"})
+	assert.NoError(t, err)
+	_, err = clusterServer.Delete(ctx, &ClusterQuery{Server: "https://cluster-a", Force: true})
+	assert.NoError(t, err)
+	clusters, err := db.ListClusters(ctx)
+	assert.NoError(t, err)
+	for _, clust := range clusters.Items {
+		assert.NotContains(t, []string{"https://cluster-a", "https://cluster-b"}, clust.Server)
+	}
+}
@@ -0,0 +1,86 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DexProxyMetrics holds the metrics of the requests proxied by the API server to Dex, which are the
+// OpenID Connect requests of the logins with Dex
+type DexProxyMetrics struct {
+	requestsCounter   *prometheus.CounterVec
+	durationHistogram *prometheus.HistogramVec
+	errorsCounter     *prometheus.CounterVec
+}
+
+// NewDexProxyMetrics returns a new instance of the Dex proxy metrics
+func NewDexProxyMetrics() *DexProxyMetrics {
+	return &DexProxyMetrics{
+		requestsCounter: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "argocd_dex_proxy_requests_total",
+				Help: "Number of requests proxied to Dex.",
+			},
+			[]string{"method", "code"},
+		),
+		durationHistogram: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "argocd_dex_proxy_request_duration_seconds",
+				Help:    "Response latency of requests proxied to Dex.",
+				Buckets: prometheus.DefBuckets,
+			},
+			[]string{"method"},
+		),
+		errorsCounter: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "argocd_dex_proxy_errors_total",
+				Help: "Number of requests proxied to Dex which failed, by reason.",
+			},
+			[]string{"reason"},
+		),
+	}
+}
+
+// Describe implements the prometheus.Collector interface
+func (m *DexProxyMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.requestsCounter.Describe(ch)
+	m.durationHistogram.Describe(ch)
+	m.errorsCounter.Describe(ch)
+}
+
+// Collect implements the prometheus.Collector interface
+func (m *DexProxyMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.requestsCounter.Collect(ch)
+	m.durationHistogram.Collect(ch)
+	m.errorsCounter.Collect(ch)
+}
+
+// IncErrors increments the number of proxied requests which failed for the given reason
+func (m *DexProxyMetrics) IncErrors(reason string) {
+	m.errorsCounter.WithLabelValues(reason).Inc()
+}
+
+// Handler returns a handler recording the metrics of the requests served by the given proxy handler
+func (m *DexProxyMetrics) Handler(proxy http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		startTime := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		proxy(recorder, r)
+		m.requestsCounter.WithLabelValues(r.Method, strconv.Itoa(recorder.status)).Inc()
+		m.durationHistogram.WithLabelValues(r.Method).Observe(time.Since(startTime).Seconds())
+	}
+}
+
+// statusRecorder is a response writer which records the status code of the response
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
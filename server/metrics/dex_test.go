@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	dexutil "github.com/argoproj/argo-cd/util/dex"
+)
+
+func TestDexProxyMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	dexMetrics := NewDexProxyMetrics()
+	metricsServ := NewMetricsServer(8082, NewAppRegistry(appLister, nil, false, dexMetrics))
+
+	dexServ := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/dex/token" {
+			http.Error(w, "<p>internal error</p>", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer dexServ.Close()
+	proxy := dexMetrics.Handler(dexutil.NewDexHTTPReverseProxy(dexServ.URL, dexMetrics.IncErrors))
+	// nothing listens on the address of the closed server
+	unavailableServ := httptest.NewServer(http.NotFoundHandler())
+	unavailableServ.Close()
+	unavailableProxy := dexMetrics.Handler(dexutil.NewDexHTTPReverseProxy(unavailableServ.URL, dexMetrics.IncErrors))
+
+	for _, path := range []string{"/api/dex/auth", "/api/dex/token"} {
+		req, err := http.NewRequest("GET", path, nil)
+		assert.NoError(t, err)
+		proxy(httptest.NewRecorder(), req)
+	}
+	req, err := http.NewRequest("POST", "/api/dex/token", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	unavailableProxy(rr, req)
+	assert.Equal(t, http.StatusBadGateway, rr.Code)
+
+	req, err = http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr = httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	assert.Contains(t, body, `argocd_dex_proxy_requests_total{code="200",method="GET"} 1`)
+	// internal Dex errors are redirected to the login page
+	assert.Contains(t, body, `argocd_dex_proxy_requests_total{code="303",method="GET"} 1`)
+	assert.Contains(t, body, `argocd_dex_proxy_requests_total{code="502",method="POST"} 1`)
+	assert.Contains(t, body, `argocd_dex_proxy_request_duration_seconds_count{method="GET"} 2`)
+	assert.Contains(t, body, `argocd_dex_proxy_errors_total{reason="server_error"} 1`)
+	assert.Contains(t, body, `argocd_dex_proxy_errors_total{reason="unavailable"} 1`)
+}
@@ -0,0 +1,156 @@
+package metrics
+
+import (
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var descGRPCDefaultLabels = []string{"grpc_service", "grpc_method", "grpc_type"}
+
+// GRPCMetrics holds the metrics of the gRPC requests served by the API server. REST requests are
+// included, since they are proxied to the gRPC server by the gateway
+type GRPCMetrics struct {
+	handledCounter       *prometheus.CounterVec
+	handlingHistogram    *prometheus.HistogramVec
+	msgReceivedHistogram *prometheus.HistogramVec
+	msgSentHistogram     *prometheus.HistogramVec
+}
+
+// NewGRPCMetrics returns a new instance of the gRPC request metrics
+func NewGRPCMetrics() *GRPCMetrics {
+	sizeBuckets := prometheus.ExponentialBuckets(64, 4, 10)
+	return &GRPCMetrics{
+		handledCounter: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "argocd_grpc_server_handled_total",
+				Help: "Number of RPCs completed on the server, regardless of success or failure.",
+			},
+			append(descGRPCDefaultLabels, "grpc_code"),
+		),
+		handlingHistogram: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "argocd_grpc_server_handling_seconds",
+				Help:    "Response latency of RPCs handled by the server.",
+				Buckets: prometheus.DefBuckets,
+			},
+			descGRPCDefaultLabels,
+		),
+		msgReceivedHistogram: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "argocd_grpc_server_msg_received_bytes",
+				Help:    "Size of the messages received by the server.",
+				Buckets: sizeBuckets,
+			},
+			descGRPCDefaultLabels,
+		),
+		msgSentHistogram: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "argocd_grpc_server_msg_sent_bytes",
+				Help:    "Size of the messages sent by the server.",
+				Buckets: sizeBuckets,
+			},
+			descGRPCDefaultLabels,
+		),
+	}
+}
+
+// Describe implements the prometheus.Collector interface
+func (m *GRPCMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.handledCounter.Describe(ch)
+	m.handlingHistogram.Describe(ch)
+	m.msgReceivedHistogram.Describe(ch)
+	m.msgSentHistogram.Describe(ch)
+}
+
+// Collect implements the prometheus.Collector interface
+func (m *GRPCMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.handledCounter.Collect(ch)
+	m.handlingHistogram.Collect(ch)
+	m.msgReceivedHistogram.Collect(ch)
+	m.msgSentHistogram.Collect(ch)
+}
+
+// UnaryServerInterceptor returns an interceptor recording the metrics of unary RPCs
+func (m *GRPCMetrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		labels := grpcLabels(info.FullMethod, "unary")
+		startTime := time.Now()
+		m.observeMsgSize(m.msgReceivedHistogram, labels, req)
+		resp, err := handler(ctx, req)
+		if err == nil {
+			m.observeMsgSize(m.msgSentHistogram, labels, resp)
+		}
+		m.observeHandled(labels, startTime, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns an interceptor recording the metrics of streaming RPCs
+func (m *GRPCMetrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		rpcType := "bidi_stream"
+		if info.IsClientStream && !info.IsServerStream {
+			rpcType = "client_stream"
+		} else if !info.IsClientStream && info.IsServerStream {
+			rpcType = "server_stream"
+		}
+		labels := grpcLabels(info.FullMethod, rpcType)
+		startTime := time.Now()
+		err := handler(srv, &monitoredServerStream{ServerStream: ss, metrics: m, labels: labels})
+		m.observeHandled(labels, startTime, err)
+		return err
+	}
+}
+
+func (m *GRPCMetrics) observeHandled(labels []string, startTime time.Time, err error) {
+	m.handledCounter.WithLabelValues(append(labels, status.Code(err).String())...).Inc()
+	m.handlingHistogram.WithLabelValues(labels...).Observe(time.Since(startTime).Seconds())
+}
+
+// observeMsgSize records the size of a message, if it is a protobuf message which knows its size
+func (m *GRPCMetrics) observeMsgSize(histogram *prometheus.HistogramVec, labels []string, msg interface{}) {
+	if sized, ok := msg.(interface {
+		Size() int
+	}); ok {
+		histogram.WithLabelValues(labels...).Observe(float64(sized.Size()))
+	}
+}
+
+// monitoredServerStream is a server stream recording the size of the messages it sends and receives
+type monitoredServerStream struct {
+	grpc.ServerStream
+	metrics *GRPCMetrics
+	labels  []string
+}
+
+func (s *monitoredServerStream) SendMsg(msg interface{}) error {
+	err := s.ServerStream.SendMsg(msg)
+	if err == nil {
+		s.metrics.observeMsgSize(s.metrics.msgSentHistogram, s.labels, msg)
+	}
+	return err
+}
+
+func (s *monitoredServerStream) RecvMsg(msg interface{}) error {
+	err := s.ServerStream.RecvMsg(msg)
+	if err == nil {
+		s.metrics.observeMsgSize(s.metrics.msgReceivedHistogram, s.labels, msg)
+	}
+	return err
+}
+
+// grpcLabels returns the service, method and type labels of an RPC from its full method name
+// (e.g. /application.ApplicationService/List)
+func grpcLabels(fullMethod string, rpcType string) []string {
+	service, method := "unknown", "unknown"
+	parts := strings.SplitN(strings.TrimPrefix(fullMethod, "/"), "/", 2)
+	if len(parts) == 2 {
+		service, method = parts[0], parts[1]
+	}
+	return []string{service, method, rpcType}
+}
@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type sizedMsg struct {
+	size int
+}
+
+func (m *sizedMsg) Size() int {
+	return m.size
+}
+
+func TestGRPCMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	grpcMetrics := NewGRPCMetrics()
+	metricsServ := NewMetricsServer(8082, NewAppRegistry(appLister, nil, false, grpcMetrics))
+
+	interceptor := grpcMetrics.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Get"}
+	_, err := interceptor(context.Background(), &sizedMsg{size: 10}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &sizedMsg{size: 1000}, nil
+	})
+	assert.NoError(t, err)
+	_, err = interceptor(context.Background(), &sizedMsg{size: 10}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Errorf(codes.NotFound, "not found")
+	})
+	assert.Error(t, err)
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	assert.Contains(t, body, `argocd_grpc_server_handled_total{grpc_code="OK",grpc_method="Get",grpc_service="application.ApplicationService",grpc_type="unary"} 1`)
+	assert.Contains(t, body, `argocd_grpc_server_handled_total{grpc_code="NotFound",grpc_method="Get",grpc_service="application.ApplicationService",grpc_type="unary"} 1`)
+	assert.Contains(t, body, `argocd_grpc_server_handling_seconds_count{grpc_method="Get",grpc_service="application.ApplicationService",grpc_type="unary"} 2`)
+	assert.Contains(t, body, `argocd_grpc_server_msg_received_bytes_sum{grpc_method="Get",grpc_service="application.ApplicationService",grpc_type="unary"} 20`)
+	assert.Contains(t, body, `argocd_grpc_server_msg_sent_bytes_sum{grpc_method="Get",grpc_service="application.ApplicationService",grpc_type="unary"} 1000`)
+}
@@ -1,13 +1,16 @@
 package metrics
 
 import (
+	"crypto/subtle"
 	"fmt"
 	"net/http"
+	"regexp"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	applister "github.com/argoproj/argo-cd/pkg/client/listers/application/v1alpha1"
@@ -21,67 +24,149 @@ const (
 var (
 	descAppDefaultLabels = []string{"namespace", "name"}
 
-	descAppInfo = prometheus.NewDesc(
-		"argocd_app_info",
-		"Information about application.",
-		append(descAppDefaultLabels, "project", "repo", "dest_server", "dest_namespace"),
-		nil,
-	)
 	descAppCreated = prometheus.NewDesc(
 		"argocd_app_created_time",
 		"Creation time in unix timestamp for an application.",
 		descAppDefaultLabels,
 		nil,
 	)
-	descAppSyncStatus = prometheus.NewDesc(
-		"argocd_app_sync_status",
-		"The application current sync status.",
-		append(descAppDefaultLabels, "sync_status"),
+
+	descAppLastSyncTimestamp = prometheus.NewDesc(
+		"argocd_app_last_sync_timestamp",
+		"Time in unix timestamp of the last successful sync of an application.",
+		descAppDefaultLabels,
+		nil,
+	)
+
+	descAppLastSyncRevisionInfo = prometheus.NewDesc(
+		"argocd_app_last_sync_revision_info",
+		"Revision of the last successful sync of an application.",
+		[]string{"namespace", "name", "revision"},
 		nil,
 	)
-	descAppHealthStatus = prometheus.NewDesc(
-		"argocd_app_health_status",
-		"The application current health status.",
-		append(descAppDefaultLabels, "health_status"),
+
+	descAppCondition = prometheus.NewDesc(
+		"argocd_app_condition",
+		"Number of current conditions of an application, by condition type.",
+		[]string{"namespace", "name", "condition"},
 		nil,
 	)
+
+	// invalidLabelCharsRE matches the characters of application labels which are not allowed in
+	// metric label names
+	invalidLabelCharsRE = regexp.MustCompile(`[^a-zA-Z0-9_]`)
 )
 
-// NewMetricsServer returns a new prometheus server which collects application metrics
-func NewMetricsServer(port int, appLister applister.ApplicationLister) *http.Server {
+// NewMetricsServer returns a new prometheus server which exposes the metrics of the given gatherer,
+// typically the registry returned by NewAppRegistry
+func NewMetricsServer(port int, gatherer prometheus.Gatherer) *http.Server {
 	mux := http.NewServeMux()
-	appRegistry := NewAppRegistry(appLister)
-	mux.Handle(MetricsPath, promhttp.HandlerFor(appRegistry, promhttp.HandlerOpts{}))
+	mux.Handle(MetricsPath, promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}))
 	return &http.Server{
 		Addr:    fmt.Sprintf("0.0.0.0:%d", port),
 		Handler: mux,
 	}
 }
 
+// NewBearerTokenHandler returns a handler which rejects the requests not authenticated with the given
+// bearer token
+func NewBearerTokenHandler(handler http.Handler, token string) http.Handler {
+	expected := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
 type appCollector struct {
-	store applister.ApplicationLister
+	store                applister.ApplicationLister
+	appLabels            []string
+	resourceCountByKind  bool
+	descAppInfo          *prometheus.Desc
+	descAppSyncStatus    *prometheus.Desc
+	descAppHealthStatus  *prometheus.Desc
+	descAppResourceCount *prometheus.Desc
 }
 
-// NewAppCollector returns a prometheus collector for application metrics
-func NewAppCollector(appLister applister.ApplicationLister) prometheus.Collector {
+// NewAppCollector returns a prometheus collector for application metrics. The values of the given
+// application labels are added to the info, sync status and health status metrics, as `label_<name>`
+// labels. If resourceCountByKind is set, the number of resources managed by the application is
+// labeled with their group and kind
+func NewAppCollector(appLister applister.ApplicationLister, appLabels []string, resourceCountByKind bool) prometheus.Collector {
+	var labelNames []string
+	var uniqueAppLabels []string
+	seen := make(map[string]bool)
+	for _, appLabel := range appLabels {
+		labelName := "label_" + invalidLabelCharsRE.ReplaceAllString(appLabel, "_")
+		if seen[labelName] {
+			continue
+		}
+		seen[labelName] = true
+		labelNames = append(labelNames, labelName)
+		uniqueAppLabels = append(uniqueAppLabels, appLabel)
+	}
+	withAppLabels := func(labels ...string) []string {
+		return append(append(append([]string{}, descAppDefaultLabels...), labels...), labelNames...)
+	}
+	resourceCountLabels := descAppDefaultLabels
+	if resourceCountByKind {
+		resourceCountLabels = append(append([]string{}, descAppDefaultLabels...), "group", "kind")
+	}
 	return &appCollector{
-		store: appLister,
+		store:               appLister,
+		appLabels:           uniqueAppLabels,
+		resourceCountByKind: resourceCountByKind,
+		descAppInfo: prometheus.NewDesc(
+			"argocd_app_info",
+			"Information about application.",
+			withAppLabels("project", "repo", "dest_server", "dest_namespace", "dest_name"),
+			nil,
+		),
+		descAppSyncStatus: prometheus.NewDesc(
+			"argocd_app_sync_status",
+			"The application current sync status.",
+			withAppLabels("sync_status"),
+			nil,
+		),
+		descAppHealthStatus: prometheus.NewDesc(
+			"argocd_app_health_status",
+			"The application current health status.",
+			withAppLabels("health_status"),
+			nil,
+		),
+		descAppResourceCount: prometheus.NewDesc(
+			"argocd_app_k8s_resource_count",
+			"Number of Kubernetes resources managed by the application.",
+			resourceCountLabels,
+			nil,
+		),
 	}
 }
 
-// NewAppRegistry creates a new prometheus registry that collects applications
-func NewAppRegistry(appLister applister.ApplicationLister) *prometheus.Registry {
+// NewAppRegistry returns a prometheus registry holding the application metrics, as well as the
+// metrics of the given collectors. The given application labels are added to the application metrics,
+// and the resource count is broken down by group and kind if resourceCountByKind is set
+func NewAppRegistry(appLister applister.ApplicationLister, appLabels []string, resourceCountByKind bool, collectors ...prometheus.Collector) *prometheus.Registry {
 	registry := prometheus.NewRegistry()
-	registry.MustRegister(NewAppCollector(appLister))
+	registry.MustRegister(NewAppCollector(appLister, appLabels, resourceCountByKind))
+	registry.MustRegister(collectors...)
 	return registry
 }
 
 // Describe implements the prometheus.Collector interface
 func (c *appCollector) Describe(ch chan<- *prometheus.Desc) {
-	ch <- descAppInfo
+	ch <- c.descAppInfo
 	ch <- descAppCreated
-	ch <- descAppSyncStatus
-	ch <- descAppHealthStatus
+	ch <- c.descAppSyncStatus
+	ch <- c.descAppHealthStatus
+	ch <- c.descAppResourceCount
+	ch <- descAppLastSyncTimestamp
+	ch <- descAppLastSyncRevisionInfo
+	ch <- descAppCondition
 }
 
 // Collect implements the prometheus.Collector interface
@@ -92,7 +177,7 @@ func (c *appCollector) Collect(ch chan<- prometheus.Metric) {
 		return
 	}
 	for _, app := range apps {
-		collectApps(ch, app)
+		c.collectApp(ch, app)
 	}
 }
 
@@ -103,7 +188,7 @@ func boolFloat64(b bool) float64 {
 	return 0
 }
 
-func collectApps(ch chan<- prometheus.Metric, app *argoappv1.Application) {
+func (c *appCollector) collectApp(ch chan<- prometheus.Metric, app *argoappv1.Application) {
 	addConstMetric := func(desc *prometheus.Desc, t prometheus.ValueType, v float64, lv ...string) {
 		lv = append([]string{app.Namespace, app.Name}, lv...)
 		ch <- prometheus.MustNewConstMetric(desc, t, v, lv...)
@@ -111,20 +196,64 @@ func collectApps(ch chan<- prometheus.Metric, app *argoappv1.Application) {
 	addGauge := func(desc *prometheus.Desc, v float64, lv ...string) {
 		addConstMetric(desc, prometheus.GaugeValue, v, lv...)
 	}
+	appLabelValues := make([]string, len(c.appLabels))
+	for i, appLabel := range c.appLabels {
+		appLabelValues[i] = app.Labels[appLabel]
+	}
+	addGaugeWithAppLabels := func(desc *prometheus.Desc, v float64, lv ...string) {
+		addGauge(desc, v, append(lv, appLabelValues...)...)
+	}
 
-	addGauge(descAppInfo, 1, app.Spec.Project, app.Spec.Source.RepoURL, app.Spec.Destination.Server, app.Spec.Destination.Namespace)
+	addGaugeWithAppLabels(c.descAppInfo, 1, app.Spec.Project, app.Spec.Source.RepoURL, app.Spec.Destination.Server, app.Spec.Destination.Namespace, app.Spec.Destination.Name)
 
 	addGauge(descAppCreated, float64(app.CreationTimestamp.Unix()))
 
 	syncStatus := app.Status.ComparisonResult.Status
-	addGauge(descAppSyncStatus, boolFloat64(syncStatus == argoappv1.ComparisonStatusSynced), string(argoappv1.ComparisonStatusSynced))
-	addGauge(descAppSyncStatus, boolFloat64(syncStatus == argoappv1.ComparisonStatusOutOfSync), string(argoappv1.ComparisonStatusOutOfSync))
-	addGauge(descAppSyncStatus, boolFloat64(syncStatus == argoappv1.ComparisonStatusUnknown || syncStatus == ""), string(argoappv1.ComparisonStatusUnknown))
+	addGaugeWithAppLabels(c.descAppSyncStatus, boolFloat64(syncStatus == argoappv1.ComparisonStatusSynced), string(argoappv1.ComparisonStatusSynced))
+	addGaugeWithAppLabels(c.descAppSyncStatus, boolFloat64(syncStatus == argoappv1.ComparisonStatusOutOfSync), string(argoappv1.ComparisonStatusOutOfSync))
+	addGaugeWithAppLabels(c.descAppSyncStatus, boolFloat64(syncStatus == argoappv1.ComparisonStatusUnknown || syncStatus == ""), string(argoappv1.ComparisonStatusUnknown))
 
 	healthStatus := app.Status.Health.Status
-	addGauge(descAppHealthStatus, boolFloat64(healthStatus == argoappv1.HealthStatusUnknown || healthStatus == ""), string(argoappv1.HealthStatusUnknown))
-	addGauge(descAppHealthStatus, boolFloat64(healthStatus == argoappv1.HealthStatusProgressing), string(argoappv1.HealthStatusProgressing))
-	addGauge(descAppHealthStatus, boolFloat64(healthStatus == argoappv1.HealthStatusHealthy), string(argoappv1.HealthStatusHealthy))
-	addGauge(descAppHealthStatus, boolFloat64(healthStatus == argoappv1.HealthStatusDegraded), string(argoappv1.HealthStatusDegraded))
-	addGauge(descAppHealthStatus, boolFloat64(healthStatus == argoappv1.HealthStatusMissing), string(argoappv1.HealthStatusMissing))
+	addGaugeWithAppLabels(c.descAppHealthStatus, boolFloat64(healthStatus == argoappv1.HealthStatusUnknown || healthStatus == ""), string(argoappv1.HealthStatusUnknown))
+	addGaugeWithAppLabels(c.descAppHealthStatus, boolFloat64(healthStatus == argoappv1.HealthStatusProgressing), string(argoappv1.HealthStatusProgressing))
+	addGaugeWithAppLabels(c.descAppHealthStatus, boolFloat64(healthStatus == argoappv1.HealthStatusHealthy), string(argoappv1.HealthStatusHealthy))
+	addGaugeWithAppLabels(c.descAppHealthStatus, boolFloat64(healthStatus == argoappv1.HealthStatusDegraded), string(argoappv1.HealthStatusDegraded))
+	addGaugeWithAppLabels(c.descAppHealthStatus, boolFloat64(healthStatus == argoappv1.HealthStatusMissing), string(argoappv1.HealthStatusMissing))
+
+	// the history only records successful syncs, the last one being the most recent
+	if len(app.Status.History) > 0 {
+		lastSync := app.Status.History[len(app.Status.History)-1]
+		addGauge(descAppLastSyncTimestamp, float64(lastSync.DeployedAt.Unix()))
+		addGauge(descAppLastSyncRevisionInfo, 1, lastSync.Revision)
+	}
+
+	// conditions are reported even if the sync status is still Synced, e.g. when the comparison fails
+	conditionCounts := make(map[argoappv1.ApplicationConditionType]int)
+	for _, condition := range app.Status.Conditions {
+		conditionCounts[condition.Type]++
+	}
+	for conditionType, count := range conditionCounts {
+		addGauge(descAppCondition, float64(count), conditionType)
+	}
+
+	// the resources are counted from the status reported by the controller, hooks excepted
+	if c.resourceCountByKind {
+		counts := make(map[schema.GroupKind]int)
+		for _, res := range app.Status.Resources {
+			if !res.Hook {
+				counts[schema.GroupKind{Group: res.Group, Kind: res.Kind}]++
+			}
+		}
+		for gk, count := range counts {
+			addGauge(c.descAppResourceCount, float64(count), gk.Group, gk.Kind)
+		}
+	} else {
+		count := 0
+		for _, res := range app.Status.Resources {
+			if !res.Hook {
+				count++
+			}
+		}
+		addGauge(c.descAppResourceCount, float64(count))
+	}
 }
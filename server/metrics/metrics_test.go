@@ -24,6 +24,9 @@ kind: Application
 metadata:
   name: my-app
   namespace: argocd
+  labels:
+    team: my-team
+    app.kubernetes.io/part-of: guestbook
 spec:
   destination:
     namespace: dummy-namespace
@@ -35,11 +38,39 @@ spec:
 status:
   comparisonResult:
     status: Synced
+  conditions:
+  - type: ComparisonError
+    message: "rpc error: code = Unknown desc = helm template failed"
+  - type: SharedResourceWarning
+    message: Service/guestbook-ui is part of a different application
   health:
     status: Healthy
+  history:
+  - id: 1
+    revision: 0a1b2c3d4e5f60718293a4b5c6d7e8f901234567
+    deployedAt: "2019-01-01T00:00:00Z"
+  - id: 2
+    revision: 1a2b3c4d5e6f70819203a4b5c6d7e8f901234567
+    deployedAt: "2019-01-02T00:00:00Z"
+  resources:
+  - kind: Service
+    name: guestbook-ui
+  - group: apps
+    kind: Deployment
+    name: guestbook-ui
+  - group: apps
+    kind: Deployment
+    name: guestbook-db
+  - kind: Pod
+    name: guestbook-migrate
+    hook: true
 `
 
-var expectedResponse = `# HELP argocd_app_created_time Creation time in unix timestamp for an application.
+var expectedResponse = `# HELP argocd_app_condition Number of current conditions of an application, by condition type.
+# TYPE argocd_app_condition gauge
+argocd_app_condition{condition="ComparisonError",name="my-app",namespace="argocd"} 1
+argocd_app_condition{condition="SharedResourceWarning",name="my-app",namespace="argocd"} 1
+# HELP argocd_app_created_time Creation time in unix timestamp for an application.
 # TYPE argocd_app_created_time gauge
 argocd_app_created_time{name="my-app",namespace="argocd"} -6.21355968e+10
 # HELP argocd_app_health_status The application current health status.
@@ -51,7 +82,16 @@ argocd_app_health_status{health_status="Progressing",name="my-app",namespace="ar
 argocd_app_health_status{health_status="Unknown",name="my-app",namespace="argocd"} 0
 # HELP argocd_app_info Information about application.
 # TYPE argocd_app_info gauge
-argocd_app_info{dest_namespace="dummy-namespace",dest_server="https://localhost:6443",name="my-app",namespace="argocd",project="default",repo="https://github.com/argoproj/argocd-example-apps.git"} 1
+argocd_app_info{dest_name="",dest_namespace="dummy-namespace",dest_server="https://localhost:6443",name="my-app",namespace="argocd",project="default",repo="https://github.com/argoproj/argocd-example-apps.git"} 1
+# HELP argocd_app_k8s_resource_count Number of Kubernetes resources managed by the application.
+# TYPE argocd_app_k8s_resource_count gauge
+argocd_app_k8s_resource_count{name="my-app",namespace="argocd"} 3
+# HELP argocd_app_last_sync_revision_info Revision of the last successful sync of an application.
+# TYPE argocd_app_last_sync_revision_info gauge
+argocd_app_last_sync_revision_info{name="my-app",namespace="argocd",revision="1a2b3c4d5e6f70819203a4b5c6d7e8f901234567"} 1
+# HELP argocd_app_last_sync_timestamp Time in unix timestamp of the last successful sync of an application.
+# TYPE argocd_app_last_sync_timestamp gauge
+argocd_app_last_sync_timestamp{name="my-app",namespace="argocd"} 1.5463872e+09
 # HELP argocd_app_sync_status The application current sync status.
 # TYPE argocd_app_sync_status gauge
 argocd_app_sync_status{name="my-app",namespace="argocd",sync_status="OutOfSync"} 0
@@ -84,7 +124,7 @@ func newFakeLister() (context.CancelFunc, applister.ApplicationLister) {
 func TestMetrics(t *testing.T) {
 	cancel, appLister := newFakeLister()
 	defer cancel()
-	metricsServ := NewMetricsServer(8082, appLister)
+	metricsServ := NewMetricsServer(8082, NewAppRegistry(appLister, nil, false))
 	req, err := http.NewRequest("GET", "/metrics", nil)
 	assert.NoError(t, err)
 	rr := httptest.NewRecorder()
@@ -94,3 +134,61 @@ func TestMetrics(t *testing.T) {
 	log.Println(body)
 	assert.Equal(t, expectedResponse, body)
 }
+
+func TestMetricsWithAppLabels(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ := NewMetricsServer(8082, NewAppRegistry(appLister, []string{"team", "app.kubernetes.io/part-of", "env"}, false))
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	assert.Contains(t, body, `argocd_app_info{dest_name="",dest_namespace="dummy-namespace",dest_server="https://localhost:6443",label_app_kubernetes_io_part_of="guestbook",label_env="",label_team="my-team",name="my-app",namespace="argocd",project="default",repo="https://github.com/argoproj/argocd-example-apps.git"} 1`)
+	assert.Contains(t, body, `argocd_app_sync_status{label_app_kubernetes_io_part_of="guestbook",label_env="",label_team="my-team",name="my-app",namespace="argocd",sync_status="Synced"} 1`)
+	assert.Contains(t, body, `argocd_app_health_status{health_status="Healthy",label_app_kubernetes_io_part_of="guestbook",label_env="",label_team="my-team",name="my-app",namespace="argocd"} 1`)
+	assert.Contains(t, body, `argocd_app_created_time{name="my-app",namespace="argocd"}`)
+}
+
+func TestMetricsResourceCountByKind(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ := NewMetricsServer(8082, NewAppRegistry(appLister, nil, true))
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	assert.Contains(t, body, `argocd_app_k8s_resource_count{group="",kind="Service",name="my-app",namespace="argocd"} 1`)
+	assert.Contains(t, body, `argocd_app_k8s_resource_count{group="apps",kind="Deployment",name="my-app",namespace="argocd"} 2`)
+	assert.NotContains(t, body, `kind="Pod"`)
+}
+
+func TestBearerTokenHandler(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ := NewMetricsServer(8082, NewAppRegistry(appLister, nil, false))
+	handler := NewBearerTokenHandler(metricsServ.Handler, "my-token")
+
+	for _, authorization := range []string{"", "Bearer other-token", "my-token"} {
+		req, err := http.NewRequest("GET", "/metrics", nil)
+		assert.NoError(t, err)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.Equal(t, "Bearer", rr.Header().Get("WWW-Authenticate"))
+	}
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer my-token")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, expectedResponse, rr.Body.String())
+}
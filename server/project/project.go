@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"strings"
 
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo-cd/common"
@@ -17,11 +19,13 @@ import (
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/argo"
+	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/git"
 	"github.com/argoproj/argo-cd/util/grpc"
 	projectutil "github.com/argoproj/argo-cd/util/project"
 	"github.com/argoproj/argo-cd/util/rbac"
 	"github.com/argoproj/argo-cd/util/session"
+	"github.com/argoproj/argo-cd/util/settings"
 	jwt "github.com/dgrijalva/jwt-go"
 )
 
@@ -39,12 +43,13 @@ type Server struct {
 	auditLogger   *argo.AuditLogger
 	projectLock   *util.KeyLock
 	sessionMgr    *session.SessionManager
+	db            db.ArgoDB
 }
 
 // NewServer returns a new instance of the Project service
 func NewServer(ns string, kubeclientset kubernetes.Interface, appclientset appclientset.Interface, enf *rbac.Enforcer, projectLock *util.KeyLock, sessionMgr *session.SessionManager) *Server {
 	auditLogger := argo.NewAuditLogger(ns, kubeclientset, "argocd-server")
-	return &Server{enf: enf, appclientset: appclientset, kubeclientset: kubeclientset, ns: ns, projectLock: projectLock, auditLogger: auditLogger, sessionMgr: sessionMgr}
+	return &Server{enf: enf, appclientset: appclientset, kubeclientset: kubeclientset, ns: ns, projectLock: projectLock, auditLogger: auditLogger, sessionMgr: sessionMgr, db: db.NewDB(ns, kubeclientset)}
 }
 
 // CreateToken creates a new token to access a project
@@ -152,7 +157,7 @@ func (s *Server) Create(ctx context.Context, q *ProjectCreateRequest) (*v1alpha1
 
 // List returns list of projects
 func (s *Server) List(ctx context.Context, q *ProjectQuery) (*v1alpha1.AppProjectList, error) {
-	list, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).List(metav1.ListOptions{})
+	list, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).List(metav1.ListOptions{LabelSelector: q.Selector})
 	if list != nil {
 		newItems := make([]v1alpha1.AppProject, 0)
 		for i := range list.Items {
@@ -276,6 +281,18 @@ func validateProject(p *v1alpha1.AppProject) error {
 			return status.Errorf(codes.InvalidArgument, "destination %s should not be listed more than once.", key)
 		}
 	}
+	saKeys := make(map[string]bool)
+	for _, sa := range p.Spec.DestinationServiceAccounts {
+		key := fmt.Sprintf("%s/%s", sa.Server, sa.Namespace)
+		if _, ok := saKeys[key]; !ok {
+			saKeys[key] = true
+		} else {
+			return status.Errorf(codes.InvalidArgument, "service account of destination %s should not be listed more than once.", key)
+		}
+		if errs := validation.IsDNS1123Subdomain(sa.ServiceAccount); len(errs) > 0 {
+			return status.Errorf(codes.InvalidArgument, "invalid service account '%s' for destination %s: %s", sa.ServiceAccount, key, strings.Join(errs, ", "))
+		}
+	}
 	srcRepos := make(map[string]bool)
 	for i, src := range p.Spec.SourceRepos {
 		if src != "*" {
@@ -347,7 +364,18 @@ func (s *Server) Update(ctx context.Context, q *ProjectUpdateRequest) (*v1alpha1
 	removedSrcUsed := make([]string, 0)
 
 	for _, a := range argo.FilterByProjects(appsList.Items, []string{q.Project.Name}) {
-		if dest, ok := removedDst[fmt.Sprintf("%s/%s", a.Spec.Destination.Server, a.Spec.Destination.Namespace)]; ok {
+		server := a.Spec.Destination.Server
+		if a.Spec.Destination.Name != "" {
+			// the destinations of the project are servers: resolve the cluster the app refers to by name
+			cluster, err := s.db.GetClusterByName(ctx, a.Spec.Destination.Name)
+			if err != nil && status.Convert(err).Code() != codes.NotFound {
+				return nil, err
+			}
+			if cluster != nil {
+				server = cluster.Server
+			}
+		}
+		if dest, ok := removedDst[fmt.Sprintf("%s/%s", server, a.Spec.Destination.Namespace)]; ok {
 			removedDstUsed = append(removedDstUsed, dest)
 		}
 		if _, ok := removedSrc[a.Spec.Source.RepoURL]; ok {
@@ -416,8 +444,8 @@ func (s *Server) Delete(ctx context.Context, q *ProjectQuery) (*EmptyResponse, e
 		return nil, err
 	}
 	apps := argo.FilterByProjects(appsList.Items, []string{q.Name})
-	if len(apps) > 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "project is referenced by %d applications", len(apps))
+	if len(apps) > 0 && !q.Force {
+		return nil, argo.ReferencedByAppsError(fmt.Sprintf("project '%s'", q.Name), apps)
 	}
 	err = s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Delete(q.Name, &metav1.DeleteOptions{})
 	if err == nil {
@@ -426,6 +454,55 @@ func (s *Server) Delete(ctx context.Context, q *ProjectQuery) (*EmptyResponse, e
 	return &EmptyResponse{}, err
 }
 
+// SetReconciliationPause pauses or resumes the reconciliation of the applications of a project, or of all
+// applications if no project is given
+func (s *Server) SetReconciliationPause(ctx context.Context, q *ProjectReconciliationPauseRequest) (*EmptyResponse, error) {
+	pause, ok := v1alpha1.ParseReconciliationPause(q.Pause)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reconciliation pause '%s': must be 'sync', 'all' or empty", q.Pause)
+	}
+	action := "resumed reconciliation"
+	if pause != v1alpha1.ReconciliationPauseNone {
+		action = fmt.Sprintf("paused reconciliation (%s)", pause)
+	}
+	if q.Name == "" {
+		// the global maintenance mode requires the permission to update all projects
+		if !s.enf.EnforceClaims(ctx.Value("claims"), "projects", "update", "*") {
+			return nil, grpc.ErrPermissionDenied
+		}
+		err := settings.NewSettingsManager(s.kubeclientset, s.ns).SetReconciliationPause(string(pause))
+		if err != nil {
+			return nil, err
+		}
+		log.Infof("%s %s of all applications", session.Username(ctx), action)
+		return &EmptyResponse{}, nil
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "projects", "update", q.Name) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	s.projectLock.Lock(q.Name)
+	defer s.projectLock.Unlock(q.Name)
+
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if proj.Annotations == nil {
+		proj.Annotations = make(map[string]string)
+	}
+	if pause != v1alpha1.ReconciliationPauseNone {
+		proj.Annotations[common.AnnotationPauseReconciliation] = string(pause)
+	} else {
+		delete(proj.Annotations, common.AnnotationPauseReconciliation)
+	}
+	proj, err = s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Update(proj)
+	if err != nil {
+		return nil, err
+	}
+	s.logEvent(proj, ctx, argo.EventReasonResourceUpdated, action)
+	return &EmptyResponse{}, nil
+}
+
 func (s *Server) ListEvents(ctx context.Context, q *ProjectQuery) (*v1.EventList, error) {
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "projects", "get", q.Name) {
 		return nil, grpc.ErrPermissionDenied
@@ -46,7 +46,7 @@ func (m *ProjectCreateRequest) Reset()         { *m = ProjectCreateRequest{} }
 func (m *ProjectCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*ProjectCreateRequest) ProtoMessage()    {}
 func (*ProjectCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_8d94583ca767d5b3, []int{0}
+	return fileDescriptor_project_ce9609783d4abe91, []int{0}
 }
 func (m *ProjectCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -96,7 +96,7 @@ func (m *ProjectTokenDeleteRequest) Reset()         { *m = ProjectTokenDeleteReq
 func (m *ProjectTokenDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*ProjectTokenDeleteRequest) ProtoMessage()    {}
 func (*ProjectTokenDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_8d94583ca767d5b3, []int{1}
+	return fileDescriptor_project_ce9609783d4abe91, []int{1}
 }
 func (m *ProjectTokenDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -162,7 +162,7 @@ func (m *ProjectTokenCreateRequest) Reset()         { *m = ProjectTokenCreateReq
 func (m *ProjectTokenCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*ProjectTokenCreateRequest) ProtoMessage()    {}
 func (*ProjectTokenCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_8d94583ca767d5b3, []int{2}
+	return fileDescriptor_project_ce9609783d4abe91, []int{2}
 }
 func (m *ProjectTokenCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -231,7 +231,7 @@ func (m *ProjectTokenResponse) Reset()         { *m = ProjectTokenResponse{} }
 func (m *ProjectTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*ProjectTokenResponse) ProtoMessage()    {}
 func (*ProjectTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_8d94583ca767d5b3, []int{3}
+	return fileDescriptor_project_ce9609783d4abe91, []int{3}
 }
 func (m *ProjectTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,7 +269,11 @@ func (m *ProjectTokenResponse) GetToken() string {
 
 // ProjectQuery is a query for Project resources
 type ProjectQuery struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the selector to restrict returned list to projects only with matched labels
+	Selector string `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+	// force deletes the project even if applications still belong to it
+	Force                bool     `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -279,7 +283,7 @@ func (m *ProjectQuery) Reset()         { *m = ProjectQuery{} }
 func (m *ProjectQuery) String() string { return proto.CompactTextString(m) }
 func (*ProjectQuery) ProtoMessage()    {}
 func (*ProjectQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_8d94583ca767d5b3, []int{4}
+	return fileDescriptor_project_ce9609783d4abe91, []int{4}
 }
 func (m *ProjectQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -315,6 +319,20 @@ func (m *ProjectQuery) GetName() string {
 	return ""
 }
 
+func (m *ProjectQuery) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+func (m *ProjectQuery) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
 type ProjectUpdateRequest struct {
 	Project              *v1alpha1.AppProject `protobuf:"bytes,1,opt,name=project" json:"project,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -326,7 +344,7 @@ func (m *ProjectUpdateRequest) Reset()         { *m = ProjectUpdateRequest{} }
 func (m *ProjectUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*ProjectUpdateRequest) ProtoMessage()    {}
 func (*ProjectUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_8d94583ca767d5b3, []int{5}
+	return fileDescriptor_project_ce9609783d4abe91, []int{5}
 }
 func (m *ProjectUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -362,6 +380,65 @@ func (m *ProjectUpdateRequest) GetProject() *v1alpha1.AppProject {
 	return nil
 }
 
+// ProjectReconciliationPauseRequest pauses or resumes the reconciliation of the applications of a
+// project, or of all applications if the project is empty
+type ProjectReconciliationPauseRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// pause is the part of the reconciliation which is paused: "sync" for the automated syncs, "all"
+	// for the automated syncs and the comparisons, or empty to resume the reconciliation
+	Pause                string   `protobuf:"bytes,2,opt,name=pause,proto3" json:"pause,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProjectReconciliationPauseRequest) Reset()         { *m = ProjectReconciliationPauseRequest{} }
+func (m *ProjectReconciliationPauseRequest) String() string { return proto.CompactTextString(m) }
+func (*ProjectReconciliationPauseRequest) ProtoMessage()    {}
+func (*ProjectReconciliationPauseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_ce9609783d4abe91, []int{6}
+}
+func (m *ProjectReconciliationPauseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectReconciliationPauseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectReconciliationPauseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ProjectReconciliationPauseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectReconciliationPauseRequest.Merge(dst, src)
+}
+func (m *ProjectReconciliationPauseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectReconciliationPauseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectReconciliationPauseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectReconciliationPauseRequest proto.InternalMessageInfo
+
+func (m *ProjectReconciliationPauseRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProjectReconciliationPauseRequest) GetPause() string {
+	if m != nil {
+		return m.Pause
+	}
+	return ""
+}
+
 type EmptyResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -372,7 +449,7 @@ func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
 func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
 func (*EmptyResponse) ProtoMessage()    {}
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_8d94583ca767d5b3, []int{6}
+	return fileDescriptor_project_ce9609783d4abe91, []int{7}
 }
 func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -408,6 +485,7 @@ func init() {
 	proto.RegisterType((*ProjectTokenResponse)(nil), "project.ProjectTokenResponse")
 	proto.RegisterType((*ProjectQuery)(nil), "project.ProjectQuery")
 	proto.RegisterType((*ProjectUpdateRequest)(nil), "project.ProjectUpdateRequest")
+	proto.RegisterType((*ProjectReconciliationPauseRequest)(nil), "project.ProjectReconciliationPauseRequest")
 	proto.RegisterType((*EmptyResponse)(nil), "project.EmptyResponse")
 }
 
@@ -436,6 +514,9 @@ type ProjectServiceClient interface {
 	Update(ctx context.Context, in *ProjectUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error)
 	// Delete deletes a project
 	Delete(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SetReconciliationPause pauses or resumes the reconciliation of the applications of a project, or of
+	// all applications
+	SetReconciliationPause(ctx context.Context, in *ProjectReconciliationPauseRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	// ListEvents returns a list of project events
 	ListEvents(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*v1.EventList, error)
 }
@@ -511,6 +592,15 @@ func (c *projectServiceClient) Delete(ctx context.Context, in *ProjectQuery, opt
 	return out, nil
 }
 
+func (c *projectServiceClient) SetReconciliationPause(ctx context.Context, in *ProjectReconciliationPauseRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/project.ProjectService/SetReconciliationPause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) ListEvents(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*v1.EventList, error) {
 	out := new(v1.EventList)
 	err := c.cc.Invoke(ctx, "/project.ProjectService/ListEvents", in, out, opts...)
@@ -537,6 +627,9 @@ type ProjectServiceServer interface {
 	Update(context.Context, *ProjectUpdateRequest) (*v1alpha1.AppProject, error)
 	// Delete deletes a project
 	Delete(context.Context, *ProjectQuery) (*EmptyResponse, error)
+	// SetReconciliationPause pauses or resumes the reconciliation of the applications of a project, or of
+	// all applications
+	SetReconciliationPause(context.Context, *ProjectReconciliationPauseRequest) (*EmptyResponse, error)
 	// ListEvents returns a list of project events
 	ListEvents(context.Context, *ProjectQuery) (*v1.EventList, error)
 }
@@ -671,6 +764,24 @@ func _ProjectService_Delete_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_SetReconciliationPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectReconciliationPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).SetReconciliationPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.ProjectService/SetReconciliationPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).SetReconciliationPause(ctx, req.(*ProjectReconciliationPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProjectQuery)
 	if err := dec(in); err != nil {
@@ -721,6 +832,10 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ProjectService_Delete_Handler,
 		},
+		{
+			MethodName: "SetReconciliationPause",
+			Handler:    _ProjectService_SetReconciliationPause_Handler,
+		},
 		{
 			MethodName: "ListEvents",
 			Handler:    _ProjectService_ListEvents_Handler,
@@ -891,6 +1006,22 @@ func (m *ProjectQuery) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintProject(dAtA, i, uint64(len(m.Name)))
 		i += copy(dAtA[i:], m.Name)
 	}
+	if len(m.Selector) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Selector)))
+		i += copy(dAtA[i:], m.Selector)
+	}
+	if m.Force {
+		dAtA[i] = 0x18
+		i++
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -928,6 +1059,39 @@ func (m *ProjectUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ProjectReconciliationPauseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectReconciliationPauseRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Pause) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Pause)))
+		i += copy(dAtA[i:], m.Pause)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1035,6 +1199,13 @@ func (m *ProjectQuery) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovProject(uint64(l))
 	}
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1054,6 +1225,23 @@ func (m *ProjectUpdateRequest) Size() (n int) {
 	return n
 }
 
+func (m *ProjectReconciliationPauseRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Pause)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *EmptyResponse) Size() (n int) {
 	var l int
 	_ = l
@@ -1583,6 +1771,55 @@ func (m *ProjectQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProject(dAtA[iNdEx:])
@@ -1689,6 +1926,115 @@ func (m *ProjectUpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProjectReconciliationPauseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectReconciliationPauseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectReconciliationPauseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pause = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EmptyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1846,53 +2192,59 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/project/project.proto", fileDescriptor_project_8d94583ca767d5b3)
-}
-
-var fileDescriptor_project_8d94583ca767d5b3 = []byte{
-	// 697 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x5d, 0x6b, 0x13, 0x4d,
-	0x14, 0x66, 0x9a, 0xbe, 0x79, 0xdf, 0x4e, 0x5e, 0xb5, 0x0c, 0xad, 0xa6, 0xb1, 0x8d, 0x61, 0x2e,
-	0xa4, 0x04, 0x3b, 0x43, 0x5a, 0x85, 0xa2, 0x57, 0x7e, 0x14, 0x29, 0x78, 0xa1, 0x51, 0x41, 0xf4,
-	0xa2, 0x4c, 0x37, 0x87, 0xed, 0x36, 0xc9, 0xce, 0x38, 0x3b, 0x5d, 0x2d, 0x25, 0x37, 0x45, 0x04,
-	0xf5, 0xd2, 0x9f, 0xe0, 0xad, 0x3f, 0xc4, 0x4b, 0xc1, 0x3f, 0x20, 0xc5, 0x1f, 0x22, 0x33, 0xbb,
-	0x9b, 0x64, 0x9b, 0x6e, 0x41, 0x08, 0x5e, 0xe5, 0xec, 0x99, 0x33, 0xe7, 0x79, 0x9e, 0xf3, 0x91,
-	0xc1, 0xcb, 0x11, 0xe8, 0x18, 0x34, 0x57, 0x5a, 0xee, 0x83, 0x67, 0xb2, 0x5f, 0xa6, 0xb4, 0x34,
-	0x92, 0xfc, 0x9b, 0x7e, 0xd6, 0x16, 0x7c, 0xe9, 0x4b, 0xe7, 0xe3, 0xd6, 0x4a, 0x8e, 0x6b, 0xcb,
-	0xbe, 0x94, 0x7e, 0x0f, 0xb8, 0x50, 0x01, 0x17, 0x61, 0x28, 0x8d, 0x30, 0x81, 0x0c, 0xa3, 0xf4,
-	0x94, 0x76, 0x37, 0x23, 0x16, 0x48, 0x77, 0xea, 0x49, 0x0d, 0x3c, 0x6e, 0x71, 0x1f, 0x42, 0xd0,
-	0xc2, 0x40, 0x27, 0x8d, 0xb9, 0x39, 0x8a, 0xe9, 0x0b, 0x6f, 0x2f, 0x08, 0x41, 0x1f, 0x72, 0xd5,
-	0xf5, 0xad, 0x23, 0xe2, 0x7d, 0x30, 0xe2, 0xac, 0x5b, 0xdb, 0x7e, 0x60, 0xf6, 0x0e, 0x76, 0x99,
-	0x27, 0xfb, 0x5c, 0x68, 0x47, 0x6c, 0xdf, 0x19, 0x6b, 0x5e, 0x67, 0x74, 0x5b, 0x28, 0xd5, 0x0b,
-	0x3c, 0x47, 0x89, 0xc7, 0x2d, 0xd1, 0x53, 0x7b, 0x62, 0x22, 0x15, 0x7d, 0x83, 0x17, 0x1e, 0x27,
-	0x1a, 0xef, 0x6b, 0x10, 0x06, 0xda, 0xf0, 0xfa, 0x00, 0x22, 0x43, 0x76, 0x70, 0xa6, 0xbd, 0x8a,
-	0x1a, 0x68, 0xb5, 0xb2, 0xbe, 0xc5, 0x46, 0xa0, 0x2c, 0x03, 0x75, 0xc6, 0x8e, 0xd7, 0x61, 0xaa,
-	0xeb, 0x33, 0x0b, 0xca, 0xc6, 0x40, 0x59, 0x06, 0xca, 0xee, 0x2a, 0x95, 0x82, 0xb4, 0xb3, 0xac,
-	0xf4, 0x15, 0x5e, 0x4a, 0x7d, 0xcf, 0x64, 0x17, 0xc2, 0x07, 0xd0, 0x83, 0x11, 0x7a, 0x35, 0x8f,
-	0x3e, 0x37, 0xbc, 0x46, 0x08, 0x9e, 0xd5, 0xb2, 0x07, 0xd5, 0x19, 0xe7, 0x76, 0x36, 0x99, 0xc7,
-	0xa5, 0x40, 0x98, 0x6a, 0xa9, 0x81, 0x56, 0x4b, 0x6d, 0x6b, 0xd2, 0x0f, 0x28, 0x9f, 0x3d, 0xaf,
-	0xad, 0x38, 0x7b, 0x03, 0x57, 0x3a, 0x10, 0x79, 0x3a, 0x50, 0x56, 0x40, 0x0a, 0x32, 0xee, 0x1a,
-	0xe2, 0x97, 0xc6, 0xf0, 0x97, 0xf1, 0x1c, 0xbc, 0x55, 0x81, 0x86, 0x68, 0x3b, 0xac, 0xce, 0x3a,
-	0x16, 0x23, 0x07, 0xbd, 0x31, 0xac, 0xb0, 0xa3, 0xd2, 0x86, 0x48, 0xc9, 0x30, 0x02, 0xb2, 0x80,
-	0xff, 0x31, 0xd6, 0x91, 0x72, 0x48, 0x3e, 0x28, 0xc5, 0xff, 0xa7, 0xd1, 0x4f, 0x0e, 0x40, 0x1f,
-	0x5a, 0xbc, 0x50, 0xf4, 0x21, 0x0d, 0x72, 0xf6, 0x58, 0xcf, 0x9e, 0xab, 0xce, 0xdf, 0xec, 0xd9,
-	0x25, 0x7c, 0x61, 0xab, 0xaf, 0xcc, 0x61, 0xa6, 0x61, 0xfd, 0xeb, 0x7f, 0xf8, 0x62, 0x1a, 0xf5,
-	0x14, 0x74, 0x1c, 0x78, 0x40, 0x3e, 0x22, 0x5c, 0x49, 0xca, 0xed, 0xe4, 0x12, 0xca, 0xb2, 0x95,
-	0x2a, 0x6c, 0x48, 0x6d, 0xe5, 0xcc, 0x98, 0x0c, 0x85, 0x6e, 0x1e, 0xff, 0xf8, 0xf5, 0x79, 0x66,
-	0x9d, 0xae, 0xb9, 0x55, 0x8a, 0x5b, 0xd9, 0x92, 0x46, 0xfc, 0x28, 0xb5, 0x06, 0xdc, 0x36, 0x22,
-	0xe2, 0x47, 0xf6, 0x67, 0xc0, 0x5d, 0x29, 0x6f, 0xa3, 0x26, 0x79, 0x8f, 0x70, 0x25, 0x99, 0xac,
-	0xf3, 0xc8, 0xe4, 0x66, 0xaf, 0x76, 0x79, 0x18, 0x93, 0xd3, 0x4a, 0xef, 0x38, 0x16, 0xb7, 0x9a,
-	0x1b, 0x7f, 0xc4, 0x82, 0x1f, 0x05, 0xc2, 0x0c, 0xc8, 0x27, 0x84, 0xcb, 0x89, 0x66, 0x32, 0x21,
-	0x36, 0x5f, 0x8b, 0xe9, 0xf4, 0x8c, 0x5e, 0x75, 0x6c, 0x17, 0xe9, 0xfc, 0x69, 0xb6, 0xb6, 0x2c,
-	0xc7, 0x08, 0xcf, 0x3e, 0x0a, 0x22, 0x43, 0x16, 0x4f, 0x73, 0x71, 0x43, 0x57, 0xdb, 0x9e, 0x0a,
-	0x07, 0x8b, 0x40, 0xab, 0x8e, 0x07, 0x21, 0x13, 0x3c, 0xc8, 0x3b, 0x84, 0x4b, 0x0f, 0xa1, 0x90,
-	0xc3, 0x94, 0xea, 0x70, 0xcd, 0xe1, 0x2f, 0x91, 0x2b, 0x93, 0x5d, 0xb3, 0xbb, 0x34, 0x20, 0x5f,
-	0x10, 0x2e, 0x27, 0x6b, 0x34, 0xd9, 0x99, 0xdc, 0x7a, 0x4d, 0x8b, 0xd1, 0x86, 0x63, 0xb4, 0x56,
-	0x5b, 0x2d, 0x9c, 0x23, 0x66, 0xff, 0xf7, 0x3b, 0xc2, 0x08, 0xe6, 0x28, 0xda, 0x8e, 0xbd, 0xc0,
-	0xe5, 0x64, 0x4a, 0x8b, 0xca, 0x55, 0x34, 0xb5, 0xa9, 0xfe, 0x66, 0xa1, 0xfe, 0x7d, 0x8c, 0x6d,
-	0xa3, 0xb6, 0x62, 0x08, 0x4d, 0x54, 0x94, 0x7d, 0x85, 0x25, 0xef, 0x94, 0x55, 0xc8, 0xec, 0x5b,
-	0xc6, 0xe2, 0x16, 0x73, 0x57, 0x5c, 0x93, 0xaf, 0x3b, 0x90, 0x06, 0xa9, 0x17, 0x80, 0x70, 0x70,
-	0xd9, 0xef, 0x6d, 0x7e, 0x3b, 0xa9, 0xa3, 0xef, 0x27, 0x75, 0xf4, 0xf3, 0xa4, 0x8e, 0x5e, 0x36,
-	0xcf, 0x7b, 0xc5, 0xf2, 0xcf, 0xf2, 0x6e, 0xd9, 0xbd, 0x56, 0x1b, 0xbf, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x41, 0x42, 0x44, 0x21, 0xaf, 0x07, 0x00, 0x00,
+	proto.RegisterFile("server/project/project.proto", fileDescriptor_project_ce9609783d4abe91)
+}
+
+var fileDescriptor_project_ce9609783d4abe91 = []byte{
+	// 796 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdd, 0x6a, 0xe3, 0x46,
+	0x14, 0x66, 0xe2, 0xc4, 0x4d, 0xc6, 0xfd, 0x09, 0x83, 0x93, 0x3a, 0xaa, 0xe3, 0x3a, 0xba, 0x28,
+	0xc1, 0xc4, 0x33, 0x38, 0x69, 0x21, 0xa4, 0x57, 0xfd, 0x09, 0x25, 0xd0, 0x42, 0xea, 0xa4, 0x50,
+	0xd2, 0x8b, 0x30, 0x91, 0x4f, 0x15, 0xc5, 0xb6, 0x66, 0x3a, 0x1a, 0xbb, 0x0d, 0xc1, 0x37, 0xa1,
+	0x14, 0xda, 0x5e, 0xf6, 0x11, 0xfa, 0x12, 0x7d, 0x84, 0x5e, 0x2e, 0xec, 0x0b, 0x2c, 0x61, 0x61,
+	0x5f, 0x63, 0x99, 0x91, 0x64, 0x5b, 0xb1, 0x15, 0x58, 0x30, 0x7b, 0xa5, 0x33, 0x47, 0x67, 0xce,
+	0xf7, 0x9d, 0x73, 0xbe, 0x19, 0x09, 0x57, 0x23, 0x50, 0x43, 0x50, 0x4c, 0x2a, 0x71, 0x03, 0x9e,
+	0x4e, 0x9f, 0x54, 0x2a, 0xa1, 0x05, 0x79, 0x27, 0x59, 0x3a, 0x65, 0x5f, 0xf8, 0xc2, 0xfa, 0x98,
+	0xb1, 0xe2, 0xd7, 0x4e, 0xd5, 0x17, 0xc2, 0xef, 0x01, 0xe3, 0x32, 0x60, 0x3c, 0x0c, 0x85, 0xe6,
+	0x3a, 0x10, 0x61, 0x94, 0xbc, 0x75, 0xbb, 0x87, 0x11, 0x0d, 0x84, 0x7d, 0xeb, 0x09, 0x05, 0x6c,
+	0xd8, 0x62, 0x3e, 0x84, 0xa0, 0xb8, 0x86, 0x4e, 0x12, 0xf3, 0xe9, 0x24, 0xa6, 0xcf, 0xbd, 0xeb,
+	0x20, 0x04, 0x75, 0xcb, 0x64, 0xd7, 0x37, 0x8e, 0x88, 0xf5, 0x41, 0xf3, 0x79, 0xbb, 0x4e, 0xfc,
+	0x40, 0x5f, 0x0f, 0xae, 0xa8, 0x27, 0xfa, 0x8c, 0x2b, 0x4b, 0xec, 0xc6, 0x1a, 0x4d, 0xaf, 0x33,
+	0xd9, 0xcd, 0xa5, 0xec, 0x05, 0x9e, 0xa5, 0xc4, 0x86, 0x2d, 0xde, 0x93, 0xd7, 0x7c, 0x26, 0x95,
+	0xfb, 0x2b, 0x2e, 0x9f, 0xc6, 0x35, 0x7e, 0xa5, 0x80, 0x6b, 0x68, 0xc3, 0x2f, 0x03, 0x88, 0x34,
+	0xb9, 0xc4, 0x69, 0xed, 0x15, 0x54, 0x47, 0xbb, 0xa5, 0xfd, 0x63, 0x3a, 0x01, 0xa5, 0x29, 0xa8,
+	0x35, 0x2e, 0xbd, 0x0e, 0x95, 0x5d, 0x9f, 0x1a, 0x50, 0x3a, 0x05, 0x4a, 0x53, 0x50, 0xfa, 0x85,
+	0x94, 0x09, 0x48, 0x3b, 0xcd, 0xea, 0xfe, 0x84, 0xb7, 0x12, 0xdf, 0xb9, 0xe8, 0x42, 0xf8, 0x35,
+	0xf4, 0x60, 0x82, 0x5e, 0xc9, 0xa2, 0xaf, 0x8d, 0xb7, 0x11, 0x82, 0x97, 0x95, 0xe8, 0x41, 0x65,
+	0xc9, 0xba, 0xad, 0x4d, 0xd6, 0x71, 0x21, 0xe0, 0xba, 0x52, 0xa8, 0xa3, 0xdd, 0x42, 0xdb, 0x98,
+	0xee, 0x9f, 0x28, 0x9b, 0x3d, 0x5b, 0x5b, 0x7e, 0xf6, 0x3a, 0x2e, 0x75, 0x20, 0xf2, 0x54, 0x20,
+	0x4d, 0x01, 0x09, 0xc8, 0xb4, 0x6b, 0x8c, 0x5f, 0x98, 0xc2, 0xaf, 0xe2, 0x35, 0xf8, 0x4d, 0x06,
+	0x0a, 0xa2, 0x93, 0xb0, 0xb2, 0x6c, 0x59, 0x4c, 0x1c, 0xee, 0x1e, 0x2e, 0x4f, 0x53, 0x69, 0x43,
+	0x24, 0x45, 0x18, 0x01, 0x29, 0xe3, 0x15, 0x6d, 0x1c, 0x09, 0x87, 0x78, 0xe1, 0x9e, 0xe3, 0x77,
+	0x93, 0xe8, 0xef, 0x07, 0xa0, 0x6e, 0x0d, 0x5e, 0xc8, 0xfb, 0x90, 0x04, 0x59, 0x9b, 0x38, 0x78,
+	0x35, 0x82, 0x1e, 0x78, 0x5a, 0xa8, 0x84, 0xe2, 0x78, 0x6d, 0xb2, 0xfe, 0x2c, 0x94, 0x17, 0x13,
+	0x5c, 0x6d, 0xc7, 0x8b, 0xa9, 0x29, 0xff, 0x20, 0x3b, 0x6f, 0x73, 0xca, 0xdf, 0xe1, 0x9d, 0xd4,
+	0x07, 0x9e, 0x08, 0xbd, 0xa0, 0x17, 0xd8, 0x5d, 0xa7, 0x7c, 0x10, 0x8d, 0x59, 0xcc, 0xab, 0xb1,
+	0x8c, 0x57, 0xa4, 0x89, 0x49, 0x0a, 0x8c, 0x17, 0xee, 0x07, 0xf8, 0xbd, 0xe3, 0xbe, 0xd4, 0xb7,
+	0x69, 0x13, 0xf7, 0x5f, 0xad, 0xe1, 0xf7, 0x13, 0x80, 0x33, 0x50, 0xc3, 0xc0, 0x03, 0xf2, 0x17,
+	0xc2, 0xa5, 0x78, 0xde, 0xb6, 0xdf, 0xc4, 0xa5, 0xe9, 0x99, 0xce, 0x55, 0x84, 0xb3, 0x3d, 0x37,
+	0x26, 0x45, 0x71, 0x0f, 0xef, 0x9f, 0xbf, 0xfc, 0x67, 0x69, 0xdf, 0x6d, 0xda, 0xb3, 0x3c, 0x6c,
+	0xa5, 0xb7, 0x44, 0xc4, 0xee, 0x12, 0x6b, 0xc4, 0x8c, 0x12, 0x22, 0x76, 0x67, 0x1e, 0x23, 0x66,
+	0x67, 0x79, 0x84, 0x1a, 0xe4, 0x0f, 0x84, 0x4b, 0xb1, 0xb4, 0x9f, 0x22, 0x93, 0x11, 0xbf, 0xb3,
+	0x39, 0x8e, 0xc9, 0xd4, 0xea, 0x7e, 0x6e, 0x59, 0x7c, 0xd6, 0x38, 0x78, 0x23, 0x16, 0xec, 0x2e,
+	0xe0, 0x7a, 0x44, 0xfe, 0x46, 0xb8, 0x18, 0xd7, 0x4c, 0x66, 0x8a, 0xcd, 0xf6, 0x62, 0x31, 0x12,
+	0x70, 0x3f, 0xb2, 0x6c, 0x37, 0xdc, 0xf5, 0xc7, 0x6c, 0x4d, 0x5b, 0xee, 0x11, 0x5e, 0xfe, 0x36,
+	0x88, 0x34, 0xd9, 0x78, 0xcc, 0xc5, 0xaa, 0xde, 0x39, 0x59, 0x08, 0x07, 0x83, 0xe0, 0x56, 0x2c,
+	0x0f, 0x42, 0x66, 0x78, 0x90, 0xdf, 0x11, 0x2e, 0x7c, 0x03, 0xb9, 0x1c, 0x16, 0xd4, 0x87, 0x8f,
+	0x2d, 0xfe, 0x16, 0xf9, 0x70, 0x76, 0x6a, 0x46, 0xe8, 0x23, 0xf2, 0x2f, 0xc2, 0xc5, 0xf8, 0x54,
+	0xce, 0x4e, 0x26, 0x73, 0x5a, 0x17, 0xc5, 0xe8, 0xc0, 0x32, 0x6a, 0x3a, 0xbb, 0xb9, 0x3a, 0xa2,
+	0xe6, 0xc3, 0xd3, 0xe1, 0x9a, 0x53, 0x4b, 0xd1, 0x4c, 0xec, 0x47, 0x5c, 0x8c, 0x55, 0x9a, 0xd7,
+	0xae, 0x3c, 0xd5, 0x26, 0xf5, 0x37, 0x72, 0xeb, 0xff, 0x0f, 0xe1, 0xcd, 0x33, 0x98, 0x77, 0x3f,
+	0x90, 0xc6, 0x63, 0xa8, 0xfc, 0x4b, 0x24, 0x17, 0xff, 0xc2, 0xe2, 0x9f, 0x3b, 0x7b, 0x39, 0xf8,
+	0x4c, 0x65, 0x72, 0x36, 0xed, 0x45, 0x73, 0x84, 0x1a, 0x17, 0x3b, 0x4e, 0x35, 0xdd, 0x92, 0x13,
+	0x42, 0x6e, 0x30, 0x36, 0x1a, 0x3b, 0x1e, 0x42, 0xa8, 0xa3, 0xbc, 0xc6, 0x6c, 0xd3, 0xf8, 0x1b,
+	0x6f, 0x86, 0x43, 0x3d, 0xa1, 0x80, 0x0e, 0x5b, 0xd4, 0x6e, 0xb1, 0xfa, 0xfc, 0xc4, 0xf2, 0xab,
+	0x93, 0x5a, 0x1e, 0x3f, 0xb0, 0xd9, 0xbf, 0x3c, 0xfc, 0xff, 0xa1, 0x86, 0x9e, 0x3d, 0xd4, 0xd0,
+	0x8b, 0x87, 0x1a, 0xba, 0x68, 0x3c, 0xf5, 0x07, 0x90, 0xfd, 0xa5, 0xb9, 0x2a, 0xda, 0x2f, 0xfd,
+	0xc1, 0xeb, 0x01, 0x00, 0x6d, 0xea, 0xc1, 0x47, 0xeb, 0x08, 0x00, 0x00,
 }
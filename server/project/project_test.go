@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 
@@ -17,6 +18,7 @@ import (
 	apps "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-cd/test"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/db"
 	jwtutil "github.com/argoproj/argo-cd/util/jwt"
 	"github.com/argoproj/argo-cd/util/rbac"
 	"github.com/argoproj/argo-cd/util/session"
@@ -76,6 +78,26 @@ func TestProjectServer(t *testing.T) {
 		assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
 	})
 
+	t.Run("TestRemoveDestinationUsedByAppByName", func(t *testing.T) {
+		existingApp := v1alpha1.Application{
+			ObjectMeta: v1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec:       v1alpha1.ApplicationSpec{Project: "test", Destination: v1alpha1.ApplicationDestination{Namespace: "ns1", Name: "cluster1"}},
+		}
+		kubeclientset := fake.NewSimpleClientset()
+		_, err := db.NewDB("default", kubeclientset).CreateCluster(context.Background(), &v1alpha1.Cluster{Server: "https://server1", Name: "cluster1"})
+		assert.NoError(t, err)
+
+		projectServer := NewServer("default", kubeclientset, apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, util.NewKeyLock(), nil)
+
+		updatedProj := existingProj.DeepCopy()
+		updatedProj.Spec.Destinations = updatedProj.Spec.Destinations[1:]
+
+		_, err = projectServer.Update(context.Background(), &ProjectUpdateRequest{Project: updatedProj})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+	})
+
 	t.Run("TestRemoveSourceSuccessful", func(t *testing.T) {
 		existingApp := v1alpha1.Application{
 			ObjectMeta: v1.ObjectMeta{Name: "test", Namespace: "default"},
@@ -139,11 +161,15 @@ func TestProjectServer(t *testing.T) {
 		_, err := projectServer.Delete(context.Background(), &ProjectQuery{Name: "test"})
 
 		assert.NotNil(t, err)
-		assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+		assert.Equal(t, codes.FailedPrecondition, grpc.Code(err))
+		assert.Contains(t, err.Error(), "referenced by 1 applications (test)")
+
+		_, err = projectServer.Delete(context.Background(), &ProjectQuery{Name: "test", Force: true})
+		assert.Nil(t, err)
 	})
 
 	t.Run("TestCreateTokenSuccesfully", func(t *testing.T) {
-		sessionMgr := session.NewSessionManager(&settings.ArgoCDSettings{})
+		sessionMgr := session.NewSessionManager(&settings.ArgoCDSettings{}, nil)
 		projectWithRole := existingProj.DeepCopy()
 		tokenName := "testToken"
 		projectWithRole.Spec.Roles = []v1alpha1.ProjectRole{{Name: tokenName}}
@@ -162,7 +188,7 @@ func TestProjectServer(t *testing.T) {
 	})
 
 	t.Run("TestDeleteTokenSuccesfully", func(t *testing.T) {
-		sessionMgr := session.NewSessionManager(&settings.ArgoCDSettings{})
+		sessionMgr := session.NewSessionManager(&settings.ArgoCDSettings{}, nil)
 		projWithToken := existingProj.DeepCopy()
 		tokenName := "testToken"
 		issuedAt := int64(1)
@@ -181,7 +207,7 @@ func TestProjectServer(t *testing.T) {
 	})
 
 	t.Run("TestCreateTwoTokensInRoleSuccess", func(t *testing.T) {
-		sessionMgr := session.NewSessionManager(&settings.ArgoCDSettings{})
+		sessionMgr := session.NewSessionManager(&settings.ArgoCDSettings{}, nil)
 		projWithToken := existingProj.DeepCopy()
 		tokenName := "testToken"
 		token := v1alpha1.ProjectRole{Name: tokenName, JWTTokens: []v1alpha1.JWTToken{{IssuedAt: 1}}}
@@ -348,4 +374,53 @@ func TestProjectServer(t *testing.T) {
 		expectedPolicy := fmt.Sprintf(policyTemplate, projWithRole.Name, roleName, action, projWithRole.Name, object, effect)
 		assert.Equal(t, expectedPolicy, updateProj.Spec.Roles[0].Policies[0])
 	})
+
+	t.Run("TestValidateDestinationServiceAccounts", func(t *testing.T) {
+		proj := existingProj.DeepCopy()
+		proj.Spec.DestinationServiceAccounts = []v1alpha1.DestinationServiceAccount{
+			{Server: "https://server1", Namespace: "ns1", ServiceAccount: "deployer"},
+			{Server: "*", Namespace: "*", ServiceAccount: "Not_Valid"},
+		}
+
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(proj), enforcer, util.NewKeyLock(), nil)
+		_, err := projectServer.Update(context.Background(), &ProjectUpdateRequest{Project: proj})
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		proj.Spec.DestinationServiceAccounts[1].ServiceAccount = "deployer"
+		_, err = projectServer.Update(context.Background(), &ProjectUpdateRequest{Project: proj})
+		assert.Nil(t, err)
+
+		proj.Spec.DestinationServiceAccounts[1].Server = "https://server1"
+		proj.Spec.DestinationServiceAccounts[1].Namespace = "ns1"
+		_, err = projectServer.Update(context.Background(), &ProjectUpdateRequest{Project: proj})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("TestSetReconciliationPause", func(t *testing.T) {
+		kubeclientset := fake.NewSimpleClientset()
+		projectServer := NewServer("default", kubeclientset, apps.NewSimpleClientset(existingProj.DeepCopy()), enforcer, util.NewKeyLock(), nil)
+
+		_, err := projectServer.SetReconciliationPause(context.Background(), &ProjectReconciliationPauseRequest{Name: "test", Pause: "sync"})
+		assert.Nil(t, err)
+		proj, err := projectServer.Get(context.Background(), &ProjectQuery{Name: "test"})
+		assert.Nil(t, err)
+		assert.Equal(t, v1alpha1.ReconciliationPauseSync, v1alpha1.GetReconciliationPause(proj.Annotations))
+
+		_, err = projectServer.SetReconciliationPause(context.Background(), &ProjectReconciliationPauseRequest{Name: "test"})
+		assert.Nil(t, err)
+		proj, err = projectServer.Get(context.Background(), &ProjectQuery{Name: "test"})
+		assert.Nil(t, err)
+		assert.NotContains(t, proj.Annotations, common.AnnotationPauseReconciliation)
+
+		// the global maintenance mode is set in the argocd-cm ConfigMap
+		_, err = projectServer.SetReconciliationPause(context.Background(), &ProjectReconciliationPauseRequest{Pause: "all"})
+		assert.Nil(t, err)
+		argoCDCM, err := kubeclientset.CoreV1().ConfigMaps("default").Get(common.ArgoCDConfigMapName, v1.GetOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, "all", argoCDCM.Annotations[common.AnnotationPauseReconciliation])
+
+		_, err = projectServer.SetReconciliationPause(context.Background(), &ProjectReconciliationPauseRequest{Name: "test", Pause: "true"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
 }
@@ -1,19 +1,25 @@
 package repository
 
 import (
+	"fmt"
 	"path"
 	"path/filepath"
 	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/ghodss/yaml"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/reposerver"
 	"github.com/argoproj/argo-cd/reposerver/repository"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/git"
 	"github.com/argoproj/argo-cd/util/grpc"
@@ -22,6 +28,8 @@ import (
 
 // Server provides a Repository service
 type Server struct {
+	ns            string
+	appclientset  appclientset.Interface
 	db            db.ArgoDB
 	repoClientset reposerver.Clientset
 	enf           *rbac.Enforcer
@@ -29,33 +37,68 @@ type Server struct {
 
 // NewServer returns a new instance of the Repository service
 func NewServer(
+	namespace string,
+	appclientset appclientset.Interface,
 	repoClientset reposerver.Clientset,
 	db db.ArgoDB,
 	enf *rbac.Enforcer,
 ) *Server {
 	return &Server{
+		ns:            namespace,
+		appclientset:  appclientset,
 		db:            db,
 		repoClientset: repoClientset,
 		enf:           enf,
 	}
 }
 
-// List returns list of repositories
+// List returns list of repositories, sorted by URL, along with the number of applications using each of them
 func (s *Server) List(ctx context.Context, q *RepoQuery) (*appsv1.RepositoryList, error) {
 	repoList, err := s.db.ListRepositories(ctx)
-	if repoList != nil {
-		newItems := make([]appsv1.Repository, 0)
-		for _, repo := range repoList.Items {
-			if s.enf.EnforceClaims(ctx.Value("claims"), "repositories", "get", repo.Repo) {
-				newItems = append(newItems, *redact(&repo))
-			}
+	if err != nil {
+		return nil, err
+	}
+	apps, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	appCounts := make(map[string]int64)
+	for _, app := range apps.Items {
+		appCounts[git.NormalizeGitURL(app.Spec.Source.RepoURL)]++
+	}
+	sort.Slice(repoList.Items, func(i, j int) bool {
+		return repoList.Items[i].Repo < repoList.Items[j].Repo
+	})
+
+	newItems := make([]appsv1.Repository, 0)
+	for _, repo := range repoList.Items {
+		if !s.enf.EnforceClaims(ctx.Value("claims"), "repositories", "get", repo.Repo) {
+			continue
+		}
+		if q.Search != "" && !strings.Contains(strings.ToLower(repo.Repo), strings.ToLower(q.Search)) {
+			continue
+		}
+		repo.ApplicationCount = appCounts[git.NormalizeGitURL(repo.Repo)]
+		if q.Unused && repo.ApplicationCount > 0 {
+			continue
 		}
-		repoList.Items = newItems
+		// the continue token is the URL of the first repository of the next page
+		if q.Continue != "" && repo.Repo < q.Continue {
+			continue
+		}
+		if q.Limit > 0 && int64(len(newItems)) == q.Limit {
+			repoList.Continue = repo.Repo
+			break
+		}
+		newItems = append(newItems, *redact(&repo))
 	}
-	return repoList, err
+	repoList.Items = newItems
+	return repoList, nil
 }
 
-// ListKsonnetApps returns list of Ksonnet apps in the repo
+// ListApps returns the applications of the repo, along with the tool detected to generate their
+// manifests (one of: ksonnet, helm, kustomize, directory). Directories are only reported as directory
+// applications if they hold a jsonnet file or a YAML/JSON file declaring a Kubernetes resource
 func (s *Server) ListApps(ctx context.Context, q *RepoAppsQuery) (*RepoAppsResponse, error) {
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "repositories", "get", q.Repo) {
 		return nil, grpc.ErrPermissionDenied
@@ -83,47 +126,17 @@ func (s *Server) ListApps(ctx context.Context, q *RepoAppsQuery) (*RepoAppsRespo
 		revision = "HEAD"
 	}
 
-	ksonnetRes, err := repoClient.ListDir(ctx, &repository.ListDirRequest{Repo: repo, Revision: revision, Path: "*app.yaml"})
-	if err != nil {
-		return nil, err
-	}
-	componentRes, err := repoClient.ListDir(ctx, &repository.ListDirRequest{Repo: repo, Revision: revision, Path: "*components/params.libsonnet"})
-	if err != nil {
-		return nil, err
-	}
-
-	helmRes, err := repoClient.ListDir(ctx, &repository.ListDirRequest{Repo: repo, Revision: revision, Path: "*Chart.yaml"})
+	appList, err := repoClient.ListApps(ctx, &repository.ListAppsRequest{Repo: repo, Revision: revision})
 	if err != nil {
 		return nil, err
 	}
-
-	kustomizationRes, err := repoClient.ListDir(ctx, &repository.ListDirRequest{Repo: repo, Revision: revision, Path: "*kustomization.yaml"})
-	if err != nil {
-		return nil, err
+	items := make([]*AppInfo, 0, len(appList.Apps))
+	for appFile, appType := range appList.Apps {
+		items = append(items, &AppInfo{Type: appType, Path: appFile, AppPath: filepath.Dir(appFile)})
 	}
-
-	componentDirs := make(map[string]interface{})
-	for _, i := range componentRes.Items {
-		d := filepath.Dir(filepath.Dir(i))
-		componentDirs[d] = struct{}{}
-	}
-
-	items := make([]*AppInfo, 0)
-	for _, i := range ksonnetRes.Items {
-		d := filepath.Dir(i)
-		if _, ok := componentDirs[d]; ok {
-			items = append(items, &AppInfo{Type: string(repository.AppSourceKsonnet), Path: i})
-		}
-	}
-
-	for i := range helmRes.Items {
-		items = append(items, &AppInfo{Type: string(repository.AppSourceHelm), Path: helmRes.Items[i]})
-	}
-
-	for i := range kustomizationRes.Items {
-		items = append(items, &AppInfo{Type: string(repository.AppSourceKustomize), Path: kustomizationRes.Items[i]})
-	}
-
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Path < items[j].Path
+	})
 	return &RepoAppsResponse{Items: items}, nil
 }
 
@@ -216,15 +229,84 @@ func (s *Server) GetAppDetails(ctx context.Context, q *RepoAppDetailsQuery) (*Re
 	return nil, status.Errorf(codes.InvalidArgument, "specified application path is not supported")
 }
 
+// ListRefs returns the branches and tags of a repository, branches first
+func (s *Server) ListRefs(ctx context.Context, q *RepoRefsQuery) (*RepoRefsResponse, error) {
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "repositories", "get", q.Repo) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	if q.Type != "" && q.Type != refTypeBranch && q.Type != refTypeTag {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ref type '%s': must be one of %s, %s", q.Type, refTypeBranch, refTypeTag)
+	}
+	repo, err := s.db.GetRepository(ctx, q.Repo)
+	if err != nil {
+		if errStatus, ok := status.FromError(err); ok && errStatus.Code() == codes.NotFound {
+			repo = &appsv1.Repository{
+				Repo: q.Repo,
+			}
+		} else {
+			return nil, err
+		}
+	}
+	conn, repoClient, err := s.repoClientset.NewRepositoryClient()
+	if err != nil {
+		return nil, err
+	}
+	defer util.Close(conn)
+	refs, err := repoClient.ListRefs(ctx, &repository.ListRefsRequest{Repo: repo})
+	if err != nil {
+		return nil, err
+	}
+
+	allRefs := make([]*RepoRef, 0, len(refs.Branches)+len(refs.Tags))
+	for _, branch := range refs.Branches {
+		allRefs = append(allRefs, &RepoRef{Name: branch, Type: refTypeBranch})
+	}
+	for _, tag := range refs.Tags {
+		allRefs = append(allRefs, &RepoRef{Name: tag, Type: refTypeTag})
+	}
+	sort.Slice(allRefs, func(i, j int) bool {
+		return refKey(allRefs[i]) < refKey(allRefs[j])
+	})
+	res := RepoRefsResponse{Items: make([]*RepoRef, 0)}
+	for _, ref := range allRefs {
+		if q.Type != "" && ref.Type != q.Type {
+			continue
+		}
+		if q.Search != "" && !strings.Contains(strings.ToLower(ref.Name), strings.ToLower(q.Search)) {
+			continue
+		}
+		// the continue token is the key of the first ref of the next page
+		if q.Continue != "" && refKey(ref) < q.Continue {
+			continue
+		}
+		if q.Limit > 0 && int64(len(res.Items)) == q.Limit {
+			res.Continue = refKey(ref)
+			break
+		}
+		res.Items = append(res.Items, ref)
+	}
+	return &res, nil
+}
+
+const (
+	refTypeBranch = "branch"
+	refTypeTag    = "tag"
+)
+
+// refKey returns the key refs are ordered by, which lists branches before tags
+func refKey(ref *RepoRef) string {
+	return ref.Type + ":" + ref.Name
+}
+
 // Create creates a repository
 func (s *Server) Create(ctx context.Context, q *RepoCreateRequest) (*appsv1.Repository, error) {
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "repositories", "create", q.Repo.Repo) {
 		return nil, grpc.ErrPermissionDenied
 	}
 	r := q.Repo
-	err := git.TestRepo(git.NormalizeGitURL(r.Repo), r.Username, r.Password, r.SSHPrivateKey)
+	err := git.TestRepo(ctx, git.NormalizeGitURL(r.Repo), r.Username, r.Password, r.SSHPrivateKey)
 	if err != nil {
-		return nil, err
+		return nil, grpc.NewReasonError(codes.InvalidArgument, grpc.ErrorReasonRepoAuthFailed, r.Repo, "%v", err)
 	}
 
 	r.ConnectionState = appsv1.ConnectionState{Status: appsv1.ConnectionStatusSuccessful}
@@ -272,10 +354,52 @@ func (s *Server) Delete(ctx context.Context, q *RepoQuery) (*RepoResponse, error
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "repositories", "delete", q.Repo) {
 		return nil, grpc.ErrPermissionDenied
 	}
+	if !q.Force {
+		apps, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if dependents := argo.FilterByRepoURL(apps.Items, q.Repo); len(dependents) > 0 {
+			return nil, argo.ReferencedByAppsError(fmt.Sprintf("repository '%s'", q.Repo), dependents)
+		}
+	}
 	err := s.db.DeleteRepository(ctx, q.Repo)
 	return &RepoResponse{}, err
 }
 
+// ListHelmCharts returns the charts and their versions of a helm repository by name
+func (s *Server) ListHelmCharts(ctx context.Context, q *HelmChartsQuery) (*appsv1.HelmChartList, error) {
+	helmRepos, err := s.db.ListHelmRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var helmRepo *appsv1.HelmRepository
+	for _, r := range helmRepos {
+		if r.Name == q.Name {
+			helmRepo = r
+			break
+		}
+	}
+	// the permission is checked before the existence of the repository, which is only disclosed to
+	// the users allowed to get all the repositories
+	repoURL := "*"
+	if helmRepo != nil {
+		repoURL = helmRepo.URL
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "repositories", "get", repoURL) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	if helmRepo == nil {
+		return nil, status.Errorf(codes.NotFound, "helm repository '%s' not found", q.Name)
+	}
+	conn, repoClient, err := s.repoClientset.NewRepositoryClient()
+	if err != nil {
+		return nil, err
+	}
+	defer util.Close(conn)
+	return repoClient.ListHelmCharts(ctx, &repository.ListHelmChartsRequest{Repo: helmRepo})
+}
+
 func redact(repo *appsv1.Repository) *appsv1.Repository {
 	if repo == nil {
 		return nil
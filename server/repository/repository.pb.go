@@ -46,7 +46,7 @@ func (m *RepoAppsQuery) Reset()         { *m = RepoAppsQuery{} }
 func (m *RepoAppsQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoAppsQuery) ProtoMessage()    {}
 func (*RepoAppsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_6cfdcc280f230e64, []int{0}
+	return fileDescriptor_repository_df6959881d1a3011, []int{0}
 }
 func (m *RepoAppsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -91,8 +91,12 @@ func (m *RepoAppsQuery) GetRevision() string {
 
 // AppInfo contains application type and app file path
 type AppInfo struct {
-	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// type is the tool detected to generate the manifests of the application (one of: ksonnet, helm, kustomize, directory)
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// path is the path of the file identifying the application (i.e. app.yaml, Chart.yaml, kustomization.yaml or the first manifest of directory applications)
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// appPath is the path of the directory of the application, to use as the path of its source
+	AppPath              string   `protobuf:"bytes,3,opt,name=appPath,proto3" json:"appPath,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -102,7 +106,7 @@ func (m *AppInfo) Reset()         { *m = AppInfo{} }
 func (m *AppInfo) String() string { return proto.CompactTextString(m) }
 func (*AppInfo) ProtoMessage()    {}
 func (*AppInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_6cfdcc280f230e64, []int{1}
+	return fileDescriptor_repository_df6959881d1a3011, []int{1}
 }
 func (m *AppInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -145,6 +149,13 @@ func (m *AppInfo) GetPath() string {
 	return ""
 }
 
+func (m *AppInfo) GetAppPath() string {
+	if m != nil {
+		return m.AppPath
+	}
+	return ""
+}
+
 // RepoAppDetailsQuery contains query information for app details request
 type RepoAppDetailsQuery struct {
 	Repo                 string   `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
@@ -159,7 +170,7 @@ func (m *RepoAppDetailsQuery) Reset()         { *m = RepoAppDetailsQuery{} }
 func (m *RepoAppDetailsQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoAppDetailsQuery) ProtoMessage()    {}
 func (*RepoAppDetailsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_6cfdcc280f230e64, []int{2}
+	return fileDescriptor_repository_df6959881d1a3011, []int{2}
 }
 func (m *RepoAppDetailsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,7 +235,7 @@ func (m *RepoAppDetailsResponse) Reset()         { *m = RepoAppDetailsResponse{}
 func (m *RepoAppDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoAppDetailsResponse) ProtoMessage()    {}
 func (*RepoAppDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_6cfdcc280f230e64, []int{3}
+	return fileDescriptor_repository_df6959881d1a3011, []int{3}
 }
 func (m *RepoAppDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -293,7 +304,7 @@ func (m *RepoAppsResponse) Reset()         { *m = RepoAppsResponse{} }
 func (m *RepoAppsResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoAppsResponse) ProtoMessage()    {}
 func (*RepoAppsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_6cfdcc280f230e64, []int{4}
+	return fileDescriptor_repository_df6959881d1a3011, []int{4}
 }
 func (m *RepoAppsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,7 +355,7 @@ func (m *KsonnetAppSpec) Reset()         { *m = KsonnetAppSpec{} }
 func (m *KsonnetAppSpec) String() string { return proto.CompactTextString(m) }
 func (*KsonnetAppSpec) ProtoMessage()    {}
 func (*KsonnetAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_6cfdcc280f230e64, []int{5}
+	return fileDescriptor_repository_df6959881d1a3011, []int{5}
 }
 func (m *KsonnetAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -408,7 +419,7 @@ func (m *HelmAppSpec) Reset()         { *m = HelmAppSpec{} }
 func (m *HelmAppSpec) String() string { return proto.CompactTextString(m) }
 func (*HelmAppSpec) ProtoMessage()    {}
 func (*HelmAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_6cfdcc280f230e64, []int{6}
+	return fileDescriptor_repository_df6959881d1a3011, []int{6}
 }
 func (m *HelmAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -470,7 +481,7 @@ func (m *KustomizeAppSpec) Reset()         { *m = KustomizeAppSpec{} }
 func (m *KustomizeAppSpec) String() string { return proto.CompactTextString(m) }
 func (*KustomizeAppSpec) ProtoMessage()    {}
 func (*KustomizeAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_6cfdcc280f230e64, []int{7}
+	return fileDescriptor_repository_df6959881d1a3011, []int{7}
 }
 func (m *KustomizeAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -524,7 +535,7 @@ func (m *KsonnetEnvironment) Reset()         { *m = KsonnetEnvironment{} }
 func (m *KsonnetEnvironment) String() string { return proto.CompactTextString(m) }
 func (*KsonnetEnvironment) ProtoMessage()    {}
 func (*KsonnetEnvironment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_6cfdcc280f230e64, []int{8}
+	return fileDescriptor_repository_df6959881d1a3011, []int{8}
 }
 func (m *KsonnetEnvironment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -595,7 +606,7 @@ func (m *KsonnetEnvironmentDestination) Reset()         { *m = KsonnetEnvironmen
 func (m *KsonnetEnvironmentDestination) String() string { return proto.CompactTextString(m) }
 func (*KsonnetEnvironmentDestination) ProtoMessage()    {}
 func (*KsonnetEnvironmentDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_6cfdcc280f230e64, []int{9}
+	return fileDescriptor_repository_df6959881d1a3011, []int{9}
 }
 func (m *KsonnetEnvironmentDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -640,7 +651,17 @@ func (m *KsonnetEnvironmentDestination) GetNamespace() string {
 
 // RepoQuery is a query for Repository resources
 type RepoQuery struct {
-	Repo                 string   `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// search restricts listed repositories to those whose URL contains the given string
+	Search string `protobuf:"bytes,2,opt,name=search,proto3" json:"search,omitempty"`
+	// unused restricts listed repositories to those not used by any application
+	Unused bool `protobuf:"varint,3,opt,name=unused,proto3" json:"unused,omitempty"`
+	// limit is the maximum number of repositories to list
+	Limit int64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// continue is the token returned by a previous list call to retrieve the next page
+	Continue string `protobuf:"bytes,5,opt,name=continue,proto3" json:"continue,omitempty"`
+	// force deletes the repository even if applications still use it
+	Force                bool     `protobuf:"varint,6,opt,name=force,proto3" json:"force,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -650,7 +671,7 @@ func (m *RepoQuery) Reset()         { *m = RepoQuery{} }
 func (m *RepoQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoQuery) ProtoMessage()    {}
 func (*RepoQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_6cfdcc280f230e64, []int{10}
+	return fileDescriptor_repository_df6959881d1a3011, []int{10}
 }
 func (m *RepoQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,6 +707,41 @@ func (m *RepoQuery) GetRepo() string {
 	return ""
 }
 
+func (m *RepoQuery) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+func (m *RepoQuery) GetUnused() bool {
+	if m != nil {
+		return m.Unused
+	}
+	return false
+}
+
+func (m *RepoQuery) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *RepoQuery) GetContinue() string {
+	if m != nil {
+		return m.Continue
+	}
+	return ""
+}
+
+func (m *RepoQuery) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
 type RepoResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -696,7 +752,7 @@ func (m *RepoResponse) Reset()         { *m = RepoResponse{} }
 func (m *RepoResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoResponse) ProtoMessage()    {}
 func (*RepoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_6cfdcc280f230e64, []int{11}
+	return fileDescriptor_repository_df6959881d1a3011, []int{11}
 }
 func (m *RepoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -737,7 +793,7 @@ func (m *RepoCreateRequest) Reset()         { *m = RepoCreateRequest{} }
 func (m *RepoCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoCreateRequest) ProtoMessage()    {}
 func (*RepoCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_6cfdcc280f230e64, []int{12}
+	return fileDescriptor_repository_df6959881d1a3011, []int{12}
 }
 func (m *RepoCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -791,7 +847,7 @@ func (m *RepoUpdateRequest) Reset()         { *m = RepoUpdateRequest{} }
 func (m *RepoUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoUpdateRequest) ProtoMessage()    {}
 func (*RepoUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_6cfdcc280f230e64, []int{13}
+	return fileDescriptor_repository_df6959881d1a3011, []int{13}
 }
 func (m *RepoUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -827,6 +883,253 @@ func (m *RepoUpdateRequest) GetRepo() *v1alpha1.Repository {
 	return nil
 }
 
+// RepoRefsQuery is a query for the branches and tags of a repository
+type RepoRefsQuery struct {
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// type restricts listed refs to branches or tags (one of: branch, tag)
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// search restricts listed refs to those whose name contains the given string
+	Search string `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	// limit is the maximum number of refs to list
+	Limit int64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// continue is the token returned by a previous list call to retrieve the next page
+	Continue             string   `protobuf:"bytes,5,opt,name=continue,proto3" json:"continue,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepoRefsQuery) Reset()         { *m = RepoRefsQuery{} }
+func (m *RepoRefsQuery) String() string { return proto.CompactTextString(m) }
+func (*RepoRefsQuery) ProtoMessage()    {}
+func (*RepoRefsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_df6959881d1a3011, []int{14}
+}
+func (m *RepoRefsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoRefsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoRefsQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RepoRefsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoRefsQuery.Merge(dst, src)
+}
+func (m *RepoRefsQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoRefsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoRefsQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoRefsQuery proto.InternalMessageInfo
+
+func (m *RepoRefsQuery) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *RepoRefsQuery) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *RepoRefsQuery) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+func (m *RepoRefsQuery) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *RepoRefsQuery) GetContinue() string {
+	if m != nil {
+		return m.Continue
+	}
+	return ""
+}
+
+// RepoRef is a branch or a tag of a repository
+type RepoRef struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// type is the type of the ref (one of: branch, tag)
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepoRef) Reset()         { *m = RepoRef{} }
+func (m *RepoRef) String() string { return proto.CompactTextString(m) }
+func (*RepoRef) ProtoMessage()    {}
+func (*RepoRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_df6959881d1a3011, []int{15}
+}
+func (m *RepoRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoRef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RepoRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoRef.Merge(dst, src)
+}
+func (m *RepoRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoRef proto.InternalMessageInfo
+
+func (m *RepoRef) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RepoRef) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+// RepoRefsResponse lists the branches and tags of a repository, branches first
+type RepoRefsResponse struct {
+	Items []*RepoRef `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	// continue is the token to retrieve the next page, if more refs are available
+	Continue             string   `protobuf:"bytes,2,opt,name=continue,proto3" json:"continue,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepoRefsResponse) Reset()         { *m = RepoRefsResponse{} }
+func (m *RepoRefsResponse) String() string { return proto.CompactTextString(m) }
+func (*RepoRefsResponse) ProtoMessage()    {}
+func (*RepoRefsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_df6959881d1a3011, []int{16}
+}
+func (m *RepoRefsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoRefsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoRefsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RepoRefsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoRefsResponse.Merge(dst, src)
+}
+func (m *RepoRefsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoRefsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoRefsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoRefsResponse proto.InternalMessageInfo
+
+func (m *RepoRefsResponse) GetItems() []*RepoRef {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *RepoRefsResponse) GetContinue() string {
+	if m != nil {
+		return m.Continue
+	}
+	return ""
+}
+
+// HelmChartsQuery is a query for the charts of a helm repository
+type HelmChartsQuery struct {
+	// name is the name of the helm repository
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HelmChartsQuery) Reset()         { *m = HelmChartsQuery{} }
+func (m *HelmChartsQuery) String() string { return proto.CompactTextString(m) }
+func (*HelmChartsQuery) ProtoMessage()    {}
+func (*HelmChartsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_df6959881d1a3011, []int{17}
+}
+func (m *HelmChartsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelmChartsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HelmChartsQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *HelmChartsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmChartsQuery.Merge(dst, src)
+}
+func (m *HelmChartsQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelmChartsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmChartsQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelmChartsQuery proto.InternalMessageInfo
+
+func (m *HelmChartsQuery) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*RepoAppsQuery)(nil), "repository.RepoAppsQuery")
 	proto.RegisterType((*AppInfo)(nil), "repository.AppInfo")
@@ -843,6 +1146,10 @@ func init() {
 	proto.RegisterType((*RepoResponse)(nil), "repository.RepoResponse")
 	proto.RegisterType((*RepoCreateRequest)(nil), "repository.RepoCreateRequest")
 	proto.RegisterType((*RepoUpdateRequest)(nil), "repository.RepoUpdateRequest")
+	proto.RegisterType((*RepoRefsQuery)(nil), "repository.RepoRefsQuery")
+	proto.RegisterType((*RepoRef)(nil), "repository.RepoRef")
+	proto.RegisterType((*RepoRefsResponse)(nil), "repository.RepoRefsResponse")
+	proto.RegisterType((*HelmChartsQuery)(nil), "repository.HelmChartsQuery")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -858,10 +1165,12 @@ const _ = grpc.SupportPackageIsVersion4
 type RepositoryServiceClient interface {
 	// List returns list of repos
 	List(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryList, error)
-	// ListApps returns list of apps in the repo
+	// ListApps returns the paths of the apps in the repo, along with their type
 	ListApps(ctx context.Context, in *RepoAppsQuery, opts ...grpc.CallOption) (*RepoAppsResponse, error)
 	// GetAppDetails returns application details by given path
 	GetAppDetails(ctx context.Context, in *RepoAppDetailsQuery, opts ...grpc.CallOption) (*RepoAppDetailsResponse, error)
+	// ListRefs returns the branches and tags of the repo
+	ListRefs(ctx context.Context, in *RepoRefsQuery, opts ...grpc.CallOption) (*RepoRefsResponse, error)
 	// Create creates a repo
 	Create(ctx context.Context, in *RepoCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Repository, error)
 	// Get returns a repo by name
@@ -870,6 +1179,8 @@ type RepositoryServiceClient interface {
 	Update(ctx context.Context, in *RepoUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Repository, error)
 	// Delete deletes a repo
 	Delete(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*RepoResponse, error)
+	// ListHelmCharts returns the charts and their versions of a helm repository
+	ListHelmCharts(ctx context.Context, in *HelmChartsQuery, opts ...grpc.CallOption) (*v1alpha1.HelmChartList, error)
 }
 
 type repositoryServiceClient struct {
@@ -907,6 +1218,15 @@ func (c *repositoryServiceClient) GetAppDetails(ctx context.Context, in *RepoApp
 	return out, nil
 }
 
+func (c *repositoryServiceClient) ListRefs(ctx context.Context, in *RepoRefsQuery, opts ...grpc.CallOption) (*RepoRefsResponse, error) {
+	out := new(RepoRefsResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepositoryService/ListRefs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryServiceClient) Create(ctx context.Context, in *RepoCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Repository, error) {
 	out := new(v1alpha1.Repository)
 	err := c.cc.Invoke(ctx, "/repository.RepositoryService/Create", in, out, opts...)
@@ -943,15 +1263,26 @@ func (c *repositoryServiceClient) Delete(ctx context.Context, in *RepoQuery, opt
 	return out, nil
 }
 
+func (c *repositoryServiceClient) ListHelmCharts(ctx context.Context, in *HelmChartsQuery, opts ...grpc.CallOption) (*v1alpha1.HelmChartList, error) {
+	out := new(v1alpha1.HelmChartList)
+	err := c.cc.Invoke(ctx, "/repository.RepositoryService/ListHelmCharts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for RepositoryService service
 
 type RepositoryServiceServer interface {
 	// List returns list of repos
 	List(context.Context, *RepoQuery) (*v1alpha1.RepositoryList, error)
-	// ListApps returns list of apps in the repo
+	// ListApps returns the paths of the apps in the repo, along with their type
 	ListApps(context.Context, *RepoAppsQuery) (*RepoAppsResponse, error)
 	// GetAppDetails returns application details by given path
 	GetAppDetails(context.Context, *RepoAppDetailsQuery) (*RepoAppDetailsResponse, error)
+	// ListRefs returns the branches and tags of the repo
+	ListRefs(context.Context, *RepoRefsQuery) (*RepoRefsResponse, error)
 	// Create creates a repo
 	Create(context.Context, *RepoCreateRequest) (*v1alpha1.Repository, error)
 	// Get returns a repo by name
@@ -960,6 +1291,8 @@ type RepositoryServiceServer interface {
 	Update(context.Context, *RepoUpdateRequest) (*v1alpha1.Repository, error)
 	// Delete deletes a repo
 	Delete(context.Context, *RepoQuery) (*RepoResponse, error)
+	// ListHelmCharts returns the charts and their versions of a helm repository
+	ListHelmCharts(context.Context, *HelmChartsQuery) (*v1alpha1.HelmChartList, error)
 }
 
 func RegisterRepositoryServiceServer(s *grpc.Server, srv RepositoryServiceServer) {
@@ -1020,6 +1353,24 @@ func _RepositoryService_GetAppDetails_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_ListRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoRefsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).ListRefs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepositoryService/ListRefs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).ListRefs(ctx, req.(*RepoRefsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepositoryService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RepoCreateRequest)
 	if err := dec(in); err != nil {
@@ -1092,6 +1443,24 @@ func _RepositoryService_Delete_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_ListHelmCharts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HelmChartsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).ListHelmCharts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepositoryService/ListHelmCharts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).ListHelmCharts(ctx, req.(*HelmChartsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RepositoryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "repository.RepositoryService",
 	HandlerType: (*RepositoryServiceServer)(nil),
@@ -1108,6 +1477,10 @@ var _RepositoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAppDetails",
 			Handler:    _RepositoryService_GetAppDetails_Handler,
 		},
+		{
+			MethodName: "ListRefs",
+			Handler:    _RepositoryService_ListRefs_Handler,
+		},
 		{
 			MethodName: "Create",
 			Handler:    _RepositoryService_Create_Handler,
@@ -1124,6 +1497,10 @@ var _RepositoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _RepositoryService_Delete_Handler,
 		},
+		{
+			MethodName: "ListHelmCharts",
+			Handler:    _RepositoryService_ListHelmCharts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/repository/repository.proto",
@@ -1189,6 +1566,12 @@ func (m *AppInfo) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRepository(dAtA, i, uint64(len(m.Path)))
 		i += copy(dAtA[i:], m.Path)
 	}
+	if len(m.AppPath) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.AppPath)))
+		i += copy(dAtA[i:], m.AppPath)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1563,6 +1946,43 @@ func (m *RepoQuery) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRepository(dAtA, i, uint64(len(m.Repo)))
 		i += copy(dAtA[i:], m.Repo)
 	}
+	if len(m.Search) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Search)))
+		i += copy(dAtA[i:], m.Search)
+	}
+	if m.Unused {
+		dAtA[i] = 0x18
+		i++
+		if m.Unused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(m.Limit))
+	}
+	if len(m.Continue) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Continue)))
+		i += copy(dAtA[i:], m.Continue)
+	}
+	if m.Force {
+		dAtA[i] = 0x30
+		i++
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1662,12 +2082,161 @@ func (m *RepoUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintRepository(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
+func (m *RepoRefsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoRefsQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Repo) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Repo)))
+		i += copy(dAtA[i:], m.Repo)
+	}
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.Search) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Search)))
+		i += copy(dAtA[i:], m.Search)
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(m.Limit))
+	}
+	if len(m.Continue) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Continue)))
+		i += copy(dAtA[i:], m.Continue)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RepoRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoRef) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RepoRefsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoRefsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Continue) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Continue)))
+		i += copy(dAtA[i:], m.Continue)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *HelmChartsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmChartsQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintRepository(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
 	dAtA[offset] = uint8(v)
 	return offset + 1
 }
@@ -1699,6 +2268,10 @@ func (m *AppInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRepository(uint64(l))
 	}
+	l = len(m.AppPath)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1881,6 +2454,23 @@ func (m *RepoQuery) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRepository(uint64(l))
 	}
+	l = len(m.Search)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Unused {
+		n += 2
+	}
+	if m.Limit != 0 {
+		n += 1 + sovRepository(uint64(m.Limit))
+	}
+	l = len(m.Continue)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1925,6 +2515,83 @@ func (m *RepoUpdateRequest) Size() (n int) {
 	return n
 }
 
+func (m *RepoRefsQuery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Search)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovRepository(uint64(m.Limit))
+	}
+	l = len(m.Continue)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoRef) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoRefsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	l = len(m.Continue)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HelmChartsQuery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovRepository(x uint64) (n int) {
 	for {
 		n++
@@ -2134,6 +2801,35 @@ func (m *AppInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -3343,17 +4039,134 @@ func (m *RepoQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Search = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unused = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Continue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Continue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
@@ -3604,6 +4417,492 @@ func (m *RepoUpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RepoRefsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoRefsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoRefsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Search = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Continue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Continue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoRefsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoRefsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoRefsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &RepoRef{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Continue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Continue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HelmChartsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HelmChartsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HelmChartsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRepository(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3710,66 +5009,78 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/repository/repository.proto", fileDescriptor_repository_6cfdcc280f230e64)
-}
-
-var fileDescriptor_repository_6cfdcc280f230e64 = []byte{
-	// 911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x5b, 0x45,
-	0x10, 0xd7, 0xc6, 0xa9, 0x13, 0x8f, 0xdb, 0x2a, 0xdd, 0x96, 0x60, 0x1e, 0x8e, 0x1b, 0x2d, 0x12,
-	0x24, 0x40, 0xdf, 0x53, 0x4c, 0x0f, 0x51, 0x10, 0x42, 0x81, 0x84, 0x12, 0x95, 0x03, 0xbc, 0x2a,
-	0x48, 0xe5, 0x40, 0xf5, 0x6a, 0x0f, 0xce, 0x62, 0xfb, 0xed, 0xf2, 0x76, 0x6d, 0xc9, 0x54, 0xb9,
-	0x20, 0x51, 0x71, 0x86, 0x3b, 0x77, 0xee, 0x7c, 0x08, 0x24, 0x2e, 0x48, 0x7c, 0x01, 0x14, 0x71,
-	0xe3, 0x4b, 0xa0, 0xdd, 0xf7, 0xc7, 0xeb, 0xf8, 0xd9, 0x20, 0x14, 0x71, 0x9b, 0x9d, 0xfd, 0xcd,
-	0xcc, 0x6f, 0x67, 0x66, 0x67, 0x17, 0x98, 0xc2, 0x64, 0x8c, 0x49, 0x90, 0xa0, 0x14, 0x8a, 0x6b,
-	0x91, 0x4c, 0x1c, 0xd1, 0x97, 0x89, 0xd0, 0x82, 0xc2, 0x54, 0xe3, 0xdd, 0xe9, 0x89, 0x9e, 0xb0,
-	0xea, 0xc0, 0x48, 0x29, 0xc2, 0x6b, 0xf6, 0x84, 0xe8, 0x0d, 0x30, 0x88, 0x24, 0x0f, 0xa2, 0x38,
-	0x16, 0x3a, 0xd2, 0x5c, 0xc4, 0x2a, 0xdb, 0x65, 0xfd, 0x7d, 0xe5, 0x73, 0x61, 0x77, 0x3b, 0x22,
-	0xc1, 0x60, 0xbc, 0x17, 0xf4, 0x30, 0xc6, 0x24, 0xd2, 0xd8, 0xcd, 0x30, 0x27, 0x3d, 0xae, 0xcf,
-	0x46, 0x4f, 0xfd, 0x8e, 0x18, 0x06, 0x51, 0x62, 0x43, 0x7c, 0x69, 0x85, 0x7b, 0x9d, 0x6e, 0x20,
-	0xfb, 0x3d, 0x63, 0xac, 0x82, 0x48, 0xca, 0x01, 0xef, 0x58, 0xe7, 0xc1, 0x78, 0x2f, 0x1a, 0xc8,
-	0xb3, 0x68, 0xce, 0x15, 0x7b, 0x17, 0x6e, 0x84, 0x28, 0xc5, 0xa1, 0x94, 0xea, 0x93, 0x11, 0x26,
-	0x13, 0x4a, 0x61, 0xd5, 0x9c, 0xa0, 0x41, 0xb6, 0xc9, 0x4e, 0x2d, 0xb4, 0x32, 0xf5, 0x60, 0x3d,
-	0xc1, 0x31, 0x57, 0x5c, 0xc4, 0x8d, 0x15, 0xab, 0x2f, 0xd6, 0x6c, 0x0f, 0xd6, 0x0e, 0xa5, 0x3c,
-	0x89, 0xbf, 0x10, 0xc6, 0x54, 0x4f, 0x24, 0xe6, 0xa6, 0x46, 0x36, 0x3a, 0x19, 0xe9, 0xb3, 0xcc,
-	0xcc, 0xca, 0xec, 0x31, 0xdc, 0xce, 0x62, 0x1e, 0xa1, 0x8e, 0xf8, 0xe0, 0xbf, 0x45, 0x2e, 0x5c,
-	0x57, 0x1c, 0xd7, 0xbf, 0x12, 0xd8, 0x9c, 0xf5, 0x1d, 0xa2, 0x92, 0x22, 0x56, 0x58, 0xca, 0xee,
-	0x3e, 0xac, 0xf5, 0x95, 0x88, 0x63, 0xd4, 0xd6, 0x7b, 0xbd, 0xed, 0xf9, 0x4e, 0x41, 0x1f, 0xa6,
-	0x5b, 0x87, 0x52, 0x3e, 0x92, 0xd8, 0x09, 0x73, 0x28, 0x7d, 0x03, 0x56, 0xcf, 0x70, 0x30, 0xb4,
-	0x81, 0xeb, 0xed, 0x17, 0x5d, 0x93, 0x0f, 0x71, 0x30, 0xcc, 0xf1, 0x16, 0x44, 0x0f, 0xa0, 0xd6,
-	0x1f, 0x29, 0x2d, 0x86, 0xfc, 0x6b, 0x6c, 0xac, 0x5a, 0x8b, 0xe6, 0x4c, 0x90, 0x7c, 0x33, 0x37,
-	0x9b, 0xc2, 0xd9, 0x3b, 0xb0, 0x91, 0x17, 0xa7, 0x38, 0xc6, 0x2e, 0x5c, 0xe3, 0x1a, 0x87, 0xaa,
-	0x41, 0xb6, 0x2b, 0x3b, 0xf5, 0xf6, 0x6d, 0xd7, 0x57, 0x56, 0x88, 0x30, 0x45, 0xb0, 0xbf, 0x08,
-	0xdc, 0x9c, 0x3d, 0x83, 0x49, 0x42, 0x1c, 0x0d, 0x8b, 0x24, 0x18, 0xb9, 0xac, 0x44, 0xf4, 0x63,
-	0xb8, 0x8e, 0xf1, 0x98, 0x27, 0x22, 0x1e, 0x62, 0xac, 0x55, 0xa3, 0x62, 0x83, 0xbd, 0xb9, 0x38,
-	0x3b, 0xfe, 0xb1, 0x03, 0x3f, 0x8e, 0x75, 0x32, 0x09, 0x67, 0x3c, 0x78, 0x4f, 0xe0, 0xd6, 0x1c,
-	0x84, 0x6e, 0x40, 0xa5, 0x8f, 0x93, 0x8c, 0x8d, 0x11, 0xe9, 0x7d, 0xb8, 0x36, 0x8e, 0x06, 0x23,
-	0xcc, 0xea, 0xd1, 0x2a, 0x89, 0xe8, 0xb8, 0x09, 0x53, 0xf0, 0xc1, 0xca, 0x3e, 0x61, 0xa7, 0x50,
-	0x77, 0xb2, 0xff, 0xaf, 0x4f, 0xda, 0x02, 0xb0, 0x3e, 0x3e, 0xe0, 0x03, 0x4c, 0xcf, 0x59, 0x0b,
-	0x1d, 0x0d, 0x7b, 0x15, 0x36, 0x2e, 0x97, 0xa8, 0xf0, 0x43, 0x9c, 0xce, 0xfb, 0x89, 0x00, 0x9d,
-	0x27, 0x58, 0x4a, 0xa3, 0x05, 0xd0, 0xdf, 0x57, 0x9f, 0x62, 0xe2, 0xb4, 0xb5, 0xa3, 0x29, 0x6b,
-	0x6c, 0xfa, 0x10, 0xea, 0x5d, 0x54, 0x9a, 0xc7, 0xf6, 0x3e, 0x67, 0x8d, 0xb4, 0xbb, 0x3c, 0x3b,
-	0x47, 0x53, 0x83, 0xd0, 0xb5, 0x66, 0xa7, 0xb0, 0xb5, 0x14, 0x4d, 0x37, 0xa1, 0x9a, 0x8e, 0xba,
-	0x8c, 0x77, 0xb6, 0xa2, 0x4d, 0xa8, 0x99, 0x13, 0x28, 0x19, 0x75, 0x30, 0x23, 0x3e, 0x55, 0xb0,
-	0xbb, 0x50, 0x33, 0xed, 0xba, 0xf0, 0x36, 0xb3, 0x9b, 0x70, 0xdd, 0x00, 0xf2, 0x5e, 0x66, 0xcf,
-	0x09, 0xdc, 0x32, 0x8a, 0xf7, 0x13, 0x8c, 0x34, 0x86, 0xf8, 0xd5, 0x08, 0x95, 0xa6, 0x8f, 0x1d,
-	0xcb, 0x7a, 0xfb, 0xd8, 0x9f, 0x0e, 0x3b, 0x3f, 0x1f, 0x76, 0x56, 0x78, 0xd2, 0xe9, 0xfa, 0xb2,
-	0xdf, 0xf3, 0xcd, 0xb0, 0xf3, 0x9d, 0x61, 0xe7, 0xe7, 0xc3, 0xce, 0x0f, 0x8b, 0xec, 0x64, 0xe3,
-	0x64, 0x13, 0xaa, 0x23, 0xa9, 0x30, 0x49, 0xaf, 0xfb, 0x7a, 0x98, 0xad, 0x58, 0x9c, 0xf2, 0x38,
-	0x95, 0xdd, 0xff, 0x85, 0x47, 0xfb, 0xe7, 0xb5, 0x34, 0x60, 0xaa, 0x7c, 0x84, 0xc9, 0x98, 0x77,
-	0x90, 0x3e, 0x27, 0xb0, 0xfa, 0x11, 0x57, 0x9a, 0xbe, 0xe0, 0xd6, 0xb5, 0x48, 0xa9, 0x77, 0x72,
-	0x25, 0x14, 0x4c, 0x04, 0xd6, 0xfc, 0xe6, 0xf7, 0x3f, 0x7f, 0x58, 0xd9, 0xa4, 0x77, 0xec, 0x3b,
-	0x33, 0xde, 0x9b, 0xbe, 0x63, 0x1c, 0x15, 0x1d, 0xc2, 0xba, 0x41, 0x99, 0xb9, 0x43, 0x5f, 0xba,
-	0xcc, 0xa5, 0x78, 0x2a, 0xbc, 0x66, 0xd9, 0x56, 0x51, 0xdc, 0x1d, 0x1b, 0x82, 0xd1, 0xed, 0xb2,
-	0x10, 0xc1, 0x33, 0xb3, 0x3a, 0x37, 0x6f, 0x94, 0xa2, 0xdf, 0x12, 0xb8, 0xf1, 0xc0, 0x4e, 0x92,
-	0x6c, 0x66, 0xd3, 0xbb, 0x25, 0x9e, 0xdd, 0xb7, 0xc2, 0x63, 0x8b, 0x01, 0x05, 0x81, 0xc0, 0x12,
-	0xd8, 0xa5, 0xaf, 0xfd, 0x13, 0x81, 0xe0, 0x99, 0xb9, 0x62, 0xe7, 0xf4, 0x7b, 0x02, 0xd5, 0xb4,
-	0x15, 0xe9, 0xd6, 0x65, 0xff, 0x33, 0x2d, 0xea, 0x5d, 0x4d, 0x33, 0x30, 0x66, 0x19, 0x36, 0x59,
-	0x69, 0x15, 0x0e, 0xd2, 0x96, 0xfd, 0x8e, 0x40, 0xe5, 0x01, 0x2e, 0xec, 0x89, 0x2b, 0x62, 0xf2,
-	0x8a, 0x65, 0xb2, 0x45, 0x5f, 0x5e, 0x92, 0x2b, 0xfa, 0x23, 0x81, 0x6a, 0x7a, 0x45, 0xe6, 0xf3,
-	0x33, 0x73, 0x75, 0xae, 0x8a, 0x95, 0x6f, 0x59, 0xed, 0x78, 0x4b, 0x5a, 0xc8, 0xf2, 0x38, 0xcf,
-	0x72, 0xf5, 0x39, 0x54, 0x8f, 0x70, 0x80, 0x1a, 0x17, 0x65, 0xab, 0x71, 0x59, 0x5d, 0x34, 0x4b,
-	0x96, 0x80, 0xd7, 0x97, 0x25, 0xe0, 0xbd, 0xb7, 0x7f, 0xb9, 0x68, 0x91, 0xdf, 0x2e, 0x5a, 0xe4,
-	0x8f, 0x8b, 0x16, 0xf9, 0xec, 0xde, 0xb2, 0x5f, 0xd8, 0xdc, 0x4f, 0xf1, 0x69, 0xd5, 0x7e, 0xb8,
-	0xde, 0xfa, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x27, 0xab, 0x67, 0x45, 0x0a, 0x00, 0x00,
+	proto.RegisterFile("server/repository/repository.proto", fileDescriptor_repository_df6959881d1a3011)
+}
+
+var fileDescriptor_repository_df6959881d1a3011 = []byte{
+	// 1098 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xbd, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xd7, 0xda, 0x89, 0x13, 0x3f, 0x97, 0x92, 0x4e, 0x43, 0x30, 0x5b, 0x27, 0x54, 0x53, 0x51,
+	0x12, 0x4a, 0x77, 0xd5, 0xc0, 0xa1, 0x0a, 0x42, 0x08, 0x9a, 0x40, 0xa3, 0xf4, 0x50, 0xb6, 0x6a,
+	0xa5, 0x72, 0xa0, 0xda, 0xae, 0x27, 0xf6, 0xd4, 0xeb, 0x9d, 0x65, 0x76, 0x6d, 0xc9, 0x54, 0x39,
+	0x80, 0x04, 0xe2, 0x0c, 0x07, 0x6e, 0x5c, 0x11, 0xdf, 0x04, 0x09, 0x21, 0x21, 0xf1, 0x05, 0x10,
+	0xe2, 0xc6, 0x97, 0x60, 0x66, 0x76, 0xf6, 0x9f, 0xbd, 0x36, 0xa1, 0x8d, 0x38, 0xd8, 0x9a, 0x79,
+	0xf3, 0xe6, 0xbd, 0xdf, 0xfb, 0x33, 0xbf, 0x99, 0x05, 0x1c, 0x11, 0x3e, 0x26, 0xdc, 0xe6, 0x24,
+	0x64, 0x11, 0x8d, 0x19, 0x9f, 0x14, 0x86, 0x56, 0xc8, 0x59, 0xcc, 0x10, 0xe4, 0x12, 0x73, 0xbd,
+	0xc7, 0x7a, 0x4c, 0x89, 0x6d, 0x39, 0x4a, 0x34, 0xcc, 0x4e, 0x8f, 0xb1, 0x9e, 0x4f, 0x6c, 0x37,
+	0xa4, 0xb6, 0x1b, 0x04, 0x2c, 0x76, 0x63, 0xca, 0x82, 0x48, 0xaf, 0xe2, 0xc1, 0xcd, 0xc8, 0xa2,
+	0x4c, 0xad, 0x7a, 0x8c, 0x13, 0x7b, 0x7c, 0xc3, 0xee, 0x91, 0x80, 0x70, 0x37, 0x26, 0x5d, 0xad,
+	0x73, 0xd8, 0xa3, 0x71, 0x7f, 0xf4, 0xd8, 0xf2, 0xd8, 0xd0, 0x76, 0xb9, 0x72, 0xf1, 0x44, 0x0d,
+	0xae, 0x7b, 0x5d, 0x3b, 0x1c, 0xf4, 0xe4, 0xe6, 0x48, 0xfc, 0x85, 0x3e, 0xf5, 0x94, 0x71, 0x61,
+	0xc4, 0xf5, 0xc3, 0xbe, 0x3b, 0x63, 0x0a, 0xbf, 0x07, 0x2f, 0x38, 0x02, 0xf0, 0xfb, 0x61, 0x18,
+	0x7d, 0x3c, 0x22, 0x7c, 0x82, 0x10, 0x2c, 0xc9, 0x08, 0xda, 0xc6, 0x65, 0x63, 0xbb, 0xe9, 0xa8,
+	0x31, 0x32, 0x61, 0x95, 0x93, 0x31, 0x8d, 0x84, 0xa5, 0x76, 0x4d, 0xc9, 0xb3, 0x39, 0x3e, 0x82,
+	0x15, 0xb1, 0xf9, 0x30, 0x38, 0x66, 0x72, 0x6b, 0x3c, 0x09, 0x49, 0xba, 0x55, 0x8e, 0xa5, 0x2c,
+	0x74, 0xe3, 0xbe, 0xde, 0xa6, 0xc6, 0xa8, 0x0d, 0x2b, 0x02, 0xdb, 0x5d, 0x29, 0xae, 0x2b, 0x71,
+	0x3a, 0xc5, 0x0f, 0xe1, 0xa2, 0x46, 0xb3, 0x4f, 0x62, 0x97, 0xfa, 0xcf, 0x86, 0x29, 0x73, 0x5a,
+	0xcf, 0x9d, 0xe2, 0x5f, 0x0c, 0xd8, 0x28, 0xdb, 0x76, 0x48, 0x14, 0x8a, 0xbc, 0x93, 0x4a, 0xdc,
+	0x6f, 0xc3, 0xca, 0x20, 0x62, 0x41, 0x40, 0x62, 0x65, 0xbd, 0xb5, 0x6b, 0x5a, 0x85, 0x52, 0x1f,
+	0x25, 0x4b, 0xc2, 0xd6, 0xbd, 0x90, 0x78, 0x4e, 0xaa, 0x8a, 0xae, 0xc1, 0x52, 0x9f, 0xf8, 0x43,
+	0xe5, 0xb8, 0xb5, 0xfb, 0x72, 0x71, 0xcb, 0x6d, 0x21, 0x4f, 0xf5, 0x95, 0x12, 0xda, 0x83, 0xe6,
+	0x60, 0x14, 0xc5, 0x6c, 0x48, 0x3f, 0x27, 0xed, 0x25, 0xb5, 0xa3, 0x53, 0x72, 0x92, 0x2e, 0xa6,
+	0xdb, 0x72, 0x75, 0xfc, 0x2e, 0xac, 0xa5, 0x65, 0xcb, 0xc2, 0xd8, 0x81, 0x65, 0x1a, 0x93, 0x61,
+	0x24, 0xe2, 0xa8, 0x0b, 0x5b, 0x17, 0x8b, 0xb6, 0x74, 0x89, 0x9c, 0x44, 0x03, 0xff, 0x6d, 0xc0,
+	0xf9, 0x72, 0x0c, 0x32, 0x09, 0x81, 0x3b, 0xcc, 0x92, 0x20, 0xc7, 0x95, 0xc5, 0xbb, 0x0b, 0xe7,
+	0x48, 0x30, 0xa6, 0x9c, 0x05, 0x43, 0x12, 0xc4, 0x91, 0x08, 0x55, 0x3a, 0x7b, 0x73, 0x7e, 0x76,
+	0xac, 0x83, 0x82, 0xfa, 0x41, 0x10, 0xf3, 0x89, 0x53, 0xb2, 0x60, 0x3e, 0x82, 0x0b, 0x33, 0x2a,
+	0x68, 0x0d, 0xea, 0x03, 0x32, 0xd1, 0x68, 0xe4, 0x50, 0x54, 0x64, 0x79, 0xec, 0xfa, 0x23, 0xa2,
+	0xeb, 0xb1, 0x55, 0xe1, 0xb1, 0x60, 0xc6, 0x49, 0x94, 0xf7, 0x6a, 0x37, 0x0d, 0x7c, 0x1f, 0x5a,
+	0x85, 0xec, 0x9f, 0x3a, 0xd2, 0x2d, 0x00, 0x65, 0xe3, 0x43, 0xea, 0x93, 0x24, 0xce, 0xa6, 0x53,
+	0x90, 0xe0, 0xab, 0xb0, 0x36, 0x5d, 0xa2, 0xcc, 0x8e, 0x51, 0xe8, 0xbc, 0x9f, 0x0c, 0x40, 0xb3,
+	0x00, 0x2b, 0x61, 0x08, 0x97, 0xe2, 0xf8, 0x3f, 0x20, 0xbc, 0xd0, 0xd6, 0x05, 0x49, 0x55, 0x63,
+	0xa3, 0x23, 0x68, 0x75, 0x49, 0x14, 0xd3, 0x40, 0x9d, 0x74, 0xdd, 0x48, 0x3b, 0x8b, 0xb3, 0xb3,
+	0x9f, 0x6f, 0x70, 0x8a, 0xbb, 0x45, 0xaa, 0x36, 0x17, 0x6a, 0xa3, 0x0d, 0x68, 0x24, 0x24, 0xa8,
+	0x71, 0xeb, 0x19, 0xea, 0x40, 0x53, 0x46, 0x10, 0x85, 0xae, 0x47, 0x34, 0xf0, 0x5c, 0x80, 0xbf,
+	0x37, 0xa0, 0x29, 0xfb, 0x75, 0xfe, 0x71, 0x56, 0x76, 0x5d, 0xee, 0xa5, 0x25, 0xd0, 0x33, 0x29,
+	0x1f, 0x05, 0xa3, 0x88, 0x74, 0x55, 0xcc, 0xab, 0x8e, 0x9e, 0xa1, 0x75, 0x58, 0xf6, 0xe9, 0x90,
+	0xc6, 0x2a, 0xde, 0xba, 0x93, 0x4c, 0x24, 0x29, 0x78, 0x2c, 0x10, 0x60, 0x45, 0x9b, 0x2c, 0x27,
+	0xa4, 0x90, 0xce, 0xe5, 0x8e, 0x63, 0xc6, 0x05, 0xba, 0x86, 0x32, 0x94, 0x4c, 0xf0, 0x79, 0x38,
+	0x27, 0x81, 0xa5, 0x87, 0x08, 0x7f, 0x6d, 0xc0, 0x05, 0x29, 0xb8, 0xc5, 0x89, 0x60, 0x49, 0x87,
+	0x7c, 0x36, 0x12, 0xc1, 0xa3, 0x87, 0x05, 0xc4, 0xad, 0xdd, 0x03, 0x2b, 0xe7, 0x5f, 0x2b, 0xe5,
+	0x5f, 0x35, 0x78, 0xe4, 0x09, 0x5e, 0x1d, 0xf4, 0x2c, 0xc9, 0xbf, 0x56, 0x81, 0x7f, 0xad, 0x94,
+	0x7f, 0x2d, 0x27, 0x2b, 0x4b, 0x1e, 0xf8, 0x28, 0x14, 0x49, 0x4c, 0x78, 0x46, 0x06, 0xa8, 0x66,
+	0x38, 0x48, 0x70, 0xdc, 0x0f, 0xbb, 0xff, 0x0b, 0x0e, 0xfc, 0x85, 0x91, 0xdc, 0x04, 0x0e, 0x39,
+	0x5e, 0xc0, 0xba, 0x29, 0x55, 0xd6, 0x0a, 0x54, 0x99, 0x97, 0xae, 0x5e, 0x2a, 0xdd, 0x7f, 0x2e,
+	0x11, 0xbe, 0x01, 0x2b, 0x1a, 0xc2, 0xbc, 0x43, 0x3a, 0xed, 0x5c, 0xdc, 0x18, 0x6b, 0x29, 0xea,
+	0x53, 0x11, 0xa1, 0x56, 0xd6, 0x44, 0x58, 0x42, 0x53, 0x9b, 0x42, 0xf3, 0x1a, 0xbc, 0x28, 0x69,
+	0xe3, 0x56, 0xdf, 0xe5, 0x71, 0x9e, 0x92, 0x69, 0x54, 0xbb, 0xbf, 0x36, 0x93, 0x4a, 0x25, 0x0e,
+	0xee, 0x89, 0xe3, 0x40, 0x3d, 0x82, 0x44, 0x1f, 0x2d, 0xdd, 0xa1, 0xa2, 0x64, 0x2f, 0x4d, 0x7b,
+	0x57, 0x96, 0xcc, 0xc3, 0x33, 0xa9, 0x9d, 0xf4, 0x80, 0x3b, 0x5f, 0xfe, 0xfe, 0xd7, 0x77, 0xb5,
+	0x0d, 0xb4, 0xae, 0xde, 0x0c, 0xe2, 0xb9, 0x90, 0x39, 0xa4, 0x24, 0x42, 0x43, 0x58, 0x95, 0x5a,
+	0xf2, 0xa6, 0x40, 0xaf, 0x4c, 0x63, 0xc9, 0xae, 0x7d, 0xb3, 0x53, 0xb5, 0x94, 0x9d, 0x8a, 0x6d,
+	0xe5, 0x02, 0xa3, 0xcb, 0x55, 0x2e, 0xec, 0xa7, 0x72, 0x76, 0x22, 0xdf, 0x1b, 0x11, 0xfa, 0x4a,
+	0xb4, 0xd1, 0x47, 0x8a, 0xfb, 0xf5, 0x2d, 0x8b, 0x5e, 0xad, 0xb0, 0x5c, 0xbc, 0xdd, 0x4d, 0x3c,
+	0x5f, 0x21, 0x03, 0x60, 0x2b, 0x00, 0x3b, 0xe8, 0xf5, 0x7f, 0x03, 0x60, 0x3f, 0x95, 0xa4, 0x78,
+	0x92, 0x86, 0x2d, 0xfb, 0x62, 0x36, 0xec, 0xac, 0xc7, 0x67, 0xc3, 0x2e, 0x36, 0xd2, 0xe9, 0xc2,
+	0xe6, 0xd2, 0xc5, 0xb7, 0x06, 0x34, 0x12, 0xca, 0x40, 0x9b, 0xd3, 0x26, 0x4b, 0x54, 0x62, 0x9e,
+	0xcd, 0xa1, 0xc5, 0x58, 0x41, 0xeb, 0xe0, 0xca, 0xa2, 0xef, 0x25, 0x87, 0xf5, 0x1b, 0x03, 0xea,
+	0xa2, 0x16, 0xf3, 0x5a, 0xf0, 0x8c, 0x90, 0x5c, 0x51, 0x48, 0x36, 0xd1, 0xa5, 0x05, 0x49, 0x42,
+	0x3f, 0x88, 0xfc, 0x24, 0x54, 0x36, 0x9b, 0x9f, 0x12, 0xc5, 0x9d, 0x15, 0x2a, 0x4b, 0xa1, 0xda,
+	0x36, 0x17, 0x94, 0x4e, 0xe1, 0x38, 0xd1, 0xb9, 0xfa, 0x14, 0x1a, 0xfb, 0xc4, 0x27, 0x02, 0xdf,
+	0x9c, 0x6c, 0xb5, 0x67, 0x3b, 0x45, 0x77, 0x89, 0x4e, 0xc0, 0x1b, 0x0b, 0x13, 0xf0, 0xa3, 0x78,
+	0x71, 0xc9, 0x86, 0xcc, 0x19, 0x05, 0x5d, 0x9a, 0x7e, 0x1e, 0x16, 0x98, 0xc6, 0xbc, 0xfd, 0x1c,
+	0x69, 0xc8, 0x6c, 0x29, 0x7a, 0xd0, 0x99, 0x40, 0x57, 0x53, 0x78, 0xf2, 0xf1, 0x59, 0x86, 0x28,
+	0x79, 0xec, 0xc4, 0xf6, 0x94, 0xfb, 0x0f, 0xde, 0xf9, 0xf9, 0xcf, 0x2d, 0xe3, 0x37, 0xf1, 0xfb,
+	0x43, 0xfc, 0x3e, 0xb9, 0xbe, 0xe8, 0x53, 0x63, 0xe6, 0x73, 0xe8, 0x71, 0x43, 0x7d, 0x55, 0xbc,
+	0xf5, 0x0f, 0x44, 0xbf, 0x9b, 0xda, 0x2a, 0x0d, 0x00, 0x00,
 }
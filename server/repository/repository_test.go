@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/errors"
+	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	apps "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
+	mockrepo "github.com/argoproj/argo-cd/reposerver/mocks"
+	"github.com/argoproj/argo-cd/reposerver/repository"
+	mockreposerver "github.com/argoproj/argo-cd/reposerver/repository/mocks"
+	"github.com/argoproj/argo-cd/test"
+	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/rbac"
+)
+
+const testNamespace = "default"
+
+func newTestApp(name string, repoURL string) *appsv1.Application {
+	return &appsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec: appsv1.ApplicationSpec{
+			Source: appsv1.ApplicationSource{RepoURL: repoURL},
+		},
+	}
+}
+
+func newTestRepoServer(repoURLs []string, apps ...*appsv1.Application) *Server {
+	kubeclientset := fake.NewSimpleClientset()
+	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	enforcer.SetBuiltinPolicy(test.BuiltinPolicy)
+	enforcer.SetDefaultRole("role:admin")
+	enforcer.SetClaimsEnforcerFunc(func(rvals ...interface{}) bool {
+		return true
+	})
+	db := db.NewDB(testNamespace, kubeclientset)
+	for _, repoURL := range repoURLs {
+		_, err := db.CreateRepository(context.Background(), &appsv1.Repository{Repo: repoURL})
+		errors.CheckError(err)
+	}
+	return NewServer(testNamespace, newFakeAppClientset(apps...), &mockrepo.Clientset{}, db, enforcer)
+}
+
+func newFakeAppClientset(objs ...*appsv1.Application) *apps.Clientset {
+	var runtimeObjs []runtime.Object
+	for _, obj := range objs {
+		runtimeObjs = append(runtimeObjs, obj)
+	}
+	return apps.NewSimpleClientset(runtimeObjs...)
+}
+
+func TestListRepositories(t *testing.T) {
+	repoServer := newTestRepoServer(
+		[]string{"https://github.com/org/a", "https://github.com/org/b", "https://github.com/other/c"},
+		newTestApp("app1", "https://github.com/org/a"),
+		newTestApp("app2", "https://github.com/org/a.git"),
+		newTestApp("app3", "https://github.com/other/c"),
+	)
+	ctx := context.Background()
+
+	repos, err := repoServer.List(ctx, &RepoQuery{})
+	assert.Nil(t, err)
+	assert.Len(t, repos.Items, 3)
+	assert.Equal(t, int64(2), repos.Items[0].ApplicationCount)
+	assert.Equal(t, int64(0), repos.Items[1].ApplicationCount)
+	assert.Equal(t, int64(1), repos.Items[2].ApplicationCount)
+
+	repos, err = repoServer.List(ctx, &RepoQuery{Unused: true})
+	assert.Nil(t, err)
+	assert.Len(t, repos.Items, 1)
+	assert.Equal(t, "https://github.com/org/b.git", repos.Items[0].Repo)
+
+	repos, err = repoServer.List(ctx, &RepoQuery{Search: "ORG/"})
+	assert.Nil(t, err)
+	assert.Len(t, repos.Items, 2)
+
+	repos, err = repoServer.List(ctx, &RepoQuery{Limit: 2})
+	assert.Nil(t, err)
+	assert.Len(t, repos.Items, 2)
+	assert.Equal(t, "https://github.com/other/c.git", repos.Continue)
+
+	repos, err = repoServer.List(ctx, &RepoQuery{Limit: 2, Continue: repos.Continue})
+	assert.Nil(t, err)
+	assert.Len(t, repos.Items, 1)
+	assert.Equal(t, "https://github.com/other/c.git", repos.Items[0].Repo)
+	assert.Equal(t, "", repos.Continue)
+}
+
+func TestDeleteRepositoryReferencedByApps(t *testing.T) {
+	repoServer := newTestRepoServer(
+		[]string{"https://github.com/org/a", "https://github.com/org/b"},
+		newTestApp("app2", "https://github.com/org/a.git"),
+		newTestApp("app1", "https://github.com/org/a"),
+	)
+	ctx := context.Background()
+
+	_, err := repoServer.Delete(ctx, &RepoQuery{Repo: "https://github.com/org/a"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "referenced by 2 applications (app1, app2)")
+
+	_, err = repoServer.Delete(ctx, &RepoQuery{Repo: "https://github.com/org/b"})
+	assert.Nil(t, err)
+	_, err = repoServer.Delete(ctx, &RepoQuery{Repo: "https://github.com/org/a", Force: true})
+	assert.Nil(t, err)
+	repos, err := repoServer.List(ctx, &RepoQuery{})
+	assert.Nil(t, err)
+	assert.Len(t, repos.Items, 0)
+}
+
+func TestListHelmChartsOfUnknownRepository(t *testing.T) {
+	repoServer := newTestRepoServer(nil)
+	_, err := repoServer.ListHelmCharts(context.Background(), &HelmChartsQuery{Name: "stable"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// the users who cannot get all the repositories are not told whether the repository exists
+	repoServer.enf.SetClaimsEnforcerFunc(func(rvals ...interface{}) bool {
+		return rvals[3] != "*"
+	})
+	_, err = repoServer.ListHelmCharts(context.Background(), &HelmChartsQuery{Name: "stable"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+type fakeCloser struct{}
+
+func (f fakeCloser) Close() error {
+	return nil
+}
+
+func TestListRefs(t *testing.T) {
+	repoServer := newTestRepoServer([]string{"https://github.com/org/a"})
+	repoServiceClient := mockreposerver.RepositoryServiceClient{}
+	repoServiceClient.On("ListRefs", mock.Anything, mock.Anything).Return(&repository.Refs{
+		Branches: []string{"master", "release-1.0"},
+		Tags:     []string{"v1.0.0", "v1.0.1"},
+	}, nil)
+	repoClientset := &mockrepo.Clientset{}
+	repoClientset.On("NewRepositoryClient").Return(&fakeCloser{}, &repoServiceClient, nil)
+	repoServer.repoClientset = repoClientset
+	ctx := context.Background()
+	refNames := func(res *RepoRefsResponse) []string {
+		var names []string
+		for _, ref := range res.Items {
+			names = append(names, ref.Name)
+		}
+		return names
+	}
+
+	res, err := repoServer.ListRefs(ctx, &RepoRefsQuery{Repo: "https://github.com/org/a", Limit: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"master", "release-1.0", "v1.0.0"}, refNames(res))
+	assert.Equal(t, "tag", res.Items[2].Type)
+	res, err = repoServer.ListRefs(ctx, &RepoRefsQuery{Repo: "https://github.com/org/a", Limit: 3, Continue: res.Continue})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"v1.0.1"}, refNames(res))
+	assert.Equal(t, "", res.Continue)
+
+	res, err = repoServer.ListRefs(ctx, &RepoRefsQuery{Repo: "https://github.com/org/a", Type: "tag", Search: "1.0.1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"v1.0.1"}, refNames(res))
+
+	_, err = repoServer.ListRefs(ctx, &RepoRefsQuery{Repo: "https://github.com/org/a", Type: "commit"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListApps(t *testing.T) {
+	repoServer := newTestRepoServer([]string{"https://github.com/org/a"})
+	repoServiceClient := mockreposerver.RepositoryServiceClient{}
+	repoServiceClient.On("ListApps", mock.Anything, mock.Anything).Return(&repository.AppList{
+		Apps: map[string]string{"guestbook/guestbook-ui.yaml": "directory", "charts/redis/Chart.yaml": "helm", "kustomization.yaml": "kustomize"},
+	}, nil)
+	repoClientset := &mockrepo.Clientset{}
+	repoClientset.On("NewRepositoryClient").Return(&fakeCloser{}, &repoServiceClient, nil)
+	repoServer.repoClientset = repoClientset
+
+	apps, err := repoServer.ListApps(context.Background(), &RepoAppsQuery{Repo: "https://github.com/org/a"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*AppInfo{
+		{Path: "charts/redis/Chart.yaml", AppPath: "charts/redis", Type: "helm"},
+		{Path: "guestbook/guestbook-ui.yaml", AppPath: "guestbook", Type: "directory"},
+		{Path: "kustomization.yaml", AppPath: ".", Type: "kustomize"},
+	}, apps.Items)
+}
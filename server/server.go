@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net"
 	"net/http"
@@ -19,6 +20,7 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 	"github.com/soheilhy/cmux"
 	netCtx "golang.org/x/net/context"
@@ -53,6 +55,7 @@ import (
 	"github.com/argoproj/argo-cd/server/settings"
 	"github.com/argoproj/argo-cd/server/version"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/audit"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/dex"
 	dexutil "github.com/argoproj/argo-cd/util/dex"
@@ -63,10 +66,13 @@ import (
 	jwtutil "github.com/argoproj/argo-cd/util/jwt"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/oidc"
+	"github.com/argoproj/argo-cd/util/otlp"
 	projectutil "github.com/argoproj/argo-cd/util/project"
+	"github.com/argoproj/argo-cd/util/ratelimit"
 	"github.com/argoproj/argo-cd/util/rbac"
 	util_session "github.com/argoproj/argo-cd/util/session"
 	settings_util "github.com/argoproj/argo-cd/util/settings"
+	"github.com/argoproj/argo-cd/util/stats"
 	"github.com/argoproj/argo-cd/util/swagger"
 	tlsutil "github.com/argoproj/argo-cd/util/tls"
 	"github.com/argoproj/argo-cd/util/webhook"
@@ -77,6 +83,9 @@ var (
 	ErrNoSession = status.Errorf(codes.Unauthenticated, "no session information")
 )
 
+// updatePasswordMethod is the only method the local admin user can call until the initial admin password is changed
+const updatePasswordMethod = "/account.AccountService/UpdatePassword"
+
 var noCacheHeaders = map[string]string{
 	"Expires":         time.Unix(0, 0).Format(time.RFC1123),
 	"Cache-Control":   "no-cache, private, max-age=0",
@@ -114,6 +123,11 @@ type ArgoCDServer struct {
 	enf          *rbac.Enforcer
 	appInformer  cache.SharedIndexInformer
 	appLister    applister.ApplicationLister
+	grpcMetrics  *metrics.GRPCMetrics
+	dexMetrics   *metrics.DexProxyMetrics
+
+	// tokenDenylist holds the revoked tokens checked by the session manager
+	tokenDenylist *util_session.TokenDenylist
 
 	// stopCh is the channel which when closed, will shutdown the Argo CD server
 	stopCh chan struct{}
@@ -128,7 +142,32 @@ type ArgoCDServerOpts struct {
 	KubeClientset       kubernetes.Interface
 	AppClientset        appclientset.Interface
 	RepoClientset       reposerver.Clientset
+	ProfileDumper       *stats.ProfileDumper
 	TLSConfigCustomizer tlsutil.ConfigCustomizer
+	KubeClientMetrics   *kube.ClientMetrics
+	// MetricsApplicationLabels are the application labels added to the application metrics
+	MetricsApplicationLabels []string
+	// MetricsResourceCountByKind breaks down the resource count of applications by group and kind
+	MetricsResourceCountByKind bool
+	// MetricsTLS serves the metrics endpoint over TLS, using the server certificate
+	MetricsTLS bool
+	// MetricsBearerToken is the bearer token required to access the metrics endpoint, if set
+	MetricsBearerToken string
+	// MetricsClientCAs are the certificate authorities of the client certificates required to access
+	// the metrics endpoint, if set. Requires MetricsTLS
+	MetricsClientCAs *x509.CertPool
+	// OTLPMetricsEndpoint is the OTLP/HTTP endpoint the metrics are pushed to, if set
+	OTLPMetricsEndpoint string
+	// OTLPMetricsInterval is the interval between two pushes of the metrics to the OTLP endpoint
+	OTLPMetricsInterval time.Duration
+	// OTLPInstanceName is the instance name reported with the metrics pushed to the OTLP endpoint
+	OTLPInstanceName string
+	// AuditSink is the sink the calls to the mutating API methods are recorded to, if set
+	AuditSink audit.Sink
+	// RateLimiter limits the rate of the API requests of each client, if set
+	RateLimiter *ratelimit.Limiter
+	// EnableGRPCWeb serves the gRPC API to gRPC-Web clients, over HTTP/1.1, on the HTTP(S) port
+	EnableGRPCWeb bool
 }
 
 // initializeDefaultProject creates the default project if it does not already exist
@@ -149,13 +188,10 @@ func initializeDefaultProject(opts ArgoCDServerOpts) error {
 	return err
 }
 
-// initializeSettings sets default secret settings (password set to hostname)
+// initializeSettings sets default secret settings (password set to a generated initial password)
 func initializeSettings(settingsMgr *settings_util.SettingsManager, opts ArgoCDServerOpts) (*settings_util.ArgoCDSettings, error) {
 
-	defaultPassword, err := os.Hostname()
-	errors.CheckError(err)
-
-	cdSettings, err := settings_util.UpdateSettings(defaultPassword, settingsMgr, false, false, opts.Namespace)
+	cdSettings, err := settings_util.InitializeSettings(settingsMgr, opts.Namespace)
 	if err != nil {
 		// assume settings are initialized by another instance of api server
 		if apierrors.IsConflict(err) {
@@ -176,7 +212,10 @@ func NewServer(opts ArgoCDServerOpts) *ArgoCDServer {
 	errors.CheckError(err)
 	err = initializeDefaultProject(opts)
 	errors.CheckError(err)
-	sessionMgr := util_session.NewSessionManager(settings)
+	tokenDenylist := util_session.NewTokenDenylist(opts.KubeClientset, opts.Namespace)
+	err = tokenDenylist.Load()
+	errors.CheckError(err)
+	sessionMgr := util_session.NewSessionManager(settings, tokenDenylist)
 
 	enf := rbac.NewEnforcer(opts.KubeClientset, opts.Namespace, common.ArgoCDRBACConfigMapName, nil)
 	enf.EnableEnforce(!opts.DisableAuth)
@@ -193,10 +232,13 @@ func NewServer(opts ArgoCDServerOpts) *ArgoCDServer {
 		log:              log.NewEntry(log.New()),
 		settings:         settings,
 		sessionMgr:       sessionMgr,
+		tokenDenylist:    tokenDenylist,
 		settingsMgr:      settingsMgr,
 		enf:              enf,
 		appInformer:      appInformer,
 		appLister:        appLister,
+		grpcMetrics:      metrics.NewGRPCMetrics(),
+		dexMetrics:       metrics.NewDexProxyMetrics(),
 	}
 }
 
@@ -206,13 +248,17 @@ func NewServer(opts ArgoCDServerOpts) *ArgoCDServer {
 // golang/protobuf).
 func (a *ArgoCDServer) Run(ctx context.Context, port int) {
 	grpcS := a.newGRPCServer()
+	var grpcWebHandler http.Handler
+	if a.EnableGRPCWeb {
+		grpcWebHandler = grpc_util.NewGRPCWebHandler(grpcS)
+	}
 	var httpS *http.Server
 	var httpsS *http.Server
 	if a.useTLS() {
 		httpS = newRedirectServer(port)
-		httpsS = a.newHTTPServer(ctx, port)
+		httpsS = a.newHTTPServer(ctx, port, grpcWebHandler)
 	} else {
-		httpS = a.newHTTPServer(ctx, port)
+		httpS = a.newHTTPServer(ctx, port, grpcWebHandler)
 	}
 
 	// Start listener
@@ -254,7 +300,15 @@ func (a *ArgoCDServer) Run(ctx context.Context, port int) {
 		httpsL = tlsm.Match(cmux.HTTP1Fast())
 		grpcL = tlsm.Match(cmux.Any())
 	}
-	metricsServ := metrics.NewMetricsServer(8082, a.appLister)
+	collectors := append([]prometheus.Collector{a.grpcMetrics, a.dexMetrics}, stats.NewRuntimeCollectors()...)
+	if a.KubeClientMetrics != nil {
+		collectors = append(collectors, a.KubeClientMetrics)
+	}
+	if a.RateLimiter != nil {
+		collectors = append(collectors, a.RateLimiter)
+	}
+	metricsRegistry := metrics.NewAppRegistry(a.appLister, a.MetricsApplicationLabels, a.MetricsResourceCountByKind, collectors...)
+	metricsServ := a.newMetricsServer(metricsRegistry)
 
 	// Start the muxed listeners for our servers
 	log.Infof("argocd %s serving on port %d (url: %s, tls: %v, namespace: %s, sso: %v)",
@@ -269,8 +323,19 @@ func (a *ArgoCDServer) Run(ctx context.Context, port int) {
 	}
 	go a.watchSettings(ctx)
 	go a.rbacPolicyLoader(ctx)
+	go a.tokenDenylist.Run(ctx)
+	if a.OTLPMetricsEndpoint != "" {
+		exporter := otlp.NewExporter(a.OTLPMetricsEndpoint, metricsRegistry, otlp.ResourceAttributes("argocd-server", a.OTLPInstanceName, argocd.GetVersion().Version))
+		go exporter.Run(ctx, a.OTLPMetricsInterval)
+	}
 	go func() { a.checkServeErr("tcpm", tcpm.Serve()) }()
-	go func() { a.checkServeErr("metrics", metricsServ.ListenAndServe()) }()
+	go func() {
+		if metricsServ.TLSConfig != nil {
+			a.checkServeErr("metrics", metricsServ.ListenAndServeTLS("", ""))
+		} else {
+			a.checkServeErr("metrics", metricsServ.ListenAndServe())
+		}
+	}()
 	if !cache.WaitForCacheSync(ctx.Done(), a.appInformer.HasSynced) {
 		log.Fatal("Timed out waiting for caches to sync")
 	}
@@ -280,6 +345,72 @@ func (a *ArgoCDServer) Run(ctx context.Context, port int) {
 	errors.CheckError(conn.Close())
 }
 
+// healthCheck returns an error if the server is not able to serve requests, regardless of its dependencies
+func (a *ArgoCDServer) healthCheck() error {
+	if a.settings == nil {
+		return fmt.Errorf("settings are not loaded")
+	}
+	return nil
+}
+
+// readinessChecks returns the checks of the dependencies the API server needs to serve requests
+func (a *ArgoCDServer) readinessChecks() []healthz.Check {
+	return []healthz.Check{{
+		Name: "application-informer",
+		Check: func() error {
+			if !a.appInformer.HasSynced() {
+				return fmt.Errorf("application informer has not synced")
+			}
+			return nil
+		},
+	}, {
+		Name: "kubernetes",
+		Check: func() error {
+			_, err := a.KubeClientset.Discovery().ServerVersion()
+			return err
+		},
+	}, {
+		Name: "repo-server",
+		Check: func() error {
+			return reposerver.CheckHealth(a.RepoClientset)
+		},
+	}, {
+		Name: "dex",
+		Check: func() error {
+			// Dex only runs when SSO is configured with Dex
+			if !a.settings.IsDexConfigured() {
+				return nil
+			}
+			return dexutil.CheckHealth(a.DexServerAddr)
+		},
+	}}
+}
+
+// authorizeProfileDump permits profile dumps to callers with a valid session who are allowed to dump profiles
+func (a *ArgoCDServer) authorizeProfileDump(r *http.Request) error {
+	if a.DisableAuth {
+		return nil
+	}
+	tokenString := ""
+	if cookie, err := r.Cookie(common.AuthCookieName); err == nil {
+		tokenString = cookie.Value
+	}
+	if token, ok := bearerToken(r.Header.Get("Authorization")); ok {
+		tokenString = token
+	}
+	if tokenString == "" {
+		return ErrNoSession
+	}
+	claims, err := a.sessionMgr.VerifyToken(tokenString)
+	if err != nil {
+		return fmt.Errorf("invalid session: %v", err)
+	}
+	if !a.enf.EnforceClaims(claims, "profiles", "dump", "*") {
+		return fmt.Errorf("permission denied")
+	}
+	return nil
+}
+
 // checkServeErr checks the error from a .Serve() call to decide if it was a graceful shutdown
 func (a *ArgoCDServer) checkServeErr(name string, err error) {
 	if err != nil {
@@ -370,6 +501,29 @@ func (a *ArgoCDServer) rbacPolicyLoader(ctx context.Context) {
 	errors.CheckError(err)
 }
 
+// newMetricsServer returns the server of the metrics endpoint, secured according to the metrics options
+func (a *ArgoCDServer) newMetricsServer(registry prometheus.Gatherer) *http.Server {
+	metricsServ := metrics.NewMetricsServer(8082, registry)
+	if a.MetricsBearerToken != "" {
+		metricsServ.Handler = metrics.NewBearerTokenHandler(metricsServ.Handler, a.MetricsBearerToken)
+	}
+	if a.MetricsTLS {
+		if a.settings.Certificate == nil {
+			errors.CheckError(fmt.Errorf("Cannot serve metrics over TLS: no server certificate is configured"))
+		}
+		tlsConfig := tls.Config{
+			Certificates: []tls.Certificate{*a.settings.Certificate},
+		}
+		a.TLSConfigCustomizer(&tlsConfig)
+		if a.MetricsClientCAs != nil {
+			tlsConfig.ClientCAs = a.MetricsClientCAs
+			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+		metricsServ.TLSConfig = &tlsConfig
+	}
+	return metricsServ
+}
+
 func (a *ArgoCDServer) useTLS() bool {
 	if a.Insecure || a.settings.Certificate == nil {
 		return false
@@ -389,41 +543,65 @@ func (a *ArgoCDServer) newGRPCServer() *grpc.Server {
 	sensitiveMethods := map[string]bool{
 		"/session.SessionService/Create":         true,
 		"/account.AccountService/UpdatePassword": true,
+		"/account.AccountService/RevokeToken":    true,
 		"/repository.RepositoryService/Create":   true,
 		"/repository.RepositoryService/Update":   true,
+		"/application.ApplicationService/Exec":   true,
 	}
 	// NOTE: notice we do not configure the gRPC server here with TLS (e.g. grpc.Creds(creds))
 	// This is because TLS handshaking occurs in cmux handling
-	sOpts = append(sOpts, grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		a.grpcMetrics.StreamServerInterceptor(),
 		grpc_logrus.StreamServerInterceptor(a.log),
 		grpc_auth.StreamServerInterceptor(a.authenticate),
-		grpc_util.PayloadStreamServerInterceptor(a.log, true, func(ctx netCtx.Context, fullMethodName string, servingObject interface{}) bool {
-			return !sensitiveMethods[fullMethodName]
-		}),
-		grpc_util.ErrorCodeStreamServerInterceptor(),
-		grpc_util.PanicLoggerStreamServerInterceptor(a.log),
-	)))
-	sOpts = append(sOpts, grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		bug21955WorkaroundInterceptor,
+		a.grpcMetrics.UnaryServerInterceptor(),
 		grpc_logrus.UnaryServerInterceptor(a.log),
 		grpc_auth.UnaryServerInterceptor(a.authenticate),
-		grpc_util.PayloadUnaryServerInterceptor(a.log, true, func(ctx netCtx.Context, fullMethodName string, servingObject interface{}) bool {
-			return !sensitiveMethods[fullMethodName]
-		}),
+	}
+	if a.RateLimiter != nil {
+		// the requests are limited once authenticated, so that the clients are identified by their user
+		streamInterceptors = append(streamInterceptors, a.RateLimiter.StreamServerInterceptor())
+		unaryInterceptors = append(unaryInterceptors, a.RateLimiter.UnaryServerInterceptor())
+	}
+	streamInterceptors = append(streamInterceptors, grpc_util.PayloadStreamServerInterceptor(a.log, true, func(ctx netCtx.Context, fullMethodName string, servingObject interface{}) bool {
+		return !sensitiveMethods[fullMethodName]
+	}))
+	unaryInterceptors = append(unaryInterceptors, grpc_util.PayloadUnaryServerInterceptor(a.log, true, func(ctx netCtx.Context, fullMethodName string, servingObject interface{}) bool {
+		return !sensitiveMethods[fullMethodName]
+	}))
+	if a.AuditSink != nil {
+		// the calls are recorded with the gRPC equivalents of the Kubernetes errors. The cluster
+		// requests are not recorded since they hold the credentials of the clusters
+		recordRequest := func(fullMethodName string) bool {
+			return !sensitiveMethods[fullMethodName] && !strings.HasPrefix(fullMethodName, "/cluster.ClusterService/")
+		}
+		streamInterceptors = append(streamInterceptors, audit.StreamServerInterceptor(a.AuditSink, recordRequest))
+		unaryInterceptors = append(unaryInterceptors, audit.UnaryServerInterceptor(a.AuditSink, recordRequest))
+	}
+	streamInterceptors = append(streamInterceptors,
+		grpc_util.ErrorCodeStreamServerInterceptor(),
+		grpc_util.PanicLoggerStreamServerInterceptor(a.log),
+	)
+	unaryInterceptors = append(unaryInterceptors,
 		grpc_util.ErrorCodeUnaryServerInterceptor(),
 		grpc_util.PanicLoggerUnaryServerInterceptor(a.log),
-	)))
+	)
+	sOpts = append(sOpts, grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)))
+	sOpts = append(sOpts, grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors...)))
 	a.enf.SetClaimsEnforcerFunc(EnforceClaims(a.enf, a.AppClientset, a.Namespace))
 	grpcS := grpc.NewServer(sOpts...)
 	db := db.NewDB(a.Namespace, a.KubeClientset)
-	clusterService := cluster.NewServer(db, a.enf)
-	repoService := repository.NewServer(a.RepoClientset, db, a.enf)
+	clusterService := cluster.NewServer(a.Namespace, a.AppClientset, db, a.enf)
+	repoService := repository.NewServer(a.Namespace, a.AppClientset, a.RepoClientset, db, a.enf)
 	sessionService := session.NewServer(a.sessionMgr)
 	projectLock := util.NewKeyLock()
-	applicationService := application.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.RepoClientset, kube.KubectlCmd{}, db, a.enf, projectLock)
+	applicationService := application.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.RepoClientset, kube.KubectlCmd{}, db, a.enf, projectLock, a.KubeClientMetrics)
 	projectService := project.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.enf, projectLock, a.sessionMgr)
 	settingsService := settings.NewServer(a.settingsMgr)
-	accountService := account.NewServer(a.sessionMgr, a.settingsMgr)
+	accountService := account.NewServer(a.sessionMgr, a.settingsMgr, a.enf)
 	version.RegisterVersionServiceServer(grpcS, &version.Server{})
 	cluster.RegisterClusterServiceServer(grpcS, clusterService)
 	application.RegisterApplicationServiceServer(grpcS, applicationService)
@@ -451,13 +629,18 @@ func (a *ArgoCDServer) translateGrpcCookieHeader(ctx context.Context, w http.Res
 }
 
 // newHTTPServer returns the HTTP server to serve HTTP/HTTPS requests. This is implemented
-// using grpc-gateway as a proxy to the gRPC server.
-func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int) *http.Server {
+// using grpc-gateway as a proxy to the gRPC server. The gRPC-Web requests are served by the given
+// gRPC-Web handler, if set.
+func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandler http.Handler) *http.Server {
 	endpoint := fmt.Sprintf("localhost:%d", port)
 	mux := http.NewServeMux()
+	var handler http.Handler = &bug21955Workaround{handler: mux}
+	if grpcWebHandler != nil {
+		handler = &grpcWebSwitcher{handler: handler, grpcWebHandler: grpcWebHandler}
+	}
 	httpS := http.Server{
 		Addr:    endpoint,
-		Handler: &bug21955Workaround{handler: mux},
+		Handler: handler,
 	}
 	dOpts := []grpc.DialOption{grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(apiclient.MaxGRPCMessageSize))}
 	if a.useTLS() {
@@ -483,6 +666,8 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int) *http.Server
 	gwMuxOpts := runtime.WithMarshalerOption(runtime.MIMEWildcard, new(jsonutil.JSONMarshaler))
 	gwCookieOpts := runtime.WithForwardResponseOption(a.translateGrpcCookieHeader)
 	gwmux := runtime.NewServeMux(gwMuxOpts, gwCookieOpts)
+	// the details of the errors are returned to the REST clients too
+	runtime.HTTPError = grpc_util.HTTPError
 	mux.Handle("/api/", gwmux)
 	mustRegisterGWHandler(version.RegisterVersionServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
 	mustRegisterGWHandler(cluster.RegisterClusterServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
@@ -492,11 +677,21 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int) *http.Server
 	mustRegisterGWHandler(settings.RegisterSettingsServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
 	mustRegisterGWHandler(project.RegisterProjectServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
 
+	// the exec sessions of the clients which cannot open gRPC streams are served over websockets
+	execConn, err := grpc.DialContext(ctx, endpoint, dOpts...)
+	errors.CheckError(err)
+	go func() {
+		<-ctx.Done()
+		_ = execConn.Close()
+	}()
+	mux.Handle(application.ExecWebSocketPath, application.NewExecWebSocketHandler(application.NewApplicationServiceClient(execConn)))
+
 	swagger.ServeSwaggerUI(mux, packr.NewBox("."), "/swagger-ui")
-	healthz.ServeHealthCheck(mux, func() error {
-		_, err := a.KubeClientset.(*kubernetes.Clientset).ServerVersion()
-		return err
-	})
+	healthz.ServeHealthCheck(mux, a.healthCheck)
+	healthz.ServeReadinessCheck(mux, a.readinessChecks()...)
+	if a.ProfileDumper != nil {
+		mux.HandleFunc(common.ProfileDumpEndpoint, a.ProfileDumper.Handler(a.authorizeProfileDump))
+	}
 
 	// Dex reverse proxy and client app and OAuth2 login/callback
 	a.registerDexHandlers(mux)
@@ -537,7 +732,7 @@ func (a *ArgoCDServer) registerDexHandlers(mux *http.ServeMux) {
 	}
 	// Run dex OpenID Connect Identity Provider behind a reverse proxy (served at /api/dex)
 	var err error
-	mux.HandleFunc(common.DexAPIEndpoint+"/", dexutil.NewDexHTTPReverseProxy(a.DexServerAddr))
+	mux.HandleFunc(common.DexAPIEndpoint+"/", a.dexMetrics.Handler(dexutil.NewDexHTTPReverseProxy(a.DexServerAddr, a.dexMetrics.IncErrors)))
 	tlsConfig := a.settings.TLSConfig()
 	tlsConfig.InsecureSkipVerify = true
 	a.ssoClientApp, err = oidc.NewClientApp(a.settings)
@@ -587,11 +782,28 @@ func (a *ArgoCDServer) authenticate(ctx context.Context) (context.Context, error
 	if err != nil {
 		return ctx, status.Errorf(codes.Unauthenticated, "invalid session: %v", err)
 	}
+	if method, ok := grpc.Method(ctx); ok && a.passwordChangeRequired(claims, method) {
+		return ctx, status.Errorf(codes.PermissionDenied, "the initial admin password has to be changed using 'argocd account update-password'")
+	}
 	// Add claims to the context to inspect for RBAC
 	ctx = context.WithValue(ctx, "claims", claims)
 	return ctx, nil
 }
 
+// passwordChangeRequired returns whether the given method is denied to the local admin user until
+// the initial admin password is changed
+func (a *ArgoCDServer) passwordChangeRequired(claims jwt.Claims, method string) bool {
+	if !a.settings.AdminPasswordChangeRequired || method == updatePasswordMethod {
+		return false
+	}
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return false
+	}
+	return jwtutil.GetField(mapClaims, "iss") == util_session.SessionManagerClaimsIssuer &&
+		jwtutil.GetField(mapClaims, "sub") == common.ArgoCDAdminUsername
+}
+
 // getToken extracts the token from gRPC metadata or cookie headers
 func getToken(md metadata.MD) string {
 	// check the "token" metadata
@@ -599,8 +811,14 @@ func getToken(md metadata.MD) string {
 	if ok && len(tokens) > 0 {
 		return tokens[0]
 	}
-	// check the HTTP cookie
-	for _, cookieToken := range md["grpcgateway-cookie"] {
+	// check the bearer token of the HTTP Authorization header
+	for _, authHeader := range md["authorization"] {
+		if token, ok := bearerToken(authHeader); ok {
+			return token
+		}
+	}
+	// check the HTTP cookie, forwarded by grpc-gateway or sent with gRPC-Web requests
+	for _, cookieToken := range append(md["grpcgateway-cookie"], md["cookie"]...) {
 		header := http.Header{}
 		header.Add("Cookie", cookieToken)
 		request := http.Request{Header: header}
@@ -612,6 +830,31 @@ func getToken(md metadata.MD) string {
 	return ""
 }
 
+// bearerToken returns the token of an HTTP Authorization header of the Bearer scheme, whose name is case
+// insensitive
+func bearerToken(authHeader string) (string, bool) {
+	const prefix = "Bearer "
+	if len(authHeader) < len(prefix) || !strings.EqualFold(authHeader[:len(prefix)], prefix) {
+		return "", false
+	}
+	return authHeader[len(prefix):], true
+}
+
+// grpcWebSwitcher serves the gRPC-Web requests with the gRPC-Web handler, and the other requests with
+// the HTTP handler
+type grpcWebSwitcher struct {
+	handler        http.Handler
+	grpcWebHandler http.Handler
+}
+
+func (s *grpcWebSwitcher) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if grpc_util.IsGRPCWebRequest(r) {
+		s.grpcWebHandler.ServeHTTP(w, r)
+	} else {
+		s.handler.ServeHTTP(w, r)
+	}
+}
+
 // Workaround for https://github.com/golang/go/issues/21955 to support escaped URLs in URL path.
 type bug21955Workaround struct {
 	handler http.Handler
@@ -7,14 +7,18 @@ import (
 	jwt "github.com/dgrijalva/jwt-go"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
 	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	apps "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/util/password"
 	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/session"
 )
 
 const (
@@ -239,3 +243,54 @@ func TestInitializingNotExistingDefaultProject(t *testing.T) {
 	assert.Equal(t, proj.Name, common.DefaultAppProjectName)
 
 }
+
+func TestInitialAdminPassword(t *testing.T) {
+	cm := fakeConfigMap()
+	secret := fakeSecret()
+	kubeclientset := fake.NewSimpleClientset(cm, secret)
+
+	argocd := NewServer(ArgoCDServerOpts{Namespace: fakeNamespace, KubeClientset: kubeclientset, AppClientset: apps.NewSimpleClientset()})
+	initialSecret, err := kubeclientset.CoreV1().Secrets(fakeNamespace).Get(common.ArgoCDInitialAdminSecretName, v1.GetOptions{})
+	assert.Nil(t, err)
+	initialPassword := string(initialSecret.Data["password"])
+	assert.NotEmpty(t, initialPassword)
+	valid, _ := password.VerifyPassword(initialPassword, argocd.settings.AdminPasswordHash)
+	assert.True(t, valid)
+	assert.True(t, argocd.settings.AdminPasswordChangeRequired)
+
+	adminClaims := jwt.MapClaims{"iss": session.SessionManagerClaimsIssuer, "sub": common.ArgoCDAdminUsername}
+	assert.True(t, argocd.passwordChangeRequired(adminClaims, "/application.ApplicationService/List"))
+	assert.False(t, argocd.passwordChangeRequired(adminClaims, updatePasswordMethod))
+	projectClaims := jwt.MapClaims{"iss": session.SessionManagerClaimsIssuer, "sub": "proj:testProj:testRole"}
+	assert.False(t, argocd.passwordChangeRequired(projectClaims, "/application.ApplicationService/List"))
+
+	// a restarted server keeps the initial password
+	argocd = NewServer(ArgoCDServerOpts{Namespace: fakeNamespace, KubeClientset: kubeclientset, AppClientset: apps.NewSimpleClientset()})
+	valid, _ = password.VerifyPassword(initialPassword, argocd.settings.AdminPasswordHash)
+	assert.True(t, valid)
+	assert.True(t, argocd.settings.AdminPasswordChangeRequired)
+}
+
+func TestExistingAdminPassword(t *testing.T) {
+	cm := fakeConfigMap()
+	secret := fakeSecret()
+	hashedPassword, err := password.HashPassword("existing")
+	assert.Nil(t, err)
+	secret.Data["admin.password"] = []byte(hashedPassword)
+	kubeclientset := fake.NewSimpleClientset(cm, secret)
+
+	argocd := NewServer(ArgoCDServerOpts{Namespace: fakeNamespace, KubeClientset: kubeclientset, AppClientset: apps.NewSimpleClientset()})
+	assert.Equal(t, hashedPassword, argocd.settings.AdminPasswordHash)
+	assert.False(t, argocd.settings.AdminPasswordChangeRequired)
+	_, err = kubeclientset.CoreV1().Secrets(fakeNamespace).Get(common.ArgoCDInitialAdminSecretName, v1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestGetToken(t *testing.T) {
+	// the scheme of the Authorization header is case insensitive
+	for _, authHeader := range []string{"Bearer my-token", "bearer my-token", "BEARER my-token"} {
+		assert.Equal(t, "my-token", getToken(metadata.New(map[string]string{"authorization": authHeader})))
+	}
+	assert.Equal(t, "", getToken(metadata.New(map[string]string{"authorization": "Basic my-token"})))
+	assert.Equal(t, "", getToken(metadata.New(map[string]string{"authorization": "Bear"})))
+}
@@ -25,7 +25,16 @@ func (s *Server) Get(ctx context.Context, q *SettingsQuery) (*Settings, error) {
 		return nil, err
 	}
 	set := Settings{
-		URL: argoCDSettings.URL,
+		URL:                 argoCDSettings.URL,
+		SSOEnabled:          argoCDSettings.IsSSOConfigured(),
+		ReconciliationPause: argoCDSettings.ReconciliationPause,
+	}
+	if argoCDSettings.UICSSURL != "" || argoCDSettings.UIBannerContent != "" || argoCDSettings.UIBannerURL != "" {
+		set.UIOptions = &UIOptions{
+			CSSURL:        argoCDSettings.UICSSURL,
+			BannerContent: argoCDSettings.UIBannerContent,
+			BannerURL:     argoCDSettings.UIBannerURL,
+		}
 	}
 	if argoCDSettings.DexConfig != "" {
 		var cfg DexConfig
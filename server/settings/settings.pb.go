@@ -42,7 +42,7 @@ func (m *SettingsQuery) Reset()         { *m = SettingsQuery{} }
 func (m *SettingsQuery) String() string { return proto.CompactTextString(m) }
 func (*SettingsQuery) ProtoMessage()    {}
 func (*SettingsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_78f024359af61686, []int{0}
+	return fileDescriptor_settings_6cf6e9b5483c7a60, []int{0}
 }
 func (m *SettingsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -72,19 +72,24 @@ func (m *SettingsQuery) XXX_DiscardUnknown() {
 var xxx_messageInfo_SettingsQuery proto.InternalMessageInfo
 
 type Settings struct {
-	URL                  string      `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	DexConfig            *DexConfig  `protobuf:"bytes,2,opt,name=dexConfig" json:"dexConfig,omitempty"`
-	OIDCConfig           *OIDCConfig `protobuf:"bytes,3,opt,name=oidcConfig" json:"oidcConfig,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	URL        string      `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	DexConfig  *DexConfig  `protobuf:"bytes,2,opt,name=dexConfig" json:"dexConfig,omitempty"`
+	OIDCConfig *OIDCConfig `protobuf:"bytes,3,opt,name=oidcConfig" json:"oidcConfig,omitempty"`
+	SSOEnabled bool        `protobuf:"varint,4,opt,name=ssoEnabled,proto3" json:"ssoEnabled,omitempty"`
+	UIOptions  *UIOptions  `protobuf:"bytes,5,opt,name=uiOptions" json:"uiOptions,omitempty"`
+	// reconciliationPause is the part of the reconciliation of all applications which is paused by the
+	// global maintenance mode ("sync" or "all"), if any
+	ReconciliationPause  string   `protobuf:"bytes,6,opt,name=reconciliationPause,proto3" json:"reconciliationPause,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Settings) Reset()         { *m = Settings{} }
 func (m *Settings) String() string { return proto.CompactTextString(m) }
 func (*Settings) ProtoMessage()    {}
 func (*Settings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_78f024359af61686, []int{1}
+	return fileDescriptor_settings_6cf6e9b5483c7a60, []int{1}
 }
 func (m *Settings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -134,6 +139,91 @@ func (m *Settings) GetOIDCConfig() *OIDCConfig {
 	return nil
 }
 
+func (m *Settings) GetSSOEnabled() bool {
+	if m != nil {
+		return m.SSOEnabled
+	}
+	return false
+}
+
+func (m *Settings) GetUIOptions() *UIOptions {
+	if m != nil {
+		return m.UIOptions
+	}
+	return nil
+}
+
+func (m *Settings) GetReconciliationPause() string {
+	if m != nil {
+		return m.ReconciliationPause
+	}
+	return ""
+}
+
+// UIOptions holds the options customizing the web UI
+type UIOptions struct {
+	CSSURL               string   `protobuf:"bytes,1,opt,name=cssURL,proto3" json:"cssURL,omitempty"`
+	BannerContent        string   `protobuf:"bytes,2,opt,name=bannerContent,proto3" json:"bannerContent,omitempty"`
+	BannerURL            string   `protobuf:"bytes,3,opt,name=bannerURL,proto3" json:"bannerURL,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UIOptions) Reset()         { *m = UIOptions{} }
+func (m *UIOptions) String() string { return proto.CompactTextString(m) }
+func (*UIOptions) ProtoMessage()    {}
+func (*UIOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_settings_6cf6e9b5483c7a60, []int{2}
+}
+func (m *UIOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UIOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UIOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *UIOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UIOptions.Merge(dst, src)
+}
+func (m *UIOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *UIOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_UIOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UIOptions proto.InternalMessageInfo
+
+func (m *UIOptions) GetCSSURL() string {
+	if m != nil {
+		return m.CSSURL
+	}
+	return ""
+}
+
+func (m *UIOptions) GetBannerContent() string {
+	if m != nil {
+		return m.BannerContent
+	}
+	return ""
+}
+
+func (m *UIOptions) GetBannerURL() string {
+	if m != nil {
+		return m.BannerURL
+	}
+	return ""
+}
+
 type DexConfig struct {
 	Connectors           []*Connector `protobuf:"bytes,1,rep,name=connectors" json:"connectors,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -145,7 +235,7 @@ func (m *DexConfig) Reset()         { *m = DexConfig{} }
 func (m *DexConfig) String() string { return proto.CompactTextString(m) }
 func (*DexConfig) ProtoMessage()    {}
 func (*DexConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_78f024359af61686, []int{2}
+	return fileDescriptor_settings_6cf6e9b5483c7a60, []int{3}
 }
 func (m *DexConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -193,7 +283,7 @@ func (m *Connector) Reset()         { *m = Connector{} }
 func (m *Connector) String() string { return proto.CompactTextString(m) }
 func (*Connector) ProtoMessage()    {}
 func (*Connector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_78f024359af61686, []int{3}
+	return fileDescriptor_settings_6cf6e9b5483c7a60, []int{4}
 }
 func (m *Connector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -249,7 +339,7 @@ func (m *OIDCConfig) Reset()         { *m = OIDCConfig{} }
 func (m *OIDCConfig) String() string { return proto.CompactTextString(m) }
 func (*OIDCConfig) ProtoMessage()    {}
 func (*OIDCConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_78f024359af61686, []int{4}
+	return fileDescriptor_settings_6cf6e9b5483c7a60, []int{5}
 }
 func (m *OIDCConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,6 +392,7 @@ func (m *OIDCConfig) GetClientID() string {
 func init() {
 	proto.RegisterType((*SettingsQuery)(nil), "cluster.SettingsQuery")
 	proto.RegisterType((*Settings)(nil), "cluster.Settings")
+	proto.RegisterType((*UIOptions)(nil), "cluster.UIOptions")
 	proto.RegisterType((*DexConfig)(nil), "cluster.DexConfig")
 	proto.RegisterType((*Connector)(nil), "cluster.Connector")
 	proto.RegisterType((*OIDCConfig)(nil), "cluster.OIDCConfig")
@@ -443,6 +534,71 @@ func (m *Settings) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n2
 	}
+	if m.SSOEnabled {
+		dAtA[i] = 0x20
+		i++
+		if m.SSOEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.UIOptions != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(m.UIOptions.Size()))
+		n3, err := m.UIOptions.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if len(m.ReconciliationPause) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.ReconciliationPause)))
+		i += copy(dAtA[i:], m.ReconciliationPause)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *UIOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UIOptions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.CSSURL) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.CSSURL)))
+		i += copy(dAtA[i:], m.CSSURL)
+	}
+	if len(m.BannerContent) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.BannerContent)))
+		i += copy(dAtA[i:], m.BannerContent)
+	}
+	if len(m.BannerURL) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.BannerURL)))
+		i += copy(dAtA[i:], m.BannerURL)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -587,6 +743,38 @@ func (m *Settings) Size() (n int) {
 		l = m.OIDCConfig.Size()
 		n += 1 + l + sovSettings(uint64(l))
 	}
+	if m.SSOEnabled {
+		n += 2
+	}
+	if m.UIOptions != nil {
+		l = m.UIOptions.Size()
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.ReconciliationPause)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UIOptions) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.CSSURL)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.BannerContent)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.BannerURL)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -834,6 +1022,226 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SSOEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SSOEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UIOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UIOptions == nil {
+				m.UIOptions = &UIOptions{}
+			}
+			if err := m.UIOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReconciliationPause", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReconciliationPause = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UIOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UIOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UIOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CSSURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CSSURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BannerContent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BannerContent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BannerURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BannerURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettings(dAtA[iNdEx:])
@@ -1291,34 +1699,42 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/settings/settings.proto", fileDescriptor_settings_78f024359af61686)
-}
-
-var fileDescriptor_settings_78f024359af61686 = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcd, 0x8a, 0xdb, 0x30,
-	0x18, 0x44, 0x71, 0x49, 0xe2, 0xaf, 0x3f, 0x69, 0xd5, 0x12, 0xdc, 0x50, 0x9c, 0xe0, 0x53, 0xa0,
-	0xd4, 0x6e, 0x93, 0x53, 0x4f, 0x05, 0x3b, 0x50, 0x52, 0x0a, 0xa5, 0x0a, 0xbd, 0x14, 0x7a, 0x70,
-	0x14, 0xd5, 0x55, 0x71, 0xa4, 0x20, 0xcb, 0xa1, 0xb9, 0xf6, 0x15, 0xf6, 0xba, 0x0f, 0xb4, 0xc7,
-	0x85, 0xbd, 0x87, 0xc5, 0xec, 0x83, 0x2c, 0x51, 0x6c, 0x27, 0xd9, 0xdd, 0xdb, 0x68, 0x46, 0x23,
-	0xbe, 0xd1, 0x37, 0xe0, 0x66, 0x4c, 0xad, 0x99, 0x0a, 0x32, 0xa6, 0x35, 0x17, 0x49, 0x56, 0x03,
-	0x7f, 0xa5, 0xa4, 0x96, 0xb8, 0x45, 0xd3, 0x3c, 0xd3, 0x4c, 0xf5, 0x5e, 0x25, 0x32, 0x91, 0x86,
-	0x0b, 0x76, 0x68, 0x2f, 0xf7, 0xde, 0x24, 0x52, 0x26, 0x29, 0x0b, 0xe2, 0x15, 0x0f, 0x62, 0x21,
-	0xa4, 0x8e, 0x35, 0x97, 0xa2, 0x34, 0x7b, 0x1d, 0x78, 0x3a, 0x2b, 0x9f, 0xfb, 0x9e, 0x33, 0xb5,
-	0xf1, 0xce, 0x11, 0xb4, 0x2b, 0x06, 0xbf, 0x06, 0x2b, 0x57, 0xa9, 0x83, 0x06, 0x68, 0x68, 0x87,
-	0xad, 0x62, 0xdb, 0xb7, 0x7e, 0x90, 0xaf, 0x64, 0xc7, 0xe1, 0xf7, 0x60, 0x2f, 0xd8, 0xbf, 0x48,
-	0x8a, 0xdf, 0x3c, 0x71, 0x1a, 0x03, 0x34, 0x7c, 0x3c, 0xc2, 0x7e, 0x39, 0x89, 0x3f, 0xa9, 0x14,
-	0x72, 0xb8, 0x84, 0x23, 0x00, 0xc9, 0x17, 0xb4, 0xb4, 0x58, 0xc6, 0xf2, 0xb2, 0xb6, 0x7c, 0x9b,
-	0x4e, 0xa2, 0xbd, 0x14, 0x3e, 0x2b, 0xb6, 0x7d, 0x38, 0x9c, 0xc9, 0x91, 0xcd, 0xfb, 0x04, 0x76,
-	0xfd, 0x38, 0x1e, 0x01, 0x50, 0x29, 0x04, 0xa3, 0x5a, 0xaa, 0xcc, 0x41, 0x03, 0xeb, 0x64, 0x88,
-	0xa8, 0x92, 0xc8, 0xd1, 0x2d, 0x6f, 0x0c, 0x76, 0x2d, 0x60, 0x0c, 0x8f, 0x44, 0xbc, 0x64, 0xfb,
-	0x80, 0xc4, 0xe0, 0x1d, 0xa7, 0x37, 0x2b, 0x66, 0x32, 0xd9, 0xc4, 0x60, 0x6f, 0x0e, 0x47, 0xf3,
-	0x3c, 0xe8, 0xea, 0x42, 0x93, 0x67, 0x59, 0xce, 0x54, 0xe9, 0x2b, 0x4f, 0x78, 0x08, 0x6d, 0x9a,
-	0x72, 0x26, 0xf4, 0x74, 0x62, 0x22, 0xdb, 0xe1, 0x93, 0x62, 0xdb, 0x6f, 0x47, 0x25, 0x47, 0x6a,
-	0x75, 0xf4, 0x0b, 0x3a, 0xd5, 0xbf, 0xcf, 0x98, 0x5a, 0x73, 0xca, 0xf0, 0x17, 0xb0, 0x3e, 0x33,
-	0x8d, 0xbb, 0x75, 0xa4, 0x93, 0x55, 0xf5, 0x5e, 0xdc, 0xe3, 0x3d, 0xe7, 0xff, 0xd5, 0xcd, 0x59,
-	0x03, 0xe3, 0xe7, 0x66, 0xdd, 0xeb, 0x0f, 0x75, 0x57, 0xc2, 0x8f, 0x17, 0x85, 0x8b, 0x2e, 0x0b,
-	0x17, 0x5d, 0x17, 0x2e, 0xfa, 0xf9, 0x36, 0xe1, 0xfa, 0x4f, 0x3e, 0xf7, 0xa9, 0x5c, 0x06, 0xb1,
-	0x32, 0xad, 0xf9, 0x6b, 0xc0, 0x3b, 0xba, 0x08, 0xee, 0xf4, 0x6d, 0xde, 0x34, 0x55, 0x19, 0xdf,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0xbf, 0xa2, 0x52, 0xc6, 0x89, 0x02, 0x00, 0x00,
+	proto.RegisterFile("server/settings/settings.proto", fileDescriptor_settings_6cf6e9b5483c7a60)
+}
+
+var fileDescriptor_settings_6cf6e9b5483c7a60 = []byte{
+	// 520 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0x66, 0xb3, 0x35, 0xcd, 0x3e, 0x8d, 0xd5, 0xa9, 0x94, 0x35, 0x48, 0x12, 0x16, 0x0f, 0x81,
+	0x62, 0xb6, 0xa6, 0x27, 0x4f, 0x85, 0x6c, 0x44, 0x22, 0x85, 0xe8, 0x2c, 0xb9, 0x08, 0x1e, 0x36,
+	0x93, 0x71, 0x1d, 0xd9, 0xce, 0x84, 0x99, 0xd9, 0x60, 0xaf, 0xfd, 0x0b, 0xfe, 0x20, 0xaf, 0x1e,
+	0x05, 0xef, 0x41, 0x16, 0x7f, 0x88, 0xec, 0x64, 0xb2, 0x49, 0xda, 0xde, 0xde, 0xfb, 0xbe, 0xf7,
+	0x3d, 0xde, 0xfb, 0x66, 0x1e, 0xb4, 0x15, 0x95, 0x4b, 0x2a, 0x43, 0x45, 0xb5, 0x66, 0x3c, 0x55,
+	0x55, 0xd0, 0x5f, 0x48, 0xa1, 0x05, 0x3a, 0x24, 0x59, 0xae, 0x34, 0x95, 0xad, 0x67, 0xa9, 0x48,
+	0x85, 0xc1, 0xc2, 0x32, 0x5a, 0xd3, 0xad, 0x17, 0xa9, 0x10, 0x69, 0x46, 0xc3, 0x64, 0xc1, 0xc2,
+	0x84, 0x73, 0xa1, 0x13, 0xcd, 0x04, 0xb7, 0xe2, 0xe0, 0x08, 0x9a, 0xb1, 0x6d, 0xf7, 0x31, 0xa7,
+	0xf2, 0x3a, 0xf8, 0x59, 0x83, 0xc6, 0x06, 0x41, 0xcf, 0xc1, 0xcd, 0x65, 0xe6, 0x3b, 0x5d, 0xa7,
+	0xe7, 0x0d, 0x0f, 0x8b, 0x55, 0xc7, 0x9d, 0xe2, 0x4b, 0x5c, 0x62, 0xe8, 0x0c, 0xbc, 0x39, 0xfd,
+	0x1e, 0x09, 0xfe, 0x85, 0xa5, 0x7e, 0xad, 0xeb, 0xf4, 0x1e, 0x0e, 0x50, 0xdf, 0x4e, 0xd2, 0x1f,
+	0x6d, 0x18, 0xbc, 0x2d, 0x42, 0x11, 0x80, 0x60, 0x73, 0x62, 0x25, 0xae, 0x91, 0x1c, 0x57, 0x92,
+	0xc9, 0x78, 0x14, 0xad, 0xa9, 0xe1, 0xe3, 0x62, 0xd5, 0x81, 0x6d, 0x8e, 0x77, 0x64, 0xa8, 0x0f,
+	0xa0, 0x94, 0x78, 0xcb, 0x93, 0x59, 0x46, 0xe7, 0xfe, 0x41, 0xd7, 0xe9, 0x35, 0xd6, 0xf5, 0x71,
+	0x3c, 0xb1, 0x28, 0xde, 0xa9, 0x40, 0x17, 0xe0, 0xe5, 0x6c, 0xb2, 0x30, 0x2b, 0xfb, 0x0f, 0x6e,
+	0x8d, 0x39, 0x1d, 0x5b, 0x66, 0xd8, 0x2c, 0x56, 0x1d, 0xaf, 0x4a, 0xf1, 0x56, 0x83, 0xce, 0xe0,
+	0x58, 0x52, 0x22, 0x38, 0x61, 0x19, 0x33, 0xce, 0x7d, 0x48, 0x72, 0x45, 0xfd, 0x7a, 0x69, 0x09,
+	0xbe, 0x8f, 0x0a, 0x6e, 0x1c, 0xd8, 0xb6, 0x42, 0x01, 0xd4, 0x89, 0x52, 0x53, 0x7c, 0x69, 0x5d,
+	0x84, 0x62, 0xd5, 0xa9, 0x47, 0x71, 0x5c, 0x1a, 0x69, 0x19, 0xf4, 0x12, 0x9a, 0xb3, 0x84, 0x73,
+	0x2a, 0x23, 0xc1, 0x35, 0xe5, 0xda, 0xf8, 0xe9, 0xe1, 0x7d, 0x10, 0x9d, 0x82, 0xb7, 0x06, 0xca,
+	0x66, 0xae, 0x69, 0x66, 0xc6, 0x1e, 0x6e, 0x40, 0xbc, 0xe5, 0x83, 0x0b, 0xf0, 0xaa, 0x47, 0x40,
+	0x03, 0x00, 0x22, 0x38, 0xa7, 0x44, 0x0b, 0xa9, 0x7c, 0xa7, 0xeb, 0xee, 0xb9, 0x10, 0x6d, 0x28,
+	0xbc, 0x53, 0x15, 0x9c, 0x83, 0x57, 0x11, 0x08, 0xc1, 0x01, 0x4f, 0xae, 0xe8, 0x7a, 0x05, 0x6c,
+	0xe2, 0x12, 0xd3, 0xd7, 0x0b, 0x6a, 0x67, 0x35, 0x71, 0x30, 0x83, 0x9d, 0x77, 0xbb, 0x57, 0x75,
+	0x02, 0x75, 0xa6, 0x54, 0x4e, 0xa5, 0xd5, 0xd9, 0x0c, 0xf5, 0xa0, 0x41, 0x32, 0x46, 0xb9, 0x1e,
+	0x8f, 0xec, 0x6e, 0x8f, 0x8a, 0x55, 0xa7, 0x11, 0x59, 0x0c, 0x57, 0xec, 0xe0, 0x33, 0x1c, 0x6d,
+	0xfe, 0x67, 0x4c, 0xe5, 0x92, 0x11, 0x8a, 0xde, 0x83, 0xfb, 0x8e, 0x6a, 0x74, 0x52, 0xad, 0xb4,
+	0xf7, 0xa5, 0x5b, 0x4f, 0xef, 0xe0, 0x81, 0x7f, 0xf3, 0xe7, 0xdf, 0x8f, 0x1a, 0x42, 0x4f, 0xcc,
+	0x59, 0x2c, 0x5f, 0x57, 0x37, 0x35, 0x7c, 0xf3, 0xab, 0x68, 0x3b, 0xbf, 0x8b, 0xb6, 0xf3, 0xb7,
+	0x68, 0x3b, 0x9f, 0x4e, 0x53, 0xa6, 0xbf, 0xe6, 0xb3, 0x3e, 0x11, 0x57, 0x61, 0x22, 0xcd, 0x75,
+	0x7d, 0x33, 0xc1, 0x2b, 0x32, 0x0f, 0x6f, 0xdd, 0xe5, 0xac, 0x6e, 0x4e, 0xea, 0xfc, 0xff, 0x00,
+	0xf2, 0x42, 0x2b, 0x68, 0xb1, 0x03, 0x00, 0x00,
 }
@@ -0,0 +1,62 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+const testNamespace = "default"
+
+func newTestServer(data map[string]string) *Server {
+	cm := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: testNamespace},
+		Data:       data,
+	}
+	secret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDSecretName, Namespace: testNamespace},
+		Data: map[string][]byte{
+			"admin.password":    []byte("test"),
+			"server.secretkey":  []byte("test"),
+			"dex.github.secret": []byte("test"),
+		},
+	}
+	return NewServer(settings.NewSettingsManager(fake.NewSimpleClientset(cm, secret), testNamespace))
+}
+
+func TestGetSettings(t *testing.T) {
+	s := newTestServer(map[string]string{
+		"url": "https://argocd.example.com",
+		"dex.config": `connectors:
+- type: github
+  name: GitHub
+  config:
+    clientID: test
+    clientSecret: $dex.github.secret
+`,
+		"ui.bannercontent": "Maintenance on Saturday",
+		"ui.bannerurl":     "https://status.example.com",
+	})
+	set, err := s.Get(context.Background(), &SettingsQuery{})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://argocd.example.com", set.URL)
+	assert.True(t, set.SSOEnabled)
+	assert.Equal(t, []*Connector{{Name: "GitHub", Type: "github"}}, set.DexConfig.Connectors)
+	assert.Equal(t, &UIOptions{BannerContent: "Maintenance on Saturday", BannerURL: "https://status.example.com"}, set.UIOptions)
+}
+
+func TestGetSettingsDefaults(t *testing.T) {
+	s := newTestServer(nil)
+	set, err := s.Get(context.Background(), &SettingsQuery{})
+	assert.NoError(t, err)
+	assert.False(t, set.SSOEnabled)
+	assert.Nil(t, set.DexConfig)
+	assert.Nil(t, set.UIOptions)
+}
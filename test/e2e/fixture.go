@@ -32,6 +32,7 @@ import (
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/reposerver"
+	"github.com/argoproj/argo-cd/reposerver/metrics"
 	"github.com/argoproj/argo-cd/reposerver/repository"
 	"github.com/argoproj/argo-cd/server"
 	"github.com/argoproj/argo-cd/server/application"
@@ -126,7 +127,7 @@ func (f *Fixture) setup() error {
 	}
 
 	memCache := cache.NewInMemoryCache(repository.DefaultRepoCacheExpiration)
-	repoSrv, err := reposerver.NewServer(&FakeGitClientFactory{}, memCache, func(config *tls.Config) {})
+	repoSrv, err := reposerver.NewServer(&FakeGitClientFactory{}, memCache, metrics.NewMetricsServer(), repository.NewCheckouts(repository.CheckoutOptions{RootDir: os.TempDir()}), func(config *tls.Config) {})
 	if err != nil {
 		return err
 	}
@@ -199,7 +200,7 @@ func (f *Fixture) ensureClusterRegistered() error {
 	errors.CheckError(err)
 	clst := commands.NewCluster(f.Config.Host, conf, managerBearerToken, nil)
 	clstCreateReq := cluster.ClusterCreateRequest{Cluster: clst}
-	_, err = cluster.NewServer(f.DB, f.Enforcer).Create(context.Background(), &clstCreateReq)
+	_, err = cluster.NewServer(f.Namespace, f.AppClient, f.DB, f.Enforcer).Create(context.Background(), &clstCreateReq)
 	return err
 }
 
@@ -313,7 +314,8 @@ func (f *Fixture) createController() *controller.ApplicationController {
 		f.KubeClient,
 		f.AppClient,
 		reposerver.NewRepositoryServerClientset(f.RepoServerAddress),
-		10*time.Second)
+		10*time.Second,
+		controller.ApplicationControllerOpts{ReconcileTimeout: time.Minute})
 }
 
 func (f *Fixture) NewApiClientset() (argocdclient.Client, error) {
@@ -393,12 +395,12 @@ func (c *FakeGitClient) Root() string {
 	return c.root
 }
 
-func (c *FakeGitClient) Fetch() error {
+func (c *FakeGitClient) Fetch(ctx context.Context) error {
 	// do nothing
 	return nil
 }
 
-func (c *FakeGitClient) Checkout(revision string) error {
+func (c *FakeGitClient) Checkout(ctx context.Context, revision string) error {
 	// do nothing
 	return nil
 }
@@ -408,11 +410,20 @@ func (c *FakeGitClient) Reset() error {
 	return nil
 }
 
-func (c *FakeGitClient) LsRemote(s string) (string, error) {
+func (c *FakeGitClient) LsRemote(ctx context.Context, s string) (string, error) {
 	return "abcdef123456890", nil
 }
 
-func (c *FakeGitClient) LsFiles(s string) ([]string, error) {
+func (c *FakeGitClient) LsRefs(ctx context.Context) (*git.Refs, error) {
+	return &git.Refs{Branches: []string{"master"}, Tags: []string{}}, nil
+}
+
+func (c *FakeGitClient) ChangedFiles(ctx context.Context, revision string, targetRevision string) ([]string, error) {
+	// the test repo has a single revision
+	return []string{}, nil
+}
+
+func (c *FakeGitClient) LsFiles(ctx context.Context, s string) ([]string, error) {
 	matches, err := filepath.Glob(path.Join(c.root, s))
 	if err != nil {
 		return nil, err
@@ -423,6 +434,10 @@ func (c *FakeGitClient) LsFiles(s string) ([]string, error) {
 	return matches, nil
 }
 
-func (c *FakeGitClient) CommitSHA() (string, error) {
+func (c *FakeGitClient) CommitSHA(ctx context.Context) (string, error) {
 	return "abcdef123456890", nil
 }
+
+func (c *FakeGitClient) RevisionMetadata(ctx context.Context, revision string) (*git.RevisionMetadata, error) {
+	return &git.RevisionMetadata{Author: "argo-cd", Date: time.Now(), Message: "fake commit"}, nil
+}
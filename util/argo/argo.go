@@ -6,9 +6,11 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/robfig/cron"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -17,7 +19,9 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/util/retry"
 
 	"github.com/argoproj/argo-cd/common"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
@@ -28,7 +32,9 @@ import (
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/grpc"
 	"github.com/argoproj/argo-cd/util/ksonnet"
+	"github.com/argoproj/argo-cd/util/kube"
 )
 
 const (
@@ -64,6 +70,42 @@ func FilterByProjects(apps []argoappv1.Application, projects []string) []argoapp
 
 }
 
+// FilterByRepoURL returns applications whose source is the specified repository
+func FilterByRepoURL(apps []argoappv1.Application, repoURL string) []argoappv1.Application {
+	items := make([]argoappv1.Application, 0)
+	repoURL = git.NormalizeGitURL(repoURL)
+	for _, a := range apps {
+		if git.NormalizeGitURL(a.Spec.Source.RepoURL) == repoURL {
+			items = append(items, a)
+		}
+	}
+	return items
+}
+
+// FilterByDestinationCluster returns applications which are deployed to the specified cluster, whether
+// their destination references the cluster by server or by name
+func FilterByDestinationCluster(apps []argoappv1.Application, cluster *argoappv1.Cluster) []argoappv1.Application {
+	items := make([]argoappv1.Application, 0)
+	for _, a := range apps {
+		dest := a.Spec.Destination
+		if (dest.Server != "" && dest.Server == cluster.Server) || (dest.Name != "" && dest.Name == cluster.Name) {
+			items = append(items, a)
+		}
+	}
+	return items
+}
+
+// ReferencedByAppsError returns the error rejecting the deletion of a resource which is still referenced
+// by the given applications, listing their names
+func ReferencedByAppsError(resource string, apps []argoappv1.Application) error {
+	names := make([]string, len(apps))
+	for i := range apps {
+		names[i] = apps[i].Name
+	}
+	sort.Strings(names)
+	return status.Errorf(codes.FailedPrecondition, "%s is referenced by %d applications (%s): use force to delete it anyway", resource, len(apps), strings.Join(names, ", "))
+}
+
 // ParamToMap converts a ComponentParameter list to a map for easy filtering
 func ParamToMap(params []argoappv1.ComponentParameter) map[string]map[string]bool {
 	validAppSet := make(map[string]map[string]bool)
@@ -86,13 +128,15 @@ func CheckValidParam(appMap map[string]map[string]bool, newParam argoappv1.Compo
 	return false
 }
 
-// RefreshApp updates the refresh annotation of an application to coerce the controller to process it
-func RefreshApp(appIf v1alpha1.ApplicationInterface, name string) (*argoappv1.Application, error) {
+// RefreshApp updates the refresh annotations of an application to coerce the controller to process it
+// using the given refresh type
+func RefreshApp(appIf v1alpha1.ApplicationInterface, name string, refreshType argoappv1.RefreshType) (*argoappv1.Application, error) {
 	refreshString := time.Now().UTC().Format(time.RFC3339)
 	metadata := map[string]interface{}{
 		"metadata": map[string]interface{}{
 			"annotations": map[string]string{
-				common.AnnotationKeyRefresh: refreshString,
+				common.AnnotationKeyRefresh:     refreshString,
+				common.AnnotationKeyRefreshType: string(refreshType),
 			},
 		},
 		"status": map[string]interface{}{
@@ -113,7 +157,7 @@ func RefreshApp(appIf v1alpha1.ApplicationInterface, name string) (*argoappv1.Ap
 				return nil, err
 			}
 		} else {
-			log.Infof("Refreshed app '%s' for controller reprocessing (%s)", name, refreshString)
+			log.Infof("Refreshed app '%s' for controller reprocessing (%s, %s)", name, refreshType, refreshString)
 			return app, nil
 		}
 		time.Sleep(100 * time.Millisecond)
@@ -177,16 +221,52 @@ func WaitForRefresh(appIf v1alpha1.ApplicationInterface, name string, timeout *t
 	}
 }
 
-// GetSpecErrors returns list of conditions which indicates that app spec is invalid. Following is checked:
+// GetSpecErrors returns list of conditions which indicates that app spec is invalid. The conditions are
+// the messages of the details returned by GetSpecErrorDetails
+func GetSpecErrors(
+	ctx context.Context, spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject, repoClientset reposerver.Clientset, db db.ArgoDB) ([]argoappv1.ApplicationCondition, error) {
+
+	details, err := GetSpecErrorDetails(ctx, spec, proj, repoClientset, db)
+	if err != nil {
+		return nil, err
+	}
+	return specConditions(details), nil
+}
+
+// specConditions returns the conditions of the reasons an app spec is invalid
+func specConditions(details []grpc.ErrorDetail) []argoappv1.ApplicationCondition {
+	conditions := make([]argoappv1.ApplicationCondition, 0)
+	for _, detail := range details {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: detail.Message,
+		})
+	}
+	return conditions
+}
+
+// InvalidSpecError returns the error of an invalid app spec, whose status details hold the reasons the
+// spec is invalid
+func InvalidSpecError(details []grpc.ErrorDetail) error {
+	return grpc.NewError(codes.InvalidArgument, details, "application spec is invalid: %s", FormatAppConditions(specConditions(details)))
+}
+
+// GetSpecErrorDetails returns the reasons the app spec is invalid, if any. Following is checked:
 // * the git repository is accessible
 // * the git path contains a valid app.yaml
 // * the specified environment exists
 // * the referenced cluster has been added to Argo CD
 // * the app source repo and destination namespace/cluster are permitted in app project
-func GetSpecErrors(
-	ctx context.Context, spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject, repoClientset reposerver.Clientset, db db.ArgoDB) ([]argoappv1.ApplicationCondition, error) {
-
-	conditions := make([]argoappv1.ApplicationCondition, 0)
+func GetSpecErrorDetails(
+	ctx context.Context, spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject, repoClientset reposerver.Clientset, db db.ArgoDB) ([]grpc.ErrorDetail, error) {
+
+	var details []grpc.ErrorDetail
+	// manifestErrors adds the conditions of manifests which cannot be generated to the details
+	manifestErrors := func(conditions []argoappv1.ApplicationCondition) {
+		for _, condition := range conditions {
+			details = append(details, grpc.ErrorDetail{Reason: grpc.ErrorReasonManifestError, Subject: spec.Source.Path, Message: condition.Message})
+		}
+	}
 
 	// Test the repo
 	conn, repoClient, err := repoClientset.NewRepositoryClient()
@@ -202,10 +282,11 @@ func GetSpecErrors(
 			// The repo has not been added to Argo CD so we do not have credentials to access it.
 			// We support the mode where apps can be created from public repositories. Test the
 			// repo to make sure it is publicly accessible
-			err = git.TestRepo(spec.Source.RepoURL, "", "", "")
+			err = git.TestRepo(ctx, spec.Source.RepoURL, "", "", "")
 			if err != nil {
-				conditions = append(conditions, argoappv1.ApplicationCondition{
-					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				details = append(details, grpc.ErrorDetail{
+					Reason:  grpc.ErrorReasonRepoAuthFailed,
+					Subject: spec.Source.RepoURL,
 					Message: fmt.Sprintf("No credentials available for source repository and repository is not publicly accessible: %v", err),
 				})
 			} else {
@@ -221,8 +302,9 @@ func GetSpecErrors(
 	if repoAccessable {
 		appSourceType, err := queryAppSourceType(ctx, spec, repoRes, repoClient)
 		if err != nil {
-			conditions = append(conditions, argoappv1.ApplicationCondition{
-				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			details = append(details, grpc.ErrorDetail{
+				Reason:  grpc.ErrorReasonManifestError,
+				Subject: spec.Source.Path,
 				Message: fmt.Sprintf("Unable to determine app source type: %v", err),
 			})
 		} else {
@@ -230,22 +312,16 @@ func GetSpecErrors(
 			case repository.AppSourceKsonnet:
 				err := verifyAppYAML(ctx, repoRes, spec, repoClient)
 				if err != nil {
-					conditions = append(conditions, argoappv1.ApplicationCondition{
-						Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					details = append(details, grpc.ErrorDetail{
+						Reason:  grpc.ErrorReasonManifestError,
+						Subject: spec.Source.Path,
 						Message: err.Error(),
 					})
-
 				}
 			case repository.AppSourceHelm:
-				helmConditions := verifyHelmChart(ctx, repoRes, spec, repoClient)
-				if len(helmConditions) > 0 {
-					conditions = append(conditions, helmConditions...)
-				}
+				manifestErrors(verifyHelmChart(ctx, repoRes, spec, repoClient))
 			case repository.AppSourceDirectory, repository.AppSourceKustomize:
-				maniDirConditions := verifyGenerateManifests(ctx, repoRes, spec, repoClient)
-				if len(maniDirConditions) > 0 {
-					conditions = append(conditions, maniDirConditions...)
-				}
+				manifestErrors(verifyGenerateManifests(ctx, repoRes, spec, repoClient))
 			}
 
 		}
@@ -256,33 +332,125 @@ func GetSpecErrors(
 	}
 
 	if !proj.IsSourcePermitted(spec.Source) {
-		conditions = append(conditions, argoappv1.ApplicationCondition{
-			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+		details = append(details, grpc.ErrorDetail{
+			Reason:  grpc.ErrorReasonProjectViolation,
+			Subject: spec.Project,
 			Message: fmt.Sprintf("application source %v is not permitted in project '%s'", spec.Source, spec.Project),
 		})
 	}
 
-	if spec.Destination.Server != "" && spec.Destination.Namespace != "" {
-		if !proj.IsDestinationPermitted(spec.Destination) {
-			conditions = append(conditions, argoappv1.ApplicationCondition{
-				Type:    argoappv1.ApplicationConditionInvalidSpecError,
-				Message: fmt.Sprintf("application destination %v is not permitted in project '%s'", spec.Destination, spec.Project),
+	// applications without a sync policy inherit the default sync policy of their project
+	syncPolicy := proj.GetSyncPolicy(spec)
+	if syncPolicy != nil && syncPolicy.Automated != nil && syncPolicy.Automated.Schedule != "" {
+		_, err := cron.ParseStandard(syncPolicy.Automated.Schedule)
+		if err != nil {
+			details = append(details, grpc.ErrorDetail{
+				Reason:  grpc.ErrorReasonInvalidSpec,
+				Message: fmt.Sprintf("invalid automated sync schedule '%s': %v", syncPolicy.Automated.Schedule, err),
+			})
+		}
+	}
+
+	if spec.ResourceMetadata != nil {
+		for _, msg := range validateResourceMetadata(spec.ResourceMetadata) {
+			details = append(details, grpc.ErrorDetail{
+				Reason:  grpc.ErrorReasonInvalidSpec,
+				Message: msg,
+			})
+		}
+	}
+
+	if syncPolicy != nil && syncPolicy.ManagedNamespaceMetadata != nil {
+		for _, msg := range validateResourceMetadata(syncPolicy.ManagedNamespaceMetadata) {
+			details = append(details, grpc.ErrorDetail{
+				Reason:  grpc.ErrorReasonInvalidSpec,
+				Message: fmt.Sprintf("invalid managed namespace metadata: %s", msg),
+			})
+		}
+	}
+
+	// Resolve a cluster referenced by name. The server is not persisted in the spec, so that the app
+	// follows the cluster if its endpoint changes
+	dest := spec.Destination
+	if dest.Name != "" {
+		cluster, err := db.GetClusterByName(ctx, dest.Name)
+		if err != nil {
+			if errStatus, ok := status.FromError(err); ok && errStatus.Code() == codes.NotFound {
+				details = append(details, grpc.ErrorDetail{
+					Reason:  grpc.ErrorReasonInvalidSpec,
+					Subject: dest.Name,
+					Message: fmt.Sprintf("cluster with name '%s' has not been configured", dest.Name),
+				})
+			} else {
+				return nil, err
+			}
+		} else {
+			dest.Server = cluster.Server
+		}
+	}
+
+	if dest.Server != "" && dest.Namespace != "" {
+		if !proj.IsDestinationPermitted(dest) {
+			details = append(details, grpc.ErrorDetail{
+				Reason:  grpc.ErrorReasonProjectViolation,
+				Subject: spec.Project,
+				Message: fmt.Sprintf("application destination %v is not permitted in project '%s'", dest, spec.Project),
 			})
 		}
 		// Ensure the k8s cluster the app is referencing, is configured in Argo CD
-		_, err = db.GetCluster(ctx, spec.Destination.Server)
+		cluster, err := db.GetCluster(ctx, dest.Server)
 		if err != nil {
 			if errStatus, ok := status.FromError(err); ok && errStatus.Code() == codes.NotFound {
-				conditions = append(conditions, argoappv1.ApplicationCondition{
-					Type:    argoappv1.ApplicationConditionInvalidSpecError,
-					Message: fmt.Sprintf("cluster '%s' has not been configured", spec.Destination.Server),
+				details = append(details, grpc.ErrorDetail{
+					Reason:  grpc.ErrorReasonInvalidSpec,
+					Subject: dest.Server,
+					Message: fmt.Sprintf("cluster '%s' has not been configured", dest.Server),
 				})
 			} else {
 				return nil, err
 			}
+		} else if !cluster.IsNamespacePermitted(dest.Namespace) {
+			details = append(details, grpc.ErrorDetail{
+				Reason:  grpc.ErrorReasonInvalidSpec,
+				Subject: dest.Server,
+				Message: fmt.Sprintf("namespace '%s' is not managed by Argo CD in cluster '%s'", dest.Namespace, dest.Server),
+			})
+		}
+	}
+	return details, nil
+}
+
+// GetDestinationCluster returns the cluster an application destination refers to, either by name or by server
+func GetDestinationCluster(ctx context.Context, dest argoappv1.ApplicationDestination, db db.ArgoDB) (*argoappv1.Cluster, error) {
+	if dest.Name != "" {
+		return db.GetClusterByName(ctx, dest.Name)
+	}
+	return db.GetCluster(ctx, dest.Server)
+}
+
+// validateResourceMetadata returns the reasons the labels and annotations stamped onto the resources of
+// an application are invalid
+func validateResourceMetadata(metadata *argoappv1.ResourceMetadata) []string {
+	var msgs []string
+	for key, val := range metadata.Labels {
+		if key == common.LabelApplicationName {
+			msgs = append(msgs, fmt.Sprintf("resource label '%s' is reserved", key))
+			continue
+		}
+		for _, msg := range validation.IsQualifiedName(key) {
+			msgs = append(msgs, fmt.Sprintf("invalid resource label key '%s': %s", key, msg))
+		}
+		for _, msg := range validation.IsValidLabelValue(val) {
+			msgs = append(msgs, fmt.Sprintf("invalid value of resource label '%s': %s", key, msg))
+		}
+	}
+	for key := range metadata.Annotations {
+		for _, msg := range validation.IsQualifiedName(strings.ToLower(key)) {
+			msgs = append(msgs, fmt.Sprintf("invalid resource annotation key '%s': %s", key, msg))
 		}
 	}
-	return conditions, nil
+	sort.Strings(msgs)
+	return msgs
 }
 
 // GetAppProject returns a project from an application
@@ -293,9 +461,46 @@ func GetAppProject(spec *argoappv1.ApplicationSpec, appclientset appclientset.In
 	return appclientset.ArgoprojV1alpha1().AppProjects(ns).Get(spec.Project, metav1.GetOptions{})
 }
 
+// TrackingLabelValue returns the value of the label tracking the resources of the application. The
+// applications outside of the namespace Argo CD is installed in are qualified with their namespace, so
+// that an application cannot claim the resources of an application of the same name in another namespace
+func TrackingLabelValue(app *argoappv1.Application, installNamespace string) string {
+	if app.Namespace == "" || app.Namespace == installNamespace {
+		return kube.TruncateLabelValue(app.Name)
+	}
+	// names and namespaces cannot contain underscores, so the qualified value is not ambiguous
+	return kube.TruncateLabelValue(app.Namespace + "_" + app.Name)
+}
+
+// GetAppManifestGeneratePaths returns the paths, relative to the root of the repository, whose changes
+// require the manifests of the application to be regenerated. Returns nil if the application does not
+// have the manifest generate paths annotation, in which case the manifests are regenerated on every change
+func GetAppManifestGeneratePaths(app *argoappv1.Application) []string {
+	value, ok := app.Annotations[common.AnnotationKeyManifestGeneratePaths]
+	if !ok {
+		return nil
+	}
+	paths := []string{path.Clean(app.Spec.Source.Path)}
+	for _, item := range strings.Split(value, ";") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if strings.HasPrefix(item, "/") {
+			paths = append(paths, path.Clean(strings.TrimPrefix(item, "/")))
+		} else {
+			paths = append(paths, path.Join(app.Spec.Source.Path, item))
+		}
+	}
+	return paths
+}
+
 // queryAppSourceType queries repo server for yaml files in a directory, and determines its
 // application source type based on the files in the directory.
 func queryAppSourceType(ctx context.Context, spec *argoappv1.ApplicationSpec, repoRes *argoappv1.Repository, repoClient repository.RepositoryServiceClient) (repository.AppSourceType, error) {
+	if spec.Source.Directory != nil {
+		return repository.AppSourceDirectory, nil
+	}
 	req := repository.ListDirRequest{
 		Repo: &argoappv1.Repository{
 			Repo: spec.Source.RepoURL,
@@ -360,7 +565,7 @@ func verifyAppYAML(ctx context.Context, repoRes *argoappv1.Repository, spec *arg
 	}
 
 	// If server and namespace are not supplied, pull it from the app.yaml
-	if spec.Destination.Server == "" {
+	if spec.Destination.Server == "" && spec.Destination.Name == "" {
 		spec.Destination.Server = dest.Server
 	}
 	if spec.Destination.Namespace == "" {
@@ -373,7 +578,7 @@ func verifyAppYAML(ctx context.Context, repoRes *argoappv1.Repository, spec *arg
 // verifyHelmChart verifies a helm chart is functional
 func verifyHelmChart(ctx context.Context, repoRes *argoappv1.Repository, spec *argoappv1.ApplicationSpec, repoClient repository.RepositoryServiceClient) []argoappv1.ApplicationCondition {
 	var conditions []argoappv1.ApplicationCondition
-	if spec.Destination.Server == "" || spec.Destination.Namespace == "" {
+	if !spec.Destination.HasCluster() || spec.Destination.Namespace == "" {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
 			Message: errDestinationMissing,
@@ -404,7 +609,7 @@ func verifyHelmChart(ctx context.Context, repoRes *argoappv1.Repository, spec *a
 // verifyGenerateManifests verifies a repo path can generate manifests
 func verifyGenerateManifests(ctx context.Context, repoRes *argoappv1.Repository, spec *argoappv1.ApplicationSpec, repoClient repository.RepositoryServiceClient) []argoappv1.ApplicationCondition {
 	var conditions []argoappv1.ApplicationCondition
-	if spec.Destination.Server == "" || spec.Destination.Namespace == "" {
+	if !spec.Destination.HasCluster() || spec.Destination.Namespace == "" {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
 			Message: errDestinationMissing,
@@ -414,9 +619,12 @@ func verifyGenerateManifests(ctx context.Context, repoRes *argoappv1.Repository,
 		Repo: &argoappv1.Repository{
 			Repo: spec.Source.RepoURL,
 		},
-		Revision:  spec.Source.TargetRevision,
-		Path:      spec.Source.Path,
-		Namespace: spec.Destination.Namespace,
+		Revision:         spec.Source.TargetRevision,
+		Path:             spec.Source.Path,
+		Namespace:        spec.Destination.Namespace,
+		Directory:        spec.Source.Directory,
+		Override:         spec.Source.Override,
+		ResourceMetadata: spec.ResourceMetadata,
 	}
 	if repoRes != nil {
 		req.Repo.Username = repoRes.Username
@@ -438,32 +646,151 @@ func verifyGenerateManifests(ctx context.Context, repoRes *argoappv1.Repository,
 	return conditions
 }
 
-// SetAppOperation updates an application with the specified operation, retrying conflict errors
+// SetAppOperation updates an application with the specified operation, retrying conflict errors. The
+// operation is rejected if the operation lock of the application is held, either by an operation in
+// progress or by queued operations
 func SetAppOperation(ctx context.Context, appIf v1alpha1.ApplicationInterface, audit *AuditLogger, appName string, op *argoappv1.Operation) (*argoappv1.Application, error) {
+	a, _, err := setAppOperation(appIf, appName, op, false)
+	return a, err
+}
+
+// QueueAppOperation updates an application with the specified operation, or queues the operation if
+// the operation lock of the application is held. Returns whether the operation was queued
+func QueueAppOperation(ctx context.Context, appIf v1alpha1.ApplicationInterface, audit *AuditLogger, appName string, op *argoappv1.Operation) (*argoappv1.Application, bool, error) {
+	return setAppOperation(appIf, appName, op, true)
+}
+
+// StartQueuedAppOperation starts the first queued operation of an application, if no operation is in
+// progress. Returns the started operation, or nil if none was started
+func StartQueuedAppOperation(appIf v1alpha1.ApplicationInterface, appName string) (*argoappv1.Operation, error) {
 	for {
 		a, err := appIf.Get(appName, metav1.GetOptions{})
 		if err != nil {
 			return nil, err
 		}
-		if a.Operation != nil {
-			return nil, status.Errorf(codes.FailedPrecondition, "another operation is already in progress")
+		if a.Operation != nil || len(a.Status.QueuedOperations) == 0 {
+			return nil, nil
 		}
-		a.Operation = op
-		a.Status.OperationState = nil
-		a, err = appIf.Update(a)
-		if op.Sync == nil {
-			return nil, status.Errorf(codes.InvalidArgument, "Operation unspecified")
+		op := a.Status.QueuedOperations[0]
+		a.Status.QueuedOperations = a.Status.QueuedOperations[1:]
+		acquireOperationLock(a, &op)
+		_, err = appIf.Update(a)
+		if err == nil {
+			return &op, nil
+		}
+		if !apierr.IsConflict(err) {
+			return nil, err
+		}
+		log.Warnf("Failed to start queued operation for app '%s' due to update conflict. Retrying again...", appName)
+	}
+}
+
+// DisableAutomatedSync sets a sync policy without automated sync in the spec of an application, so that the
+// default sync policy of its project does not apply either, retrying conflict errors. Applications
+// inheriting the sync policy of the project keep its other settings, such as its sync options
+func DisableAutomatedSync(appIf v1alpha1.ApplicationInterface, appName string, proj *argoappv1.AppProject) (*argoappv1.Application, error) {
+	for {
+		a, err := appIf.Get(appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if a.Spec.SyncPolicy == nil {
+			a.Spec.SyncPolicy = proj.Spec.SyncPolicy.DeepCopy()
+		}
+		if a.Spec.SyncPolicy == nil {
+			a.Spec.SyncPolicy = &argoappv1.SyncPolicy{}
 		}
+		a.Spec.SyncPolicy.Automated = nil
+		a, err = appIf.Update(a)
 		if err == nil {
 			return a, nil
 		}
 		if !apierr.IsConflict(err) {
 			return nil, err
 		}
+		log.Warnf("Failed to disable auto-sync of app '%s' due to update conflict. Retrying again...", appName)
+	}
+}
+
+// SetSyncPolicy sets the sync policy in the spec of an application, retrying conflict errors. A nil
+// policy makes the application inherit the default sync policy of its project
+func SetSyncPolicy(appIf v1alpha1.ApplicationInterface, appName string, policy *argoappv1.SyncPolicy) (*argoappv1.Application, error) {
+	var updated *argoappv1.Application
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		a, err := appIf.Get(appName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		a.Spec.SyncPolicy = policy
+		updated, err = appIf.Update(a)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// IsOperationLockedError returns whether an error was returned because the operation lock of an
+// application is held
+func IsOperationLockedError(err error) bool {
+	return status.Code(err) == codes.FailedPrecondition
+}
+
+func setAppOperation(appIf v1alpha1.ApplicationInterface, appName string, op *argoappv1.Operation, queue bool) (*argoappv1.Application, bool, error) {
+	if op.Sync == nil {
+		return nil, false, status.Errorf(codes.InvalidArgument, "Operation unspecified")
+	}
+	for {
+		a, err := appIf.Get(appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, false, err
+		}
+		locked := a.Operation != nil || len(a.Status.QueuedOperations) > 0
+		if locked && !queue {
+			if lock := a.Status.OperationLock; lock != nil {
+				return nil, false, status.Errorf(codes.FailedPrecondition, "another operation is already in progress (initiated by %s at %s)", lock.Holder, lock.AcquiredAt.Format(time.RFC3339))
+			}
+			return nil, false, status.Errorf(codes.FailedPrecondition, "another operation is already in progress")
+		}
+		if locked {
+			a.Status.QueuedOperations = append(a.Status.QueuedOperations, *op)
+		} else {
+			acquireOperationLock(a, op)
+		}
+		a, err = appIf.Update(a)
+		if err == nil {
+			return a, locked, nil
+		}
+		if !apierr.IsConflict(err) {
+			return nil, false, err
+		}
 		log.Warnf("Failed to set operation for app '%s' due to update conflict. Retrying again...", appName)
 	}
 }
 
+// acquireOperationLock sets the operation of an application, and records the initiator of the
+// operation as the holder of the operation lock
+func acquireOperationLock(a *argoappv1.Application, op *argoappv1.Operation) {
+	a.Operation = op
+	a.Status.OperationState = nil
+	a.Status.OperationLock = &argoappv1.OperationLock{
+		Holder:     operationInitiator(op),
+		AcquiredAt: metav1.Now(),
+	}
+}
+
+// operationInitiator returns a description of the initiator of an operation
+func operationInitiator(op *argoappv1.Operation) string {
+	if op.InitiatedBy.Automated {
+		return "automated sync policy"
+	}
+	if op.InitiatedBy.Username != "" {
+		return op.InitiatedBy.Username
+	}
+	return "unknown"
+}
+
 // ContainsSyncResource determines if the given resource exists in the provided slice of sync operation resources.
 // ContainsSyncResource returns false if either argument is nil.
 func ContainsSyncResource(u *unstructured.Unstructured, rr []argoappv1.SyncOperationResource) bool {
@@ -1,6 +1,7 @@
 package argo
 
 import (
+	"context"
 	"strings"
 	"testing"
 	"time"
@@ -9,10 +10,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/argoproj/argo-cd/common"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/util/db"
 	testcore "k8s.io/client-go/testing"
 )
 
@@ -22,7 +25,7 @@ func TestRefreshApp(t *testing.T) {
 	testApp.Namespace = "default"
 	appClientset := appclientset.NewSimpleClientset(&testApp)
 	appIf := appClientset.ArgoprojV1alpha1().Applications("default")
-	_, err := RefreshApp(appIf, "test-app")
+	_, err := RefreshApp(appIf, "test-app", argoappv1.RefreshTypeNormal)
 	assert.Nil(t, err)
 	// For some reason, the fake Application inferface doesn't reflect the patch status after Patch(),
 	// so can't verify it was set in unit tests.
@@ -30,6 +33,58 @@ func TestRefreshApp(t *testing.T) {
 	//assert.True(t, ok)
 }
 
+func TestQueueAppOperation(t *testing.T) {
+	var testApp argoappv1.Application
+	testApp.Name = "test-app"
+	testApp.Namespace = "default"
+	appClientset := appclientset.NewSimpleClientset(&testApp)
+	appIf := appClientset.ArgoprojV1alpha1().Applications("default")
+	newOp := func(username string) *argoappv1.Operation {
+		return &argoappv1.Operation{
+			Sync:        &argoappv1.SyncOperation{},
+			InitiatedBy: argoappv1.OperationInitiator{Username: username},
+		}
+	}
+
+	app, queued, err := QueueAppOperation(context.Background(), appIf, nil, "test-app", newOp("alice"))
+	assert.NoError(t, err)
+	assert.False(t, queued)
+	assert.Equal(t, "alice", app.Status.OperationLock.Holder)
+
+	_, err = SetAppOperation(context.Background(), appIf, nil, "test-app", newOp("bob"))
+	assert.True(t, IsOperationLockedError(err))
+	assert.Contains(t, err.Error(), "another operation is already in progress (initiated by alice")
+
+	app, queued, err = QueueAppOperation(context.Background(), appIf, nil, "test-app", newOp("bob"))
+	assert.NoError(t, err)
+	assert.True(t, queued)
+	assert.Equal(t, "alice", app.Operation.InitiatedBy.Username)
+	assert.Len(t, app.Status.QueuedOperations, 1)
+
+	// queued operations wait for the operation in progress
+	op, err := StartQueuedAppOperation(appIf, "test-app")
+	assert.NoError(t, err)
+	assert.Nil(t, op)
+
+	// complete the operation in progress, and release the lock
+	app.Operation = nil
+	app.Status.OperationLock = nil
+	_, err = appIf.Update(app)
+	assert.NoError(t, err)
+	// queued operations take the lock before new operations
+	_, err = SetAppOperation(context.Background(), appIf, nil, "test-app", newOp("carol"))
+	assert.True(t, IsOperationLockedError(err))
+
+	op, err = StartQueuedAppOperation(appIf, "test-app")
+	assert.NoError(t, err)
+	assert.Equal(t, "bob", op.InitiatedBy.Username)
+	app, err = appIf.Get("test-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "bob", app.Operation.InitiatedBy.Username)
+	assert.Equal(t, "bob", app.Status.OperationLock.Holder)
+	assert.Len(t, app.Status.QueuedOperations, 0)
+}
+
 func TestGetAppProjectWithNoProjDefined(t *testing.T) {
 	projName := "default"
 	namespace := "default"
@@ -120,3 +175,101 @@ func TestContainsSyncResource(t *testing.T) {
 		}
 	}
 }
+
+func TestGetDestinationCluster(t *testing.T) {
+	argoDB := db.NewDB("default", fake.NewSimpleClientset())
+	_, err := argoDB.CreateCluster(context.Background(), &argoappv1.Cluster{Server: "https://1.2.3.4", Name: "prod"})
+	assert.NoError(t, err)
+
+	cluster, err := GetDestinationCluster(context.Background(), argoappv1.ApplicationDestination{Name: "prod"}, argoDB)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://1.2.3.4", cluster.Server)
+
+	cluster, err = GetDestinationCluster(context.Background(), argoappv1.ApplicationDestination{Server: "https://1.2.3.4"}, argoDB)
+	assert.NoError(t, err)
+	assert.Equal(t, "prod", cluster.Name)
+
+	// the name takes precedence over the server
+	cluster, err = GetDestinationCluster(context.Background(), argoappv1.ApplicationDestination{Name: "prod", Server: "https://5.6.7.8"}, argoDB)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://1.2.3.4", cluster.Server)
+
+	_, err = GetDestinationCluster(context.Background(), argoappv1.ApplicationDestination{Name: "staging"}, argoDB)
+	assert.Error(t, err)
+}
+
+func TestValidateResourceMetadata(t *testing.T) {
+	msgs := validateResourceMetadata(&argoappv1.ResourceMetadata{
+		Labels:      map[string]string{"team": "a", "cost-center": "1234"},
+		Annotations: map[string]string{"example.com/Owner": "Team A"},
+	})
+	assert.Empty(t, msgs)
+
+	msgs = validateResourceMetadata(&argoappv1.ResourceMetadata{
+		Labels: map[string]string{
+			common.LabelApplicationName: "other",
+			"team":                      "not a valid value",
+		},
+		Annotations: map[string]string{"invalid key": "foo"},
+	})
+	assert.Len(t, msgs, 3)
+}
+
+func TestGetAppManifestGeneratePaths(t *testing.T) {
+	app := argoappv1.Application{
+		Spec: argoappv1.ApplicationSpec{Source: argoappv1.ApplicationSource{Path: "apps/guestbook"}},
+	}
+	assert.Nil(t, GetAppManifestGeneratePaths(&app))
+
+	app.Annotations = map[string]string{common.AnnotationKeyManifestGeneratePaths: ""}
+	assert.Equal(t, []string{"apps/guestbook"}, GetAppManifestGeneratePaths(&app))
+
+	app.Annotations[common.AnnotationKeyManifestGeneratePaths] = "../../bases/guestbook; /components/;"
+	assert.Equal(t, []string{"apps/guestbook", "bases/guestbook", "components"}, GetAppManifestGeneratePaths(&app))
+}
+
+func TestTrackingLabelValue(t *testing.T) {
+	app := argoappv1.Application{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"}}
+	assert.Equal(t, "guestbook", TrackingLabelValue(&app, "argocd"))
+
+	app.Namespace = "team-a"
+	assert.Equal(t, "team-a_guestbook", TrackingLabelValue(&app, "argocd"))
+}
+
+func TestFilterByDestinationCluster(t *testing.T) {
+	apps := []argoappv1.Application{
+		{ObjectMeta: metav1.ObjectMeta{Name: "by-server"}, Spec: argoappv1.ApplicationSpec{Destination: argoappv1.ApplicationDestination{Server: "https://cluster-api.com"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "by-name"}, Spec: argoappv1.ApplicationSpec{Destination: argoappv1.ApplicationDestination{Name: "in-cluster"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "other"}, Spec: argoappv1.ApplicationSpec{Destination: argoappv1.ApplicationDestination{Name: "other-cluster"}}},
+	}
+	filtered := FilterByDestinationCluster(apps, &argoappv1.Cluster{Name: "in-cluster", Server: "https://cluster-api.com"})
+	if assert.Len(t, filtered, 2) {
+		assert.Equal(t, "by-server", filtered[0].Name)
+		assert.Equal(t, "by-name", filtered[1].Name)
+	}
+	// clusters without a name are only referenced by server
+	assert.Len(t, FilterByDestinationCluster(apps, &argoappv1.Cluster{Server: "https://other-server"}), 0)
+}
+
+func TestDisableAutomatedSync(t *testing.T) {
+	proj := &argoappv1.AppProject{Spec: argoappv1.AppProjectSpec{SyncPolicy: &argoappv1.SyncPolicy{
+		Automated:   &argoappv1.SyncPolicyAutomated{Prune: true},
+		SyncOptions: argoappv1.SyncOptions{"PruneLast=true"},
+	}}}
+	inheriting := argoappv1.Application{ObjectMeta: metav1.ObjectMeta{Name: "inheriting", Namespace: "default"}}
+	owning := argoappv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "owning", Namespace: "default"},
+		Spec:       argoappv1.ApplicationSpec{SyncPolicy: &argoappv1.SyncPolicy{Automated: &argoappv1.SyncPolicyAutomated{}}},
+	}
+	appIf := appclientset.NewSimpleClientset(&inheriting, &owning).ArgoprojV1alpha1().Applications("default")
+
+	// the sync options inherited from the project are kept
+	app, err := DisableAutomatedSync(appIf, "inheriting", proj)
+	assert.NoError(t, err)
+	assert.Equal(t, &argoappv1.SyncPolicy{SyncOptions: argoappv1.SyncOptions{"PruneLast=true"}}, app.Spec.SyncPolicy)
+	assert.NotNil(t, proj.Spec.SyncPolicy.Automated)
+
+	app, err = DisableAutomatedSync(appIf, "owning", proj)
+	assert.NoError(t, err)
+	assert.Equal(t, &argoappv1.SyncPolicy{}, app.Spec.SyncPolicy)
+}
@@ -31,9 +31,12 @@ const (
 	EventReasonResourceDeleted    = "ResourceDeleted"
 	EventReasonOperationStarted   = "OperationStarted"
 	EventReasonOperationCompleted = "OperationCompleted"
+	EventReasonParametersChanged  = "ParametersChanged"
+	EventReasonResourceActionRan  = "ResourceActionRan"
+	EventReasonExecSession        = "ExecSession"
 )
 
-func (l *AuditLogger) logEvent(objMeta metav1.ObjectMeta, gvk schema.GroupVersionKind, info EventInfo, message string) {
+func (l *AuditLogger) logEvent(objMeta metav1.ObjectMeta, gvk schema.GroupVersionKind, info EventInfo, message string, annotations map[string]string) {
 	logCtx := log.WithFields(log.Fields{
 		"type":   info.Type,
 		"reason": info.Reason,
@@ -49,7 +52,8 @@ func (l *AuditLogger) logEvent(objMeta metav1.ObjectMeta, gvk schema.GroupVersio
 	t := metav1.Time{Time: time.Now()}
 	event := v1.Event{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: fmt.Sprintf("%v.%x", objMeta.Name, t.UnixNano()),
+			Name:        fmt.Sprintf("%v.%x", objMeta.Name, t.UnixNano()),
+			Annotations: annotations,
 		},
 		Source: v1.EventSource{
 			Component: l.component,
@@ -78,11 +82,17 @@ func (l *AuditLogger) logEvent(objMeta metav1.ObjectMeta, gvk schema.GroupVersio
 }
 
 func (l *AuditLogger) LogAppEvent(app *v1alpha1.Application, info EventInfo, message string) {
-	l.logEvent(app.ObjectMeta, v1alpha1.ApplicationSchemaGroupVersionKind, info, message)
+	l.logEvent(app.ObjectMeta, v1alpha1.ApplicationSchemaGroupVersionKind, info, message, nil)
+}
+
+// LogAppEventWithAnnotations logs an event of the application, whose annotations hold structured
+// details of the event
+func (l *AuditLogger) LogAppEventWithAnnotations(app *v1alpha1.Application, info EventInfo, message string, annotations map[string]string) {
+	l.logEvent(app.ObjectMeta, v1alpha1.ApplicationSchemaGroupVersionKind, info, message, annotations)
 }
 
 func (l *AuditLogger) LogAppProjEvent(proj *v1alpha1.AppProject, info EventInfo, message string) {
-	l.logEvent(proj.ObjectMeta, v1alpha1.AppProjectSchemaGroupVersionKind, info, message)
+	l.logEvent(proj.ObjectMeta, v1alpha1.AppProjectSchemaGroupVersionKind, info, message, nil)
 }
 
 func NewAuditLogger(ns string, kIf kubernetes.Interface, component string) *AuditLogger {
@@ -0,0 +1,126 @@
+package argo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/pkg/client/clientset/versioned/typed/application/v1alpha1"
+)
+
+// DefaultOperationRetention is the time the resource results of completed operations are kept in the
+// status of applications
+const DefaultOperationRetention = 7 * 24 * time.Hour
+
+// GCPolicy controls which records are garbage collected from the status of applications
+type GCPolicy struct {
+	// MaxHistory is the number of deployments kept in the history
+	MaxHistory int
+	// OperationRetention is the time the resource results of completed operations are kept
+	OperationRetention time.Duration
+}
+
+// GCResult counts the records garbage collected from the status of an application
+type GCResult struct {
+	// History is the number of deployments removed from the history
+	History int
+	// OperationResources is the number of resource results removed from the completed operation
+	OperationResources int
+	// OrphanedHooks is the number of records removed of hooks which never completed while their
+	// operation did
+	OrphanedHooks int
+}
+
+// IsEmpty returns whether no record was garbage collected
+func (r GCResult) IsEmpty() bool {
+	return r.History == 0 && r.OperationResources == 0 && r.OrphanedHooks == 0
+}
+
+func (r GCResult) String() string {
+	if r.IsEmpty() {
+		return "nothing to collect"
+	}
+	var removed []string
+	if r.History > 0 {
+		removed = append(removed, fmt.Sprintf("%d deployment(s) from history", r.History))
+	}
+	if r.OperationResources > 0 {
+		removed = append(removed, fmt.Sprintf("%d resource result(s) of the completed operation", r.OperationResources))
+	}
+	if r.OrphanedHooks > 0 {
+		removed = append(removed, fmt.Sprintf("%d orphaned hook record(s)", r.OrphanedHooks))
+	}
+	return "removed " + strings.Join(removed, ", ")
+}
+
+// GarbageCollectStatus removes from the given application status the oldest deployments beyond the
+// history limit, the resource results of an operation completed before the retention period, and the
+// records of the hooks which never completed while their operation did
+func GarbageCollectStatus(status *argoappv1.ApplicationStatus, policy GCPolicy, now time.Time) GCResult {
+	var result GCResult
+	if policy.MaxHistory > 0 && len(status.History) > policy.MaxHistory {
+		result.History = len(status.History) - policy.MaxHistory
+		status.History = status.History[result.History:]
+	}
+	state := status.OperationState
+	if state == nil || !state.Phase.Completed() || state.SyncResult == nil {
+		return result
+	}
+	if state.FinishedAt != nil && now.Sub(state.FinishedAt.Time) > policy.OperationRetention {
+		result.OperationResources = len(state.SyncResult.Resources)
+		state.SyncResult.Resources = nil
+	}
+	var hooks []*argoappv1.HookStatus
+	for _, hook := range state.SyncResult.Hooks {
+		if hook.Status.Completed() {
+			hooks = append(hooks, hook)
+		} else {
+			result.OrphanedHooks++
+		}
+	}
+	state.SyncResult.Hooks = hooks
+	return result
+}
+
+// GarbageCollectApp garbage collects the records of the status of the given application, unless an
+// operation is in progress. Unless dryRun is set, the status is patched, provided the application did
+// not change since it was read
+func GarbageCollectApp(appIf v1alpha1.ApplicationInterface, app *argoappv1.Application, policy GCPolicy, dryRun bool) (GCResult, error) {
+	if app.Operation != nil || app.Status.OperationLock != nil {
+		return GCResult{}, nil
+	}
+	status := app.Status.DeepCopy()
+	result := GarbageCollectStatus(status, policy, time.Now())
+	if result.IsEmpty() || dryRun {
+		return result, nil
+	}
+	statusPatch := map[string]interface{}{
+		"history": status.History,
+	}
+	if state := status.OperationState; state != nil && state.SyncResult != nil {
+		statusPatch["operationState"] = map[string]interface{}{
+			"syncResult": map[string]interface{}{
+				"resources": state.SyncResult.Resources,
+				"hooks":     state.SyncResult.Hooks,
+			},
+		}
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		// the resource version makes the patch fail if an operation was started in the meantime
+		"metadata": map[string]interface{}{
+			"resourceVersion": app.ResourceVersion,
+		},
+		"status": statusPatch,
+	})
+	if err != nil {
+		return GCResult{}, err
+	}
+	if _, err = appIf.Patch(app.Name, types.MergePatchType, patch); err != nil {
+		return GCResult{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,92 @@
+package argo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
+)
+
+func newGCTestStatus(finishedAt time.Time) *argoappv1.ApplicationStatus {
+	finished := metav1.NewTime(finishedAt)
+	status := argoappv1.ApplicationStatus{
+		OperationState: &argoappv1.OperationState{
+			Phase:      argoappv1.OperationSucceeded,
+			FinishedAt: &finished,
+			SyncResult: &argoappv1.SyncOperationResult{
+				Resources: []*argoappv1.ResourceDetails{{Name: "guestbook-ui"}, {Name: "guestbook-svc"}},
+				Hooks: []*argoappv1.HookStatus{
+					{Name: "pre-sync", Status: argoappv1.OperationSucceeded},
+					{Name: "post-sync", Status: argoappv1.OperationRunning},
+				},
+			},
+		},
+	}
+	for i := 0; i < 8; i++ {
+		status.History = append(status.History, argoappv1.DeploymentInfo{ID: int64(i)})
+	}
+	return &status
+}
+
+func TestGarbageCollectStatus(t *testing.T) {
+	now := time.Now()
+	policy := GCPolicy{MaxHistory: 5, OperationRetention: time.Hour}
+
+	t.Run("Retained", func(t *testing.T) {
+		status := newGCTestStatus(now.Add(-time.Minute))
+		result := GarbageCollectStatus(status, policy, now)
+		assert.Equal(t, GCResult{History: 3, OrphanedHooks: 1}, result)
+		assert.Len(t, status.History, 5)
+		assert.Equal(t, int64(3), status.History[0].ID)
+		assert.Len(t, status.OperationState.SyncResult.Resources, 2)
+		assert.Len(t, status.OperationState.SyncResult.Hooks, 1)
+		assert.Equal(t, "pre-sync", status.OperationState.SyncResult.Hooks[0].Name)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		status := newGCTestStatus(now.Add(-2 * time.Hour))
+		result := GarbageCollectStatus(status, policy, now)
+		assert.Equal(t, GCResult{History: 3, OperationResources: 2, OrphanedHooks: 1}, result)
+		assert.Nil(t, status.OperationState.SyncResult.Resources)
+	})
+
+	t.Run("OperationInProgress", func(t *testing.T) {
+		status := newGCTestStatus(now.Add(-2 * time.Hour))
+		status.OperationState.Phase = argoappv1.OperationRunning
+		result := GarbageCollectStatus(status, policy, now)
+		assert.Equal(t, GCResult{History: 3}, result)
+		assert.Len(t, status.OperationState.SyncResult.Hooks, 2)
+	})
+
+	t.Run("NothingToCollect", func(t *testing.T) {
+		status := argoappv1.ApplicationStatus{History: []argoappv1.DeploymentInfo{{ID: 0}}}
+		result := GarbageCollectStatus(&status, policy, now)
+		assert.True(t, result.IsEmpty())
+		assert.Equal(t, "nothing to collect", result.String())
+	})
+}
+
+func TestGarbageCollectApp(t *testing.T) {
+	var testApp argoappv1.Application
+	testApp.Name = "test-app"
+	testApp.Namespace = "default"
+	testApp.Status = *newGCTestStatus(time.Now().Add(-2 * time.Hour))
+	appClientset := appclientset.NewSimpleClientset(&testApp)
+	appIf := appClientset.ArgoprojV1alpha1().Applications("default")
+	policy := GCPolicy{MaxHistory: 5, OperationRetention: time.Hour}
+
+	result, err := GarbageCollectApp(appIf, &testApp, policy, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "removed 3 deployment(s) from history, 2 resource result(s) of the completed operation, 1 orphaned hook record(s)", result.String())
+	// the application passed is left untouched
+	assert.Len(t, testApp.Status.History, 8)
+
+	testApp.Operation = &argoappv1.Operation{Sync: &argoappv1.SyncOperation{}}
+	result, err = GarbageCollectApp(appIf, &testApp, policy, false)
+	assert.Nil(t, err)
+	assert.True(t, result.IsEmpty())
+}
@@ -0,0 +1,263 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/session"
+)
+
+const (
+	// SinkStdout writes the audit records to the standard output, as JSON lines
+	SinkStdout = "stdout"
+	// SinkFile appends the audit records to a file, as JSON lines
+	SinkFile = "file"
+	// SinkEvents records the audit records as Kubernetes events
+	SinkEvents = "events"
+
+	// EventReasonAPICall is the reason of the Kubernetes events recording the audit records
+	EventReasonAPICall = "APICall"
+)
+
+// mutatingMethods are the names of the API methods which change the state of Argo CD or of the
+// applications, regardless of their service
+var mutatingMethods = map[string]bool{
+	"BulkRefresh":            true,
+	"BulkSync":               true,
+	"Create":                 true,
+	"CreateFromKubeConfig":   true,
+	"CreateToken":            true,
+	"Delete":                 true,
+	"DeleteResource":         true,
+	"DeleteToken":            true,
+	"Exec":                   true,
+	"GarbageCollect":         true,
+	"InvalidateSessions":     true,
+	"Resync":                 true,
+	"RevokeToken":            true,
+	"Rollback":               true,
+	"RunResourceAction":      true,
+	"SetReconciliationPause": true,
+	"Sync":                   true,
+	"TerminateOperation":     true,
+	"Update":                 true,
+	"UpdatePassword":         true,
+	"UpdateSpec":             true,
+}
+
+// IsMutating returns whether the API method with the given full name (e.g.
+// /application.ApplicationService/Sync) changes the state of Argo CD or of the applications
+func IsMutating(fullMethod string) bool {
+	return mutatingMethods[fullMethod[strings.LastIndex(fullMethod, "/")+1:]]
+}
+
+// Record is the audit record of a call to a mutating API method
+type Record struct {
+	Time time.Time `json:"time"`
+	// User is the user who made the call
+	User string `json:"user"`
+	// Method is the full name of the API method
+	Method string `json:"method"`
+	// Request is the JSON content of the request, which is omitted for the methods handling credentials
+	Request json.RawMessage `json:"request,omitempty"`
+	// Code is the gRPC status code of the call
+	Code string `json:"code"`
+	// Error is the error message of failed calls
+	Error string `json:"error,omitempty"`
+
+	// name is the name of the object the request targets, if any
+	name string
+}
+
+// Sink stores audit records
+type Sink interface {
+	Write(record Record) error
+}
+
+// NewSink returns the sink of the given kind. The path is the path of the file of the file sink, and
+// the Kubernetes client and the namespace are where the events of the events sink are created
+func NewSink(kind string, path string, kubeClientset kubernetes.Interface, namespace string) (Sink, error) {
+	switch kind {
+	case SinkStdout:
+		return NewWriterSink(os.Stdout), nil
+	case SinkFile:
+		if path == "" {
+			return nil, fmt.Errorf("the file of the audit log is required by the %s sink", SinkFile)
+		}
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, err
+		}
+		return NewWriterSink(f), nil
+	case SinkEvents:
+		return NewEventSink(kubeClientset, namespace), nil
+	default:
+		return nil, fmt.Errorf("unknown audit log sink '%s', must be one of: %s, %s, %s", kind, SinkStdout, SinkFile, SinkEvents)
+	}
+}
+
+// writerSink writes the audit records as JSON lines
+type writerSink struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+// NewWriterSink returns a sink writing the audit records to the given writer, as JSON lines
+func NewWriterSink(w io.Writer) Sink {
+	return &writerSink{w: w}
+}
+
+func (s *writerSink) Write(record Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err = s.w.Write(append(data, '\n'))
+	return err
+}
+
+// eventSink records the audit records as Kubernetes events
+type eventSink struct {
+	kubeClientset kubernetes.Interface
+	namespace     string
+}
+
+// NewEventSink returns a sink recording the audit records as Kubernetes events of the given namespace.
+// The events involve the application or the project targeted by the call, or the namespace otherwise
+func NewEventSink(kubeClientset kubernetes.Interface, namespace string) Sink {
+	return &eventSink{kubeClientset: kubeClientset, namespace: namespace}
+}
+
+func (s *eventSink) Write(record Record) error {
+	involvedObject := v1.ObjectReference{Kind: "Namespace", APIVersion: "v1", Name: s.namespace}
+	if record.name != "" {
+		switch {
+		case strings.HasPrefix(record.Method, "/application.ApplicationService/"):
+			involvedObject = v1.ObjectReference{Kind: v1alpha1.ApplicationSchemaGroupVersionKind.Kind, APIVersion: v1alpha1.SchemeGroupVersion.String(), Namespace: s.namespace, Name: record.name}
+		case strings.HasPrefix(record.Method, "/project.ProjectService/"):
+			involvedObject = v1.ObjectReference{Kind: v1alpha1.AppProjectSchemaGroupVersionKind.Kind, APIVersion: v1alpha1.SchemeGroupVersion.String(), Namespace: s.namespace, Name: record.name}
+		}
+	}
+	eventType := v1.EventTypeNormal
+	if record.Error != "" {
+		eventType = v1.EventTypeWarning
+	}
+	message := fmt.Sprintf("%s called %s: %s", record.User, record.Method, record.Code)
+	if record.Error != "" {
+		message = fmt.Sprintf("%s (%s)", message, record.Error)
+	}
+	annotations := map[string]string{}
+	if len(record.Request) > 0 {
+		annotations["request"] = string(record.Request)
+	}
+	t := metav1.Time{Time: record.Time}
+	_, err := s.kubeClientset.CoreV1().Events(s.namespace).Create(&v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%v.%x", involvedObject.Name, t.UnixNano()),
+			Annotations: annotations,
+		},
+		Source:         v1.EventSource{Component: "argocd-server"},
+		InvolvedObject: involvedObject,
+		FirstTimestamp: t,
+		LastTimestamp:  t,
+		Count:          1,
+		Message:        message,
+		Type:           eventType,
+		Reason:         EventReasonAPICall,
+	})
+	return err
+}
+
+// RequestDecider returns whether the content of the request of the API method is recorded
+type RequestDecider func(fullMethod string) bool
+
+// newRecord returns the audit record of a call
+func newRecord(ctx context.Context, fullMethod string, req interface{}, recordRequest bool, err error) Record {
+	record := Record{
+		Time:   time.Now().UTC(),
+		User:   session.Username(ctx),
+		Method: fullMethod,
+		Code:   status.Code(err).String(),
+	}
+	if record.User == "" {
+		record.User = "Unknown user"
+	}
+	if err != nil {
+		record.Error = status.Convert(err).Message()
+	}
+	if named, ok := req.(interface{ GetName() string }); ok {
+		record.name = named.GetName()
+	}
+	if msg, ok := req.(proto.Message); ok && recordRequest {
+		b := &bytes.Buffer{}
+		if err := grpc_logrus.JsonPbMarshaller.Marshal(b, msg); err == nil {
+			record.Request = b.Bytes()
+		}
+	}
+	return record
+}
+
+func write(sink Sink, record Record) {
+	if err := sink.Write(record); err != nil {
+		log.Errorf("Failed to write audit record of %s call by %s: %v", record.Method, record.User, err)
+	}
+}
+
+// UnaryServerInterceptor records the calls to the mutating API methods to the sink
+func UnaryServerInterceptor(sink Sink, decider RequestDecider) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !IsMutating(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		resp, err := handler(ctx, req)
+		write(sink, newRecord(ctx, info.FullMethod, req, decider(info.FullMethod), err))
+		return resp, err
+	}
+}
+
+// firstRequestServerStream keeps the first request received by a stream
+type firstRequestServerStream struct {
+	grpc.ServerStream
+	req interface{}
+}
+
+func (s *firstRequestServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.req == nil {
+		s.req = m
+	}
+	return err
+}
+
+// StreamServerInterceptor records the calls to the mutating streaming API methods to the sink, once
+// the stream ends. The first request of the stream is recorded
+func StreamServerInterceptor(sink Sink, decider RequestDecider) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !IsMutating(info.FullMethod) {
+			return handler(srv, stream)
+		}
+		recordingStream := &firstRequestServerStream{ServerStream: stream}
+		err := handler(srv, recordingStream)
+		write(sink, newRecord(stream.Context(), info.FullMethod, recordingStream.req, decider(info.FullMethod), err))
+		return err
+	}
+}
@@ -0,0 +1,90 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/server/application"
+	"github.com/argoproj/argo-cd/util/session"
+)
+
+func newContext(user string) context.Context {
+	return context.WithValue(context.Background(), "claims", jwt.MapClaims{"iss": session.SessionManagerClaimsIssuer, "sub": user})
+}
+
+func TestIsMutating(t *testing.T) {
+	assert.True(t, IsMutating("/application.ApplicationService/Sync"))
+	assert.True(t, IsMutating("/project.ProjectService/Delete"))
+	assert.False(t, IsMutating("/application.ApplicationService/Get"))
+	assert.False(t, IsMutating("/application.ApplicationService/Watch"))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	out := &bytes.Buffer{}
+	interceptor := UnaryServerInterceptor(NewWriterSink(out), func(fullMethod string) bool {
+		return fullMethod != "/application.ApplicationService/Rollback"
+	})
+	name := "guestbook"
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	_, err := interceptor(newContext("admin"), &application.ApplicationSyncRequest{Name: &name}, &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Sync"}, handler)
+	assert.NoError(t, err)
+	_, err = interceptor(newContext("admin"), &application.ApplicationQuery{Name: &name}, &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Get"}, handler)
+	assert.NoError(t, err)
+	_, err = interceptor(newContext("viewer"), &application.ApplicationRollbackRequest{Name: &name}, &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Rollback"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	})
+	assert.Error(t, err)
+
+	// the calls to the read-only methods are not recorded
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if !assert.Len(t, lines, 2) {
+		return
+	}
+	var record Record
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &record))
+	assert.Equal(t, "admin", record.User)
+	assert.Equal(t, "/application.ApplicationService/Sync", record.Method)
+	assert.Equal(t, codes.OK.String(), record.Code)
+	assert.Contains(t, string(record.Request), `"name":"guestbook"`)
+
+	record = Record{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), &record))
+	assert.Equal(t, "viewer", record.User)
+	assert.Equal(t, codes.PermissionDenied.String(), record.Code)
+	assert.Equal(t, "permission denied", record.Error)
+	assert.Empty(t, record.Request)
+}
+
+func TestEventSink(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset()
+	interceptor := UnaryServerInterceptor(NewEventSink(kubeclientset, "argocd"), func(string) bool { return true })
+	name := "guestbook"
+	_, err := interceptor(newContext("admin"), &application.ApplicationSyncRequest{Name: &name}, &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Sync"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	assert.NoError(t, err)
+
+	events, err := kubeclientset.CoreV1().Events("argocd").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	if assert.Len(t, events.Items, 1) {
+		event := events.Items[0]
+		assert.Equal(t, EventReasonAPICall, event.Reason)
+		assert.Equal(t, "Application", event.InvolvedObject.Kind)
+		assert.Equal(t, "guestbook", event.InvolvedObject.Name)
+		assert.Equal(t, "admin called /application.ApplicationService/Sync: OK", event.Message)
+		assert.Contains(t, event.Annotations["request"], `"name":"guestbook"`)
+	}
+}
@@ -18,3 +18,8 @@ type Cache interface {
 	Set(item *Item) error
 	Get(key string, obj interface{}) error
 }
+
+// EvictionNotifier is implemented by caches which can notify when items expire or are deleted
+type EvictionNotifier interface {
+	OnEvicted(f func(key string))
+}
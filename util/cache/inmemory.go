@@ -37,6 +37,18 @@ func (i *InMemoryCache) Get(key string, obj interface{}) error {
 	return gob.NewDecoder(&buf).Decode(obj)
 }
 
+// Delete deletes the item with the given key from the cache
+func (i *InMemoryCache) Delete(key string) {
+	i.memCache.Delete(key)
+}
+
 func (i *InMemoryCache) Flush() {
 	i.memCache.Flush()
 }
+
+// OnEvicted sets a function called with the key of items which expire or are deleted from the cache
+func (i *InMemoryCache) OnEvicted(f func(key string)) {
+	i.memCache.OnEvicted(func(key string, _ interface{}) {
+		f(key)
+	})
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"hash/fnv"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/argoproj/argo-cd/common"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
@@ -18,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 )
 
@@ -30,23 +33,15 @@ var (
 
 // ListClusters returns list of clusters
 func (s *db) ListClusters(ctx context.Context) (*appv1.ClusterList, error) {
-	listOpts := metav1.ListOptions{}
-	labelSelector := labels.NewSelector()
-	req, err := labels.NewRequirement(common.LabelKeySecretType, selection.Equals, []string{common.SecretTypeCluster})
-	if err != nil {
-		return nil, err
-	}
-	labelSelector = labelSelector.Add(*req)
-	listOpts.LabelSelector = labelSelector.String()
-	clusterSecrets, err := s.kubeclientset.CoreV1().Secrets(s.ns).List(listOpts)
+	clusterSecrets, err := s.listClusterSecrets()
 	if err != nil {
 		return nil, err
 	}
 	clusterList := appv1.ClusterList{
-		Items: make([]appv1.Cluster, len(clusterSecrets.Items)),
+		Items: make([]appv1.Cluster, len(clusterSecrets)),
 	}
 	hasInClusterCredentials := false
-	for i, clusterSecret := range clusterSecrets.Items {
+	for i, clusterSecret := range clusterSecrets {
 		cluster := *SecretToCluster(&clusterSecret)
 		clusterList.Items[i] = cluster
 		if cluster.Server == common.KubernetesInternalAPIServerAddr {
@@ -59,6 +54,20 @@ func (s *db) ListClusters(ctx context.Context) (*appv1.ClusterList, error) {
 	return &clusterList, nil
 }
 
+// listClusterSecrets returns the secrets of the clusters matching the given label requirements
+func (s *db) listClusterSecrets(reqs ...labels.Requirement) ([]apiv1.Secret, error) {
+	req, err := labels.NewRequirement(common.LabelKeySecretType, selection.Equals, []string{common.SecretTypeCluster})
+	if err != nil {
+		return nil, err
+	}
+	labelSelector := labels.NewSelector().Add(*req).Add(reqs...)
+	clusterSecrets, err := s.kubeclientset.CoreV1().Secrets(s.ns).List(metav1.ListOptions{LabelSelector: labelSelector.String()})
+	if err != nil {
+		return nil, err
+	}
+	return clusterSecrets.Items, nil
+}
+
 // CreateCluster creates a cluster
 func (s *db) CreateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Cluster, error) {
 	secName, err := serverToSecretName(c.Server)
@@ -74,6 +83,7 @@ func (s *db) CreateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Cluste
 		},
 	}
 	clusterSecret.Data = clusterToData(c)
+	clusterSecret.Labels[common.LabelKeyClusterNameHash] = clusterNameHash(string(clusterSecret.Data["name"]))
 	clusterSecret, err = s.kubeclientset.CoreV1().Secrets(s.ns).Create(clusterSecret)
 	if err != nil {
 		if apierr.IsAlreadyExists(err) {
@@ -177,6 +187,31 @@ func (s *db) GetCluster(ctx context.Context, server string) (*appv1.Cluster, err
 	return SecretToCluster(clusterSecret), nil
 }
 
+// GetClusterByName returns a cluster by its friendly name. The secrets are looked up by the hash of the
+// name, and then among the secrets which do not record the hash, created by previous versions
+func (s *db) GetClusterByName(ctx context.Context, name string) (*appv1.Cluster, error) {
+	byHash, err := labels.NewRequirement(common.LabelKeyClusterNameHash, selection.Equals, []string{clusterNameHash(name)})
+	if err != nil {
+		return nil, err
+	}
+	withoutHash, err := labels.NewRequirement(common.LabelKeyClusterNameHash, selection.DoesNotExist, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, req := range []*labels.Requirement{byHash, withoutHash} {
+		clusterSecrets, err := s.listClusterSecrets(*req)
+		if err != nil {
+			return nil, err
+		}
+		for i := range clusterSecrets {
+			if cluster := SecretToCluster(&clusterSecrets[i]); cluster.Name == name {
+				return cluster, nil
+			}
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "cluster with name %q not found", name)
+}
+
 // UpdateCluster updates a cluster
 func (s *db) UpdateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Cluster, error) {
 	clusterSecret, err := s.getClusterSecret(c.Server)
@@ -184,6 +219,10 @@ func (s *db) UpdateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Cluste
 		return nil, err
 	}
 	clusterSecret.Data = clusterToData(c)
+	if clusterSecret.Labels == nil {
+		clusterSecret.Labels = make(map[string]string)
+	}
+	clusterSecret.Labels[common.LabelKeyClusterNameHash] = clusterNameHash(string(clusterSecret.Data["name"]))
 	clusterSecret, err = s.kubeclientset.CoreV1().Secrets(s.ns).Update(clusterSecret)
 	if err != nil {
 		return nil, err
@@ -191,6 +230,58 @@ func (s *db) UpdateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Cluste
 	return SecretToCluster(clusterSecret), nil
 }
 
+// RequestClusterResync requests the controller to resync the resources of a cluster
+func (s *db) RequestClusterResync(ctx context.Context, server string) (*appv1.Cluster, error) {
+	return s.setClusterAnnotations(server, map[string]string{
+		common.AnnotationCacheResyncRequestedAt: time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
+// SetClusterResynced records the time of the last full resync of the resources of a cluster, along with
+// the resync request handled by the resync, if any
+func (s *db) SetClusterResynced(ctx context.Context, server string, resyncedAt time.Time, handledRequestedAt *metav1.Time) error {
+	annotations := map[string]string{
+		common.AnnotationCacheLastResyncAt: resyncedAt.UTC().Format(time.RFC3339),
+	}
+	if handledRequestedAt != nil {
+		annotations[common.AnnotationCacheHandledResyncRequestedAt] = handledRequestedAt.UTC().Format(time.RFC3339)
+	}
+	_, err := s.setClusterAnnotations(server, annotations)
+	return err
+}
+
+// setClusterAnnotations sets annotations of the secret of a cluster. The secret of the local cluster is
+// created if it does not exist yet
+func (s *db) setClusterAnnotations(server string, annotations map[string]string) (*appv1.Cluster, error) {
+	secName, err := serverToSecretName(server)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.getClusterSecret(server)
+	if grpc.Code(err) == codes.NotFound && server == common.KubernetesInternalAPIServerAddr {
+		_, err = s.CreateCluster(context.Background(), &localCluster)
+		if grpc.Code(err) == codes.AlreadyExists {
+			err = nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": annotations,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	clusterSecret, err := s.kubeclientset.CoreV1().Secrets(s.ns).Patch(secName, types.MergePatchType, patch)
+	if err != nil {
+		return nil, err
+	}
+	return SecretToCluster(clusterSecret), nil
+}
+
 // Delete deletes a cluster by name
 func (s *db) DeleteCluster(ctx context.Context, name string) error {
 	secName, err := serverToSecretName(name)
@@ -213,6 +304,13 @@ func serverToSecretName(server string) (string, error) {
 	return fmt.Sprintf("cluster-%s-%v", host, h.Sum32()), nil
 }
 
+// clusterNameHash hashes the name of a cluster to a label value, since names may not be valid label values
+func clusterNameHash(name string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	return strconv.FormatUint(uint64(h.Sum32()), 10)
+}
+
 // clusterToData converts a cluster object to string data for serialization to a secret
 func clusterToData(c *appv1.Cluster) map[string][]byte {
 	data := make(map[string][]byte)
@@ -227,6 +325,9 @@ func clusterToData(c *appv1.Cluster) map[string][]byte {
 		panic(err)
 	}
 	data["config"] = configBytes
+	if len(c.Namespaces) > 0 {
+		data["namespaces"] = []byte(strings.Join(c.Namespaces, ","))
+	}
 	return data
 }
 
@@ -242,6 +343,10 @@ func SecretToCluster(s *apiv1.Secret) *appv1.Cluster {
 		Name:            string(s.Data["name"]),
 		Config:          config,
 		ConnectionState: ConnectionStateFromAnnotations(s.Annotations),
+		CacheInfo:       CacheInfoFromAnnotations(s.Annotations),
+	}
+	if namespaces := string(s.Data["namespaces"]); namespaces != "" {
+		cluster.Namespaces = strings.Split(namespaces, ",")
 	}
 	return &cluster
 }
@@ -0,0 +1,79 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func TestClusterResync(t *testing.T) {
+	db := NewDB("default", fake.NewSimpleClientset())
+
+	// the secret of the local cluster is created on the first resync
+	err := db.SetClusterResynced(context.Background(), common.KubernetesInternalAPIServerAddr, time.Now().Add(-time.Minute), nil)
+	assert.NoError(t, err)
+	cluster, err := db.GetCluster(context.Background(), common.KubernetesInternalAPIServerAddr)
+	assert.NoError(t, err)
+	assert.NotNil(t, cluster.CacheInfo.LastResyncAt)
+	assert.False(t, cluster.CacheInfo.IsResyncRequested())
+	assert.True(t, cluster.CacheInfo.Age() >= time.Minute)
+
+	cluster, err = db.RequestClusterResync(context.Background(), common.KubernetesInternalAPIServerAddr)
+	assert.NoError(t, err)
+	assert.True(t, cluster.CacheInfo.IsResyncRequested())
+
+	// the request is handled even if the clock of the controller is behind the clock of the API server
+	err = db.SetClusterResynced(context.Background(), common.KubernetesInternalAPIServerAddr, time.Now().Add(-time.Hour), cluster.CacheInfo.ResyncRequestedAt)
+	assert.NoError(t, err)
+	cluster, err = db.GetCluster(context.Background(), common.KubernetesInternalAPIServerAddr)
+	assert.NoError(t, err)
+	assert.False(t, cluster.CacheInfo.IsResyncRequested())
+
+	_, err = db.RequestClusterResync(context.Background(), "https://unknown-cluster")
+	assert.Equal(t, codes.NotFound, grpc.Code(err))
+}
+
+func TestGetClusterByName(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset()
+	db := NewDB("default", kubeclientset)
+	_, err := db.CreateCluster(context.Background(), &appv1.Cluster{Server: "https://server1", Name: "cluster1"})
+	assert.NoError(t, err)
+	// secrets created by previous versions do not record the hash of the name
+	legacyCluster := &appv1.Cluster{Server: "https://server2", Name: "cluster2"}
+	_, err = kubeclientset.CoreV1().Secrets("default").Create(&apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "cluster-server2",
+			Labels: map[string]string{common.LabelKeySecretType: common.SecretTypeCluster},
+		},
+		Data: clusterToData(legacyCluster),
+	})
+	assert.NoError(t, err)
+
+	cluster, err := db.GetClusterByName(context.Background(), "cluster1")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://server1", cluster.Server)
+	cluster, err = db.GetClusterByName(context.Background(), "cluster2")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://server2", cluster.Server)
+
+	cluster, err = db.GetClusterByName(context.Background(), "cluster1")
+	assert.NoError(t, err)
+	cluster.Name = "renamed"
+	_, err = db.UpdateCluster(context.Background(), cluster)
+	assert.NoError(t, err)
+	cluster, err = db.GetClusterByName(context.Background(), "renamed")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://server1", cluster.Server)
+	_, err = db.GetClusterByName(context.Background(), "cluster1")
+	assert.Equal(t, codes.NotFound, grpc.Code(err))
+}
@@ -20,10 +20,16 @@ type ArgoDB interface {
 	WatchClusters(ctx context.Context, callback func(*ClusterEvent)) error
 	// Get returns a cluster from a query
 	GetCluster(ctx context.Context, name string) (*appv1.Cluster, error)
+	// GetClusterByName returns a cluster by its friendly name
+	GetClusterByName(ctx context.Context, name string) (*appv1.Cluster, error)
 	// UpdateCluster updates a cluster
 	UpdateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Cluster, error)
 	// DeleteCluster deletes a cluster by name
 	DeleteCluster(ctx context.Context, name string) error
+	// RequestClusterResync requests the controller to resync the resources of a cluster
+	RequestClusterResync(ctx context.Context, server string) (*appv1.Cluster, error)
+	// SetClusterResynced records the time of the last full resync of the resources of a cluster, along with the resync request it handled
+	SetClusterResynced(ctx context.Context, server string, resyncedAt time.Time, handledRequestedAt *metav1.Time) error
 
 	// ListRepositories lists repositories
 	ListRepositories(ctx context.Context) (*appv1.RepositoryList, error)
@@ -35,6 +41,9 @@ type ArgoDB interface {
 	UpdateRepository(ctx context.Context, r *appv1.Repository) (*appv1.Repository, error)
 	// DeleteRepository updates a repository
 	DeleteRepository(ctx context.Context, name string) error
+
+	// ListHelmRepositories lists helm repositories
+	ListHelmRepositories(ctx context.Context) ([]*appv1.HelmRepository, error)
 }
 
 type db struct {
@@ -62,6 +71,28 @@ func AnnotationsFromConnectionState(connectionState *appv1.ConnectionState) map[
 	}
 }
 
+// CacheInfoFromAnnotations returns the cache info of a cluster from the annotations of its secret
+func CacheInfoFromAnnotations(annotations map[string]string) appv1.ClusterCacheInfo {
+	parseTime := func(key string) *metav1.Time {
+		value := annotations[key]
+		if value == "" {
+			return nil
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			log.Warnf("Unable to parse %s time: %v", key, err)
+			return nil
+		}
+		metaTime := metav1.NewTime(t)
+		return &metaTime
+	}
+	return appv1.ClusterCacheInfo{
+		LastResyncAt:             parseTime(common.AnnotationCacheLastResyncAt),
+		ResyncRequestedAt:        parseTime(common.AnnotationCacheResyncRequestedAt),
+		HandledResyncRequestedAt: parseTime(common.AnnotationCacheHandledResyncRequestedAt),
+	}
+}
+
 func ConnectionStateFromAnnotations(annotations map[string]string) appv1.ConnectionState {
 	status := annotations[common.AnnotationConnectionStatus]
 	if status == "" {
@@ -0,0 +1,51 @@
+package db
+
+import (
+	"sort"
+
+	"golang.org/x/net/context"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	"github.com/argoproj/argo-cd/common"
+	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// ListHelmRepositories returns the helm repositories, which are stored in the secrets labeled with the
+// helm repository secret type, sorted by name
+func (s *db) ListHelmRepositories(ctx context.Context) ([]*appsv1.HelmRepository, error) {
+	req, err := labels.NewRequirement(common.LabelKeySecretType, selection.Equals, []string{common.SecretTypeHelmRepository})
+	if err != nil {
+		return nil, err
+	}
+	listOpts := metav1.ListOptions{LabelSelector: labels.NewSelector().Add(*req).String()}
+	repoSecrets, err := s.kubeclientset.CoreV1().Secrets(s.ns).List(listOpts)
+	if err != nil {
+		return nil, err
+	}
+	repos := make([]*appsv1.HelmRepository, len(repoSecrets.Items))
+	for i := range repoSecrets.Items {
+		repos[i] = SecretToHelmRepo(&repoSecrets.Items[i])
+	}
+	sort.Slice(repos, func(i, j int) bool {
+		return repos[i].Name < repos[j].Name
+	})
+	return repos, nil
+}
+
+// SecretToHelmRepo converts a secret into a helm repository. The repository is named after the secret
+// unless the secret holds a name
+func SecretToHelmRepo(s *apiv1.Secret) *appsv1.HelmRepository {
+	repo := appsv1.HelmRepository{
+		Name:     string(s.Data["name"]),
+		URL:      string(s.Data["url"]),
+		Username: string(s.Data["username"]),
+		Password: string(s.Data["password"]),
+	}
+	if repo.Name == "" {
+		repo.Name = s.Name
+	}
+	return &repo
+}
@@ -0,0 +1,43 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func newHelmRepoSecret(name string, data map[string]string) *apiv1.Secret {
+	secret := apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "argocd",
+			Labels:    map[string]string{common.LabelKeySecretType: common.SecretTypeHelmRepository},
+		},
+		Data: make(map[string][]byte),
+	}
+	for key, value := range data {
+		secret.Data[key] = []byte(value)
+	}
+	return &secret
+}
+
+func TestListHelmRepositories(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		newHelmRepoSecret("stable", map[string]string{"url": "https://kubernetes-charts.storage.googleapis.com"}),
+		newHelmRepoSecret("helm-repo-private", map[string]string{"name": "private", "url": "https://charts.example.com", "username": "admin", "password": "secret"}),
+		&apiv1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: "argocd"}},
+	)
+	repos, err := NewDB("argocd", clientset).ListHelmRepositories(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []*appsv1.HelmRepository{
+		{Name: "private", URL: "https://charts.example.com", Username: "admin", Password: "secret"},
+		{Name: "stable", URL: "https://kubernetes-charts.storage.googleapis.com"},
+	}, repos)
+}
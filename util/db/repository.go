@@ -8,6 +8,7 @@ import (
 	"github.com/argoproj/argo-cd/common"
 	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/grpc"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -79,9 +80,9 @@ func (s *db) GetRepository(ctx context.Context, name string) (*appsv1.Repository
 
 // UpdateRepository updates a repository
 func (s *db) UpdateRepository(ctx context.Context, r *appsv1.Repository) (*appsv1.Repository, error) {
-	err := git.TestRepo(r.Repo, r.Username, r.Password, r.SSHPrivateKey)
+	err := git.TestRepo(ctx, r.Repo, r.Username, r.Password, r.SSHPrivateKey)
 	if err != nil {
-		return nil, err
+		return nil, grpc.NewReasonError(codes.InvalidArgument, grpc.ErrorReasonRepoAuthFailed, r.Repo, "%v", err)
 	}
 	repoSecret, err := s.getRepoSecret(r.Repo)
 	if err != nil {
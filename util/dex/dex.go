@@ -9,22 +9,43 @@ import (
 	"net/url"
 	"regexp"
 	"strconv"
+	"time"
 
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/errors"
 )
 
+// healthCheckTimeout is the maximum duration of a Dex server health check
+const healthCheckTimeout = 5 * time.Second
+
+const (
+	// ProxyErrorUnavailable is the reason of the proxied requests failing because Dex cannot be reached
+	ProxyErrorUnavailable = "unavailable"
+	// ProxyErrorServerError is the reason of the proxied requests failing with an internal Dex error
+	ProxyErrorServerError = "server_error"
+)
+
 var messageRe = regexp.MustCompile(`<p>(.*)([\s\S]*?)<\/p>`)
 
 // NewDexHTTPReverseProxy returns a reverse proxy to the Dex server. Dex is assumed to be configured
 // with the external issuer URL muxed to the same path configured in server.go. In other words, if
 // Argo CD API server wants to proxy requests at /api/dex, then the dex config yaml issuer URL should
-// also be /api/dex (e.g. issuer: https://argocd.example.com/api/dex)
-func NewDexHTTPReverseProxy(serverAddr string) func(writer http.ResponseWriter, request *http.Request) {
+// also be /api/dex (e.g. issuer: https://argocd.example.com/api/dex). The given function, if set, is
+// called with the reason of the requests which failed
+func NewDexHTTPReverseProxy(serverAddr string, onError func(reason string)) func(writer http.ResponseWriter, request *http.Request) {
 	target, err := url.Parse(serverAddr)
 	errors.CheckError(err)
+	if onError == nil {
+		onError = func(string) {}
+	}
 	proxy := httputil.NewSingleHostReverseProxy(target)
+	// the proxy responds with a bad gateway status to the requests failing to reach Dex
+	proxy.Transport = &errorReportingTransport{transport: http.DefaultTransport, onError: onError}
 	proxy.ModifyResponse = func(resp *http.Response) error {
 		if resp.StatusCode == 500 {
+			onError(ProxyErrorServerError)
 			b, err := ioutil.ReadAll(resp.Body)
 			if err != nil {
 				return err
@@ -52,3 +73,33 @@ func NewDexHTTPReverseProxy(serverAddr string) func(writer http.ResponseWriter,
 		proxy.ServeHTTP(w, r)
 	}
 }
+
+// errorReportingTransport reports the requests which cannot be sent to Dex
+type errorReportingTransport struct {
+	transport http.RoundTripper
+	onError   func(reason string)
+}
+
+func (t *errorReportingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(r)
+	if err != nil {
+		log.Warnf("Failed to proxy request to dex server: %v", err)
+		t.onError(ProxyErrorUnavailable)
+	}
+	return resp, err
+}
+
+// CheckHealth returns an error unless the Dex server at the given address serves its OpenID Connect
+// discovery document
+func CheckHealth(serverAddr string) error {
+	client := http.Client{Timeout: healthCheckTimeout}
+	resp, err := client.Get(serverAddr + common.DexAPIEndpoint + "/.well-known/openid-configuration")
+	if err != nil {
+		return fmt.Errorf("dex server health check failed: %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("dex server health check failed: status %d", resp.StatusCode)
+	}
+	return nil
+}
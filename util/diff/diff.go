@@ -20,6 +20,11 @@ import (
 type DiffResult struct {
 	Diff     gojsondiff.Diff
 	Modified bool
+	// NormalizedLive is the live object, stripped of any fields which are not considered during the
+	// comparison (e.g. defaulted fields not present in the config or last-applied-configuration)
+	NormalizedLive *unstructured.Unstructured
+	// NormalizedTarget is the state the live object is expected to have once the config is applied
+	NormalizedTarget *unstructured.Unstructured
 }
 
 type DiffResultList struct {
@@ -63,6 +68,12 @@ func TwoWayDiff(config, live *unstructured.Unstructured) *DiffResult {
 		Diff:     gjDiff,
 		Modified: gjDiff.Modified(),
 	}
+	if config != nil {
+		dr.NormalizedTarget = config
+	}
+	if live != nil {
+		dr.NormalizedLive = &unstructured.Unstructured{Object: liveObj}
+	}
 	return &dr
 }
 
@@ -105,8 +116,10 @@ func ThreeWayDiff(orig, config, live *unstructured.Unstructured) (*DiffResult, e
 	// 3. diff the live object vs. the patched live object
 	gjDiff := gojsondiff.New().CompareObjects(patchedLive.Object, live.Object)
 	dr := DiffResult{
-		Diff:     gjDiff,
-		Modified: gjDiff.Modified(),
+		Diff:             gjDiff,
+		Modified:         gjDiff.Modified(),
+		NormalizedLive:   live,
+		NormalizedTarget: &patchedLive,
 	}
 	return &dr, nil
 }
@@ -273,3 +286,40 @@ func (d *DiffResult) ASCIIFormat(left *unstructured.Unstructured, formatOpts for
 	asciiFmt := formatter.NewAsciiFormatter(left.Object, formatOpts)
 	return asciiFmt.Format(d.Diff)
 }
+
+// ChangedPaths returns the dot-separated paths of all fields which differ between the target and
+// live objects, e.g. "spec.replicas" or "spec.template.spec.containers.0.image"
+func (d *DiffResult) ChangedPaths() []string {
+	paths := make([]string, 0)
+	if d.Diff == nil {
+		return paths
+	}
+	for _, delta := range d.Diff.Deltas() {
+		paths = appendChangedPaths(paths, "", delta)
+	}
+	return paths
+}
+
+func appendChangedPaths(paths []string, prefix string, delta gojsondiff.Delta) []string {
+	joinPath := func(pos gojsondiff.Position) string {
+		if prefix == "" {
+			return pos.String()
+		}
+		return prefix + "." + pos.String()
+	}
+	switch d := delta.(type) {
+	case *gojsondiff.Object:
+		for _, child := range d.Deltas {
+			paths = appendChangedPaths(paths, joinPath(d.PostPosition()), child)
+		}
+	case *gojsondiff.Array:
+		for _, child := range d.Deltas {
+			paths = appendChangedPaths(paths, joinPath(d.PostPosition()), child)
+		}
+	case gojsondiff.PostDelta:
+		paths = append(paths, joinPath(d.PostPosition()))
+	case gojsondiff.PreDelta:
+		paths = append(paths, joinPath(d.PrePosition()))
+	}
+	return paths
+}
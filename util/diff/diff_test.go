@@ -94,6 +94,25 @@ func TestDiffArrayModification(t *testing.T) {
 	assert.True(t, diffResList.Modified)
 }
 
+func TestDiffChangedPaths(t *testing.T) {
+	leftDep := test.DemoDeployment()
+	rightDep := leftDep.DeepCopy()
+	ten := int32(10)
+	rightDep.Spec.Replicas = &ten
+
+	leftUn := kube.MustToUnstructured(leftDep)
+	rightUn := kube.MustToUnstructured(rightDep)
+
+	diffRes := Diff(leftUn, rightUn)
+	assert.True(t, diffRes.Modified)
+	assert.Equal(t, []string{"spec.replicas"}, diffRes.ChangedPaths())
+	assert.NotNil(t, diffRes.NormalizedLive)
+	assert.NotNil(t, diffRes.NormalizedTarget)
+
+	diffRes = Diff(leftUn, leftUn)
+	assert.Empty(t, diffRes.ChangedPaths())
+}
+
 // TestThreeWayDiff will perform a diff when there is a kubectl.kubernetes.io/last-applied-configuration
 // present in the live object.
 func TestThreeWayDiff(t *testing.T) {
@@ -1,10 +1,15 @@
 package git
 
 import (
+	"context"
 	"fmt"
+	nethttp "net/http"
 	"os"
 	"os/exec"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/crypto/ssh"
@@ -12,20 +17,54 @@ import (
 	"gopkg.in/src-d/go-git.v4/config"
 	"gopkg.in/src-d/go-git.v4/plumbing"
 	"gopkg.in/src-d/go-git.v4/plumbing/transport"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport/client"
 	"gopkg.in/src-d/go-git.v4/plumbing/transport/http"
 	ssh2 "gopkg.in/src-d/go-git.v4/plumbing/transport/ssh"
-	"gopkg.in/src-d/go-git.v4/storage/memory"
 )
 
 // Client is a generic git client interface
 type Client interface {
 	Root() string
 	Init() error
-	Fetch() error
-	Checkout(revision string) error
-	LsRemote(revision string) (string, error)
-	LsFiles(path string) ([]string, error)
-	CommitSHA() (string, error)
+	Fetch(ctx context.Context) error
+	Checkout(ctx context.Context, revision string) error
+	LsRemote(ctx context.Context, revision string) (string, error)
+	LsRefs(ctx context.Context) (*Refs, error)
+	LsFiles(ctx context.Context, path string) ([]string, error)
+	ChangedFiles(ctx context.Context, revision string, targetRevision string) ([]string, error)
+	CommitSHA(ctx context.Context) (string, error)
+	RevisionMetadata(ctx context.Context, revision string) (*RevisionMetadata, error)
+}
+
+// RevisionMetadata holds the metadata of a commit
+type RevisionMetadata struct {
+	// Author is the name and email of the author of the commit
+	Author string
+	// Date is the time the commit was made
+	Date time.Time
+	// Message is the full commit message
+	Message string
+	// Tags are the tags pointing at the commit
+	Tags []string
+	// SignatureStatus is the status of the GPG signature of the commit, which is empty if it is unsigned
+	SignatureStatus string
+}
+
+// signatureStatuses are the GPG signature statuses, by the code git reports them with
+var signatureStatuses = map[string]string{
+	"G": "Good",
+	"B": "Bad",
+	"U": "GoodUntrusted",
+	"X": "GoodExpired",
+	"Y": "GoodExpiredKey",
+	"R": "GoodRevokedKey",
+	"E": "Unverified",
+}
+
+// Refs holds the names of the branches and tags of a repository
+type Refs struct {
+	Branches []string
+	Tags     []string
 }
 
 // ClientFactory is a factory of Git Clients
@@ -101,14 +140,14 @@ func (m *nativeGitClient) Init() error {
 }
 
 // Fetch fetches latest updates from origin
-func (m *nativeGitClient) Fetch() error {
+func (m *nativeGitClient) Fetch(ctx context.Context) error {
 	log.Debugf("Fetching repo %s at %s", m.repoURL, m.root)
 	repo, err := git.PlainOpen(m.root)
 	if err != nil {
 		return err
 	}
 	log.Debug("git fetch origin --tags --force")
-	err = repo.Fetch(&git.FetchOptions{
+	err = repo.FetchContext(ctx, &git.FetchOptions{
 		RemoteName: git.DefaultRemoteName,
 		Auth:       m.auth,
 		Tags:       git.AllTags,
@@ -131,8 +170,20 @@ func (m *nativeGitClient) Fetch() error {
 }
 
 // LsFiles lists the local working tree, including only files that are under source control
-func (m *nativeGitClient) LsFiles(path string) ([]string, error) {
-	out, err := m.runCmd("git", "ls-files", "--full-name", "-z", "--", path)
+func (m *nativeGitClient) LsFiles(ctx context.Context, path string) ([]string, error) {
+	out, err := m.runCmd(ctx, "git", "ls-files", "--full-name", "-z", "--", path)
+	if err != nil {
+		return nil, err
+	}
+	// remove last element, which is blank regardless of whether we're using nullbyte or newline
+	ss := strings.Split(out, "\000")
+	return ss[:len(ss)-1], nil
+}
+
+// ChangedFiles lists the files changed between two revisions of the local repository, relative to the
+// root of the repository
+func (m *nativeGitClient) ChangedFiles(ctx context.Context, revision string, targetRevision string) ([]string, error) {
+	out, err := m.runCmd(ctx, "git", "diff", "--name-only", "-z", revision, targetRevision, "--")
 	if err != nil {
 		return nil, err
 	}
@@ -142,14 +193,14 @@ func (m *nativeGitClient) LsFiles(path string) ([]string, error) {
 }
 
 // Checkout checkout specified git sha
-func (m *nativeGitClient) Checkout(revision string) error {
+func (m *nativeGitClient) Checkout(ctx context.Context, revision string) error {
 	if revision == "" || revision == "HEAD" {
 		revision = "origin/HEAD"
 	}
-	if _, err := m.runCmd("git", "checkout", "--force", revision); err != nil {
+	if _, err := m.runCmd(ctx, "git", "checkout", "--force", revision); err != nil {
 		return err
 	}
-	if _, err := m.runCmd("git", "clean", "-fdx"); err != nil {
+	if _, err := m.runCmd(ctx, "git", "clean", "-fdx"); err != nil {
 		return err
 	}
 	return nil
@@ -159,23 +210,12 @@ func (m *nativeGitClient) Checkout(revision string) error {
 // does not resolve, and "looks" like a 7+ hexadecimal commit SHA, it return the revision string.
 // Otherwise, it returns an error indicating that the revision could not be resolved. This method
 // runs with in-memory storage and is safe to run concurrently, or to be run without a git
-// repository locally cloned.
-func (m *nativeGitClient) LsRemote(revision string) (string, error) {
+// repository locally cloned. Listing the remote refs is abandoned if the context is done first.
+func (m *nativeGitClient) LsRemote(ctx context.Context, revision string) (string, error) {
 	if IsCommitSHA(revision) {
 		return revision, nil
 	}
-	repo, err := git.Init(memory.NewStorage(), nil)
-	if err != nil {
-		return "", err
-	}
-	remote, err := repo.CreateRemote(&config.RemoteConfig{
-		Name: git.DefaultRemoteName,
-		URLs: []string{m.repoURL},
-	})
-	if err != nil {
-		return "", err
-	}
-	refs, err := remote.List(&git.ListOptions{Auth: m.auth})
+	refs, err := m.listRemoteRefs(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -227,18 +267,136 @@ func (m *nativeGitClient) LsRemote(revision string) (string, error) {
 	return "", fmt.Errorf("Unable to resolve '%s' to a commit SHA", revision)
 }
 
+// LsRefs returns the branches and tags of the remote repository
+func (m *nativeGitClient) LsRefs(ctx context.Context) (*Refs, error) {
+	refs, err := m.listRemoteRefs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return refsOf(refs), nil
+}
+
+// refsOf returns the sorted names of the branches and tags of the given references
+func refsOf(refs []*plumbing.Reference) *Refs {
+	res := Refs{Branches: []string{}, Tags: []string{}}
+	for _, ref := range refs {
+		if ref.Name().IsBranch() {
+			res.Branches = append(res.Branches, ref.Name().Short())
+		} else if ref.Name().IsTag() {
+			res.Tags = append(res.Tags, ref.Name().Short())
+		}
+	}
+	sort.Strings(res.Branches)
+	sort.Strings(res.Tags)
+	return &res
+}
+
+// listRemoteRefs lists the references of the remote repository. go-git does not support cancelling the
+// listing, so the upload-pack session is opened here: it is closed when the context is done, which aborts
+// the listing over SSH and git, and requests over HTTP(S) time out at the deadline of the context
+func (m *nativeGitClient) listRemoteRefs(ctx context.Context) ([]*plumbing.Reference, error) {
+	ep, err := transport.NewEndpoint(m.repoURL)
+	if err != nil {
+		return nil, err
+	}
+	var tr transport.Transport
+	switch ep.Protocol {
+	case "http", "https":
+		httpClient := &nethttp.Client{}
+		if deadline, ok := ctx.Deadline(); ok {
+			httpClient.Timeout = time.Until(deadline)
+		}
+		tr = http.NewClient(httpClient)
+	default:
+		tr, err = client.NewClient(ep)
+		if err != nil {
+			return nil, err
+		}
+	}
+	session, err := tr.NewUploadPackSession(ep, m.auth)
+	if err != nil {
+		return nil, err
+	}
+	type listResult struct {
+		refs []*plumbing.Reference
+		err  error
+	}
+	resCh := make(chan listResult, 1)
+	go func() {
+		refs, err := advertisedReferences(session)
+		resCh <- listResult{refs: refs, err: err}
+	}()
+	select {
+	case res := <-resCh:
+		if err := session.Close(); err != nil && res.err == nil {
+			return nil, err
+		}
+		return res.refs, res.err
+	case <-ctx.Done():
+		_ = session.Close()
+		<-resCh
+		return nil, ctx.Err()
+	}
+}
+
+// advertisedReferences returns the references advertised by the remote of the session
+func advertisedReferences(session transport.UploadPackSession) ([]*plumbing.Reference, error) {
+	ar, err := session.AdvertisedReferences()
+	if err != nil {
+		return nil, err
+	}
+	allRefs, err := ar.AllReferences()
+	if err != nil {
+		return nil, err
+	}
+	var refs []*plumbing.Reference
+	for _, ref := range allRefs {
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
+
 // CommitSHA returns current commit sha from `git rev-parse HEAD`
-func (m *nativeGitClient) CommitSHA() (string, error) {
-	out, err := m.runCmd("git", "rev-parse", "HEAD")
+func (m *nativeGitClient) CommitSHA(ctx context.Context) (string, error) {
+	out, err := m.runCmd(ctx, "git", "rev-parse", "HEAD")
 	if err != nil {
 		return "", err
 	}
 	return strings.TrimSpace(out), nil
 }
 
-// runCmd is a convenience function to run a command in a given directory and return its output
-func (m *nativeGitClient) runCmd(command string, args ...string) (string, error) {
-	cmd := exec.Command(command, args...)
+// RevisionMetadata returns the metadata of the given revision. Signatures are verified with the keys
+// of the GPG keyring of the process, and are reported as unverified if it does not hold the signing key
+func (m *nativeGitClient) RevisionMetadata(ctx context.Context, revision string) (*RevisionMetadata, error) {
+	out, err := m.runCmd(ctx, "git", "show", "-s", "--format=%an <%ae>%x00%ct%x00%G?%x00%B", revision)
+	if err != nil {
+		return nil, err
+	}
+	fields := strings.SplitN(out, "\x00", 4)
+	if len(fields) != 4 {
+		return nil, fmt.Errorf("unexpected metadata of %s: %s", revision, out)
+	}
+	seconds, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected commit time of %s: %v", revision, err)
+	}
+	out, err = m.runCmd(ctx, "git", "tag", "--points-at", revision)
+	if err != nil {
+		return nil, err
+	}
+	return &RevisionMetadata{
+		Author:          fields[0],
+		Date:            time.Unix(seconds, 0),
+		Message:         strings.TrimSpace(fields[3]),
+		Tags:            strings.Fields(out),
+		SignatureStatus: signatureStatuses[fields[2]],
+	}, nil
+}
+
+// runCmd is a convenience function to run a command in a given directory and return its output. The
+// command is killed if the context is done before it completes
+func (m *nativeGitClient) runCmd(ctx context.Context, command string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, command, args...)
 	log.Debug(strings.Join(cmd.Args, " "))
 	cmd.Dir = m.root
 	env := os.Environ()
@@ -251,6 +409,9 @@ func (m *nativeGitClient) runCmd(command string, args ...string) (string, error)
 		log.Debug(string(out))
 	}
 	if err != nil {
+		if ctx.Err() != nil {
+			return string(out), fmt.Errorf("'%s' aborted: %v", strings.Join(cmd.Args, " "), ctx.Err())
+		}
 		exErr, ok := err.(*exec.ExitError)
 		if ok {
 			errOutput := strings.Split(string(exErr.Stderr), "\n")[0]
@@ -1,7 +1,9 @@
 package git
 
 import (
+	"context"
 	"net/url"
+	"path/filepath"
 	"regexp"
 	"strings"
 )
@@ -36,6 +38,20 @@ func IsTruncatedCommitSHA(sha string) bool {
 	return truncatedCommitSHARegex.MatchString(sha)
 }
 
+// IsChangedInPaths returns whether one of the changed files is one of the given paths, or is in one of
+// them. Both the files and the paths are relative to the root of the repository, which is the "." path
+func IsChangedInPaths(changedFiles []string, paths []string) bool {
+	for _, path := range paths {
+		path = strings.Trim(filepath.Clean(path), "/")
+		for _, file := range changedFiles {
+			if path == "." || path == "" || file == path || strings.HasPrefix(file, path+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // NormalizeGitURL normalizes a git URL for lookup and storage
 func NormalizeGitURL(repo string) string {
 	repo = strings.TrimSpace(repo)
@@ -72,11 +88,11 @@ func IsSSHURL(url string) bool {
 }
 
 // TestRepo tests if a repo exists and is accessible with the given credentials
-func TestRepo(repo, username, password string, sshPrivateKey string) error {
+func TestRepo(ctx context.Context, repo, username, password string, sshPrivateKey string) error {
 	clnt, err := NewFactory().NewClient(repo, "", username, password, sshPrivateKey)
 	if err != nil {
 		return err
 	}
-	_, err = clnt.LsRemote("HEAD")
+	_, err = clnt.LsRemote(ctx, "HEAD")
 	return err
 }
@@ -1,9 +1,14 @@
 package git
 
 import (
+	"context"
+	"io/ioutil"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"gopkg.in/src-d/go-git.v4/plumbing"
 )
 
 func TestIsCommitSHA(t *testing.T) {
@@ -18,6 +23,16 @@ func TestIsCommitSHA(t *testing.T) {
 	assert.False(t, IsTruncatedCommitSHA("branch-name"))
 }
 
+func TestIsChangedInPaths(t *testing.T) {
+	changedFiles := []string{"apps/guestbook/deployment.yaml", "README.md"}
+	assert.True(t, IsChangedInPaths(changedFiles, []string{"apps/guestbook"}))
+	assert.True(t, IsChangedInPaths(changedFiles, []string{"apps/other", "/README.md"}))
+	assert.True(t, IsChangedInPaths(changedFiles, []string{"."}))
+	assert.False(t, IsChangedInPaths(changedFiles, []string{"apps/guest"}))
+	assert.False(t, IsChangedInPaths(changedFiles, []string{"apps/other"}))
+	assert.False(t, IsChangedInPaths(nil, []string{"."}))
+}
+
 func TestEnsurePrefix(t *testing.T) {
 	data := [][]string{
 		{"world", "hello", "helloworld"},
@@ -106,13 +121,13 @@ func TestLsRemote(t *testing.T) {
 		//"4e22a3c",
 	}
 	for _, revision := range xpass {
-		commitSHA, err := clnt.LsRemote(revision)
+		commitSHA, err := clnt.LsRemote(context.Background(), revision)
 		assert.NoError(t, err)
 		assert.True(t, IsCommitSHA(commitSHA))
 	}
 
 	// We do not resolve truncated git hashes and return the commit as-is if it appears to be a commit
-	commitSHA, err := clnt.LsRemote("4e22a3c")
+	commitSHA, err := clnt.LsRemote(context.Background(), "4e22a3c")
 	assert.NoError(t, err)
 	assert.False(t, IsCommitSHA(commitSHA))
 	assert.True(t, IsTruncatedCommitSHA(commitSHA))
@@ -122,7 +137,54 @@ func TestLsRemote(t *testing.T) {
 		"4e22a3", // too short (6 characters)
 	}
 	for _, revision := range xfail {
-		_, err := clnt.LsRemote(revision)
+		_, err := clnt.LsRemote(context.Background(), revision)
 		assert.Error(t, err)
 	}
 }
+
+func TestRunCmdAborted(t *testing.T) {
+	clnt := &nativeGitClient{root: "/tmp"}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := clnt.runCmd(ctx, "git", "version")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "aborted")
+}
+
+func TestRefsOf(t *testing.T) {
+	hash := plumbing.NewHash("9d921f65f3c5373b682e2eb4b37afba6592e8f8b")
+	refs := refsOf([]*plumbing.Reference{
+		plumbing.NewSymbolicReference("HEAD", "refs/heads/master"),
+		plumbing.NewHashReference("refs/heads/master", hash),
+		plumbing.NewHashReference("refs/heads/feature/login", hash),
+		plumbing.NewHashReference("refs/tags/v1.0.0", hash),
+		plumbing.NewHashReference("refs/pull/1/head", hash),
+	})
+	assert.Equal(t, []string{"feature/login", "master"}, refs.Branches)
+	assert.Equal(t, []string{"v1.0.0"}, refs.Tags)
+}
+
+func TestRevisionMetadata(t *testing.T) {
+	dir, err := ioutil.TempDir("", "git")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	clnt := &nativeGitClient{root: dir}
+	for _, args := range [][]string{
+		{"init"},
+		{"-c", "user.name=argo-cd", "-c", "user.email=argo-cd@example.com", "commit", "--allow-empty", "-m", "Update guestbook\n\nDetails"},
+		{"tag", "v1.0.0"},
+	} {
+		_, err = clnt.runCmd(context.Background(), "git", args...)
+		assert.NoError(t, err)
+	}
+	commitSHA, err := clnt.CommitSHA(context.Background())
+	assert.NoError(t, err)
+
+	metadata, err := clnt.RevisionMetadata(context.Background(), commitSHA)
+	assert.NoError(t, err)
+	assert.Equal(t, "argo-cd <argo-cd@example.com>", metadata.Author)
+	assert.Equal(t, "Update guestbook\n\nDetails", metadata.Message)
+	assert.Equal(t, []string{"v1.0.0"}, metadata.Tags)
+	assert.Empty(t, metadata.SignatureStatus)
+	assert.WithinDuration(t, time.Now(), metadata.Date, time.Minute)
+}
@@ -1,7 +1,11 @@
 package grpc
 
 import (
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -73,3 +77,128 @@ func ErrorCodeStreamServerInterceptor() grpc.StreamServerInterceptor {
 		return kubeErrToGRPC(err)
 	}
 }
+
+// ErrorReason is the machine-readable reason of an API error. It is carried in the details of the gRPC
+// status of the error, so that clients can branch on the type of the error instead of parsing its message
+type ErrorReason string
+
+const (
+	// ErrorReasonRepoAuthFailed is the reason of errors caused by a git repository which cannot be accessed
+	ErrorReasonRepoAuthFailed ErrorReason = "RepoAuthFailed"
+	// ErrorReasonProjectViolation is the reason of errors caused by a source, a destination or a resource
+	// which is not permitted in the project of an application
+	ErrorReasonProjectViolation ErrorReason = "ProjectViolation"
+	// ErrorReasonClusterUnreachable is the reason of errors caused by a cluster which cannot be reached
+	ErrorReasonClusterUnreachable ErrorReason = "ClusterUnreachable"
+	// ErrorReasonManifestError is the reason of errors caused by manifests which cannot be generated
+	ErrorReasonManifestError ErrorReason = "ManifestError"
+	// ErrorReasonInvalidSpec is the reason of the other errors caused by an invalid application spec
+	ErrorReasonInvalidSpec ErrorReason = "InvalidSpec"
+)
+
+// ErrorDetail is a machine-readable detail of an API error
+type ErrorDetail struct {
+	// Reason is the type of the error
+	Reason ErrorReason `json:"reason"`
+	// Subject is what caused the error, relative to its reason (e.g. the URL of a repository, or the
+	// name of a project)
+	Subject string `json:"subject"`
+	// Message is the human-readable description of the error
+	Message string `json:"message"`
+}
+
+// NewError returns a gRPC error with the given code and message, whose status details hold the given
+// details. The details are encoded as the violations of a google.rpc.PreconditionFailure, whose types
+// are the reasons of the error
+func NewError(code codes.Code, details []ErrorDetail, format string, args ...interface{}) error {
+	st := status.Newf(code, format, args...)
+	if len(details) == 0 {
+		return st.Err()
+	}
+	failure := &errdetails.PreconditionFailure{}
+	for _, detail := range details {
+		failure.Violations = append(failure.Violations, &errdetails.PreconditionFailure_Violation{
+			Type:        string(detail.Reason),
+			Subject:     detail.Subject,
+			Description: detail.Message,
+		})
+	}
+	detailed, err := st.WithDetails(failure)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// NewReasonError returns a gRPC error with the given code and message, with a single detail of the given
+// reason and subject
+func NewReasonError(code codes.Code, reason ErrorReason, subject string, format string, args ...interface{}) error {
+	st := status.Newf(code, format, args...)
+	return NewError(code, []ErrorDetail{{Reason: reason, Subject: subject, Message: st.Message()}}, "%s", st.Message())
+}
+
+// GetErrorDetails returns the machine-readable details of an error returned by NewError, or nil if the
+// error has none
+func GetErrorDetails(err error) []ErrorDetail {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+	var details []ErrorDetail
+	for _, detail := range st.Details() {
+		failure, ok := detail.(*errdetails.PreconditionFailure)
+		if !ok {
+			continue
+		}
+		for _, violation := range failure.Violations {
+			details = append(details, ErrorDetail{
+				Reason:  ErrorReason(violation.Type),
+				Subject: violation.Subject,
+				Message: violation.Description,
+			})
+		}
+	}
+	return details
+}
+
+// HasErrorReason returns whether an error has a detail of the given reason
+func HasErrorReason(err error, reason ErrorReason) bool {
+	for _, detail := range GetErrorDetails(err) {
+		if detail.Reason == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// httpErrorBody is the body of the REST error responses of errors with details. It extends the body of
+// the error responses of grpc-gateway, which only holds the message and the code of the error
+type httpErrorBody struct {
+	Error   string        `json:"error"`
+	Code    int32         `json:"code"`
+	Details []ErrorDetail `json:"details"`
+}
+
+// errorBodyMarshaler is a marshaler which writes the given body in place of the error responses of grpc-gateway
+type errorBodyMarshaler struct {
+	runtime.Marshaler
+	body *httpErrorBody
+}
+
+// Marshal implements runtime.Marshaler
+func (m *errorBodyMarshaler) Marshal(_ interface{}) ([]byte, error) {
+	return m.Marshaler.Marshal(m.body)
+}
+
+// HTTPError replies to a REST request with an error like runtime.DefaultHTTPError does, adding the
+// machine-readable details of the error, if any, in the details field of the body of the response
+func HTTPError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if details := GetErrorDetails(err); len(details) > 0 {
+		st := status.Convert(err)
+		marshaler = &errorBodyMarshaler{
+			Marshaler: marshaler,
+			body:      &httpErrorBody{Error: st.Message(), Code: int32(st.Code()), Details: details},
+		}
+	}
+	runtime.DefaultHTTPError(ctx, mux, marshaler, w, r, err)
+}
@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewError(t *testing.T) {
+	err := NewError(codes.InvalidArgument, []ErrorDetail{
+		{Reason: ErrorReasonRepoAuthFailed, Subject: "https://github.com/argoproj/argocd-example-apps", Message: "authentication required"},
+		{Reason: ErrorReasonProjectViolation, Subject: "default", Message: "destination not permitted"},
+	}, "application spec is invalid")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "application spec is invalid", status.Convert(err).Message())
+	assert.Equal(t, []ErrorDetail{
+		{Reason: ErrorReasonRepoAuthFailed, Subject: "https://github.com/argoproj/argocd-example-apps", Message: "authentication required"},
+		{Reason: ErrorReasonProjectViolation, Subject: "default", Message: "destination not permitted"},
+	}, GetErrorDetails(err))
+	assert.True(t, HasErrorReason(err, ErrorReasonProjectViolation))
+	assert.False(t, HasErrorReason(err, ErrorReasonClusterUnreachable))
+}
+
+func TestNewReasonError(t *testing.T) {
+	err := NewReasonError(codes.Unavailable, ErrorReasonClusterUnreachable, "https://kubernetes.default.svc", "connection refused: %s", "dial tcp")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, []ErrorDetail{{
+		Reason:  ErrorReasonClusterUnreachable,
+		Subject: "https://kubernetes.default.svc",
+		Message: "connection refused: dial tcp",
+	}}, GetErrorDetails(err))
+}
+
+func TestGetErrorDetailsWithoutDetails(t *testing.T) {
+	assert.Nil(t, GetErrorDetails(nil))
+	assert.Nil(t, GetErrorDetails(fmt.Errorf("plain error")))
+	assert.Nil(t, GetErrorDetails(status.Errorf(codes.NotFound, "not found")))
+}
+
+func TestHTTPError(t *testing.T) {
+	mux := runtime.NewServeMux()
+	marshaler := &runtime.JSONBuiltin{}
+
+	w := httptest.NewRecorder()
+	err := NewReasonError(codes.Unavailable, ErrorReasonClusterUnreachable, "https://kubernetes.default.svc", "connection refused")
+	HTTPError(context.Background(), mux, marshaler, w, httptest.NewRequest("GET", "/api/v1/clusters", nil), err)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.JSONEq(t, `{"error":"connection refused","code":14,"details":[{"reason":"ClusterUnreachable","subject":"https://kubernetes.default.svc","message":"connection refused"}]}`, w.Body.String())
+
+	// the errors without details have the body of the default error responses
+	w = httptest.NewRecorder()
+	HTTPError(context.Background(), mux, marshaler, w, httptest.NewRequest("GET", "/api/v1/clusters", nil), status.Errorf(codes.NotFound, "not found"))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.JSONEq(t, `{"error":"not found","code":5}`, w.Body.String())
+}
@@ -0,0 +1,177 @@
+package grpc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/http2"
+	"google.golang.org/grpc"
+)
+
+const (
+	grpcContentType        = "application/grpc"
+	grpcWebContentType     = "application/grpc-web"
+	grpcWebTextContentType = "application/grpc-web-text"
+	// grpcWebTrailerFlag flags the frame holding the trailers at the end of a gRPC-Web response body
+	grpcWebTrailerFlag = 0x80
+)
+
+// IsGRPCWebRequest returns whether the given HTTP request is a gRPC-Web request
+func IsGRPCWebRequest(req *http.Request) bool {
+	return req.Method == http.MethodPost && strings.HasPrefix(req.Header.Get("Content-Type"), grpcWebContentType)
+}
+
+// grpcWebHandler serves gRPC-Web requests with a gRPC server
+type grpcWebHandler struct {
+	server *grpc.Server
+}
+
+// NewGRPCWebHandler returns an HTTP handler serving the gRPC-Web requests, including the base64
+// encoded application/grpc-web-text requests, with the given gRPC server. gRPC-Web requests are made
+// over HTTP/1.1, which allows browsers, and clients behind proxies not supporting HTTP/2 end to end,
+// to call the gRPC API. Client streaming calls are not supported by gRPC-Web
+func NewGRPCWebHandler(server *grpc.Server) http.Handler {
+	return &grpcWebHandler{server: server}
+}
+
+func (h *grpcWebHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	contentType := req.Header.Get("Content-Type")
+	text := strings.HasPrefix(contentType, grpcWebTextContentType)
+	// the gRPC server only serves HTTP/2 requests of the gRPC content type, with the same framing of
+	// the messages as gRPC-Web
+	grpcReq := req.WithContext(req.Context())
+	grpcReq.ProtoMajor, grpcReq.ProtoMinor, grpcReq.Proto = 2, 0, "HTTP/2.0"
+	grpcReq.Header = make(http.Header)
+	for k, v := range req.Header {
+		grpcReq.Header[k] = v
+	}
+	grpcReq.Header.Set("Content-Type", grpcContentType+grpcWebContentSubtype(contentType))
+	grpcReq.Header.Del("Content-Length")
+	if text {
+		grpcReq.Body = struct {
+			io.Reader
+			io.Closer
+		}{base64.NewDecoder(base64.StdEncoding, req.Body), req.Body}
+	}
+	ww := &grpcWebResponseWriter{
+		w:           w,
+		header:      make(http.Header),
+		contentType: contentType,
+		text:        text,
+	}
+	h.server.ServeHTTP(ww, grpcReq)
+	ww.writeTrailers()
+}
+
+// grpcWebContentSubtype returns the suffix of the given gRPC-Web content type (e.g. +proto)
+func grpcWebContentSubtype(contentType string) string {
+	for _, prefix := range []string{grpcWebTextContentType, grpcWebContentType} {
+		if strings.HasPrefix(contentType, prefix) {
+			return strings.TrimPrefix(contentType, prefix)
+		}
+	}
+	return ""
+}
+
+// grpcWebResponseWriter translates the gRPC responses written by the gRPC server into gRPC-Web
+// responses, which send the trailers in the last frame of the body
+type grpcWebResponseWriter struct {
+	w           http.ResponseWriter
+	header      http.Header
+	contentType string
+	text        bool
+	wroteHeader bool
+}
+
+func (ww *grpcWebResponseWriter) Header() http.Header {
+	return ww.header
+}
+
+func (ww *grpcWebResponseWriter) WriteHeader(code int) {
+	if ww.wroteHeader {
+		return
+	}
+	ww.wroteHeader = true
+	trailers := ww.declaredTrailers()
+	for k, v := range ww.header {
+		if k == "Trailer" || trailers[k] || strings.HasPrefix(k, http2.TrailerPrefix) {
+			continue
+		}
+		ww.w.Header()[k] = v
+	}
+	ww.w.Header().Set("Content-Type", ww.contentType)
+	ww.w.WriteHeader(code)
+}
+
+func (ww *grpcWebResponseWriter) Write(b []byte) (int, error) {
+	if !ww.wroteHeader {
+		ww.WriteHeader(http.StatusOK)
+	}
+	if ww.text {
+		if _, err := ww.w.Write([]byte(base64.StdEncoding.EncodeToString(b))); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	}
+	return ww.w.Write(b)
+}
+
+func (ww *grpcWebResponseWriter) Flush() {
+	if !ww.wroteHeader {
+		ww.WriteHeader(http.StatusOK)
+	}
+	if flusher, ok := ww.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (ww *grpcWebResponseWriter) CloseNotify() <-chan bool {
+	if notifier, ok := ww.w.(http.CloseNotifier); ok {
+		return notifier.CloseNotify()
+	}
+	return make(chan bool)
+}
+
+// declaredTrailers returns the canonical keys of the trailers declared by the Trailer header
+func (ww *grpcWebResponseWriter) declaredTrailers() map[string]bool {
+	trailers := make(map[string]bool)
+	for _, v := range ww.header["Trailer"] {
+		for _, k := range strings.Split(v, ",") {
+			trailers[http.CanonicalHeaderKey(strings.TrimSpace(k))] = true
+		}
+	}
+	return trailers
+}
+
+// writeTrailers writes the trailers set by the gRPC server, such as the status of the call, in the
+// trailer frame of the body
+func (ww *grpcWebResponseWriter) writeTrailers() {
+	trailers := ww.declaredTrailers()
+	if len(trailers) == 0 {
+		// the request was rejected by the gRPC server before being handled
+		return
+	}
+	var buf bytes.Buffer
+	for k, v := range ww.header {
+		name := k
+		if strings.HasPrefix(k, http2.TrailerPrefix) {
+			name = strings.TrimPrefix(k, http2.TrailerPrefix)
+		} else if !trailers[k] {
+			continue
+		}
+		for _, value := range v {
+			_, _ = fmt.Fprintf(&buf, "%s: %s\r\n", strings.ToLower(name), value)
+		}
+	}
+	frame := make([]byte, 5+buf.Len())
+	frame[0] = grpcWebTrailerFlag
+	binary.BigEndian.PutUint32(frame[1:5], uint32(buf.Len()))
+	copy(frame[5:], buf.Bytes())
+	_, _ = ww.Write(frame)
+	ww.Flush()
+}
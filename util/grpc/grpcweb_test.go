@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestGRPCWebHandler(t *testing.T) {
+	handler := NewGRPCWebHandler(grpc.NewServer())
+
+	t.Run("Binary", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/version.VersionService/Version", bytes.NewReader([]byte{0, 0, 0, 0, 0}))
+		req.Header.Set("Content-Type", "application/grpc-web+proto")
+		assert.True(t, IsGRPCWebRequest(req))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/grpc-web+proto", w.Header().Get("Content-Type"))
+		assert.Empty(t, w.Header().Get("Grpc-Status"))
+		body := w.Body.Bytes()
+		if assert.True(t, len(body) > 5) {
+			assert.Equal(t, byte(grpcWebTrailerFlag), body[0])
+			// the service is not registered
+			assert.Contains(t, string(body[5:]), "grpc-status: 12\r\n")
+		}
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/version.VersionService/Version", strings.NewReader(base64.StdEncoding.EncodeToString([]byte{0, 0, 0, 0, 0})))
+		req.Header.Set("Content-Type", "application/grpc-web-text")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		assert.Equal(t, "application/grpc-web-text", w.Header().Get("Content-Type"))
+		body, err := base64.StdEncoding.DecodeString(w.Body.String())
+		assert.Nil(t, err)
+		assert.Contains(t, string(body), "grpc-status: 12\r\n")
+	})
+}
+
+func TestIsGRPCWebRequest(t *testing.T) {
+	req := httptest.NewRequest("POST", "/application.ApplicationService/List", nil)
+	req.Header.Set("Content-Type", "application/grpc")
+	assert.False(t, IsGRPCWebRequest(req))
+	req = httptest.NewRequest("GET", "/api/v1/applications", nil)
+	assert.False(t, IsGRPCWebRequest(req))
+}
@@ -1,6 +1,7 @@
 package healthz
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 
@@ -19,3 +20,32 @@ func ServeHealthCheck(mux *http.ServeMux, f func() error) {
 		}
 	})
 }
+
+// Check is a named check of a dependency of a service, which returns an error if the dependency is
+// unavailable and nil otherwise.
+type Check struct {
+	Name  string
+	Check func() error
+}
+
+// ServeReadinessCheck serves the readiness check endpoint.
+// ServeReadinessCheck reports the result of each of the provided checks, and fails if any of them fails.
+func ServeReadinessCheck(mux *http.ServeMux, checks ...Check) {
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		var results bytes.Buffer
+		ready := true
+		for _, check := range checks {
+			if err := check.Check(); err != nil {
+				ready = false
+				log.Warnf("Readiness check %s failed: %v", check.Name, err)
+				fmt.Fprintf(&results, "[-]%s failed: %v\n", check.Name, err)
+			} else {
+				fmt.Fprintf(&results, "[+]%s ok\n", check.Name)
+			}
+		}
+		if !ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_, _ = w.Write(results.Bytes())
+	})
+}
@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestHealthCheck(t *testing.T) {
@@ -57,3 +60,31 @@ func TestHealthCheck(t *testing.T) {
 	}
 
 }
+
+func TestReadinessCheck(t *testing.T) {
+	repoServerDown := false
+	mux := http.NewServeMux()
+	ServeReadinessCheck(mux, Check{
+		Name:  "kubernetes",
+		Check: func() error { return nil },
+	}, Check{
+		Name: "repo-server",
+		Check: func() error {
+			if repoServerDown {
+				return fmt.Errorf("connection refused")
+			}
+			return nil
+		},
+	})
+
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest("GET", "/readyz", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "[+]kubernetes ok\n[+]repo-server ok\n", rr.Body.String())
+
+	repoServerDown = true
+	rr = httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest("GET", "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	assert.Equal(t, "[+]kubernetes ok\n[-]repo-server failed: connection refused\n", rr.Body.String())
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/url"
+	"os"
 	"os/exec"
 	"path"
 	"strings"
@@ -25,10 +26,14 @@ type Helm interface {
 	GetParameters(valuesFiles []string) ([]*argoappv1.ComponentParameter, error)
 	// DependencyBuild runs `helm dependency build` to download a chart's dependencies
 	DependencyBuild() error
+	// HasDependencies returns whether the chart declares dependencies
+	HasDependencies() (bool, error)
 	// SetHome sets the helm home location (default "~/.helm")
 	SetHome(path string)
 	// Init runs `helm init --client-only`
 	Init() error
+	// RepoAdd adds a chart repository to the helm home, with the given credentials if set
+	RepoAdd(name, url, username, password string) error
 }
 
 // NewHelmApp create a new wrapper to run commands on the `helm` command-line tool.
@@ -66,15 +71,82 @@ func (h *helm) DependencyBuild() error {
 	return err
 }
 
+// HasDependencies returns whether the chart declares dependencies, either in its requirements.yaml
+// file or in its Chart.yaml file
+func (h *helm) HasDependencies() (bool, error) {
+	for _, file := range []string{"requirements.yaml", "Chart.yaml"} {
+		data, err := ioutil.ReadFile(path.Join(h.path, file))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return false, err
+		}
+		var requirements struct {
+			Dependencies []interface{} `json:"dependencies"`
+		}
+		if err = yaml.Unmarshal(data, &requirements); err != nil {
+			return false, fmt.Errorf("failed to parse %s: %v", file, err)
+		}
+		if len(requirements.Dependencies) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (h *helm) SetHome(home string) {
 	h.home = home
 }
 
+// Init initializes the helm home, without refreshing the index of the default repository, which is
+// only downloaded if a chart depends on it
 func (h *helm) Init() error {
-	_, err := h.helmCmd("init", "--client-only")
+	_, err := h.helmCmd("init", "--client-only", "--skip-refresh")
 	return err
 }
 
+// RepoAdd adds the chart repository to the repositories file of the helm home and downloads its index,
+// as `helm repo add` does. The credentials are written to the repositories file rather than passed to
+// `helm repo add`, which only accepts them as arguments, so that they are not visible in the process list
+func (h *helm) RepoAdd(name, url, username, password string) error {
+	if h.home == "" {
+		return fmt.Errorf("helm home is not set")
+	}
+	index, err := getIndexData(url, username, password)
+	if err != nil {
+		return err
+	}
+	cacheFile := path.Join(h.home, "repository", "cache", fmt.Sprintf("%s-index.yaml", name))
+	err = ioutil.WriteFile(cacheFile, index, 0600)
+	if err != nil {
+		return err
+	}
+	reposFile := path.Join(h.home, "repository", "repositories.yaml")
+	data, err := ioutil.ReadFile(reposFile)
+	if err != nil {
+		return err
+	}
+	var repos map[string]interface{}
+	err = yaml.Unmarshal(data, &repos)
+	if err != nil {
+		return fmt.Errorf("failed to parse %s: %v", reposFile, err)
+	}
+	entries, _ := repos["repositories"].([]interface{})
+	repos["repositories"] = append(entries, map[string]interface{}{
+		"name":     name,
+		"url":      url,
+		"cache":    cacheFile,
+		"username": username,
+		"password": password,
+	})
+	data, err = yaml.Marshal(repos)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(reposFile, data, 0600)
+}
+
 func (h *helm) GetParameters(valuesFiles []string) ([]*argoappv1.ComponentParameter, error) {
 	out, err := h.helmCmd("inspect", "values", ".")
 	if err != nil {
@@ -118,7 +190,7 @@ func (h *helm) helmCmd(args ...string) (string, error) {
 	cmd := exec.Command("helm", args...)
 	cmd.Dir = h.path
 	if h.home != "" {
-		cmd.Env = append(cmd.Env, fmt.Sprintf("HELM_HOME=%s", h.home))
+		cmd.Env = append(os.Environ(), fmt.Sprintf("HELM_HOME=%s", h.home))
 	}
 	cmdStr := strings.Join(cmd.Args, " ")
 	log.Info(cmdStr)
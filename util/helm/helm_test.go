@@ -2,9 +2,13 @@ package helm
 
 import (
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path"
 	"testing"
 
+	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
 	appsv1 "k8s.io/api/apps/v1"
 	apiv1 "k8s.io/api/core/v1"
@@ -117,3 +121,54 @@ func TestHelmDependencyBuild(t *testing.T) {
 	_, err = h.Template("wordpress", "", nil, nil)
 	assert.NoError(t, err)
 }
+
+func TestHelmHasDependencies(t *testing.T) {
+	hasDependencies, err := NewHelmApp("./testdata/wordpress").HasDependencies()
+	assert.NoError(t, err)
+	assert.True(t, hasDependencies)
+	hasDependencies, err = NewHelmApp("./testdata/redis").HasDependencies()
+	assert.NoError(t, err)
+	assert.False(t, hasDependencies)
+}
+
+func TestHelmRepoAdd(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok || username != "admin" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(testIndex))
+	}))
+	defer server.Close()
+	// the layout of a helm home created by `helm init --client-only --skip-refresh`
+	helmHome, err := ioutil.TempDir("", "")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(helmHome) }()
+	assert.NoError(t, os.MkdirAll(path.Join(helmHome, "repository", "cache"), 0755))
+	reposFile := path.Join(helmHome, "repository", "repositories.yaml")
+	assert.NoError(t, ioutil.WriteFile(reposFile, []byte("apiVersion: v1\nrepositories:\n- name: stable\n  url: https://kubernetes-charts.storage.googleapis.com\n"), 0644))
+
+	h := NewHelmApp("./testdata/wordpress")
+	h.SetHome(helmHome)
+	err = h.RepoAdd("private", server.URL, "admin", "secret")
+	assert.NoError(t, err)
+
+	index, err := ioutil.ReadFile(path.Join(helmHome, "repository", "cache", "private-index.yaml"))
+	assert.NoError(t, err)
+	assert.Equal(t, testIndex, string(index))
+	data, err := ioutil.ReadFile(reposFile)
+	assert.NoError(t, err)
+	var repos struct {
+		Repositories []map[string]string `json:"repositories"`
+	}
+	assert.NoError(t, yaml.Unmarshal(data, &repos))
+	if assert.Len(t, repos.Repositories, 2) {
+		assert.Equal(t, "stable", repos.Repositories[0]["name"])
+		assert.Equal(t, "private", repos.Repositories[1]["name"])
+		assert.Equal(t, server.URL, repos.Repositories[1]["url"])
+		assert.Equal(t, "admin", repos.Repositories[1]["username"])
+		assert.Equal(t, "secret", repos.Repositories[1]["password"])
+	}
+
+	assert.Error(t, h.RepoAdd("invalid", server.URL, "admin", "invalid"))
+}
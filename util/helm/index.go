@@ -0,0 +1,73 @@
+package helm
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+)
+
+// indexTimeout is the timeout of the requests fetching the index of a chart repository
+const indexTimeout = 30 * time.Second
+
+// maxIndexSize is the maximum size in bytes of the index of a chart repository which is read
+var maxIndexSize int64 = 64 * 1024 * 1024
+
+// Index is the index.yaml file of a chart repository
+type Index struct {
+	// Entries holds the versions of each chart of the repository, by chart name
+	Entries map[string][]ChartVersion `json:"entries"`
+}
+
+// ChartVersion is a version of a chart listed in the index of a chart repository
+type ChartVersion struct {
+	Version string `json:"version"`
+}
+
+// GetIndex downloads and parses the index.yaml file of the chart repository at the given URL, with
+// the given basic authentication credentials if set
+func GetIndex(repoURL, username, password string) (*Index, error) {
+	data, err := getIndexData(repoURL, username, password)
+	if err != nil {
+		return nil, err
+	}
+	var index Index
+	err = yaml.Unmarshal(data, &index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse index of %s: %v", repoURL, err)
+	}
+	return &index, nil
+}
+
+// getIndexData downloads the index.yaml file of the chart repository at the given URL
+func getIndexData(repoURL, username, password string) ([]byte, error) {
+	indexURL := strings.TrimSuffix(repoURL, "/") + "/index.yaml"
+	req, err := http.NewRequest("GET", indexURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if username != "" || password != "" {
+		req.SetBasicAuth(username, password)
+	}
+	client := http.Client{Timeout: indexTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get index of %s: %s", repoURL, resp.Status)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxIndexSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxIndexSize {
+		return nil, fmt.Errorf("index of %s exceeds the maximum size of %d bytes", repoURL, maxIndexSize)
+	}
+	return data, nil
+}
@@ -0,0 +1,54 @@
+package helm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testIndex = `apiVersion: v1
+entries:
+  redis:
+  - name: redis
+    version: 9.0.1
+  - name: redis
+    version: 8.1.0
+  wordpress:
+  - name: wordpress
+    version: 5.9.0
+`
+
+func TestGetIndex(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "admin" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/charts/index.yaml" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(testIndex))
+	}))
+	defer server.Close()
+
+	index, err := GetIndex(server.URL+"/charts/", "admin", "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]ChartVersion{
+		"redis":     {{Version: "9.0.1"}, {Version: "8.1.0"}},
+		"wordpress": {{Version: "5.9.0"}},
+	}, index.Entries)
+
+	_, err = GetIndex(server.URL+"/charts", "admin", "wrong")
+	assert.Error(t, err)
+
+	defer func(size int64) { maxIndexSize = size }(maxIndexSize)
+	maxIndexSize = int64(len(testIndex) - 1)
+	_, err = GetIndex(server.URL+"/charts", "admin", "secret")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "exceeds the maximum size")
+	}
+}
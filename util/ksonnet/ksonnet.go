@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/ghodss/yaml"
@@ -37,6 +38,23 @@ func Destination(data []byte, environment string) (*v1alpha1.ApplicationDestinat
 	return &envSpec.Destination, nil
 }
 
+// Environments returns the sorted names of the environments in app spec data
+func Environments(data []byte) ([]string, error) {
+	var appSpec struct {
+		Environments map[string]interface{}
+	}
+	err := yaml.Unmarshal(data, &appSpec)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal ksonnet spec app.yaml: %v", err)
+	}
+	envs := make([]string, 0, len(appSpec.Environments))
+	for env := range appSpec.Environments {
+		envs = append(envs, env)
+	}
+	sort.Strings(envs)
+	return envs, nil
+}
+
 // KsonnetApp represents a ksonnet application directory and provides wrapper functionality around
 // the `ks` command.
 type KsonnetApp interface {
@@ -49,6 +67,9 @@ type KsonnetApp interface {
 	// Destination returns the deployment destination for an environment
 	Destination(environment string) (*v1alpha1.ApplicationDestination, error)
 
+	// ListEnvs returns the names of the environments of the application
+	ListEnvs() ([]string, error)
+
 	// ListEnvParams returns list of environment parameters
 	ListEnvParams(environment string) ([]*v1alpha1.ComponentParameter, error)
 
@@ -148,6 +169,19 @@ func (k *ksonnetApp) Destination(environment string) (*v1alpha1.ApplicationDesti
 	return Destination(data, environment)
 }
 
+// ListEnvs returns the names of the environments of the application
+func (k *ksonnetApp) ListEnvs() ([]string, error) {
+	p, err := k.appYamlPath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(p)
+	if err != nil {
+		return nil, err
+	}
+	return Environments(data)
+}
+
 // ListEnvParams returns list of environment parameters
 func (k *ksonnetApp) ListEnvParams(environment string) ([]*v1alpha1.ComponentParameter, error) {
 	log.Infof("listing environment '%s' parameters", environment)
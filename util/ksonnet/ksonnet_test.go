@@ -34,6 +34,14 @@ func TestKsonnet(t *testing.T) {
 	assert.Equal(t, "https://1.2.3.4", defaultDest.Server)
 }
 
+func TestListEnvs(t *testing.T) {
+	ksApp, err := NewKsonnetApp(filepath.Join(testDataDir, testAppName))
+	assert.Nil(t, err)
+	envs, err := ksApp.ListEnvs()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{testEnvName}, envs)
+}
+
 func TestShow(t *testing.T) {
 	ksApp, err := NewKsonnetApp(filepath.Join(testDataDir, testAppName))
 	assert.Nil(t, err)
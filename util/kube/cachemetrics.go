@@ -0,0 +1,93 @@
+package kube
+
+import (
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/argoproj/argo-cd/util/cache"
+)
+
+// clusterCacheNames are the names of the caches of the cluster state, by prefix of their keys
+var clusterCacheNames = map[string]string{
+	"apires":  "api-resources",
+	"version": "server-version",
+}
+
+// clusterCacheName returns the name of the cache the key belongs to
+func clusterCacheName(key string) string {
+	prefix := strings.SplitN(key, "|", 2)[0]
+	if name, ok := clusterCacheNames[prefix]; ok {
+		return name
+	}
+	return prefix
+}
+
+// clusterCacheMetrics is a prometheus collector holding the hits, misses and evictions of the cache of
+// the API resources and versions of the clusters
+type clusterCacheMetrics struct {
+	hitCounter      *prometheus.CounterVec
+	missCounter     *prometheus.CounterVec
+	evictionCounter *prometheus.CounterVec
+}
+
+// apiResourceCacheMetrics are the metrics of apiResourceCache
+var apiResourceCacheMetrics = &clusterCacheMetrics{
+	hitCounter: prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_cluster_cache_hit_total",
+			Help: "Number of cluster cache lookups which found the item.",
+		},
+		[]string{"cache"},
+	),
+	missCounter: prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_cluster_cache_miss_total",
+			Help: "Number of cluster cache lookups which did not find the item.",
+		},
+		[]string{"cache"},
+	),
+	evictionCounter: prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_cluster_cache_eviction_total",
+			Help: "Number of items expired or deleted from the cluster cache.",
+		},
+		[]string{"cache"},
+	),
+}
+
+func init() {
+	apiResourceCache.OnEvicted(func(key string) {
+		apiResourceCacheMetrics.evictionCounter.WithLabelValues(clusterCacheName(key)).Inc()
+	})
+}
+
+// ClusterCacheMetrics returns the prometheus collector of the hits, misses and evictions of the cache of
+// the API resources and versions of the clusters, which is shared by all the clients of the process
+func ClusterCacheMetrics() prometheus.Collector {
+	return apiResourceCacheMetrics
+}
+
+// observeLookup records the result of a lookup of the given key
+func (m *clusterCacheMetrics) observeLookup(key string, err error) {
+	switch err {
+	case nil:
+		m.hitCounter.WithLabelValues(clusterCacheName(key)).Inc()
+	case cache.ErrCacheMiss:
+		m.missCounter.WithLabelValues(clusterCacheName(key)).Inc()
+	}
+}
+
+// Describe implements the prometheus.Collector interface
+func (m *clusterCacheMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.hitCounter.Describe(ch)
+	m.missCounter.Describe(ch)
+	m.evictionCounter.Describe(ch)
+}
+
+// Collect implements the prometheus.Collector interface
+func (m *clusterCacheMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.hitCounter.Collect(ch)
+	m.missCounter.Collect(ch)
+	m.evictionCounter.Collect(ch)
+}
@@ -22,9 +22,12 @@ import (
 )
 
 type Kubectl interface {
-	ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force bool) (string, error)
+	ApplyResource(ctx context.Context, config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force bool) (string, error)
+	ApplyResources(ctx context.Context, config *rest.Config, objs []*unstructured.Unstructured, namespace string, dryRun, force bool) ([]string, error)
 	ConvertToVersion(obj *unstructured.Unstructured, group, version string) (*unstructured.Unstructured, error)
-	DeleteResource(config *rest.Config, obj *unstructured.Unstructured, namespace string) error
+	DeleteResource(ctx context.Context, config *rest.Config, obj *unstructured.Unstructured, namespace string) error
+	GetResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error)
+	UpdateResource(ctx context.Context, config *rest.Config, obj *unstructured.Unstructured, namespace string) (*unstructured.Unstructured, error)
 	WatchResources(ctx context.Context, config *rest.Config, namespace string, selector func(kind schema.GroupVersionKind) metav1.ListOptions) (chan watch.Event, error)
 }
 
@@ -38,7 +41,14 @@ func (k KubectlCmd) WatchResources(
 	selector func(kind schema.GroupVersionKind) metav1.ListOptions,
 ) (chan watch.Event, error) {
 	log.Infof("Start watching for resources changes with in cluster %s", config.Host)
-	apiResIfs, err := filterAPIResources(config, watchSupported, namespace)
+	filter := watchSupported
+	if namespace != "" {
+		// cluster-scoped resources can't be watched within a namespace
+		filter = func(groupVersion string, apiResource *metav1.APIResource) bool {
+			return apiResource.Namespaced && watchSupported(groupVersion, apiResource)
+		}
+	}
+	apiResIfs, err := filterAPIResources(config, filter, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -65,28 +75,56 @@ func (k KubectlCmd) WatchResources(
 }
 
 // DeleteResource deletes resource
-func (k KubectlCmd) DeleteResource(config *rest.Config, obj *unstructured.Unstructured, namespace string) error {
-	dynamicIf, err := dynamic.NewForConfig(config)
+func (k KubectlCmd) DeleteResource(ctx context.Context, config *rest.Config, obj *unstructured.Unstructured, namespace string) error {
+	resourceIf, err := resourceInterface(ctx, config, obj.GroupVersionKind(), namespace)
 	if err != nil {
 		return err
 	}
-	gvk := obj.GroupVersionKind()
+	propagationPolicy := metav1.DeletePropagationForeground
+	return resourceIf.Delete(obj.GetName(), &metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+}
+
+// resourceInterface returns the dynamic client of the resources of the given kind
+func resourceInterface(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, namespace string) (dynamic.ResourceInterface, error) {
+	config = WithContext(ctx, config)
+	dynamicIf, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
 	disco, err := discovery.NewDiscoveryClientForConfig(config)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	apiResource, err := ServerResourceForGroupVersionKind(disco, gvk)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	resource := gvk.GroupVersion().WithResource(apiResource.Name)
-	resourceIf := ToResourceInterface(dynamicIf, apiResource, resource, namespace)
-	propagationPolicy := metav1.DeletePropagationForeground
-	return resourceIf.Delete(obj.GetName(), &metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+	return ToResourceInterface(dynamicIf, apiResource, resource, namespace), nil
+}
+
+// GetResource returns the live resource of the given kind and name
+func (k KubectlCmd) GetResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error) {
+	resourceIf, err := resourceInterface(ctx, config, gvk, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return resourceIf.Get(name, metav1.GetOptions{})
+}
+
+// UpdateResource updates a live resource. The update fails if the resource was modified since the
+// resource version of the given object
+func (k KubectlCmd) UpdateResource(ctx context.Context, config *rest.Config, obj *unstructured.Unstructured, namespace string) (*unstructured.Unstructured, error) {
+	log.Infof("Updating resource %s/%s in cluster: %s, namespace: %s", obj.GetKind(), obj.GetName(), config.Host, namespace)
+	resourceIf, err := resourceInterface(ctx, config, obj.GroupVersionKind(), namespace)
+	if err != nil {
+		return nil, err
+	}
+	return resourceIf.Update(obj, metav1.UpdateOptions{})
 }
 
 // ApplyResource performs an apply of a unstructured resource
-func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force bool) (string, error) {
+func (k KubectlCmd) ApplyResource(ctx context.Context, config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force bool) (string, error) {
 	log.Infof("Applying resource %s/%s in cluster: %s, namespace: %s", obj.GetKind(), obj.GetName(), config.Host, namespace)
 	f, err := ioutil.TempFile(kubectlTempDir, "")
 	if err != nil {
@@ -103,20 +141,21 @@ func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstruc
 		return "", err
 	}
 
+	globalFlags, applyFlags := kubectlVersionFlags(config)
 	var out []string
 	if obj.GetAPIVersion() == "rbac.authorization.k8s.io/v1" {
-		outReconcile, err := runKubectl(f.Name(), namespace, []string{"auth", "reconcile"}, manifestBytes, dryRun)
+		outReconcile, err := runKubectl(ctx, f.Name(), namespace, globalFlags, []string{"auth", "reconcile"}, manifestBytes, dryRun)
 		if err != nil {
 			return "", err
 		}
 		out = append(out, outReconcile)
 	}
 
-	applyArgs := []string{"apply"}
+	applyArgs := append([]string{"apply"}, applyFlags...)
 	if force {
 		applyArgs = append(applyArgs, "--force")
 	}
-	outApply, err := runKubectl(f.Name(), namespace, applyArgs, manifestBytes, dryRun)
+	outApply, err := runKubectl(ctx, f.Name(), namespace, globalFlags, applyArgs, manifestBytes, dryRun)
 	if err != nil {
 		return "", err
 	}
@@ -124,16 +163,109 @@ func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstruc
 	return strings.Join(out, "\n"), nil
 }
 
-func runKubectl(kubeconfigPath string, namespace string, args []string, manifestBytes []byte, dryRun bool) (string, error) {
-	cmdArgs := append(append([]string{"--kubeconfig", kubeconfigPath, "-n", namespace}, args...), "-f", "-")
+// ApplyResources applies the given objects with a single kubectl call, and returns the output of kubectl
+// about each object. The objects are not applied one by one, so the error does not tell which of them
+// failed to apply
+func (k KubectlCmd) ApplyResources(ctx context.Context, config *rest.Config, objs []*unstructured.Unstructured, namespace string, dryRun, force bool) ([]string, error) {
+	log.Infof("Applying %d resources in cluster: %s, namespace: %s", len(objs), config.Host, namespace)
+	f, err := ioutil.TempFile(kubectlTempDir, "")
+	if err != nil {
+		return nil, fmt.Errorf("Failed to generate temp file for kubeconfig: %v", err)
+	}
+	_ = f.Close()
+	err = WriteKubeConfig(config, namespace, f.Name())
+	if err != nil {
+		return nil, fmt.Errorf("Failed to write kubeconfig: %v", err)
+	}
+	defer deleteFile(f.Name())
+
+	globalFlags, applyFlags := kubectlVersionFlags(config)
+	outputs := make([][]string, len(objs))
+	var rbacObjs []*unstructured.Unstructured
+	for _, obj := range objs {
+		if obj.GetAPIVersion() == "rbac.authorization.k8s.io/v1" {
+			rbacObjs = append(rbacObjs, obj)
+		}
+	}
+	if len(rbacObjs) > 0 {
+		manifestBytes, err := marshalList(rbacObjs)
+		if err != nil {
+			return nil, err
+		}
+		outReconcile, err := runKubectl(ctx, f.Name(), namespace, globalFlags, []string{"auth", "reconcile"}, manifestBytes, dryRun)
+		if err != nil {
+			return nil, err
+		}
+		for i, obj := range objs {
+			if obj.GetAPIVersion() == "rbac.authorization.k8s.io/v1" {
+				outputs[i] = append(outputs[i], outputOf(outReconcile, obj))
+			}
+		}
+	}
+
+	manifestBytes, err := marshalList(objs)
+	if err != nil {
+		return nil, err
+	}
+	applyArgs := append([]string{"apply"}, applyFlags...)
+	if force {
+		applyArgs = append(applyArgs, "--force")
+	}
+	outApply, err := runKubectl(ctx, f.Name(), namespace, globalFlags, applyArgs, manifestBytes, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]string, len(objs))
+	for i, obj := range objs {
+		res[i] = strings.Join(append(outputs[i], outputOf(outApply, obj)), "\n")
+	}
+	return res, nil
+}
+
+// marshalList returns the manifest of a list holding the given objects
+func marshalList(objs []*unstructured.Unstructured) ([]byte, error) {
+	items := make([]interface{}, len(objs))
+	for i := range objs {
+		items[i] = objs[i].Object
+	}
+	return json.Marshal(map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "List",
+		"items":      items,
+	})
+}
+
+// outputOf returns the line of the output of kubectl about the given object (e.g.
+// deployment.apps/guestbook-ui configured), or the whole output if there is no such line
+func outputOf(out string, obj *unstructured.Unstructured) string {
+	kind := strings.ToLower(obj.GetKind())
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimSpace(line)
+		resource := strings.SplitN(line, " ", 2)[0]
+		if strings.HasPrefix(resource, kind+".") || strings.HasPrefix(resource, kind+"/") || resource == kind {
+			if strings.HasSuffix(resource, "/"+obj.GetName()) || strings.Contains(line, fmt.Sprintf(" \"%s\" ", obj.GetName())) {
+				return line
+			}
+		}
+	}
+	return out
+}
+
+// runKubectl runs kubectl with the given global flags and manifest as input. The process is killed if the
+// context is done before it completes
+func runKubectl(ctx context.Context, kubeconfigPath string, namespace string, globalFlags []string, args []string, manifestBytes []byte, dryRun bool) (string, error) {
+	cmdArgs := append(append(append([]string{"--kubeconfig", kubeconfigPath, "-n", namespace}, globalFlags...), args...), "-f", "-")
 	if dryRun {
 		cmdArgs = append(cmdArgs, "--dry-run")
 	}
-	cmd := exec.Command("kubectl", cmdArgs...)
+	cmd := exec.CommandContext(ctx, "kubectl", cmdArgs...)
 	log.Info(cmd.Args)
 	cmd.Stdin = bytes.NewReader(manifestBytes)
 	out, err := cmd.Output()
 	if err != nil {
+		if ctx.Err() != nil {
+			return "", fmt.Errorf("kubectl %s aborted: %v", args[0], ctx.Err())
+		}
 		if exErr, ok := err.(*exec.ExitError); ok {
 			errMsg := cleanKubectlOutput(string(exErr.Stderr))
 			return "", errors.New(errMsg)
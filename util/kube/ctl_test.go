@@ -31,3 +31,18 @@ func TestConvertToVersion(t *testing.T) {
 	assert.Equal(t, "apps", gvk.Group)
 	assert.Equal(t, "v1", gvk.Version)
 }
+
+func TestOutputOf(t *testing.T) {
+	newObj := func(kind, name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetKind(kind)
+		obj.SetName(name)
+		return obj
+	}
+	out := "role.rbac.authorization.k8s.io/guestbook configured\nrolebinding.rbac.authorization.k8s.io/guestbook created\nservice \"guestbook-ui\" unchanged"
+	assert.Equal(t, "role.rbac.authorization.k8s.io/guestbook configured", outputOf(out, newObj("Role", "guestbook")))
+	assert.Equal(t, "rolebinding.rbac.authorization.k8s.io/guestbook created", outputOf(out, newObj("RoleBinding", "guestbook")))
+	assert.Equal(t, "service \"guestbook-ui\" unchanged", outputOf(out, newObj("Service", "guestbook-ui")))
+	// the whole output is returned if no line is about the object
+	assert.Equal(t, out, outputOf(out, newObj("Deployment", "guestbook-ui")))
+}
@@ -0,0 +1,131 @@
+package kube
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+// APIDeprecation is the deprecation of the API version of a kind
+type APIDeprecation struct {
+	Group   string
+	Version string
+	Kind    string
+	// DeprecatedIn is the Kubernetes 1.x minor version the API version is deprecated in
+	DeprecatedIn int
+	// RemovedIn is the Kubernetes 1.x minor version the API version is removed in, or 0 if its removal
+	// is not scheduled
+	RemovedIn int
+	// Replacement is the API version replacing the deprecated one, if any
+	Replacement string
+}
+
+// APIVersion returns the API version of the deprecation (e.g. extensions/v1beta1)
+func (d APIDeprecation) APIVersion() string {
+	if d.Group == "" {
+		return d.Version
+	}
+	return d.Group + "/" + d.Version
+}
+
+// apiDeprecations are the deprecations of the API versions of the built-in kinds
+var apiDeprecations = []APIDeprecation{
+	{Group: "extensions", Version: "v1beta1", Kind: "Deployment", DeprecatedIn: 9, RemovedIn: 16, Replacement: "apps/v1"},
+	{Group: "extensions", Version: "v1beta1", Kind: "DaemonSet", DeprecatedIn: 9, RemovedIn: 16, Replacement: "apps/v1"},
+	{Group: "extensions", Version: "v1beta1", Kind: "ReplicaSet", DeprecatedIn: 9, RemovedIn: 16, Replacement: "apps/v1"},
+	{Group: "extensions", Version: "v1beta1", Kind: "NetworkPolicy", DeprecatedIn: 9, RemovedIn: 16, Replacement: "networking.k8s.io/v1"},
+	{Group: "extensions", Version: "v1beta1", Kind: "PodSecurityPolicy", DeprecatedIn: 11, RemovedIn: 16, Replacement: "policy/v1beta1"},
+	{Group: "extensions", Version: "v1beta1", Kind: "Ingress", DeprecatedIn: 14, RemovedIn: 22, Replacement: "networking.k8s.io/v1"},
+	{Group: "apps", Version: "v1beta1", Kind: "Deployment", DeprecatedIn: 9, RemovedIn: 16, Replacement: "apps/v1"},
+	{Group: "apps", Version: "v1beta1", Kind: "StatefulSet", DeprecatedIn: 9, RemovedIn: 16, Replacement: "apps/v1"},
+	{Group: "apps", Version: "v1beta2", Kind: "Deployment", DeprecatedIn: 9, RemovedIn: 16, Replacement: "apps/v1"},
+	{Group: "apps", Version: "v1beta2", Kind: "DaemonSet", DeprecatedIn: 9, RemovedIn: 16, Replacement: "apps/v1"},
+	{Group: "apps", Version: "v1beta2", Kind: "ReplicaSet", DeprecatedIn: 9, RemovedIn: 16, Replacement: "apps/v1"},
+	{Group: "apps", Version: "v1beta2", Kind: "StatefulSet", DeprecatedIn: 9, RemovedIn: 16, Replacement: "apps/v1"},
+	{Group: "networking.k8s.io", Version: "v1beta1", Kind: "Ingress", DeprecatedIn: 19, RemovedIn: 22, Replacement: "networking.k8s.io/v1"},
+	{Group: "apiextensions.k8s.io", Version: "v1beta1", Kind: "CustomResourceDefinition", DeprecatedIn: 16, RemovedIn: 22, Replacement: "apiextensions.k8s.io/v1"},
+	{Group: "admissionregistration.k8s.io", Version: "v1beta1", Kind: "MutatingWebhookConfiguration", DeprecatedIn: 16, RemovedIn: 22, Replacement: "admissionregistration.k8s.io/v1"},
+	{Group: "admissionregistration.k8s.io", Version: "v1beta1", Kind: "ValidatingWebhookConfiguration", DeprecatedIn: 16, RemovedIn: 22, Replacement: "admissionregistration.k8s.io/v1"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1beta1", Kind: "ClusterRole", DeprecatedIn: 17, RemovedIn: 22, Replacement: "rbac.authorization.k8s.io/v1"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1beta1", Kind: "ClusterRoleBinding", DeprecatedIn: 17, RemovedIn: 22, Replacement: "rbac.authorization.k8s.io/v1"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1beta1", Kind: "Role", DeprecatedIn: 17, RemovedIn: 22, Replacement: "rbac.authorization.k8s.io/v1"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1beta1", Kind: "RoleBinding", DeprecatedIn: 17, RemovedIn: 22, Replacement: "rbac.authorization.k8s.io/v1"},
+	{Group: "scheduling.k8s.io", Version: "v1beta1", Kind: "PriorityClass", DeprecatedIn: 14, RemovedIn: 22, Replacement: "scheduling.k8s.io/v1"},
+	{Group: "storage.k8s.io", Version: "v1beta1", Kind: "StorageClass", DeprecatedIn: 19, RemovedIn: 22, Replacement: "storage.k8s.io/v1"},
+	{Group: "storage.k8s.io", Version: "v1beta1", Kind: "CSIDriver", DeprecatedIn: 19, RemovedIn: 22, Replacement: "storage.k8s.io/v1"},
+	{Group: "coordination.k8s.io", Version: "v1beta1", Kind: "Lease", DeprecatedIn: 14, RemovedIn: 22, Replacement: "coordination.k8s.io/v1"},
+	{Group: "certificates.k8s.io", Version: "v1beta1", Kind: "CertificateSigningRequest", DeprecatedIn: 19, RemovedIn: 22, Replacement: "certificates.k8s.io/v1"},
+	{Group: "apiregistration.k8s.io", Version: "v1beta1", Kind: "APIService", DeprecatedIn: 19, RemovedIn: 22, Replacement: "apiregistration.k8s.io/v1"},
+	{Group: "batch", Version: "v1beta1", Kind: "CronJob", DeprecatedIn: 21, RemovedIn: 25, Replacement: "batch/v1"},
+	{Group: "policy", Version: "v1beta1", Kind: "PodDisruptionBudget", DeprecatedIn: 21, RemovedIn: 25, Replacement: "policy/v1"},
+	{Group: "policy", Version: "v1beta1", Kind: "PodSecurityPolicy", DeprecatedIn: 21, RemovedIn: 25},
+	{Group: "autoscaling", Version: "v2beta1", Kind: "HorizontalPodAutoscaler", DeprecatedIn: 22, RemovedIn: 25, Replacement: "autoscaling/v2"},
+	{Group: "autoscaling", Version: "v2beta2", Kind: "HorizontalPodAutoscaler", DeprecatedIn: 23, RemovedIn: 26, Replacement: "autoscaling/v2"},
+}
+
+// DeprecatedAPIUsage is the use of a deprecated API version by resources
+type DeprecatedAPIUsage struct {
+	APIDeprecation
+	// Resources are the names of the resources using the API version
+	Resources []string
+}
+
+func (u DeprecatedAPIUsage) String() string {
+	var status string
+	if u.RemovedIn > 0 {
+		status = fmt.Sprintf("deprecated in 1.%d, removed in 1.%d", u.DeprecatedIn, u.RemovedIn)
+	} else {
+		status = fmt.Sprintf("deprecated in 1.%d", u.DeprecatedIn)
+	}
+	if u.Replacement != "" {
+		status += ", use " + u.Replacement
+	}
+	return fmt.Sprintf("%s %s %s (%s)", u.APIVersion(), u.Kind, strings.Join(u.Resources, ", "), status)
+}
+
+// GetDeprecatedAPIUsages returns the API versions used by the given objects which are deprecated or removed in
+// the given version of Kubernetes or in its next minor version, so that they are replaced before the cluster
+// is upgraded. No usage is returned if the version cannot be parsed
+func GetDeprecatedAPIUsages(objs []*unstructured.Unstructured, serverVersion *version.Info) []DeprecatedAPIUsage {
+	minor, err := minorVersion(serverVersion)
+	if err != nil {
+		return nil
+	}
+	usages := make(map[APIDeprecation]*DeprecatedAPIUsage)
+	for _, obj := range objs {
+		if obj == nil {
+			continue
+		}
+		gvk := obj.GroupVersionKind()
+		for _, deprecation := range apiDeprecations {
+			if deprecation.Group != gvk.Group || deprecation.Version != gvk.Version || deprecation.Kind != gvk.Kind {
+				continue
+			}
+			if deprecation.DeprecatedIn > minor+1 {
+				break
+			}
+			usage, ok := usages[deprecation]
+			if !ok {
+				usage = &DeprecatedAPIUsage{APIDeprecation: deprecation}
+				usages[deprecation] = usage
+			}
+			usage.Resources = append(usage.Resources, obj.GetName())
+			break
+		}
+	}
+	res := make([]DeprecatedAPIUsage, 0, len(usages))
+	for _, usage := range usages {
+		sort.Strings(usage.Resources)
+		res = append(res, *usage)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].APIVersion() != res[j].APIVersion() {
+			return res[i].APIVersion() < res[j].APIVersion()
+		}
+		return res[i].Kind < res[j].Kind
+	})
+	return res
+}
@@ -0,0 +1,44 @@
+package kube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+func newDeprecationTestObj(apiVersion string, kind string, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName(name)
+	return obj
+}
+
+func TestGetDeprecatedAPIUsages(t *testing.T) {
+	objs := []*unstructured.Unstructured{
+		newDeprecationTestObj("extensions/v1beta1", "Deployment", "guestbook-ui"),
+		newDeprecationTestObj("extensions/v1beta1", "Deployment", "guestbook-db"),
+		newDeprecationTestObj("extensions/v1beta1", "Ingress", "guestbook"),
+		newDeprecationTestObj("apps/v1", "Deployment", "redis"),
+		newDeprecationTestObj("v1", "Service", "guestbook-ui"),
+		nil,
+	}
+
+	usages := GetDeprecatedAPIUsages(objs, &version.Info{Major: "1", Minor: "12"})
+	if assert.Len(t, usages, 1) {
+		assert.Equal(t, "extensions/v1beta1 Deployment guestbook-db, guestbook-ui (deprecated in 1.9, removed in 1.16, use apps/v1)", usages[0].String())
+	}
+
+	// the API versions deprecated in the next minor version are reported too
+	usages = GetDeprecatedAPIUsages(objs, &version.Info{Major: "1", Minor: "13+"})
+	if assert.Len(t, usages, 2) {
+		assert.Equal(t, "Deployment", usages[0].Kind)
+		assert.Equal(t, "Ingress", usages[1].Kind)
+		assert.Equal(t, []string{"guestbook"}, usages[1].Resources)
+	}
+
+	assert.Empty(t, GetDeprecatedAPIUsages(objs, &version.Info{Major: "1", Minor: ""}))
+	assert.Empty(t, GetDeprecatedAPIUsages([]*unstructured.Unstructured{objs[3]}, &version.Info{Major: "1", Minor: "16"}))
+}
@@ -3,6 +3,7 @@ package kube
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -21,6 +22,8 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -52,6 +55,8 @@ const (
 	IngressKind                  = "Ingress"
 	PersistentVolumeClaimKind    = "PersistentVolumeClaim"
 	CustomResourceDefinitionKind = "CustomResourceDefinition"
+	PodKind                      = "Pod"
+	NamespaceKind                = "Namespace"
 )
 
 const (
@@ -85,6 +90,23 @@ func TestConfig(config *rest.Config) error {
 	return nil
 }
 
+// WithContext returns a copy of the REST config whose requests time out when the deadline of the context
+// is reached, so that clients created from it do not block past the deadline of the context
+func WithContext(ctx context.Context, config *rest.Config) *rest.Config {
+	config = rest.CopyConfig(config)
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout := time.Until(deadline)
+		if timeout <= 0 {
+			// a zero timeout means no timeout
+			timeout = time.Nanosecond
+		}
+		if config.Timeout == 0 || timeout < config.Timeout {
+			config.Timeout = timeout
+		}
+	}
+	return config
+}
+
 // ToUnstructured converts a concrete K8s API type to a un unstructured object
 func ToUnstructured(obj interface{}) (*unstructured.Unstructured, error) {
 	uObj, err := runtime.NewTestUnstructuredConverter(equality.Semantic).ToUnstructured(obj)
@@ -117,6 +139,20 @@ func UnsetLabel(target *unstructured.Unstructured, key string) {
 	}
 }
 
+// labelValueHashLength is the length of the hash suffix of truncated label values
+const labelValueHashLength = 10
+
+// TruncateLabelValue returns the value unchanged if it fits into the maximum length of label values.
+// Longer values are deterministically truncated and suffixed with a hash of the full value, so that
+// distinct values are unlikely to map to the same label value
+func TruncateLabelValue(value string) string {
+	if len(value) <= validation.LabelValueMaxLength {
+		return value
+	}
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(value)))[:labelValueHashLength]
+	return value[:validation.LabelValueMaxLength-labelValueHashLength-1] + "-" + hash
+}
+
 // SetLabel sets our app labels against an unstructured object
 func SetLabel(target *unstructured.Unstructured, key, val string) error {
 	labels := target.GetLabels()
@@ -173,6 +209,7 @@ func GetCachedServerResources(host string, disco discovery.DiscoveryInterface) (
 	var resList []*metav1.APIResourceList
 	cacheKey := fmt.Sprintf("apires|%s", host)
 	err := apiResourceCache.Get(cacheKey, &resList)
+	apiResourceCacheMetrics.observeLookup(cacheKey, err)
 	if err == nil {
 		log.Debugf("cache hit: %s", cacheKey)
 		return resList, nil
@@ -350,9 +387,14 @@ func copyEventsChannel(ctx context.Context, src <-chan watch.Event, dst chan wat
 	}
 }
 
-// GetResourcesWithLabel returns all kubernetes resources with specified label
-func GetResourcesWithLabel(config *rest.Config, namespace string, labelName string, labelValue string) ([]*unstructured.Unstructured, error) {
+// GetResourcesWithLabel returns all kubernetes resources with specified label. If namespacedOnly is set,
+// cluster-scoped resources are not listed
+func GetResourcesWithLabel(ctx context.Context, config *rest.Config, namespace string, labelName string, labelValue string, namespacedOnly bool) ([]*unstructured.Unstructured, error) {
+	config = WithContext(ctx, config)
 	listSupported := func(groupVersion string, apiResource *metav1.APIResource) bool {
+		if namespacedOnly && !apiResource.Namespaced {
+			return false
+		}
 		return isSupportedVerb(apiResource, listVerb) && !isExcludedResourceGroup(*apiResource)
 	}
 	apiResIfs, err := filterAPIResources(config, listSupported, namespace)
@@ -395,9 +437,71 @@ func GetResourcesWithLabel(config *rest.Config, namespace string, labelName stri
 	return result, asyncErr
 }
 
-// DeleteResourcesWithLabel delete all resources which match to specified label selector
-func DeleteResourcesWithLabel(config *rest.Config, namespace string, labelName string, labelValue string) error {
+// inventoryExcludedKinds are the kinds of resources which are never listed as top-level resources: events
+// are not managed, and endpoints are managed by their service
+var inventoryExcludedKinds = map[string]bool{
+	"Event":       true,
+	EndpointsKind: true,
+}
+
+// GetTopLevelResources returns all the kubernetes resources which have no owner. Resources served by
+// several API versions are only returned once. If namespace is set, cluster-scoped resources are not listed
+func GetTopLevelResources(ctx context.Context, config *rest.Config, namespace string) ([]*unstructured.Unstructured, error) {
+	config = WithContext(ctx, config)
+	listSupported := func(groupVersion string, apiResource *metav1.APIResource) bool {
+		if namespace != "" && !apiResource.Namespaced {
+			return false
+		}
+		if inventoryExcludedKinds[apiResource.Kind] {
+			return false
+		}
+		return isSupportedVerb(apiResource, listVerb) && !isExcludedResourceGroup(*apiResource)
+	}
+	apiResIfs, err := filterAPIResources(config, listSupported, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var asyncErr error
+	var result []*unstructured.Unstructured
+	seen := make(map[types.UID]bool)
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	wg.Add(len(apiResIfs))
+	for _, apiResIf := range apiResIfs {
+		go func(resourceIf dynamic.ResourceInterface) {
+			defer wg.Done()
+			list, err := resourceIf.List(metav1.ListOptions{})
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				if !apierr.IsNotFound(err) && !apierr.IsMethodNotSupported(err) {
+					asyncErr = err
+				}
+				return
+			}
+			for i := range list.Items {
+				item := list.Items[i]
+				if len(item.GetOwnerReferences()) > 0 || seen[item.GetUID()] {
+					continue
+				}
+				seen[item.GetUID()] = true
+				result = append(result, &item)
+			}
+		}(apiResIf.resourceIf)
+	}
+	wg.Wait()
+	return result, asyncErr
+}
+
+// DeleteResourcesWithLabel delete all resources which match to specified label selector. If namespacedOnly
+// is set, cluster-scoped resources are not deleted
+func DeleteResourcesWithLabel(ctx context.Context, config *rest.Config, namespace string, labelName string, labelValue string, namespacedOnly bool) error {
+	config = WithContext(ctx, config)
 	deleteSupported := func(groupVersion string, apiResource *metav1.APIResource) bool {
+		if namespacedOnly && !apiResource.Namespaced {
+			return false
+		}
 		if !isSupportedVerb(apiResource, deleteCollectionVerb) {
 			// if we can't delete by collection, we better be able to list and delete
 			if !isSupportedVerb(apiResource, listVerb) || !isSupportedVerb(apiResource, deleteVerb) {
@@ -522,6 +626,11 @@ func cleanKubectlOutput(s string) string {
 
 // WriteKubeConfig takes a rest.Config and writes it as a kubeconfig at the specified path
 func WriteKubeConfig(restConfig *rest.Config, namespace, filename string) error {
+	return clientcmd.WriteToFile(newKubeConfig(restConfig, namespace), filename)
+}
+
+// newKubeConfig converts a rest.Config to a kubeconfig whose current context uses the given namespace
+func newKubeConfig(restConfig *rest.Config, namespace string) clientcmdapi.Config {
 	var kubeConfig = clientcmdapi.Config{
 		CurrentContext: restConfig.Host,
 		Contexts: map[string]*clientcmdapi.Context{
@@ -575,7 +684,11 @@ func WriteKubeConfig(restConfig *rest.Config, namespace, filename string) error
 	if restConfig.ExecProvider != nil {
 		kubeConfig.AuthInfos[restConfig.Host].Exec = restConfig.ExecProvider
 	}
-	return clientcmd.WriteToFile(kubeConfig, filename)
+	if restConfig.Impersonate.UserName != "" {
+		kubeConfig.AuthInfos[restConfig.Host].Impersonate = restConfig.Impersonate.UserName
+		kubeConfig.AuthInfos[restConfig.Host].ImpersonateGroups = restConfig.Impersonate.Groups
+	}
+	return kubeConfig
 }
 
 var diffSeparator = regexp.MustCompile(`\n---`)
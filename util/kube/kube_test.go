@@ -1,9 +1,14 @@
 package kube
 
 import (
+	"context"
 	"encoding/json"
 	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/test"
 	"github.com/ghodss/yaml"
@@ -15,9 +20,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	fakediscovery "k8s.io/client-go/discovery/fake"
 	fakedynamic "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
 	kubetesting "k8s.io/client-go/testing"
 
 	"github.com/argoproj/argo-cd/common"
@@ -253,6 +261,23 @@ func TestSetLabels(t *testing.T) {
 
 }
 
+func TestTruncateLabelValue(t *testing.T) {
+	assert.Equal(t, "my-app", TruncateLabelValue("my-app"))
+
+	name := strings.Repeat("a", 63)
+	assert.Equal(t, name, TruncateLabelValue(name))
+
+	longName := strings.Repeat("a", 64)
+	truncated := TruncateLabelValue(longName)
+	assert.Len(t, truncated, 63)
+	assert.True(t, strings.HasPrefix(truncated, strings.Repeat("a", 52)+"-"))
+	assert.Equal(t, truncated, TruncateLabelValue(longName))
+	assert.Empty(t, validation.IsValidLabelValue(truncated))
+
+	otherName := strings.Repeat("a", 65)
+	assert.NotEqual(t, truncated, TruncateLabelValue(otherName))
+}
+
 func TestCleanKubectlOutput(t *testing.T) {
 	testString := `error: error validating "STDIN": error validating data: ValidationError(Deployment.spec): missing required field "selector" in io.k8s.api.apps.v1beta2.DeploymentSpec; if you choose to ignore these errors, turn validation off with --validate=false`
 	assert.Equal(t, cleanKubectlOutput(testString), `error validating data: ValidationError(Deployment.spec): missing required field "selector" in io.k8s.api.apps.v1beta2.DeploymentSpec`)
@@ -304,3 +329,38 @@ spec:
 	assert.Equal(t, float64(0.2), requestsBefore["cpu"])
 	assert.Equal(t, "200m", requestsAfter["cpu"])
 }
+
+func TestNewKubeConfigImpersonate(t *testing.T) {
+	restConfig := rest.Config{
+		Host:        "https://localhost:6443",
+		BearerToken: "token",
+		Impersonate: rest.ImpersonationConfig{UserName: "system:serviceaccount:default:deployer"},
+	}
+	kubeConfig := newKubeConfig(&restConfig, "default")
+	authInfo := kubeConfig.AuthInfos[restConfig.Host]
+	assert.Equal(t, "token", authInfo.Token)
+	assert.Equal(t, "system:serviceaccount:default:deployer", authInfo.Impersonate)
+	assert.Equal(t, "default", kubeConfig.Contexts[kubeConfig.CurrentContext].Namespace)
+}
+
+func TestWithContext(t *testing.T) {
+	unblock := make(chan struct{})
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-unblock
+	}))
+	defer apiServer.Close()
+	defer close(unblock)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	config := WithContext(ctx, &rest.Config{Host: apiServer.URL})
+	assert.True(t, config.Timeout > 0 && config.Timeout <= 100*time.Millisecond)
+	kubeClient := kubernetes.NewForConfigOrDie(config)
+	start := time.Now()
+	_, err := kubeClient.Discovery().ServerVersion()
+	assert.Error(t, err)
+	assert.True(t, time.Since(start) < 5*time.Second)
+
+	config = WithContext(context.Background(), &rest.Config{Host: apiServer.URL, Timeout: time.Minute})
+	assert.Equal(t, time.Minute, config.Timeout)
+}
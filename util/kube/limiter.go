@@ -0,0 +1,77 @@
+package kube
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+)
+
+// clusterLimitedKubectl limits the number of kubectl commands changing resources which run at the same
+// time against each cluster
+type clusterLimitedKubectl struct {
+	Kubectl
+	limit int
+	lock  sync.Mutex
+	// semaphores holds the semaphore of each cluster, by cluster URL
+	semaphores map[string]chan struct{}
+}
+
+// NewClusterLimitedKubectl returns a kubectl which runs at most the given number of apply and delete
+// commands at the same time against each cluster. The commands are not limited if the limit is 0
+func NewClusterLimitedKubectl(kubectl Kubectl, limit int) Kubectl {
+	if limit <= 0 {
+		return kubectl
+	}
+	return &clusterLimitedKubectl{
+		Kubectl:    kubectl,
+		limit:      limit,
+		semaphores: make(map[string]chan struct{}),
+	}
+}
+
+// acquire waits until a command can run against the cluster, and returns the function releasing it.
+// It returns an error if the context is done first
+func (k *clusterLimitedKubectl) acquire(ctx context.Context, config *rest.Config) (func(), error) {
+	k.lock.Lock()
+	semaphore, ok := k.semaphores[config.Host]
+	if !ok {
+		semaphore = make(chan struct{}, k.limit)
+		k.semaphores[config.Host] = semaphore
+	}
+	k.lock.Unlock()
+	select {
+	case semaphore <- struct{}{}:
+		return func() { <-semaphore }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (k *clusterLimitedKubectl) ApplyResource(ctx context.Context, config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force bool) (string, error) {
+	release, err := k.acquire(ctx, config)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return k.Kubectl.ApplyResource(ctx, config, obj, namespace, dryRun, force)
+}
+
+func (k *clusterLimitedKubectl) ApplyResources(ctx context.Context, config *rest.Config, objs []*unstructured.Unstructured, namespace string, dryRun, force bool) ([]string, error) {
+	release, err := k.acquire(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return k.Kubectl.ApplyResources(ctx, config, objs, namespace, dryRun, force)
+}
+
+func (k *clusterLimitedKubectl) DeleteResource(ctx context.Context, config *rest.Config, obj *unstructured.Unstructured, namespace string) error {
+	release, err := k.acquire(ctx, config)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return k.Kubectl.DeleteResource(ctx, config, obj, namespace)
+}
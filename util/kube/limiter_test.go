@@ -0,0 +1,77 @@
+package kube
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+)
+
+// slowKubectl applies resources slowly, and records the maximum number of concurrent applies
+type slowKubectl struct {
+	KubectlCmd
+	lock    sync.Mutex
+	running int
+	max     int
+}
+
+func (k *slowKubectl) ApplyResource(ctx context.Context, config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force bool) (string, error) {
+	k.lock.Lock()
+	k.running++
+	if k.running > k.max {
+		k.max = k.running
+	}
+	k.lock.Unlock()
+	time.Sleep(10 * time.Millisecond)
+	k.lock.Lock()
+	k.running--
+	k.lock.Unlock()
+	return "", nil
+}
+
+func TestClusterLimitedKubectl(t *testing.T) {
+	slow := &slowKubectl{}
+	kubectl := NewClusterLimitedKubectl(slow, 2)
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := kubectl.ApplyResource(context.Background(), &rest.Config{Host: "https://cluster-1"}, &unstructured.Unstructured{}, "default", false, false)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 2, slow.max)
+
+	// the calls are limited per cluster
+	slow.max = 0
+	for i := 0; i < 2; i++ {
+		for _, host := range []string{"https://cluster-1", "https://cluster-2"} {
+			wg.Add(1)
+			go func(host string) {
+				defer wg.Done()
+				_, err := kubectl.ApplyResource(context.Background(), &rest.Config{Host: host}, &unstructured.Unstructured{}, "default", false, false)
+				assert.NoError(t, err)
+			}(host)
+		}
+	}
+	wg.Wait()
+	assert.Equal(t, 4, slow.max)
+
+	// the calls waiting for the cluster are aborted when their context is done
+	release, err := kubectl.(*clusterLimitedKubectl).acquire(context.Background(), &rest.Config{Host: "https://cluster-1"})
+	assert.NoError(t, err)
+	defer release()
+	release2, err := kubectl.(*clusterLimitedKubectl).acquire(context.Background(), &rest.Config{Host: "https://cluster-1"})
+	assert.NoError(t, err)
+	defer release2()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = kubectl.ApplyResource(ctx, &rest.Config{Host: "https://cluster-1"}, &unstructured.Unstructured{}, "default", false, false)
+	assert.Equal(t, context.Canceled, err)
+}
@@ -0,0 +1,174 @@
+package kube
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// DefaultRateLimiterBuckets are the buckets of the client side throttling histogram, in seconds
+var DefaultRateLimiterBuckets = []float64{0.01, 0.05, 0.1, 0.5, 1, 2, 5, 10, 30, 60}
+
+const (
+	// DefaultClusterQPS is the default rate of the requests made to each managed cluster
+	DefaultClusterQPS = 50
+	// DefaultClusterBurst is the default number of requests made at once to each managed cluster
+	DefaultClusterBurst = 100
+)
+
+// ClientMetrics is a prometheus collector holding the metrics of the requests made by kubernetes clients
+type ClientMetrics struct {
+	requestCounter       *prometheus.CounterVec
+	requestHistogram     *prometheus.HistogramVec
+	throttledCounter     *prometheus.CounterVec
+	rateLimiterHistogram *prometheus.HistogramVec
+	// ClusterQPS and ClusterBurst limit the requests made to each cluster with the configs returned by
+	// WrapClusterConfig
+	ClusterQPS   float32
+	ClusterBurst int
+	// clusterLimiters holds the rate limiter of each cluster, by cluster URL
+	clusterLimitersLock sync.Mutex
+	clusterLimiters     map[string]flowcontrol.RateLimiter
+}
+
+// NewClientMetrics returns a new collector of kubernetes client metrics
+func NewClientMetrics() *ClientMetrics {
+	return &ClientMetrics{
+		requestCounter: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "argocd_kube_client_request_total",
+				Help: "Number of requests made to the Kubernetes API server.",
+			},
+			[]string{"host", "method", "response_code"},
+		),
+		requestHistogram: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name: "argocd_kube_client_request_duration_seconds",
+				Help: "Latency of the requests made to the Kubernetes API server.",
+			},
+			[]string{"host", "method"},
+		),
+		throttledCounter: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "argocd_kube_client_throttled_request_total",
+				Help: "Number of requests delayed by the client side rate limiter.",
+			},
+			[]string{"host"},
+		),
+		rateLimiterHistogram: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "argocd_kube_client_rate_limiter_duration_seconds",
+				Help:    "Time spent waiting on the client side rate limiter.",
+				Buckets: DefaultRateLimiterBuckets,
+			},
+			[]string{"host"},
+		),
+		ClusterQPS:      DefaultClusterQPS,
+		ClusterBurst:    DefaultClusterBurst,
+		clusterLimiters: make(map[string]flowcontrol.RateLimiter),
+	}
+}
+
+// Describe implements the prometheus.Collector interface
+func (m *ClientMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.requestCounter.Describe(ch)
+	m.requestHistogram.Describe(ch)
+	m.throttledCounter.Describe(ch)
+	m.rateLimiterHistogram.Describe(ch)
+}
+
+// Collect implements the prometheus.Collector interface
+func (m *ClientMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.requestCounter.Collect(ch)
+	m.requestHistogram.Collect(ch)
+	m.throttledCounter.Collect(ch)
+	m.rateLimiterHistogram.Collect(ch)
+}
+
+// WrapConfig returns a copy of the given rest config whose transport and rate limiter record their
+// metrics. Like the clientsets do when a QPS is configured, the rate limiter is shared by all the
+// clients created from the returned config.
+func (m *ClientMetrics) WrapConfig(config *rest.Config) *rest.Config {
+	config = rest.CopyConfig(config)
+	host := config.Host
+	wrapTransport := config.WrapTransport
+	config.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		if wrapTransport != nil {
+			rt = wrapTransport(rt)
+		}
+		return &metricsRoundTripper{host: host, delegate: rt, metrics: m}
+	}
+	rateLimiter := config.RateLimiter
+	if rateLimiter == nil {
+		qps := config.QPS
+		if qps == 0 {
+			qps = rest.DefaultQPS
+		}
+		burst := config.Burst
+		if burst == 0 {
+			burst = rest.DefaultBurst
+		}
+		rateLimiter = flowcontrol.NewTokenBucketRateLimiter(qps, burst)
+	}
+	config.RateLimiter = &metricsRateLimiter{host: host, RateLimiter: rateLimiter, metrics: m}
+	return config
+}
+
+// WrapClusterConfig returns a copy of the rest config of a managed cluster whose transport and rate
+// limiter record their metrics. The configs of the managed clusters are created for every reconciliation
+// and sync, so the requests made with all the configs of a cluster share the same rate limiter. The
+// config is returned unchanged if the metrics are nil.
+func (m *ClientMetrics) WrapClusterConfig(config *rest.Config) *rest.Config {
+	if m == nil {
+		return config
+	}
+	m.clusterLimitersLock.Lock()
+	rateLimiter, ok := m.clusterLimiters[config.Host]
+	if !ok {
+		rateLimiter = flowcontrol.NewTokenBucketRateLimiter(m.ClusterQPS, m.ClusterBurst)
+		m.clusterLimiters[config.Host] = rateLimiter
+	}
+	m.clusterLimitersLock.Unlock()
+	config = rest.CopyConfig(config)
+	config.RateLimiter = rateLimiter
+	return m.WrapConfig(config)
+}
+
+type metricsRoundTripper struct {
+	host     string
+	delegate http.RoundTripper
+	metrics  *ClientMetrics
+}
+
+func (rt *metricsRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	startTime := time.Now()
+	resp, err := rt.delegate.RoundTrip(req)
+	rt.metrics.requestHistogram.WithLabelValues(rt.host, req.Method).Observe(time.Since(startTime).Seconds())
+	code := "<error>"
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+	rt.metrics.requestCounter.WithLabelValues(rt.host, req.Method, code).Inc()
+	return resp, err
+}
+
+type metricsRateLimiter struct {
+	flowcontrol.RateLimiter
+	host    string
+	metrics *ClientMetrics
+}
+
+// Accept records whether the request had to wait for a token, and for how long
+func (l *metricsRateLimiter) Accept() {
+	startTime := time.Now()
+	if !l.RateLimiter.TryAccept() {
+		l.metrics.throttledCounter.WithLabelValues(l.host).Inc()
+		l.RateLimiter.Accept()
+	}
+	l.metrics.rateLimiterHistogram.WithLabelValues(l.host).Observe(time.Since(startTime).Seconds())
+}
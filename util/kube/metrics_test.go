@@ -0,0 +1,96 @@
+package kube
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestClientMetrics(t *testing.T) {
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"major":"1","minor":"11"}`))
+	}))
+	defer apiServer.Close()
+
+	clientMetrics := NewClientMetrics()
+	config := &rest.Config{Host: apiServer.URL, QPS: 20, Burst: 1}
+	wrappedConfig := clientMetrics.WrapConfig(config)
+	assert.Nil(t, config.RateLimiter)
+	kubeClient := kubernetes.NewForConfigOrDie(wrappedConfig)
+	// the second request exceeds the burst, so has to wait on the rate limiter
+	for i := 0; i < 2; i++ {
+		_, err := kubeClient.Discovery().ServerVersion()
+		assert.NoError(t, err)
+	}
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(clientMetrics)
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	promhttp.HandlerFor(registry, promhttp.HandlerOpts{}).ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	assert.Contains(t, body, `argocd_kube_client_request_total{host="`+apiServer.URL+`",method="GET",response_code="200"} 2`)
+	assert.Contains(t, body, `argocd_kube_client_request_duration_seconds_count{host="`+apiServer.URL+`",method="GET"} 2`)
+	assert.Contains(t, body, `argocd_kube_client_throttled_request_total{host="`+apiServer.URL+`"} 1`)
+	assert.Contains(t, body, `argocd_kube_client_rate_limiter_duration_seconds_count{host="`+apiServer.URL+`"} 2`)
+}
+
+func TestWrapClusterConfig(t *testing.T) {
+	config := &rest.Config{Host: "https://cluster-1"}
+	var nilMetrics *ClientMetrics
+	assert.Equal(t, config, nilMetrics.WrapClusterConfig(config))
+
+	clientMetrics := NewClientMetrics()
+	// the configs of a cluster share its rate limiter
+	limiter := clientMetrics.WrapClusterConfig(config).RateLimiter.(*metricsRateLimiter).RateLimiter
+	assert.True(t, limiter == clientMetrics.WrapClusterConfig(&rest.Config{Host: "https://cluster-1"}).RateLimiter.(*metricsRateLimiter).RateLimiter)
+	assert.False(t, limiter == clientMetrics.WrapClusterConfig(&rest.Config{Host: "https://cluster-2"}).RateLimiter.(*metricsRateLimiter).RateLimiter)
+	assert.Nil(t, config.RateLimiter)
+}
+
+func TestClusterCacheMetrics(t *testing.T) {
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"major":"1","minor":"11"}`))
+	}))
+	defer apiServer.Close()
+	FlushServerResourcesCache()
+	defer FlushServerResourcesCache()
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(ClusterCacheMetrics())
+	metricValue := func(name string) float64 {
+		families, err := registry.Gather()
+		assert.NoError(t, err)
+		for _, family := range families {
+			if family.GetName() == name {
+				for _, metric := range family.GetMetric() {
+					if metric.GetLabel()[0].GetValue() == "server-version" {
+						return metric.GetCounter().GetValue()
+					}
+				}
+			}
+		}
+		return 0
+	}
+	hits := metricValue("argocd_cluster_cache_hit_total")
+	misses := metricValue("argocd_cluster_cache_miss_total")
+
+	config := &rest.Config{Host: apiServer.URL}
+	for i := 0; i < 2; i++ {
+		_, err := GetCachedServerVersion(config)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, hits+1, metricValue("argocd_cluster_cache_hit_total"))
+	assert.Equal(t, misses+1, metricValue("argocd_cluster_cache_miss_total"))
+	assert.Equal(t, "api-resources", clusterCacheName("apires|https://cluster-1"))
+}
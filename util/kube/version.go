@@ -0,0 +1,172 @@
+package kube
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/util/cache"
+)
+
+// maxKubectlVersionSkew is the number of minor versions kubectl supports around its own version
+const maxKubectlVersionSkew = 1
+
+var (
+	kubectlVersionOnce sync.Once
+	// kubectlVersion is the version of the kubectl client, or nil if it is unknown
+	kubectlVersion *version.Info
+	// kubectlCacheRoot is the directory of the kubectl caches of the clusters. The caches hold the multi-MB
+	// openapi schemas of the clusters, so they are kept on disk rather than in kubectlTempDir
+	kubectlCacheRoot = filepath.Join(os.TempDir(), "kubectl-cache")
+
+	clusterVersionsLock sync.Mutex
+	// clusterVersions is the last known version of each cluster, by host
+	clusterVersions = make(map[string]string)
+)
+
+// GetCachedServerVersion returns the version of a Kube API server.
+// Caches the results for apiResourceCacheDuration (per host)
+func GetCachedServerVersion(config *rest.Config) (*version.Info, error) {
+	var serverVersion version.Info
+	cacheKey := fmt.Sprintf("version|%s", config.Host)
+	err := apiResourceCache.Get(cacheKey, &serverVersion)
+	apiResourceCacheMetrics.observeLookup(cacheKey, err)
+	if err == nil {
+		return &serverVersion, nil
+	}
+	if err != cache.ErrCacheMiss {
+		log.Warnf("cache error %s: %v", cacheKey, err)
+	}
+	disco, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	info, err := disco.ServerVersion()
+	if err != nil {
+		return nil, err
+	}
+	err = apiResourceCache.Set(&cache.Item{
+		Key:    cacheKey,
+		Object: *info,
+	})
+	if err != nil {
+		log.Warnf("Failed to cache %s: %v", cacheKey, err)
+	}
+	return info, nil
+}
+
+// getKubectlVersion returns the version of the kubectl client, or nil if it cannot be determined
+func getKubectlVersion() *version.Info {
+	kubectlVersionOnce.Do(func() {
+		out, err := exec.Command("kubectl", "version", "--client", "-o", "json").Output()
+		if err != nil {
+			log.Warnf("Failed to determine the kubectl version: %v", err)
+			return
+		}
+		var res struct {
+			ClientVersion *version.Info `json:"clientVersion"`
+		}
+		if err := json.Unmarshal(out, &res); err != nil {
+			log.Warnf("Failed to parse the kubectl version: %v", err)
+			return
+		}
+		kubectlVersion = res.ClientVersion
+	})
+	return kubectlVersion
+}
+
+// minorVersion returns the minor version of a version, ignoring the suffixes of some providers (e.g. 13+)
+func minorVersion(info *version.Info) (int, error) {
+	if info.Major != "1" {
+		return 0, fmt.Errorf("unsupported major version '%s'", info.Major)
+	}
+	return strconv.Atoi(strings.TrimRight(info.Minor, "+"))
+}
+
+// isVersionSkewSupported returns whether kubectl supports the version of a server. Versions which cannot be
+// parsed are assumed to be supported
+func isVersionSkewSupported(client *version.Info, server *version.Info) bool {
+	clientMinor, err := minorVersion(client)
+	if err != nil {
+		return true
+	}
+	serverMinor, err := minorVersion(server)
+	if err != nil {
+		return true
+	}
+	skew := clientMinor - serverMinor
+	return skew <= maxKubectlVersionSkew && skew >= -maxKubectlVersionSkew
+}
+
+// kubectlCacheDir returns the directory of the discovery and openapi schema caches of kubectl for a cluster
+// and its version, so that manifests are validated against the schema of their destination cluster, and
+// that the schema is fetched again once the cluster is upgraded
+func kubectlCacheDir(host string, server *version.Info) string {
+	return filepath.Join(kubectlCacheRoot, fmt.Sprintf("%x-%s", sha256.Sum256([]byte(host)), server.GitVersion))
+}
+
+// removeStaleKubectlCaches removes the kubectl caches of the previous versions of a cluster
+func removeStaleKubectlCaches(host string, server *version.Info) {
+	current := kubectlCacheDir(host, server)
+	dirs, err := filepath.Glob(filepath.Join(kubectlCacheRoot, fmt.Sprintf("%x-*", sha256.Sum256([]byte(host)))))
+	if err != nil {
+		log.Warnf("Failed to list the kubectl caches of cluster %s: %v", host, err)
+		return
+	}
+	for _, dir := range dirs {
+		if dir == current {
+			continue
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			log.Warnf("Failed to remove the stale kubectl cache %s: %v", dir, err)
+		}
+	}
+}
+
+// observeClusterVersion records the version of a cluster, and returns whether it differs from the last
+// version recorded for the cluster, i.e. when the cluster is first seen or was upgraded
+func observeClusterVersion(host string, server *version.Info) bool {
+	clusterVersionsLock.Lock()
+	defer clusterVersionsLock.Unlock()
+	if clusterVersions[host] == server.GitVersion {
+		return false
+	}
+	clusterVersions[host] = server.GitVersion
+	return true
+}
+
+// kubectlVersionFlags returns the flags of kubectl compatible with the version of the cluster of the given
+// config: the global flags of all commands, and the flags of apply. Client-side validation is disabled for
+// clusters out of the version skew supported by kubectl, whose schema kubectl may not validate correctly,
+// and which still validate the resources when they are applied
+func kubectlVersionFlags(config *rest.Config) ([]string, []string) {
+	serverVersion, err := GetCachedServerVersion(config)
+	if err != nil {
+		log.Warnf("Failed to determine the version of cluster %s: %v", config.Host, err)
+		return nil, nil
+	}
+	versionChanged := observeClusterVersion(config.Host, serverVersion)
+	if versionChanged {
+		removeStaleKubectlCaches(config.Host, serverVersion)
+	}
+	globalFlags := []string{"--cache-dir", kubectlCacheDir(config.Host, serverVersion)}
+	var applyFlags []string
+	if clientVersion := getKubectlVersion(); clientVersion != nil && !isVersionSkewSupported(clientVersion, serverVersion) {
+		if versionChanged {
+			log.Warnf("Cluster %s version %s is not supported by kubectl version %s: disabling client-side validation", config.Host, serverVersion.GitVersion, clientVersion.GitVersion)
+		}
+		applyFlags = append(applyFlags, "--validate=false")
+	}
+	return globalFlags, applyFlags
+}
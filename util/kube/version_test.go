@@ -0,0 +1,57 @@
+package kube
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+func TestIsVersionSkewSupported(t *testing.T) {
+	client := &version.Info{Major: "1", Minor: "13", GitVersion: "v1.13.4"}
+	assert.True(t, isVersionSkewSupported(client, &version.Info{Major: "1", Minor: "13", GitVersion: "v1.13.1"}))
+	assert.True(t, isVersionSkewSupported(client, &version.Info{Major: "1", Minor: "12+", GitVersion: "v1.12.5-gke.5"}))
+	assert.True(t, isVersionSkewSupported(client, &version.Info{Major: "1", Minor: "14", GitVersion: "v1.14.0"}))
+	assert.False(t, isVersionSkewSupported(client, &version.Info{Major: "1", Minor: "11", GitVersion: "v1.11.7"}))
+	assert.False(t, isVersionSkewSupported(client, &version.Info{Major: "1", Minor: "15", GitVersion: "v1.15.0"}))
+	// versions which cannot be parsed are assumed to be supported
+	assert.True(t, isVersionSkewSupported(client, &version.Info{Major: "1", Minor: "", GitVersion: "v1.10.0-dirty"}))
+	assert.True(t, isVersionSkewSupported(&version.Info{}, &version.Info{Major: "1", Minor: "9"}))
+}
+
+func TestKubectlCacheDir(t *testing.T) {
+	v1 := &version.Info{Major: "1", Minor: "12", GitVersion: "v1.12.5"}
+	v2 := &version.Info{Major: "1", Minor: "13", GitVersion: "v1.13.4"}
+	// the schema of each cluster and version is cached separately
+	assert.NotEqual(t, kubectlCacheDir("https://cluster-1", v1), kubectlCacheDir("https://cluster-2", v1))
+	assert.NotEqual(t, kubectlCacheDir("https://cluster-1", v1), kubectlCacheDir("https://cluster-1", v2))
+	assert.Equal(t, kubectlCacheDir("https://cluster-1", v1), kubectlCacheDir("https://cluster-1", v1))
+}
+
+func TestRemoveStaleKubectlCaches(t *testing.T) {
+	root, err := ioutil.TempDir("", "kubectl-cache")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(root) }()
+	kubectlCacheRoot = root
+	defer func() { kubectlCacheRoot = filepath.Join(os.TempDir(), "kubectl-cache") }()
+
+	v1 := &version.Info{Major: "1", Minor: "12", GitVersion: "v1.12.5"}
+	v2 := &version.Info{Major: "1", Minor: "13", GitVersion: "v1.13.4"}
+	for _, dir := range []string{kubectlCacheDir("https://cluster-1", v1), kubectlCacheDir("https://cluster-1", v2), kubectlCacheDir("https://cluster-2", v1)} {
+		assert.NoError(t, os.MkdirAll(dir, 0700))
+	}
+
+	// the cache of the previous version is removed once the cluster is upgraded
+	assert.True(t, observeClusterVersion("https://cluster-1", v2))
+	assert.False(t, observeClusterVersion("https://cluster-1", v2))
+	removeStaleKubectlCaches("https://cluster-1", v2)
+	_, err = os.Stat(kubectlCacheDir("https://cluster-1", v1))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(kubectlCacheDir("https://cluster-1", v2))
+	assert.NoError(t, err)
+	_, err = os.Stat(kubectlCacheDir("https://cluster-2", v1))
+	assert.NoError(t, err)
+}
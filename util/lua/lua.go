@@ -0,0 +1,187 @@
+package lua
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	lua "github.com/yuin/gopher-lua"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// DefaultActionTimeout is the maximum duration of the scripts of the resource actions
+const DefaultActionTimeout = 5 * time.Second
+
+var (
+	// safeLibs are the Lua libraries opened for the scripts, which cannot access the file system or
+	// the process of the Argo CD components
+	safeLibs = map[string]lua.LGFunction{
+		lua.BaseLibName:   lua.OpenBase,
+		lua.TabLibName:    lua.OpenTable,
+		lua.StringLibName: lua.OpenString,
+		lua.MathLibName:   lua.OpenMath,
+	}
+	// unsafeBaseFuncs are the functions of the base library loading files or modules
+	unsafeBaseFuncs = []string{"dofile", "loadfile", "module", "require"}
+	// safeOsFuncs are the functions of the os library exposed to the scripts
+	safeOsFuncs = []string{"clock", "date", "difftime", "time"}
+)
+
+// ExecuteAction runs the Lua script of a resource action on a copy of the given object, and returns the
+// object returned by the script. The object is held by the obj global variable of the script
+func ExecuteAction(obj *unstructured.Unstructured, script string, timeout time.Duration) (*unstructured.Unstructured, error) {
+	l := newState()
+	defer l.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	l.SetContext(ctx)
+
+	l.SetGlobal("obj", toLuaValue(l, obj.DeepCopy().Object))
+	err := l.DoString(script)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run action: %v", err)
+	}
+	res, ok := l.Get(-1).(*lua.LTable)
+	if !ok {
+		return nil, fmt.Errorf("action returned %s instead of the modified object", l.Get(-1).Type())
+	}
+	value, err := toGoValue(l, res)
+	if err != nil {
+		return nil, err
+	}
+	newObj, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("action returned an array instead of the modified object")
+	}
+	updated := &unstructured.Unstructured{Object: newObj}
+	if updated.GetAPIVersion() != obj.GetAPIVersion() || updated.GetKind() != obj.GetKind() ||
+		updated.GetName() != obj.GetName() || updated.GetNamespace() != obj.GetNamespace() {
+		return nil, fmt.Errorf("action must not change the API version, kind, name or namespace of the resource")
+	}
+	return updated, nil
+}
+
+// newState returns a Lua state with the safe libraries opened
+func newState() *lua.LState {
+	l := lua.NewState(lua.Options{SkipOpenLibs: true})
+	for name, open := range safeLibs {
+		l.Push(l.NewFunction(open))
+		l.Push(lua.LString(name))
+		l.Call(1, 0)
+	}
+	for _, name := range unsafeBaseFuncs {
+		l.SetGlobal(name, lua.LNil)
+	}
+	l.Push(l.NewFunction(lua.OpenOs))
+	l.Push(lua.LString(lua.OsLibName))
+	l.Call(1, 1)
+	osLib := l.Get(-1).(*lua.LTable)
+	l.Pop(1)
+	safeOs := l.NewTable()
+	for _, name := range safeOsFuncs {
+		safeOs.RawSetString(name, osLib.RawGetString(name))
+	}
+	l.SetGlobal(lua.OsLibName, safeOs)
+	return l
+}
+
+// arrayMetatableKey is the registry key of the metatable marking the tables converted from arrays,
+// which keeps the empty arrays of the object from being converted back to maps
+const arrayMetatableKey = "argocd.array"
+
+// toLuaValue converts a value of an unstructured object to a Lua value
+func toLuaValue(l *lua.LState, value interface{}) lua.LValue {
+	switch v := value.(type) {
+	case nil:
+		return lua.LNil
+	case bool:
+		return lua.LBool(v)
+	case string:
+		return lua.LString(v)
+	case int64:
+		return lua.LNumber(v)
+	case float64:
+		return lua.LNumber(v)
+	case map[string]interface{}:
+		table := l.NewTable()
+		for key, item := range v {
+			table.RawSetString(key, toLuaValue(l, item))
+		}
+		return table
+	case []interface{}:
+		table := l.NewTable()
+		for _, item := range v {
+			table.Append(toLuaValue(l, item))
+		}
+		l.SetMetatable(table, l.NewTypeMetatable(arrayMetatableKey))
+		return table
+	default:
+		return lua.LString(fmt.Sprintf("%v", v))
+	}
+}
+
+// toGoValue converts a Lua value to a value of an unstructured object. Tables converted from arrays,
+// or whose keys are a sequence of integers, are converted to arrays
+func toGoValue(l *lua.LState, value lua.LValue) (interface{}, error) {
+	switch v := value.(type) {
+	case *lua.LNilType:
+		return nil, nil
+	case lua.LBool:
+		return bool(v), nil
+	case lua.LString:
+		return string(v), nil
+	case lua.LNumber:
+		if f := float64(v); f == math.Trunc(f) && math.Abs(f) < math.MaxInt64 {
+			return int64(f), nil
+		}
+		return float64(v), nil
+	case *lua.LTable:
+		if isArray(l, v) {
+			items := make([]interface{}, 0, v.Len())
+			for i := 1; i <= v.Len(); i++ {
+				item, err := toGoValue(l, v.RawGetInt(i))
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, item)
+			}
+			return items, nil
+		}
+		fields := make(map[string]interface{})
+		var err error
+		v.ForEach(func(key lua.LValue, item lua.LValue) {
+			if err != nil {
+				return
+			}
+			name, ok := key.(lua.LString)
+			if !ok {
+				err = fmt.Errorf("object keys must be strings, not %s", key.Type())
+				return
+			}
+			fields[string(name)], err = toGoValue(l, item)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return fields, nil
+	default:
+		return nil, fmt.Errorf("%s values cannot be converted to object values", value.Type())
+	}
+}
+
+// isArray returns whether the table was converted from an array, or holds a sequence of items
+func isArray(l *lua.LState, table *lua.LTable) bool {
+	if l.GetMetatable(table) == l.NewTypeMetatable(arrayMetatableKey) {
+		return true
+	}
+	length := table.Len()
+	if length == 0 {
+		return false
+	}
+	count := 0
+	table.ForEach(func(lua.LValue, lua.LValue) {
+		count++
+	})
+	return count == length
+}
@@ -0,0 +1,77 @@
+package lua
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const cronJobManifest = `
+apiVersion: batch/v1beta1
+kind: CronJob
+metadata:
+  name: hello
+  namespace: default
+spec:
+  schedule: "*/1 * * * *"
+  suspend: true
+  successfulJobsHistoryLimit: 3
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          containers:
+          - name: hello
+            image: busybox
+            args: []
+`
+
+func newCronJob(t *testing.T) *unstructured.Unstructured {
+	var obj unstructured.Unstructured
+	assert.NoError(t, yaml.Unmarshal([]byte(cronJobManifest), &obj))
+	return &obj
+}
+
+func TestExecuteAction(t *testing.T) {
+	obj := newCronJob(t)
+	updated, err := ExecuteAction(obj, `
+obj.spec.suspend = false
+obj.metadata.annotations = {}
+obj.metadata.annotations["resumedAt"] = os.date("!%Y")
+return obj`, DefaultActionTimeout)
+	assert.NoError(t, err)
+	suspend, _, _ := unstructured.NestedBool(updated.Object, "spec", "suspend")
+	assert.False(t, suspend)
+	assert.Contains(t, updated.GetAnnotations(), "resumedAt")
+	limit, _, _ := unstructured.NestedInt64(updated.Object, "spec", "successfulJobsHistoryLimit")
+	assert.Equal(t, int64(3), limit)
+	containers, _, _ := unstructured.NestedSlice(updated.Object, "spec", "jobTemplate", "spec", "template", "spec", "containers")
+	if assert.Len(t, containers, 1) {
+		// empty arrays are kept as arrays
+		assert.Equal(t, []interface{}{}, containers[0].(map[string]interface{})["args"])
+	}
+	// the given object is not modified
+	suspend, _, _ = unstructured.NestedBool(obj.Object, "spec", "suspend")
+	assert.True(t, suspend)
+}
+
+func TestExecuteActionErrors(t *testing.T) {
+	obj := newCronJob(t)
+	_, err := ExecuteAction(obj, `obj.spec.suspend = false`, DefaultActionTimeout)
+	assert.Contains(t, err.Error(), "instead of the modified object")
+
+	_, err = ExecuteAction(obj, `obj.metadata.name = "other"; return obj`, DefaultActionTimeout)
+	assert.Contains(t, err.Error(), "must not change")
+
+	_, err = ExecuteAction(obj, `os.execute("true"); return obj`, DefaultActionTimeout)
+	assert.Error(t, err)
+
+	_, err = ExecuteAction(obj, `dofile("/etc/passwd"); return obj`, DefaultActionTimeout)
+	assert.Error(t, err)
+
+	_, err = ExecuteAction(obj, `while true do end`, 100*time.Millisecond)
+	assert.Error(t, err)
+}
@@ -3,9 +3,14 @@ package oidc
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"html/template"
+	"io"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"net/url"
@@ -21,7 +26,7 @@ import (
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/util/cache"
 	httputil "github.com/argoproj/argo-cd/util/http"
-	"github.com/argoproj/argo-cd/util/rand"
+	argorand "github.com/argoproj/argo-cd/util/rand"
 	"github.com/argoproj/argo-cd/util/settings"
 )
 
@@ -29,14 +34,30 @@ const (
 	GrantTypeAuthorizationCode = "authorization_code"
 	GrantTypeImplicit          = "implicit"
 	ResponseTypeCode           = "code"
+	// CodeChallengeMethodS256 is the PKCE code challenge method hashing the code verifier with SHA-256
+	CodeChallengeMethodS256 = "S256"
 )
 
+const (
+	// stateExpiration is the duration during which a login flow can be completed
+	stateExpiration = 3 * time.Minute
+	// maxRetries is the number of times the requests to the identity provider are retried when they
+	// fail transiently (e.g. while Dex restarts)
+	maxRetries = 3
+)
+
+// retryInterval is the duration before retrying a failed request to the identity provider, which
+// doubles with each retry
+var retryInterval = 500 * time.Millisecond
+
 // OIDCConfiguration holds a subset of interested fields from the OIDC configuration spec
 type OIDCConfiguration struct {
 	Issuer                 string   `json:"issuer"`
 	ScopesSupported        []string `json:"scopes_supported"`
 	ResponseTypesSupported []string `json:"response_types_supported"`
 	GrantTypesSupported    []string `json:"grant_types_supported,omitempty"`
+	// CodeChallengeMethodsSupported lists the PKCE code challenge methods supported by the provider
+	CodeChallengeMethodsSupported []string `json:"code_challenge_methods_supported,omitempty"`
 }
 
 type ClientApp struct {
@@ -57,14 +78,18 @@ type ClientApp struct {
 	settings *settings.ArgoCDSettings
 	// provider is the OIDC configuration
 	provider *gooidc.Provider
-	// states holds temporary nonce tokens to which hold application state values
+	// states holds temporary nonce tokens to which hold application state values. Each state can
+	// only be used once, by the browser which started the login flow.
 	// See http://tools.ietf.org/html/rfc6749#section-10.12 for more info.
-	states cache.Cache
+	states *cache.InMemoryCache
 }
 
 type appState struct {
 	// ReturnURL is the URL in which to redirect a user back to after completing an OAuth2 login
 	ReturnURL string `json:"returnURL"`
+	// CodeVerifier is the PKCE code verifier of the login flow, if the provider supports PKCE
+	// See https://tools.ietf.org/html/rfc7636 for more info.
+	CodeVerifier string `json:"codeVerifier,omitempty"`
 }
 
 // NewClientApp will register the Argo CD client app (either via Dex or external OIDC) and return an
@@ -86,19 +111,22 @@ func NewClientApp(settings *settings.ArgoCDSettings) (*ClientApp, error) {
 		tlsConfig.InsecureSkipVerify = true
 	}
 	a.client = &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: tlsConfig,
-			Proxy:           http.ProxyFromEnvironment,
-			Dial: (&net.Dialer{
-				Timeout:   30 * time.Second,
-				KeepAlive: 30 * time.Second,
-			}).Dial,
-			TLSHandshakeTimeout:   10 * time.Second,
-			ExpectContinueTimeout: 1 * time.Second,
+		Transport: &retryTransport{
+			RoundTripper: &http.Transport{
+				TLSClientConfig: tlsConfig,
+				Proxy:           http.ProxyFromEnvironment,
+				Dial: (&net.Dialer{
+					Timeout:   30 * time.Second,
+					KeepAlive: 30 * time.Second,
+				}).Dial,
+				TLSHandshakeTimeout:   10 * time.Second,
+				ExpectContinueTimeout: 1 * time.Second,
+			},
+			retries: maxRetries,
 		},
 	}
 	// NOTE: if we ever have replicas of Argo CD, this needs to switch to Redis cache
-	a.states = cache.NewInMemoryCache(3 * time.Minute)
+	a.states = cache.NewInMemoryCache(stateExpiration)
 	a.secureCookie = bool(u.Scheme == "https")
 	a.settings = settings
 	return &a, nil
@@ -118,28 +146,39 @@ func (a *ClientApp) oauth2Config(scopes []string) (*oauth2.Config, error) {
 	}, nil
 }
 
-// generateAppState creates an app state nonce
-func (a *ClientApp) generateAppState(returnURL string) string {
-	randStr := rand.RandString(10)
+// generateAppState creates an app state nonce, and binds it to the browser with a cookie
+func (a *ClientApp) generateAppState(w http.ResponseWriter, returnURL string, codeVerifier string) (string, error) {
+	state, err := randomString()
+	if err != nil {
+		return "", err
+	}
 	if returnURL == "" {
 		returnURL = "/"
 	}
-	err := a.states.Set(&cache.Item{
-		Key: randStr,
+	err = a.states.Set(&cache.Item{
+		Key: state,
 		Object: &appState{
-			ReturnURL: returnURL,
+			ReturnURL:    returnURL,
+			CodeVerifier: codeVerifier,
 		},
 	})
 	if err != nil {
 		// This should never happen with the in-memory cache
 		log.Errorf("Failed to set app state: %v", err)
 	}
-	return randStr
+	a.setStateCookie(w, state, int(stateExpiration.Seconds()))
+	return state, nil
 }
 
-func (a *ClientApp) verifyAppState(state string) (*appState, error) {
+// verifyAppState verifies that the state was generated for the browser which sent the request, and
+// removes it so that it cannot be replayed
+func (a *ClientApp) verifyAppState(w http.ResponseWriter, r *http.Request, state string) (*appState, error) {
+	cookie, err := r.Cookie(common.StateCookieName)
+	if err != nil || cookie.Value != state {
+		return nil, fmt.Errorf("app state %s was not issued to this browser", state)
+	}
 	var aState appState
-	err := a.states.Get(state, &aState)
+	err = a.states.Get(state, &aState)
 	if err != nil {
 		if err == cache.ErrCacheMiss {
 			return nil, fmt.Errorf("unknown app state %s", state)
@@ -147,10 +186,68 @@ func (a *ClientApp) verifyAppState(state string) (*appState, error) {
 			return nil, fmt.Errorf("failed to verify app state %s: %v", state, err)
 		}
 	}
-	// TODO: purge the state string from the cache so that it is a true nonce
+	a.states.Delete(state)
+	a.setStateCookie(w, "", -1)
 	return &aState, nil
 }
 
+// setStateCookie sets the cookie holding the state of the login flow, which expires after the given
+// number of seconds or is deleted if negative
+func (a *ClientApp) setStateCookie(w http.ResponseWriter, state string, maxAge int) {
+	flags := []string{"path=" + common.CallbackEndpoint, fmt.Sprintf("Max-Age=%d", maxAge), "HttpOnly"}
+	if a.secureCookie {
+		flags = append(flags, "Secure")
+	}
+	w.Header().Add("Set-Cookie", httputil.MakeCookieMetadata(common.StateCookieName, state, flags...))
+}
+
+// isValidReturnURL returns whether the user can be redirected to the given URL after logging in,
+// which must be a path of Argo CD, or a URL of Argo CD at the given base URL
+func isValidReturnURL(baseURL string, returnURL string) bool {
+	if returnURL == "" {
+		return true
+	}
+	if strings.HasPrefix(returnURL, "/") {
+		// protocol relative URLs (e.g. //example.com) would redirect to other hosts
+		return !strings.HasPrefix(returnURL, "//") && !strings.HasPrefix(returnURL, "/\\")
+	}
+	base, err := url.Parse(baseURL)
+	if err != nil || base.Host == "" {
+		return false
+	}
+	u, err := url.Parse(returnURL)
+	if err != nil {
+		return false
+	}
+	return u.Scheme == base.Scheme && u.Host == base.Host
+}
+
+// randomString returns a URL safe string encoding 32 cryptographically secure random bytes
+func randomString() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// codeChallenge returns the S256 PKCE code challenge of the code verifier
+func codeChallenge(codeVerifier string) string {
+	hash := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// supportsPKCE returns whether the provider supports the S256 PKCE code challenge method
+func supportsPKCE(oidcConf *OIDCConfiguration) bool {
+	for _, method := range oidcConf.CodeChallengeMethodsSupported {
+		if method == CodeChallengeMethodS256 {
+			return true
+		}
+	}
+	return false
+}
+
 // HandleLogin formulates the proper OAuth2 URL (auth code or implicit) and redirects the user to
 // the IDp login & consent page
 func (a *ClientApp) HandleLogin(w http.ResponseWriter, r *http.Request) {
@@ -171,12 +268,32 @@ func (a *ClientApp) HandleLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	returnURL := r.FormValue("return_url")
-	stateNonce := a.generateAppState(returnURL)
+	if !isValidReturnURL(a.settings.URL, returnURL) {
+		http.Error(w, fmt.Sprintf("Invalid return URL: %s", returnURL), http.StatusBadRequest)
+		return
+	}
 	grantType := InferGrantType(oauth2Config, oidcConf)
+	var opts []oauth2.AuthCodeOption
+	var codeVerifier string
+	if grantType == GrantTypeAuthorizationCode && supportsPKCE(oidcConf) {
+		codeVerifier, err = randomString()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		opts = append(opts,
+			oauth2.SetAuthURLParam("code_challenge", codeChallenge(codeVerifier)),
+			oauth2.SetAuthURLParam("code_challenge_method", CodeChallengeMethodS256))
+	}
+	stateNonce, err := a.generateAppState(w, returnURL, codeVerifier)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 	var url string
 	switch grantType {
 	case GrantTypeAuthorizationCode:
-		url = oauth2Config.AuthCodeURL(stateNonce)
+		url = oauth2Config.AuthCodeURL(stateNonce, opts...)
 	case GrantTypeImplicit:
 		url = ImplicitFlowURL(oauth2Config, stateNonce)
 	default:
@@ -207,11 +324,20 @@ func (a *ClientApp) HandleCallback(w http.ResponseWriter, r *http.Request) {
 		a.handleImplicitFlow(w, r, state)
 		return
 	}
-	appState, err := a.verifyAppState(state)
+	appState, err := a.verifyAppState(w, r, state)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	if appState.CodeVerifier != "" {
+		ctx = gooidc.ClientContext(r.Context(), &http.Client{
+			Transport: &codeVerifierTransport{
+				RoundTripper: a.client.Transport,
+				tokenURL:     oauth2Config.Endpoint.TokenURL,
+				codeVerifier: appState.CodeVerifier,
+			},
+		})
+	}
 	token, err := oauth2Config.Exchange(ctx, code)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("failed to get token: %v", err), http.StatusInternalServerError)
@@ -232,7 +358,7 @@ func (a *ClientApp) HandleCallback(w http.ResponseWriter, r *http.Request) {
 		flags = append(flags, "Secure")
 	}
 	cookie := httputil.MakeCookieMetadata(common.AuthCookieName, idTokenRAW, flags...)
-	w.Header().Set("Set-Cookie", cookie)
+	w.Header().Add("Set-Cookie", cookie)
 
 	var claims jwt.MapClaims
 	err = idToken.Claims(&claims)
@@ -278,7 +404,7 @@ func (a *ClientApp) handleImplicitFlow(w http.ResponseWriter, r *http.Request, s
 		CookieName: common.AuthCookieName,
 	}
 	if state != "" {
-		appState, err := a.verifyAppState(state)
+		appState, err := a.verifyAppState(w, r, state)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
@@ -330,7 +456,7 @@ func ImplicitFlowURL(c *oauth2.Config, state string, opts ...oauth2.AuthCodeOpti
 	buf.WriteString(c.Endpoint.AuthURL)
 	v := url.Values{
 		"response_type": {"id_token"},
-		"nonce":         {rand.RandString(10)},
+		"nonce":         {argorand.RandString(10)},
 		"client_id":     {c.ClientID},
 		"redirect_uri":  condVal(c.RedirectURL),
 		"scope":         condVal(strings.Join(c.Scopes, " ")),
@@ -405,3 +531,89 @@ func InferGrantType(oauth2conf *oauth2.Config, oidcConf *OIDCConfiguration) stri
 	// If we don't have the client secret (e.g. SPA app), we can assume to be implicit
 	return GrantTypeImplicit
 }
+
+// retryTransport retries the requests which fail transiently, because the identity provider cannot be
+// reached or is unavailable (e.g. while Dex restarts)
+type retryTransport struct {
+	http.RoundTripper
+	retries int
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.RoundTripper.RoundTrip(req)
+		if attempt >= t.retries || !isTransientFailure(resp, err) {
+			return resp, err
+		}
+		if req.Body != nil {
+			// the body of the request is consumed, so it can only be sent again if it can be copied
+			if req.GetBody == nil {
+				return resp, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, err
+			}
+			retryReq := *req
+			retryReq.Body = body
+			req = &retryReq
+		}
+		if resp != nil {
+			_, _ = io.Copy(ioutil.Discard, resp.Body)
+			_ = resp.Body.Close()
+			err = fmt.Errorf("status %s", resp.Status)
+		}
+		interval := retryInterval * time.Duration(1<<uint(attempt))
+		log.Warnf("Request to %s failed, retrying in %s: %v", req.URL, interval, err)
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// isTransientFailure returns whether the request failed because the server could not be reached or
+// was unavailable
+func isTransientFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// codeVerifierTransport adds the PKCE code verifier to the token requests of the authorization code
+// flow, since the oauth2 client cannot add parameters to them
+type codeVerifierTransport struct {
+	http.RoundTripper
+	tokenURL     string
+	codeVerifier string
+}
+
+func (t *codeVerifierTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != "POST" || req.URL.String() != t.tokenURL || req.Body == nil {
+		return t.RoundTripper.RoundTrip(req)
+	}
+	data, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	_ = req.Body.Close()
+	values, err := url.ParseQuery(string(data))
+	if err != nil {
+		return nil, err
+	}
+	values.Set("code_verifier", t.codeVerifier)
+	body := values.Encode()
+	tokenReq := *req
+	tokenReq.Body = ioutil.NopCloser(strings.NewReader(body))
+	tokenReq.ContentLength = int64(len(body))
+	tokenReq.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(strings.NewReader(body)), nil
+	}
+	return t.RoundTripper.RoundTrip(&tokenReq)
+}
@@ -3,11 +3,18 @@ package oidc
 import (
 	"encoding/json"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"golang.org/x/oauth2"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/util/cache"
 )
 
 var (
@@ -47,3 +54,106 @@ func TestInferGrantType(t *testing.T) {
 		assert.Equal(t, GrantTypeAuthorizationCode, grantType)
 	}
 }
+
+func TestVerifyAppState(t *testing.T) {
+	app := ClientApp{states: cache.NewInMemoryCache(time.Minute)}
+	rr := httptest.NewRecorder()
+	state, err := app.generateAppState(rr, "/applications", "verifier")
+	assert.NoError(t, err)
+	cookie := rr.Result().Cookies()[0]
+	assert.Equal(t, common.StateCookieName, cookie.Name)
+	assert.Equal(t, state, cookie.Value)
+	assert.True(t, cookie.HttpOnly)
+
+	// the state is only valid for the browser the state cookie was set to
+	req := httptest.NewRequest("GET", "/auth/callback?state="+state, nil)
+	_, err = app.verifyAppState(httptest.NewRecorder(), req, state)
+	assert.Error(t, err)
+
+	req.AddCookie(&http.Cookie{Name: common.StateCookieName, Value: state})
+	rr = httptest.NewRecorder()
+	appState, err := app.verifyAppState(rr, req, state)
+	assert.NoError(t, err)
+	assert.Equal(t, "/applications", appState.ReturnURL)
+	assert.Equal(t, "verifier", appState.CodeVerifier)
+	assert.Equal(t, -1, rr.Result().Cookies()[0].MaxAge)
+
+	// the state cannot be replayed
+	_, err = app.verifyAppState(httptest.NewRecorder(), req, state)
+	assert.Error(t, err)
+}
+
+func TestIsValidReturnURL(t *testing.T) {
+	baseURL := "https://argocd.example.com"
+	assert.True(t, isValidReturnURL(baseURL, ""))
+	assert.True(t, isValidReturnURL(baseURL, "/applications"))
+	assert.True(t, isValidReturnURL(baseURL, "https://argocd.example.com/applications/guestbook"))
+	assert.False(t, isValidReturnURL(baseURL, "https://evil.example.com/applications"))
+	assert.False(t, isValidReturnURL(baseURL, "http://argocd.example.com/applications"))
+	assert.False(t, isValidReturnURL(baseURL, "//evil.example.com"))
+	assert.False(t, isValidReturnURL("", "https://argocd.example.com"))
+}
+
+func TestCodeChallenge(t *testing.T) {
+	// example of https://tools.ietf.org/html/rfc7636#appendix-B
+	assert.Equal(t, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", codeChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"))
+	assert.True(t, supportsPKCE(&OIDCConfiguration{CodeChallengeMethodsSupported: []string{"plain", "S256"}}))
+	assert.False(t, supportsPKCE(&OIDCConfiguration{}))
+}
+
+func TestRetryTransport(t *testing.T) {
+	retryInterval = time.Millisecond
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.URL.Path == "/invalid" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: &retryTransport{RoundTripper: http.DefaultTransport, retries: maxRetries}}
+
+	resp, err := client.Post(server.URL, "text/plain", strings.NewReader("code=abc"))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	body, _ := ioutil.ReadAll(resp.Body)
+	assert.Equal(t, "code=abc", string(body))
+	assert.Equal(t, 3, requests)
+
+	// client errors are not retried
+	requests = 0
+	resp, err = client.Get(server.URL + "/invalid")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, 1, requests)
+}
+
+func TestCodeVerifierTransport(t *testing.T) {
+	var form map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		form = r.PostForm
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: &codeVerifierTransport{
+		RoundTripper: http.DefaultTransport,
+		tokenURL:     server.URL + "/token",
+		codeVerifier: "verifier",
+	}}
+
+	_, err := client.PostForm(server.URL+"/token", map[string][]string{"code": {"abc"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"abc"}, form["code"])
+	assert.Equal(t, []string{"verifier"}, form["code_verifier"])
+
+	_, err = client.PostForm(server.URL+"/other", map[string][]string{"code": {"abc"}})
+	assert.NoError(t, err)
+	assert.Nil(t, form["code_verifier"])
+}
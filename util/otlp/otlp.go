@@ -0,0 +1,351 @@
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// scopeName is the instrumentation scope of the exported metrics
+	scopeName = "github.com/argoproj/argo-cd"
+	// aggregationTemporalityCumulative is the OTLP temporality of prometheus counters and histograms
+	aggregationTemporalityCumulative = 2
+	// exportTimeout is the time limit of a push of the metrics to the collector
+	exportTimeout = 30 * time.Second
+)
+
+// Exporter periodically pushes the metrics of a prometheus gatherer to an OpenTelemetry collector,
+// using the OTLP/HTTP protocol with JSON encoding
+type Exporter struct {
+	endpoint   string
+	gatherer   prometheus.Gatherer
+	attributes []keyValue
+	client     *http.Client
+	startTime  time.Time
+}
+
+// NewExporter returns a new exporter of the metrics of the given gatherer to the OTLP/HTTP metrics
+// endpoint of a collector (e.g. http://otel-collector:4318/v1/metrics). The given resource attributes
+// identify the exporting process
+func NewExporter(endpoint string, gatherer prometheus.Gatherer, resourceAttributes map[string]string) *Exporter {
+	keys := make([]string, 0, len(resourceAttributes))
+	for key := range resourceAttributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	attributes := make([]keyValue, 0, len(keys))
+	for _, key := range keys {
+		attributes = append(attributes, newKeyValue(key, resourceAttributes[key]))
+	}
+	return &Exporter{
+		endpoint:   endpoint,
+		gatherer:   gatherer,
+		attributes: attributes,
+		client:     &http.Client{Timeout: exportTimeout},
+		startTime:  time.Now(),
+	}
+}
+
+// ResourceAttributes returns the resource attributes identifying an instance of an Argo CD service.
+// The instance name defaults to the hostname, which is the pod name when running in Kubernetes
+func ResourceAttributes(serviceName, instanceName, version string) map[string]string {
+	if instanceName == "" {
+		instanceName, _ = os.Hostname()
+	}
+	return map[string]string{
+		"service.name":        serviceName,
+		"service.instance.id": instanceName,
+		"service.version":     version,
+	}
+}
+
+// Run pushes the metrics at the given interval until the context is done. The metrics are not pushed if
+// the interval is not positive
+func (e *Exporter) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		log.Warnf("Not exporting metrics to %s: invalid interval %v", e.endpoint, interval)
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := e.Export(ctx); err != nil {
+				log.Warnf("Failed to export metrics to %s: %v", e.endpoint, err)
+			}
+		}
+	}
+}
+
+// Export pushes the current values of the metrics to the collector
+func (e *Exporter) Export(ctx context.Context) error {
+	families, err := e.gatherer.Gather()
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(e.newRequest(families, time.Now()))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := e.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("collector responded with status %d: %s", resp.StatusCode, string(message))
+	}
+	return nil
+}
+
+// newRequest converts the gathered metric families to an OTLP export request
+func (e *Exporter) newRequest(families []*dto.MetricFamily, now time.Time) *exportMetricsRequest {
+	metrics := make([]metric, 0, len(families))
+	for _, family := range families {
+		metrics = append(metrics, newMetric(family, e.startTime, now))
+	}
+	return &exportMetricsRequest{
+		ResourceMetrics: []resourceMetrics{{
+			Resource: resource{Attributes: e.attributes},
+			ScopeMetrics: []scopeMetrics{{
+				Scope:   scope{Name: scopeName},
+				Metrics: metrics,
+			}},
+		}},
+	}
+}
+
+// newMetric converts a prometheus metric family. Counters become cumulative monotonic sums, and
+// untyped metrics become gauges
+func newMetric(family *dto.MetricFamily, startTime, now time.Time) metric {
+	res := metric{Name: family.GetName(), Description: family.GetHelp()}
+	start := uint64(startTime.UnixNano())
+	switch family.GetType() {
+	case dto.MetricType_COUNTER:
+		res.Sum = &sum{AggregationTemporality: aggregationTemporalityCumulative, IsMonotonic: true}
+		for _, m := range family.Metric {
+			res.Sum.DataPoints = append(res.Sum.DataPoints, numberDataPoint{
+				Attributes:        newAttributes(m),
+				StartTimeUnixNano: start,
+				TimeUnixNano:      timestamp(m, now),
+				AsDouble:          jsonFloat(m.GetCounter().GetValue()),
+			})
+		}
+	case dto.MetricType_HISTOGRAM:
+		res.Histogram = &histogram{AggregationTemporality: aggregationTemporalityCumulative}
+		for _, m := range family.Metric {
+			res.Histogram.DataPoints = append(res.Histogram.DataPoints, newHistogramDataPoint(m, start, now))
+		}
+	case dto.MetricType_SUMMARY:
+		res.Summary = &summary{}
+		for _, m := range family.Metric {
+			point := summaryDataPoint{
+				Attributes:        newAttributes(m),
+				StartTimeUnixNano: start,
+				TimeUnixNano:      timestamp(m, now),
+				Count:             m.GetSummary().GetSampleCount(),
+				Sum:               jsonFloat(m.GetSummary().GetSampleSum()),
+			}
+			for _, q := range m.GetSummary().GetQuantile() {
+				point.QuantileValues = append(point.QuantileValues, valueAtQuantile{Quantile: q.GetQuantile(), Value: jsonFloat(q.GetValue())})
+			}
+			res.Summary.DataPoints = append(res.Summary.DataPoints, point)
+		}
+	default:
+		res.Gauge = &gauge{}
+		for _, m := range family.Metric {
+			value := m.GetGauge().GetValue()
+			if family.GetType() == dto.MetricType_UNTYPED {
+				value = m.GetUntyped().GetValue()
+			}
+			res.Gauge.DataPoints = append(res.Gauge.DataPoints, numberDataPoint{
+				Attributes:   newAttributes(m),
+				TimeUnixNano: timestamp(m, now),
+				AsDouble:     jsonFloat(value),
+			})
+		}
+	}
+	return res
+}
+
+// newHistogramDataPoint converts a prometheus histogram. Prometheus buckets are cumulative, while
+// OTLP buckets only count the observations between their bounds, and end with an unbounded bucket
+func newHistogramDataPoint(m *dto.Metric, start uint64, now time.Time) histogramDataPoint {
+	h := m.GetHistogram()
+	point := histogramDataPoint{
+		Attributes:        newAttributes(m),
+		StartTimeUnixNano: start,
+		TimeUnixNano:      timestamp(m, now),
+		Count:             h.GetSampleCount(),
+		Sum:               jsonFloat(h.GetSampleSum()),
+	}
+	var prev uint64
+	for _, bucket := range h.GetBucket() {
+		if math.IsInf(bucket.GetUpperBound(), 1) {
+			continue
+		}
+		point.ExplicitBounds = append(point.ExplicitBounds, jsonFloat(bucket.GetUpperBound()))
+		point.BucketCounts = append(point.BucketCounts, jsonUint64(bucket.GetCumulativeCount()-prev))
+		prev = bucket.GetCumulativeCount()
+	}
+	point.BucketCounts = append(point.BucketCounts, jsonUint64(h.GetSampleCount()-prev))
+	return point
+}
+
+func newAttributes(m *dto.Metric) []keyValue {
+	attributes := make([]keyValue, 0, len(m.GetLabel()))
+	for _, label := range m.GetLabel() {
+		attributes = append(attributes, newKeyValue(label.GetName(), label.GetValue()))
+	}
+	return attributes
+}
+
+func timestamp(m *dto.Metric, now time.Time) uint64 {
+	if m.TimestampMs != nil {
+		return uint64(m.GetTimestampMs()) * uint64(time.Millisecond)
+	}
+	return uint64(now.UnixNano())
+}
+
+func newKeyValue(key, value string) keyValue {
+	return keyValue{Key: key, Value: anyValue{StringValue: value}}
+}
+
+// The types below are the subset of the OTLP metrics protocol used by the exporter, encoded following
+// the JSON mapping of protocol buffers
+
+type exportMetricsRequest struct {
+	ResourceMetrics []resourceMetrics `json:"resourceMetrics"`
+}
+
+type resourceMetrics struct {
+	Resource     resource       `json:"resource"`
+	ScopeMetrics []scopeMetrics `json:"scopeMetrics"`
+}
+
+type resource struct {
+	Attributes []keyValue `json:"attributes"`
+}
+
+type scopeMetrics struct {
+	Scope   scope    `json:"scope"`
+	Metrics []metric `json:"metrics"`
+}
+
+type scope struct {
+	Name string `json:"name"`
+}
+
+type keyValue struct {
+	Key   string   `json:"key"`
+	Value anyValue `json:"value"`
+}
+
+type anyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type metric struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	Gauge       *gauge     `json:"gauge,omitempty"`
+	Sum         *sum       `json:"sum,omitempty"`
+	Histogram   *histogram `json:"histogram,omitempty"`
+	Summary     *summary   `json:"summary,omitempty"`
+}
+
+type gauge struct {
+	DataPoints []numberDataPoint `json:"dataPoints"`
+}
+
+type sum struct {
+	DataPoints             []numberDataPoint `json:"dataPoints"`
+	AggregationTemporality int               `json:"aggregationTemporality"`
+	IsMonotonic            bool              `json:"isMonotonic"`
+}
+
+type histogram struct {
+	DataPoints             []histogramDataPoint `json:"dataPoints"`
+	AggregationTemporality int                  `json:"aggregationTemporality"`
+}
+
+type summary struct {
+	DataPoints []summaryDataPoint `json:"dataPoints"`
+}
+
+type numberDataPoint struct {
+	Attributes        []keyValue `json:"attributes"`
+	StartTimeUnixNano uint64     `json:"startTimeUnixNano,string,omitempty"`
+	TimeUnixNano      uint64     `json:"timeUnixNano,string"`
+	AsDouble          jsonFloat  `json:"asDouble"`
+}
+
+type histogramDataPoint struct {
+	Attributes        []keyValue   `json:"attributes"`
+	StartTimeUnixNano uint64       `json:"startTimeUnixNano,string"`
+	TimeUnixNano      uint64       `json:"timeUnixNano,string"`
+	Count             uint64       `json:"count,string"`
+	Sum               jsonFloat    `json:"sum"`
+	BucketCounts      []jsonUint64 `json:"bucketCounts"`
+	ExplicitBounds    []jsonFloat  `json:"explicitBounds"`
+}
+
+type summaryDataPoint struct {
+	Attributes        []keyValue        `json:"attributes"`
+	StartTimeUnixNano uint64            `json:"startTimeUnixNano,string"`
+	TimeUnixNano      uint64            `json:"timeUnixNano,string"`
+	Count             uint64            `json:"count,string"`
+	Sum               jsonFloat         `json:"sum"`
+	QuantileValues    []valueAtQuantile `json:"quantileValues"`
+}
+
+type valueAtQuantile struct {
+	Quantile float64   `json:"quantile"`
+	Value    jsonFloat `json:"value"`
+}
+
+// jsonFloat is a float encoded as a string when it is not a finite number, which JSON numbers cannot hold
+type jsonFloat float64
+
+func (f jsonFloat) MarshalJSON() ([]byte, error) {
+	v := float64(f)
+	switch {
+	case math.IsNaN(v):
+		return []byte(`"NaN"`), nil
+	case math.IsInf(v, 1):
+		return []byte(`"Infinity"`), nil
+	case math.IsInf(v, -1):
+		return []byte(`"-Infinity"`), nil
+	}
+	return json.Marshal(v)
+}
+
+// jsonUint64 is a 64-bit integer encoded as a string, which is how 64-bit integers are mapped to JSON
+type jsonUint64 uint64
+
+func (i jsonUint64) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(strconv.FormatUint(uint64(i), 10))), nil
+}
@@ -0,0 +1,98 @@
+package otlp
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExport(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_total", Help: "Test counter."}, []string{"name"})
+	counter.WithLabelValues("guestbook").Add(3)
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{Name: "test_duration_seconds", Help: "Test histogram.", Buckets: []float64{1, 5}})
+	for _, v := range []float64{0.5, 2, 3, 10} {
+		histogram.Observe(v)
+	}
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "test_gauge", Help: "Test gauge."})
+	gauge.Set(math.NaN())
+	registry.MustRegister(counter, histogram, gauge)
+
+	var body map[string]interface{}
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/metrics", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		data, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.NoError(t, json.Unmarshal(data, &body))
+	}))
+	defer collector.Close()
+
+	exporter := NewExporter(collector.URL+"/v1/metrics", registry, ResourceAttributes("argocd-server", "argocd-server-0", "v0.11.0"))
+	assert.NoError(t, exporter.Export(context.Background()))
+
+	resourceMetrics := body["resourceMetrics"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"key": "service.instance.id", "value": map[string]interface{}{"stringValue": "argocd-server-0"}},
+		map[string]interface{}{"key": "service.name", "value": map[string]interface{}{"stringValue": "argocd-server"}},
+		map[string]interface{}{"key": "service.version", "value": map[string]interface{}{"stringValue": "v0.11.0"}},
+	}, resourceMetrics["resource"].(map[string]interface{})["attributes"])
+
+	metrics := make(map[string]map[string]interface{})
+	for _, m := range resourceMetrics["scopeMetrics"].([]interface{})[0].(map[string]interface{})["metrics"].([]interface{}) {
+		metric := m.(map[string]interface{})
+		metrics[metric["name"].(string)] = metric
+	}
+
+	sum := metrics["test_total"]["sum"].(map[string]interface{})
+	assert.Equal(t, "Test counter.", metrics["test_total"]["description"])
+	assert.Equal(t, true, sum["isMonotonic"])
+	assert.Equal(t, float64(aggregationTemporalityCumulative), sum["aggregationTemporality"])
+	point := sum["dataPoints"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, float64(3), point["asDouble"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"key": "name", "value": map[string]interface{}{"stringValue": "guestbook"}}}, point["attributes"])
+
+	point = metrics["test_duration_seconds"]["histogram"].(map[string]interface{})["dataPoints"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "4", point["count"])
+	assert.Equal(t, 15.5, point["sum"])
+	assert.Equal(t, []interface{}{float64(1), float64(5)}, point["explicitBounds"])
+	assert.Equal(t, []interface{}{"1", "2", "1"}, point["bucketCounts"])
+
+	point = metrics["test_gauge"]["gauge"].(map[string]interface{})["dataPoints"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "NaN", point["asDouble"])
+}
+
+func TestExportError(t *testing.T) {
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer collector.Close()
+
+	exporter := NewExporter(collector.URL, prometheus.NewRegistry(), nil)
+	err := exporter.Export(context.Background())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "status 503")
+}
+
+func TestRunInvalidInterval(t *testing.T) {
+	exporter := NewExporter("http://localhost:4318/v1/metrics", prometheus.NewRegistry(), nil)
+	done := make(chan struct{})
+	go func() {
+		// the metrics are not pushed, rather than panicking on the ticker
+		exporter.Run(context.Background(), 0)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("exporter ran with an invalid interval")
+	}
+}
@@ -1,8 +1,10 @@
 package password
 
 import (
+	"crypto/rand"
 	"crypto/subtle"
 	"fmt"
+	"math/big"
 
 	"golang.org/x/crypto/bcrypt"
 )
@@ -60,11 +62,34 @@ func verifyPasswordWithHashers(password, hashedPassword string, hashers []Passwo
 	return valid, stale
 }
 
+// passwordCharset holds the characters of generated passwords, which are easy to type and to copy
+const passwordCharset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// GeneratePassword generates a cryptographically-secure random password of the given length.
+func GeneratePassword(length int) (string, error) {
+	b := make([]byte, length)
+	max := big.NewInt(int64(len(passwordCharset)))
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = passwordCharset[n.Int64()]
+	}
+	return string(b), nil
+}
+
 // HashPassword hashes against the current preferred hasher.
 func HashPassword(password string) (string, error) {
 	return hashPasswordWithHashers(password, preferredHashers)
 }
 
+// HashPasswordWithCost hashes against the current preferred hasher, with the given bcrypt work factor.
+// The work factor is always at least bcrypt.DefaultCost.
+func HashPasswordWithCost(password string, cost int) (string, error) {
+	return hashPasswordWithHashers(password, []PasswordHasher{BcryptPasswordHasher{Cost: cost}})
+}
+
 // VerifyPassword verifies an entered password against a hashed password and returns whether the hash is "stale" (i.e., was verified using the FIRST preferred hasher above).
 func VerifyPassword(password, hashedPassword string) (valid, stale bool) {
 	valid, stale = verifyPasswordWithHashers(password, hashedPassword, preferredHashers)
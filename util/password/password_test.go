@@ -2,6 +2,8 @@ package password
 
 import (
 	"testing"
+
+	"golang.org/x/crypto/bcrypt"
 )
 
 func testPasswordHasher(t *testing.T, h PasswordHasher) {
@@ -66,3 +68,43 @@ func TestPasswordHashing(t *testing.T) {
 		t.Errorf("Blank password should have failed verification")
 	}
 }
+
+func TestGeneratePassword(t *testing.T) {
+	first, err := GeneratePassword(16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first) != 16 {
+		t.Errorf("Password %q should have 16 characters", first)
+	}
+	second, err := GeneratePassword(16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first == second {
+		t.Errorf("Generated passwords should differ, got %q twice", first)
+	}
+}
+
+func TestHashPasswordWithCost(t *testing.T) {
+	const defaultPassword = "Hello, world!"
+	hashedPassword, err := HashPasswordWithCost(defaultPassword, bcrypt.DefaultCost+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cost, _ := bcrypt.Cost([]byte(hashedPassword)); cost != bcrypt.DefaultCost+1 {
+		t.Errorf("Hash %q should have cost %d, got %d", hashedPassword, bcrypt.DefaultCost+1, cost)
+	}
+	if valid, stale := VerifyPassword(defaultPassword, hashedPassword); !valid || stale {
+		t.Errorf("Password %q should have validated against hash %q", defaultPassword, hashedPassword)
+	}
+
+	// costs lower than the default cost are raised to the default cost
+	hashedPassword, err = HashPasswordWithCost(defaultPassword, bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cost, _ := bcrypt.Cost([]byte(hashedPassword)); cost != bcrypt.DefaultCost {
+		t.Errorf("Hash %q should have cost %d, got %d", hashedPassword, bcrypt.DefaultCost, cost)
+	}
+}
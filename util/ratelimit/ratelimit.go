@@ -0,0 +1,267 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/net/context"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/util/session"
+)
+
+// idleTimeout is the time after which the buckets of the clients which did not make requests are forgotten
+const idleTimeout = 10 * time.Minute
+
+// DefaultTrustedProxies are the proxies whose forwarded client addresses are trusted by default: the
+// gateway of the REST API, which runs in the API server
+var DefaultTrustedProxies = []string{"127.0.0.0/8", "::1/128"}
+
+// Limit is the rate limit of a token bucket
+type Limit struct {
+	// Rate is the number of requests per second
+	Rate float64
+	// Burst is the maximum number of requests made at once
+	Burst int
+}
+
+// ParseMethodLimit parses the rate limit of an API method, formatted as METHOD=RATE[:BURST] (e.g.
+// application.ApplicationService/Sync=0.5:2). The burst defaults to the rate, rounded up
+func ParseMethodLimit(s string) (string, Limit, error) {
+	parts := strings.SplitN(s, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return "", Limit{}, fmt.Errorf("rate limit '%s' must be formatted as METHOD=RATE[:BURST]", s)
+	}
+	method := "/" + strings.TrimPrefix(parts[0], "/")
+	values := strings.SplitN(parts[1], ":", 2)
+	r, err := strconv.ParseFloat(values[0], 64)
+	if err != nil || r <= 0 {
+		return "", Limit{}, fmt.Errorf("rate of rate limit '%s' must be a positive number", s)
+	}
+	limit := Limit{Rate: r, Burst: int(math.Ceil(r))}
+	if len(values) == 2 {
+		limit.Burst, err = strconv.Atoi(values[1])
+		if err != nil || limit.Burst <= 0 {
+			return "", Limit{}, fmt.Errorf("burst of rate limit '%s' must be a positive integer", s)
+		}
+	}
+	return method, limit, nil
+}
+
+// ParseTrustedProxies parses the IP addresses or CIDR ranges of trusted proxies
+func ParseTrustedProxies(proxies []string) ([]*net.IPNet, error) {
+	var nets []*net.IPNet
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("trusted proxy '%s' must be an IP address or a CIDR range", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy '%s' must be an IP address or a CIDR range", proxy)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
+}
+
+// bucket is the token bucket of a client, and the time it was last used
+type bucket struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+}
+
+// Limiter limits the rate of the API requests of each client. The clients are identified by their user
+// if they are authenticated, or by their IP address otherwise
+type Limiter struct {
+	clientLimit    Limit
+	methodLimits   map[string]Limit
+	trustedProxies []*net.IPNet
+	lock           sync.Mutex
+	// buckets holds the buckets of the client limit by client, and the buckets of the method limits by
+	// client and method
+	buckets     map[string]*bucket
+	lastCleanup time.Time
+
+	rejectedCounter *prometheus.CounterVec
+}
+
+// NewLimiter returns a limiter allowing each client to make requests at the given client limit,
+// and to call the given methods at their limits. The requests are not limited by client if the rate
+// of the client limit is 0. The addresses forwarded by the given trusted proxies identify the clients
+// of the requests they make
+func NewLimiter(clientLimit Limit, methodLimits map[string]Limit, trustedProxies []*net.IPNet) *Limiter {
+	return &Limiter{
+		clientLimit:    clientLimit,
+		methodLimits:   methodLimits,
+		trustedProxies: trustedProxies,
+		buckets:        make(map[string]*bucket),
+		lastCleanup:    time.Now(),
+		rejectedCounter: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "argocd_api_rate_limited_requests_total",
+				Help: "Number of API requests rejected because their client exceeded a rate limit.",
+			},
+			[]string{"grpc_service", "grpc_method", "limit"},
+		),
+	}
+}
+
+// Describe implements the prometheus.Collector interface
+func (l *Limiter) Describe(ch chan<- *prometheus.Desc) {
+	l.rejectedCounter.Describe(ch)
+}
+
+// Collect implements the prometheus.Collector interface
+func (l *Limiter) Collect(ch chan<- prometheus.Metric) {
+	l.rejectedCounter.Collect(ch)
+}
+
+// allow returns whether the client can call the method now, and the limit it exceeded otherwise. Tokens
+// are only taken from the buckets of the client if the call is allowed by all its limits
+func (l *Limiter) allow(client string, fullMethod string) (bool, string) {
+	now := time.Now()
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if now.Sub(l.lastCleanup) > idleTimeout {
+		for key, b := range l.buckets {
+			if now.Sub(b.lastUsed) > idleTimeout {
+				delete(l.buckets, key)
+			}
+		}
+		l.lastCleanup = now
+	}
+	var methodReservation *rate.Reservation
+	if limit, ok := l.methodLimits[fullMethod]; ok {
+		if methodReservation = l.reserve(client+" "+fullMethod, limit, now); methodReservation == nil {
+			return false, "method"
+		}
+	}
+	if l.clientLimit.Rate > 0 && l.reserve(client, l.clientLimit, now) == nil {
+		if methodReservation != nil {
+			methodReservation.CancelAt(now)
+		}
+		return false, "client"
+	}
+	return true, ""
+}
+
+// reserve takes a token from the bucket with the given key if there is one, and returns the reservation
+// of the token, which is cancelled to give it back. It returns nil if the bucket is empty
+func (l *Limiter) reserve(key string, limit Limit, now time.Time) *rate.Reservation {
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst)}
+		l.buckets[key] = b
+	}
+	b.lastUsed = now
+	r := b.limiter.ReserveN(now, 1)
+	if !r.OK() {
+		return nil
+	}
+	if r.DelayFrom(now) > 0 {
+		r.CancelAt(now)
+		return nil
+	}
+	return r
+}
+
+// check returns a ResourceExhausted error if the client of the request exceeded a rate limit
+func (l *Limiter) check(ctx context.Context, fullMethod string) error {
+	client := l.clientOf(ctx)
+	allowed, limit := l.allow(client, fullMethod)
+	if allowed {
+		return nil
+	}
+	service, method := "unknown", "unknown"
+	if parts := strings.SplitN(strings.TrimPrefix(fullMethod, "/"), "/", 2); len(parts) == 2 {
+		service, method = parts[0], parts[1]
+	}
+	l.rejectedCounter.WithLabelValues(service, method, limit).Inc()
+	return status.Errorf(codes.ResourceExhausted, "rate limit of %s exceeded, retry later", client)
+}
+
+// clientOf returns the identity of the client of a request: its user if it is authenticated, or its
+// IP address otherwise. The addresses forwarded by trusted proxies, such as the gateway of the REST API,
+// are followed back to the first address which is not a trusted proxy
+func (l *Limiter) clientOf(ctx context.Context) string {
+	if user := session.Username(ctx); user != "" {
+		return "user " + user
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "unknown client"
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	if !l.isTrustedProxy(host) {
+		return "address " + host
+	}
+	// each proxy appends the address of its client to the forwarded addresses
+	md, _ := metadata.FromIncomingContext(ctx)
+	var addrs []string
+	for _, fwd := range md.Get("x-forwarded-for") {
+		for _, addr := range strings.Split(fwd, ",") {
+			addrs = append(addrs, strings.TrimSpace(addr))
+		}
+	}
+	for i := len(addrs) - 1; i >= 0 && l.isTrustedProxy(host); i-- {
+		host = addrs[i]
+	}
+	return "address " + host
+}
+
+// isTrustedProxy returns whether the given address is the address of a trusted proxy
+func (l *Limiter) isTrustedProxy(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, proxy := range l.trustedProxies {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// UnaryServerInterceptor rejects the requests of the clients which exceeded a rate limit
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := l.check(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects the streams of the clients which exceeded a rate limit
+func (l *Limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := l.check(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,125 @@
+package ratelimit
+
+import (
+	"net"
+	"testing"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/util/session"
+)
+
+func newContext(user string) context.Context {
+	return context.WithValue(context.Background(), "claims", jwt.MapClaims{"iss": session.SessionManagerClaimsIssuer, "sub": user})
+}
+
+func call(limiter *Limiter, ctx context.Context, fullMethod string) error {
+	_, err := limiter.UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: fullMethod}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	return err
+}
+
+func TestParseMethodLimit(t *testing.T) {
+	method, limit, err := ParseMethodLimit("application.ApplicationService/Sync=0.5:2")
+	assert.NoError(t, err)
+	assert.Equal(t, "/application.ApplicationService/Sync", method)
+	assert.Equal(t, Limit{Rate: 0.5, Burst: 2}, limit)
+
+	_, limit, err = ParseMethodLimit("/application.ApplicationService/Sync=1.5")
+	assert.NoError(t, err)
+	assert.Equal(t, Limit{Rate: 1.5, Burst: 2}, limit)
+
+	for _, s := range []string{"application.ApplicationService/Sync", "=1", "application.ApplicationService/Sync=0", "application.ApplicationService/Sync=1:a"} {
+		_, _, err = ParseMethodLimit(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	limiter := NewLimiter(Limit{Rate: 0.001, Burst: 2}, map[string]Limit{
+		"/application.ApplicationService/Sync": {Rate: 0.001, Burst: 1},
+	}, nil)
+	admin := newContext("admin")
+	assert.NoError(t, call(limiter, admin, "/application.ApplicationService/Sync"))
+	err := call(limiter, admin, "/application.ApplicationService/Sync")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.NoError(t, call(limiter, admin, "/application.ApplicationService/Get"))
+	err = call(limiter, admin, "/application.ApplicationService/Get")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// the other clients are not limited
+	assert.NoError(t, call(limiter, newContext("ci"), "/application.ApplicationService/Sync"))
+
+	registry := prometheus.NewPedanticRegistry()
+	assert.NoError(t, registry.Register(limiter))
+	families, err := registry.Gather()
+	assert.NoError(t, err)
+	rejected := map[string]float64{}
+	for _, family := range families {
+		for _, m := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, label := range m.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			rejected[labels["grpc_method"]+" "+labels["limit"]] = m.GetCounter().GetValue()
+		}
+	}
+	assert.Equal(t, map[string]float64{"Sync method": 1, "Get client": 1}, rejected)
+}
+
+func TestLimiterRejectionTakesNoToken(t *testing.T) {
+	limiter := NewLimiter(Limit{Rate: 0.001, Burst: 1}, map[string]Limit{
+		"/application.ApplicationService/Sync": {Rate: 0.001, Burst: 2},
+	}, nil)
+	assert.NoError(t, call(limiter, newContext("admin"), "/application.ApplicationService/Sync"))
+	// the calls rejected by the client limit do not take tokens from the bucket of the method
+	for i := 0; i < 2; i++ {
+		err := call(limiter, newContext("admin"), "/application.ApplicationService/Sync")
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	}
+	assert.True(t, limiter.buckets["user admin /application.ApplicationService/Sync"].limiter.Allow())
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"10.0.0.1", "192.168.0.0/16", "::1"})
+	assert.NoError(t, err)
+	if assert.Len(t, proxies, 3) {
+		assert.Equal(t, "10.0.0.1/32", proxies[0].String())
+		assert.Equal(t, "192.168.0.0/16", proxies[1].String())
+		assert.Equal(t, "::1/128", proxies[2].String())
+	}
+
+	_, err = ParseTrustedProxies([]string{"proxy.local"})
+	assert.Error(t, err)
+}
+
+func TestClientOf(t *testing.T) {
+	proxies, err := ParseTrustedProxies(append(DefaultTrustedProxies, "10.1.0.0/16"))
+	assert.NoError(t, err)
+	limiter := NewLimiter(Limit{}, nil, proxies)
+	assert.Equal(t, "user admin", limiter.clientOf(newContext("admin")))
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 40000}})
+	assert.Equal(t, "address 10.0.0.1", limiter.clientOf(ctx))
+	// the forwarded addresses are ignored unless the request comes from a trusted proxy
+	forwarded := metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", "10.0.0.2"))
+	assert.Equal(t, "address 10.0.0.1", limiter.clientOf(forwarded))
+
+	// the gateway forwards the request of a trusted load balancer, which forwards the request of the client
+	ctx = peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 40000}})
+	forwarded = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", "10.0.0.3, 10.0.0.2, 10.1.0.1"))
+	assert.Equal(t, "address 10.0.0.2", limiter.clientOf(forwarded))
+
+	// the gateway is not trusted unless it is a trusted proxy
+	forwarded = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", "10.0.0.2"))
+	assert.Equal(t, "address 127.0.0.1", NewLimiter(Limit{}, nil, nil).clientOf(forwarded))
+}
@@ -0,0 +1,198 @@
+package session
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	informersv1 "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/common"
+)
+
+const (
+	// sessionsInvalidatedAtKey is the key of the time before which all the sessions are invalid
+	sessionsInvalidatedAtKey = "sessions.invalidatedAt"
+	// neverExpires is the expiry of the revoked tokens which do not expire
+	neverExpires = "never"
+)
+
+// TokenDenylist holds the revoked tokens, and the time before which all the sessions are invalid. It is
+// stored in the argocd-token-denylist ConfigMap, which is shared by the instances of the API server and
+// cached in memory by each of them
+type TokenDenylist struct {
+	clientset kubernetes.Interface
+	namespace string
+	lock      sync.RWMutex
+	// revoked holds the expiry of the revoked tokens by token hash, which is zero for the tokens which do
+	// not expire
+	revoked       map[string]time.Time
+	invalidatedAt time.Time
+}
+
+// NewTokenDenylist returns a denylist stored in the ConfigMap of the given namespace
+func NewTokenDenylist(clientset kubernetes.Interface, namespace string) *TokenDenylist {
+	return &TokenDenylist{
+		clientset: clientset,
+		namespace: namespace,
+		revoked:   make(map[string]time.Time),
+	}
+}
+
+// tokenHash returns the hash identifying a token in the denylist, so that the ConfigMap does not hold
+// usable tokens
+func tokenHash(tokenString string) string {
+	sum := sha256.Sum256([]byte(tokenString))
+	return hex.EncodeToString(sum[:])
+}
+
+// update replaces the cached denylist with the content of the ConfigMap
+func (d *TokenDenylist) update(cm *apiv1.ConfigMap) {
+	revoked := make(map[string]time.Time)
+	var invalidatedAt time.Time
+	for key, value := range cm.Data {
+		if key == sessionsInvalidatedAtKey {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				log.Warnf("Invalid %s in %s: %v", sessionsInvalidatedAtKey, common.ArgoCDTokenDenylistConfigMapName, err)
+				continue
+			}
+			invalidatedAt = t
+			continue
+		}
+		// tokens with an invalid expiry are revoked for good
+		expiry, _ := time.Parse(time.RFC3339, value)
+		revoked[key] = expiry
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.revoked = revoked
+	d.invalidatedAt = invalidatedAt
+}
+
+// Load reads the denylist from the ConfigMap
+func (d *TokenDenylist) Load() error {
+	cm, err := d.clientset.CoreV1().ConfigMaps(d.namespace).Get(common.ArgoCDTokenDenylistConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	d.update(cm)
+	return nil
+}
+
+// Run keeps the cached denylist up to date with the ConfigMap until the context is done
+func (d *TokenDenylist) Run(ctx context.Context) {
+	informer := informersv1.NewFilteredConfigMapInformer(d.clientset, d.namespace, 3*time.Minute, cache.Indexers{}, func(options *metav1.ListOptions) {
+		options.FieldSelector = fields.OneTermEqualSelector("metadata.name", common.ArgoCDTokenDenylistConfigMapName).String()
+	})
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if cm, ok := obj.(*apiv1.ConfigMap); ok {
+				d.update(cm)
+			}
+		},
+		UpdateFunc: func(old, new interface{}) {
+			if cm, ok := new.(*apiv1.ConfigMap); ok {
+				d.update(cm)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			d.update(&apiv1.ConfigMap{})
+		},
+	})
+	informer.Run(ctx.Done())
+}
+
+// Check returns an error if the token was revoked, or if it was issued before the sessions were
+// invalidated
+func (d *TokenDenylist) Check(tokenString string, claims jwt.MapClaims) error {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	if _, ok := d.revoked[tokenHash(tokenString)]; ok {
+		return fmt.Errorf("token has been revoked")
+	}
+	if !d.invalidatedAt.IsZero() {
+		issuedAt, ok := claims["iat"].(float64)
+		if !ok || time.Unix(int64(issuedAt), 0).Before(d.invalidatedAt) {
+			return fmt.Errorf("session has been invalidated")
+		}
+	}
+	return nil
+}
+
+// Revoke adds the token to the denylist until it expires. A zero expiry revokes a token which does not
+// expire. The tokens of the denylist which expired are removed
+func (d *TokenDenylist) Revoke(tokenString string, expiresAt time.Time) error {
+	value := neverExpires
+	if !expiresAt.IsZero() {
+		value = expiresAt.UTC().Format(time.RFC3339)
+	}
+	return d.modify(func(data map[string]string) {
+		now := time.Now()
+		for key, expiry := range data {
+			if key == sessionsInvalidatedAtKey {
+				continue
+			}
+			// expired tokens are rejected anyway
+			if t, err := time.Parse(time.RFC3339, expiry); err == nil && t.Before(now) {
+				delete(data, key)
+			}
+		}
+		data[tokenHash(tokenString)] = value
+	})
+}
+
+// InvalidateSessions invalidates the tokens issued before the given time, whoever issued them
+func (d *TokenDenylist) InvalidateSessions(t time.Time) error {
+	return d.modify(func(data map[string]string) {
+		data[sessionsInvalidatedAtKey] = t.UTC().Format(time.RFC3339)
+	})
+}
+
+// modify changes the data of the ConfigMap, creating it if it does not exist and retrying conflict
+// errors, then updates the cached denylist
+func (d *TokenDenylist) modify(change func(data map[string]string)) error {
+	configMaps := d.clientset.CoreV1().ConfigMaps(d.namespace)
+	for {
+		cm, err := configMaps.Get(common.ArgoCDTokenDenylistConfigMapName, metav1.GetOptions{})
+		create := false
+		if err != nil {
+			if !apierr.IsNotFound(err) {
+				return err
+			}
+			cm = &apiv1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDTokenDenylistConfigMapName}}
+			create = true
+		}
+		if cm.Data == nil {
+			cm.Data = make(map[string]string)
+		}
+		change(cm.Data)
+		if create {
+			cm, err = configMaps.Create(cm)
+		} else {
+			cm, err = configMaps.Update(cm)
+		}
+		if apierr.IsConflict(err) || apierr.IsAlreadyExists(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		d.update(cm)
+		return nil
+	}
+}
@@ -0,0 +1,82 @@
+package session
+
+import (
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+func TestRevokeToken(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset()
+	mgr := NewSessionManager(&settings.ArgoCDSettings{ServerSignature: []byte("Hello, world!")}, NewTokenDenylist(kubeclientset, "argocd"))
+	revoked, err := mgr.Create("admin", 60)
+	assert.NoError(t, err)
+	other, err := mgr.Create("proj:default:ci", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, mgr.RevokeToken(revoked))
+	_, err = mgr.VerifyToken(revoked)
+	assert.EqualError(t, err, "token has been revoked")
+	_, err = mgr.VerifyToken(other)
+	assert.NoError(t, err)
+
+	// the ConfigMap holds the hash of the token until it expires
+	cm, err := kubeclientset.CoreV1().ConfigMaps("argocd").Get(common.ArgoCDTokenDenylistConfigMapName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, cm.Data, 1)
+	assert.NotContains(t, cm.Data, revoked)
+	assert.Contains(t, cm.Data, tokenHash(revoked))
+
+	// the other instances of the API server load the denylist from the ConfigMap
+	denylist := NewTokenDenylist(kubeclientset, "argocd")
+	assert.NoError(t, denylist.Load())
+	assert.Error(t, denylist.Check(revoked, nil))
+
+	// invalid tokens cannot be revoked
+	assert.Error(t, mgr.RevokeToken("invalid"))
+}
+
+func TestRevokeTokenRemovesExpiredTokens(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(&apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDTokenDenylistConfigMapName, Namespace: "argocd"},
+		Data: map[string]string{
+			"expired":                time.Now().Add(-time.Minute).UTC().Format(time.RFC3339),
+			"valid":                  time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+			"forever":                neverExpires,
+			sessionsInvalidatedAtKey: time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+		},
+	})
+	denylist := NewTokenDenylist(kubeclientset, "argocd")
+	assert.NoError(t, denylist.Revoke("token", time.Time{}))
+
+	cm, err := kubeclientset.CoreV1().ConfigMaps("argocd").Get(common.ArgoCDTokenDenylistConfigMapName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotContains(t, cm.Data, "expired")
+	assert.Contains(t, cm.Data, "valid")
+	assert.Contains(t, cm.Data, sessionsInvalidatedAtKey)
+	assert.Equal(t, neverExpires, cm.Data["forever"])
+	assert.Equal(t, neverExpires, cm.Data[tokenHash("token")])
+}
+
+func TestInvalidateSessions(t *testing.T) {
+	mgr := NewSessionManager(&settings.ArgoCDSettings{ServerSignature: []byte("Hello, world!")}, NewTokenDenylist(fake.NewSimpleClientset(), "argocd"))
+	now := time.Now()
+	before, err := mgr.signClaims(jwt.StandardClaims{IssuedAt: now.Add(-time.Minute).Unix(), Issuer: SessionManagerClaimsIssuer, Subject: "admin"})
+	assert.NoError(t, err)
+	after, err := mgr.signClaims(jwt.StandardClaims{IssuedAt: now.Unix(), Issuer: SessionManagerClaimsIssuer, Subject: "admin"})
+	assert.NoError(t, err)
+
+	assert.NoError(t, mgr.InvalidateSessions(now.Add(-30*time.Second)))
+	_, err = mgr.VerifyToken(before)
+	assert.EqualError(t, err, "session has been invalidated")
+	_, err = mgr.VerifyToken(after)
+	assert.NoError(t, err)
+}
@@ -26,6 +26,7 @@ import (
 // SessionManager generates and validates JWT tokens for login sessions.
 type SessionManager struct {
 	settings *settings.ArgoCDSettings
+	denylist *TokenDenylist
 	client   *http.Client
 	provider *oidc.Provider
 }
@@ -33,6 +34,8 @@ type SessionManager struct {
 const (
 	// SessionManagerClaimsIssuer fills the "iss" field of the token.
 	SessionManagerClaimsIssuer = "argocd"
+	// SessionManagerClaimsAudience fills the "aud" field of the token.
+	SessionManagerClaimsAudience = "argocd"
 
 	// invalidLoginError, for security purposes, doesn't say whether the username or password was invalid.  This does not mitigate the potential for timing attacks to determine which is which.
 	invalidLoginError  = "Invalid username or password"
@@ -40,10 +43,12 @@ const (
 	badUserError       = "Bad local superuser username"
 )
 
-// NewSessionManager creates a new session manager from Argo CD settings
-func NewSessionManager(settings *settings.ArgoCDSettings) *SessionManager {
+// NewSessionManager creates a new session manager from Argo CD settings. The tokens are not checked
+// against a denylist if it is nil
+func NewSessionManager(settings *settings.ArgoCDSettings, denylist *TokenDenylist) *SessionManager {
 	s := SessionManager{
 		settings: settings,
+		denylist: denylist,
 	}
 	tlsConfig := settings.TLSConfig()
 	if tlsConfig != nil {
@@ -74,6 +79,7 @@ func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int64) (st
 	// you would like it to contain.
 	now := time.Now().UTC()
 	claims := jwt.StandardClaims{
+		Audience:  SessionManagerClaimsAudience,
 		IssuedAt:  now.Unix(),
 		Issuer:    SessionManagerClaimsIssuer,
 		NotBefore: now.Unix(),
@@ -110,6 +116,13 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, error) {
 	if err != nil {
 		return nil, err
 	}
+	if issuer := jwtutil.GetField(claims, "iss"); issuer != SessionManagerClaimsIssuer {
+		return nil, fmt.Errorf("Unexpected issuer: %q", issuer)
+	}
+	// tokens issued before the audience was set have no audience, and are still accepted
+	if _, ok := claims["aud"]; ok && !claims.VerifyAudience(SessionManagerClaimsAudience, true) {
+		return nil, fmt.Errorf("Unexpected audience: %v", claims["aud"])
+	}
 
 	issuedAt := time.Unix(int64(claims["iat"].(float64)), 0)
 	if issuedAt.Before(mgr.settings.AdminPasswordMtime) {
@@ -139,22 +152,28 @@ func (mgr *SessionManager) VerifyToken(tokenString string) (jwt.Claims, error) {
 	parser := &jwt.Parser{
 		SkipClaimsValidation: true,
 	}
-	var claims jwt.StandardClaims
+	var claims jwt.MapClaims
 	_, _, err := parser.ParseUnverified(tokenString, &claims)
 	if err != nil {
 		return nil, err
 	}
-	switch claims.Issuer {
+	if mgr.denylist != nil {
+		if err := mgr.denylist.Check(tokenString, claims); err != nil {
+			return nil, err
+		}
+	}
+	switch jwtutil.GetField(claims, "iss") {
 	case SessionManagerClaimsIssuer:
 		// Argo CD signed token
 		return mgr.Parse(tokenString)
 	default:
-		// Dex signed token
+		// Dex signed token. The audience is verified against the allowed audiences below, since the
+		// verifier only accepts a single client ID
 		provider, err := mgr.oidcProvider()
 		if err != nil {
 			return nil, err
 		}
-		verifier := provider.Verifier(&oidc.Config{ClientID: claims.Audience})
+		verifier := provider.Verifier(&oidc.Config{SkipClientIDCheck: true})
 		idToken, err := verifier.Verify(context.Background(), tokenString)
 		if err != nil {
 			// HACK: if we failed token verification, it's possible the reason was because dex
@@ -172,7 +191,7 @@ func (mgr *SessionManager) VerifyToken(tokenString string) (jwt.Claims, error) {
 				// return original error if we fail to re-initialize OIDC
 				return nil, err
 			}
-			verifier = provider.Verifier(&oidc.Config{ClientID: claims.Audience})
+			verifier = provider.Verifier(&oidc.Config{SkipClientIDCheck: true})
 			idToken, err = verifier.Verify(context.Background(), tokenString)
 			if err != nil {
 				return nil, err
@@ -183,8 +202,66 @@ func (mgr *SessionManager) VerifyToken(tokenString string) (jwt.Claims, error) {
 		}
 		var claims jwt.MapClaims
 		err = idToken.Claims(&claims)
-		return claims, err
+		if err != nil {
+			return nil, err
+		}
+		err = verifyAudience(idToken.Audience, jwtutil.GetField(claims, "azp"), mgr.settings.OAuth2AllowedAudiences())
+		if err != nil {
+			return nil, err
+		}
+		return claims, nil
+	}
+}
+
+// RevokeToken revokes a valid token, which is rejected until it expires
+func (mgr *SessionManager) RevokeToken(tokenString string) error {
+	if mgr.denylist == nil {
+		return fmt.Errorf("token revocation is not supported")
+	}
+	claims, err := mgr.VerifyToken(tokenString)
+	if err != nil {
+		return err
+	}
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return err
+	}
+	var expiresAt time.Time
+	if exp, ok := mapClaims["exp"].(float64); ok {
+		expiresAt = time.Unix(int64(exp), 0)
+	}
+	return mgr.denylist.Revoke(tokenString, expiresAt)
+}
+
+// InvalidateSessions invalidates the tokens issued before the given time, either by Argo CD or by the
+// identity provider
+func (mgr *SessionManager) InvalidateSessions(t time.Time) error {
+	if mgr.denylist == nil {
+		return fmt.Errorf("session invalidation is not supported")
+	}
+	return mgr.denylist.InvalidateSessions(t)
+}
+
+// verifyAudience returns an error unless the token was issued for one of the allowed audiences. When
+// the token has several audiences, the authorized party (azp) has to be present and allowed, so that
+// tokens issued to other clients of a shared identity provider are rejected.
+func verifyAudience(audiences []string, authorizedParty string, allowedAudiences []string) error {
+	allowed := make(map[string]bool, len(allowedAudiences))
+	for _, audience := range allowedAudiences {
+		allowed[audience] = true
+	}
+	if authorizedParty != "" && !allowed[authorizedParty] {
+		return fmt.Errorf("token was issued to unexpected authorized party %q", authorizedParty)
+	}
+	if len(audiences) > 1 && authorizedParty == "" {
+		return fmt.Errorf("token with multiple audiences %v has no authorized party", audiences)
+	}
+	for _, audience := range audiences {
+		if allowed[audience] {
+			return nil
+		}
 	}
+	return fmt.Errorf("token was issued for unexpected audience %v", audiences)
 }
 
 // Username is a helper to extract a human readable username from a context
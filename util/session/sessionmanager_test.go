@@ -2,9 +2,11 @@ package session
 
 import (
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/util/settings"
 	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestSessionManager(t *testing.T) {
@@ -15,7 +17,7 @@ func TestSessionManager(t *testing.T) {
 	set := settings.ArgoCDSettings{
 		ServerSignature: []byte(defaultSecretKey),
 	}
-	mgr := NewSessionManager(&set)
+	mgr := NewSessionManager(&set, nil)
 
 	token, err := mgr.Create(defaultSubject, 0)
 	if err != nil {
@@ -33,3 +35,39 @@ func TestSessionManager(t *testing.T) {
 		t.Errorf("Token claim subject \"%s\" does not match expected subject \"%s\".", subject, defaultSubject)
 	}
 }
+
+func TestSessionManagerRejectsForeignClaims(t *testing.T) {
+	set := settings.ArgoCDSettings{
+		ServerSignature: []byte("Hello, world!"),
+	}
+	mgr := NewSessionManager(&set, nil)
+	now := time.Now().UTC().Unix()
+
+	// tokens issued before the audience was set are accepted
+	token, err := mgr.signClaims(jwt.StandardClaims{IssuedAt: now, Issuer: SessionManagerClaimsIssuer, Subject: "admin"})
+	assert.NoError(t, err)
+	_, err = mgr.Parse(token)
+	assert.NoError(t, err)
+
+	token, err = mgr.signClaims(jwt.StandardClaims{IssuedAt: now, Issuer: "https://idp.example.com", Subject: "admin"})
+	assert.NoError(t, err)
+	_, err = mgr.Parse(token)
+	assert.Error(t, err)
+
+	token, err = mgr.signClaims(jwt.StandardClaims{IssuedAt: now, Issuer: SessionManagerClaimsIssuer, Audience: "other", Subject: "admin"})
+	assert.NoError(t, err)
+	_, err = mgr.Parse(token)
+	assert.Error(t, err)
+}
+
+func TestVerifyAudience(t *testing.T) {
+	allowed := []string{"argo-cd", "argo-cd-cli"}
+	assert.NoError(t, verifyAudience([]string{"argo-cd"}, "", allowed))
+	assert.NoError(t, verifyAudience([]string{"argo-cd-cli"}, "argo-cd-cli", allowed))
+	assert.NoError(t, verifyAudience([]string{"argo-cd", "other-app"}, "argo-cd", allowed))
+	// token issued to another client of the same identity provider
+	assert.Error(t, verifyAudience([]string{"other-app"}, "", allowed))
+	assert.Error(t, verifyAudience([]string{"argo-cd", "other-app"}, "other-app", allowed))
+	assert.Error(t, verifyAudience([]string{"argo-cd", "other-app"}, "", allowed))
+	assert.Error(t, verifyAudience([]string{"argo-cd"}, "", nil))
+}
@@ -7,11 +7,13 @@ import (
 	"crypto/x509"
 	"encoding/base64"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/ghodss/yaml"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/bcrypt"
 	apiv1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -35,6 +37,9 @@ type ArgoCDSettings struct {
 	// Admin superuser password storage
 	AdminPasswordHash  string    `json:"adminPasswordHash,omitempty"`
 	AdminPasswordMtime time.Time `json:"adminPasswordMtime,omitempty"`
+	// AdminPasswordChangeRequired indicates the admin password is the generated initial password,
+	// which has to be changed before the admin user can use the API
+	AdminPasswordChangeRequired bool `json:"adminPasswordChangeRequired,omitempty"`
 	// DexConfig contains portions of a dex config yaml
 	DexConfig string `json:"dexConfig,omitempty"`
 	// OIDCConfigRAW holds OIDC configuration as a raw string
@@ -52,13 +57,63 @@ type ArgoCDSettings struct {
 	WebhookBitbucketUUID string `json:"webhookBitbucketUUID,omitempty"`
 	// Secrets holds all secrets in argocd-secret as a map[string]string
 	Secrets map[string]string `json:"secrets,omitempty"`
+	// UICSSURL is the URL of a stylesheet customizing the web UI
+	UICSSURL string `json:"uiCssURL,omitempty"`
+	// UIBannerContent is the text of a banner displayed at the top of the web UI
+	UIBannerContent string `json:"uiBannerContent,omitempty"`
+	// UIBannerURL is the link of the banner displayed at the top of the web UI
+	UIBannerURL string `json:"uiBannerURL,omitempty"`
+	// WebhookRefreshMappingsRAW holds the webhook refresh mappings configuration as a raw string
+	WebhookRefreshMappingsRAW string `json:"webhookRefreshMappings,omitempty"`
+	// ReconciliationPause is the pause-reconciliation annotation of the argocd-cm ConfigMap, which pauses
+	// the reconciliation of all applications
+	ReconciliationPause string `json:"reconciliationPause,omitempty"`
+	// ResourceActionsRAW holds the custom resource actions configuration as a raw string
+	ResourceActionsRAW string `json:"resourceActions,omitempty"`
+	// PasswordPattern is the regular expression the new passwords of the local users have to match.
+	// Defaults to DefaultPasswordPattern
+	PasswordPattern string `json:"passwordPattern,omitempty"`
+	// PasswordBcryptCost is the bcrypt work factor of the hashes of the new passwords of the local users.
+	// Costs lower than bcrypt.DefaultCost are ignored
+	PasswordBcryptCost int `json:"passwordBcryptCost,omitempty"`
 }
 
+// DefaultPasswordPattern is the default pattern of the passwords of the local users
+const DefaultPasswordPattern = "^.{8,32}$"
+
 type OIDCConfig struct {
 	Name         string `json:"name,omitempty"`
 	Issuer       string `json:"issuer,omitempty"`
 	ClientID     string `json:"clientID,omitempty"`
 	ClientSecret string `json:"clientSecret,omitempty"`
+	// AllowedAudiences are the audiences accepted in the tokens of the provider. Defaults to the client ID
+	AllowedAudiences []string `json:"allowedAudiences,omitempty"`
+}
+
+// WebhookRefreshMapping restricts the applications refreshed by the webhook push events of a repository
+type WebhookRefreshMapping struct {
+	// RepoURL is the URL of the repository the mapping applies to
+	RepoURL string `json:"repoURL"`
+	// Projects restricts the refreshed applications to the applications of these projects
+	Projects []string `json:"projects,omitempty"`
+	// Applications restricts the refreshed applications to these applications
+	Applications []string `json:"applications,omitempty"`
+	// Paths are files, directories or glob patterns which refresh the applications when changed, in
+	// addition to the files under the source path of the applications
+	Paths []string `json:"paths,omitempty"`
+}
+
+// ResourceAction is a custom action of the resources of a kind, implemented by a Lua script
+type ResourceAction struct {
+	// Group is the API group of the resources, or empty for the core group
+	Group string `json:"group,omitempty"`
+	// Kind is the kind of the resources
+	Kind string `json:"kind"`
+	// Name is the name of the action, which is unique per kind
+	Name string `json:"name"`
+	// Lua is the script of the action, which modifies the resource held by the obj global variable and
+	// returns it
+	Lua string `json:"lua"`
 }
 
 const (
@@ -66,6 +121,12 @@ const (
 	settingAdminPasswordHashKey = "admin.password"
 	// settingAdminPasswordMtimeKey designates the key for a root password mtime inside a Kubernetes secret.
 	settingAdminPasswordMtimeKey = "admin.passwordMtime"
+	// settingAdminPasswordChangeRequiredKey designates the key indicating the root password has to be changed inside a Kubernetes secret.
+	settingAdminPasswordChangeRequiredKey = "admin.passwordChangeRequired"
+	// initialAdminPasswordKey designates the key for the initial root password inside the initial admin secret.
+	initialAdminPasswordKey = "password"
+	// initialAdminPasswordLength is the length of the generated initial root password
+	initialAdminPasswordLength = 16
 	// settingServerSignatureKey designates the key for a server secret key inside a Kubernetes secret.
 	settingServerSignatureKey = "server.secretkey"
 	// settingServerCertificate designates the key for the public cert used in TLS
@@ -78,6 +139,20 @@ const (
 	settingDexConfigKey = "dex.config"
 	// settingsOIDCConfigKey designates the key for OIDC config
 	settingsOIDCConfigKey = "oidc.config"
+	// settingUICSSURLKey designates the key for the URL of the stylesheet customizing the web UI
+	settingUICSSURLKey = "ui.cssurl"
+	// settingUIBannerContentKey designates the key for the text of the web UI banner
+	settingUIBannerContentKey = "ui.bannercontent"
+	// settingUIBannerURLKey designates the key for the link of the web UI banner
+	settingUIBannerURLKey = "ui.bannerurl"
+	// settingsWebhookRefreshMappingsKey designates the key for the webhook refresh mappings
+	settingsWebhookRefreshMappingsKey = "webhook.refreshMappings"
+	// settingsResourceActionsKey designates the key for the custom resource actions
+	settingsResourceActionsKey = "resource.actions"
+	// settingsPasswordPatternKey is the key of the pattern of the passwords of the local users
+	settingsPasswordPatternKey = "password.pattern"
+	// settingsPasswordBcryptCostKey is the key of the bcrypt work factor of the passwords of the local users
+	settingsPasswordBcryptCostKey = "password.bcryptCost"
 	// settingsWebhookGitHubSecret is the key for the GitHub shared webhook secret
 	settingsWebhookGitHubSecretKey = "webhook.github.secret"
 	// settingsWebhookGitLabSecret is the key for the GitLab shared webhook secret
@@ -127,6 +202,68 @@ func updateSettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *apiv1.Confi
 	settings.DexConfig = argoCDCM.Data[settingDexConfigKey]
 	settings.OIDCConfigRAW = argoCDCM.Data[settingsOIDCConfigKey]
 	settings.URL = argoCDCM.Data[settingURLKey]
+	settings.UICSSURL = argoCDCM.Data[settingUICSSURLKey]
+	settings.UIBannerContent = argoCDCM.Data[settingUIBannerContentKey]
+	settings.UIBannerURL = argoCDCM.Data[settingUIBannerURLKey]
+	settings.WebhookRefreshMappingsRAW = argoCDCM.Data[settingsWebhookRefreshMappingsKey]
+	settings.ReconciliationPause = argoCDCM.Annotations[common.AnnotationPauseReconciliation]
+	settings.ResourceActionsRAW = argoCDCM.Data[settingsResourceActionsKey]
+	settings.PasswordPattern = argoCDCM.Data[settingsPasswordPatternKey]
+	settings.PasswordBcryptCost = 0
+	if cost, ok := argoCDCM.Data[settingsPasswordBcryptCostKey]; ok {
+		if c, err := strconv.Atoi(cost); err != nil || c < bcrypt.MinCost || c > bcrypt.MaxCost {
+			log.Warnf("Invalid %s '%s', must be an integer between %d and %d", settingsPasswordBcryptCostKey, cost, bcrypt.MinCost, bcrypt.MaxCost)
+		} else {
+			settings.PasswordBcryptCost = c
+		}
+	}
+}
+
+// GetResourceActions returns the custom actions of the resources of the given group and kind. Unlike
+// GetSettings, it only reads the argocd-cm configmap
+func (mgr *SettingsManager) GetResourceActions(group, kind string) ([]ResourceAction, error) {
+	argoCDCM, err := mgr.clientset.CoreV1().ConfigMaps(mgr.namespace).Get(common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var settings ArgoCDSettings
+	updateSettingsFromConfigMap(&settings, argoCDCM)
+	return settings.ResourceActions(group, kind), nil
+}
+
+// SetReconciliationPause sets the pause-reconciliation annotation of the argocd-cm ConfigMap, which
+// pauses the reconciliation of all applications, or removes it if the pause is empty
+func (mgr *SettingsManager) SetReconciliationPause(pause string) error {
+	argoCDCM, err := mgr.clientset.CoreV1().ConfigMaps(mgr.namespace).Get(common.ArgoCDConfigMapName, metav1.GetOptions{})
+	createCM := false
+	if err != nil {
+		if !apierr.IsNotFound(err) {
+			return err
+		}
+		argoCDCM = &apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: common.ArgoCDConfigMapName,
+			},
+		}
+		createCM = true
+	}
+	if argoCDCM.Annotations == nil {
+		argoCDCM.Annotations = make(map[string]string)
+	}
+	if pause != "" {
+		argoCDCM.Annotations[common.AnnotationPauseReconciliation] = pause
+	} else {
+		delete(argoCDCM.Annotations, common.AnnotationPauseReconciliation)
+	}
+	if createCM {
+		_, err = mgr.clientset.CoreV1().ConfigMaps(mgr.namespace).Create(argoCDCM)
+	} else {
+		_, err = mgr.clientset.CoreV1().ConfigMaps(mgr.namespace).Update(argoCDCM)
+	}
+	return err
 }
 
 // updateSettingsFromSecret transfers settings from a Kubernetes secret into an ArgoCDSettings struct.
@@ -142,6 +279,7 @@ func updateSettingsFromSecret(settings *ArgoCDSettings, argoCDSecret *apiv1.Secr
 			settings.AdminPasswordMtime = adminPasswordMtime
 		}
 	}
+	settings.AdminPasswordChangeRequired = string(argoCDSecret.Data[settingAdminPasswordChangeRequiredKey]) == "true"
 
 	secretKey, ok := argoCDSecret.Data[settingServerSignatureKey]
 	if !ok {
@@ -239,6 +377,11 @@ func (mgr *SettingsManager) SaveSettings(settings *ArgoCDSettings) error {
 	argoCDSecret.StringData[settingServerSignatureKey] = string(settings.ServerSignature)
 	argoCDSecret.StringData[settingAdminPasswordHashKey] = settings.AdminPasswordHash
 	argoCDSecret.StringData[settingAdminPasswordMtimeKey] = settings.AdminPasswordMtime.Format(time.RFC3339)
+	if settings.AdminPasswordChangeRequired {
+		argoCDSecret.StringData[settingAdminPasswordChangeRequiredKey] = "true"
+	} else {
+		delete(argoCDSecret.Data, settingAdminPasswordChangeRequiredKey)
+	}
 	if settings.WebhookGitHubSecret != "" {
 		argoCDSecret.StringData[settingsWebhookGitHubSecretKey] = settings.WebhookGitHubSecret
 	}
@@ -313,6 +456,48 @@ func (a *ArgoCDSettings) OIDCConfig() *OIDCConfig {
 	return &oidcConfig
 }
 
+// WebhookRefreshMappings returns the mappings restricting the applications refreshed by webhook events
+func (a *ArgoCDSettings) WebhookRefreshMappings() []WebhookRefreshMapping {
+	if a.WebhookRefreshMappingsRAW == "" {
+		return nil
+	}
+	var mappings []WebhookRefreshMapping
+	err := yaml.Unmarshal([]byte(a.WebhookRefreshMappingsRAW), &mappings)
+	if err != nil {
+		log.Warnf("invalid webhook refresh mappings: %v", err)
+		return nil
+	}
+	return mappings
+}
+
+// ResourceActions returns the custom actions of the resources of the given group and kind
+func (a *ArgoCDSettings) ResourceActions(group, kind string) []ResourceAction {
+	if a.ResourceActionsRAW == "" {
+		return nil
+	}
+	var actions []ResourceAction
+	err := yaml.Unmarshal([]byte(a.ResourceActionsRAW), &actions)
+	if err != nil {
+		log.Warnf("invalid resource actions: %v", err)
+		return nil
+	}
+	kindActions := make([]ResourceAction, 0)
+	for _, action := range actions {
+		if action.Group == group && action.Kind == kind {
+			kindActions = append(kindActions, action)
+		}
+	}
+	return kindActions
+}
+
+// GetPasswordPattern returns the pattern the new passwords of the local users have to match
+func (a *ArgoCDSettings) GetPasswordPattern() string {
+	if a.PasswordPattern == "" {
+		return DefaultPasswordPattern
+	}
+	return a.PasswordPattern
+}
+
 // TLSConfig returns a tls.Config with the configured certificates
 func (a *ArgoCDSettings) TLSConfig() *tls.Config {
 	if a.Certificate == nil {
@@ -349,6 +534,20 @@ func (a *ArgoCDSettings) OAuth2ClientID() string {
 	return ""
 }
 
+// OAuth2AllowedAudiences returns the audiences accepted in the tokens issued by the SSO provider
+func (a *ArgoCDSettings) OAuth2AllowedAudiences() []string {
+	if oidcConfig := a.OIDCConfig(); oidcConfig != nil {
+		if len(oidcConfig.AllowedAudiences) > 0 {
+			return oidcConfig.AllowedAudiences
+		}
+		return []string{oidcConfig.ClientID}
+	}
+	if a.DexConfig != "" {
+		return []string{common.ArgoCDClientAppID, common.ArgoCDCLIClientAppID}
+	}
+	return nil
+}
+
 func (a *ArgoCDSettings) OAuth2ClientSecret() string {
 	if oidcConfig := a.OIDCConfig(); oidcConfig != nil {
 		return oidcConfig.ClientSecret
@@ -377,13 +576,19 @@ func (a *ArgoCDSettings) DexOAuth2ClientSecret() string {
 	return base64.URLEncoding.EncodeToString(sha)[:40]
 }
 
-// newInformers returns two new informers on the Argo CD
-func (mgr *SettingsManager) newInformers() (cache.SharedIndexInformer, cache.SharedIndexInformer) {
+// NewConfigMapInformer returns a new informer on the argocd-cm ConfigMap, for components which read
+// its settings too often to get it from the API server each time
+func (mgr *SettingsManager) NewConfigMapInformer() cache.SharedIndexInformer {
 	tweakConfigMap := func(options *metav1.ListOptions) {
 		cmFieldSelector := fields.ParseSelectorOrDie(fmt.Sprintf("metadata.name=%s", common.ArgoCDConfigMapName))
 		options.FieldSelector = cmFieldSelector.String()
 	}
-	cmInformer := v1.NewFilteredConfigMapInformer(mgr.clientset, mgr.namespace, 3*time.Minute, cache.Indexers{}, tweakConfigMap)
+	return v1.NewFilteredConfigMapInformer(mgr.clientset, mgr.namespace, 3*time.Minute, cache.Indexers{}, tweakConfigMap)
+}
+
+// newInformers returns two new informers on the Argo CD
+func (mgr *SettingsManager) newInformers() (cache.SharedIndexInformer, cache.SharedIndexInformer) {
+	cmInformer := mgr.NewConfigMapInformer()
 	tweakSecret := func(options *metav1.ListOptions) {
 		secFieldSelector := fields.ParseSelectorOrDie(fmt.Sprintf("metadata.name=%s", common.ArgoCDSecretName))
 		options.FieldSelector = secFieldSelector.String()
@@ -488,6 +693,24 @@ func isIncompleteSettingsError(err error) bool {
 
 // UpdateSettings is used to update the admin password, signature, certificate etc
 func UpdateSettings(defaultPassword string, settingsMgr *SettingsManager, updateSignature bool, updateSuperuser bool, Namespace string) (*ArgoCDSettings, error) {
+	return updateSettings(defaultPassword, settingsMgr, updateSignature, updateSuperuser, false, Namespace)
+}
+
+// InitializeSettings creates the settings missing on first start. The initial admin password is
+// randomly generated, stored in the initial admin secret, and has to be changed on first login.
+func InitializeSettings(settingsMgr *SettingsManager, Namespace string) (*ArgoCDSettings, error) {
+	cdSettings, _ := settingsMgr.GetSettings()
+	if cdSettings != nil && cdSettings.AdminPasswordHash != "" {
+		return UpdateSettings("", settingsMgr, false, false, Namespace)
+	}
+	initialPassword, err := settingsMgr.GetOrCreateInitialAdminPassword()
+	if err != nil {
+		return nil, err
+	}
+	return updateSettings(initialPassword, settingsMgr, false, false, true, Namespace)
+}
+
+func updateSettings(defaultPassword string, settingsMgr *SettingsManager, updateSignature bool, updateSuperuser bool, passwordChangeRequired bool, Namespace string) (*ArgoCDSettings, error) {
 
 	cdSettings, err := settingsMgr.GetSettings()
 	if err != nil && !apierr.IsNotFound(err) && !isIncompleteSettingsError(err) {
@@ -518,6 +741,7 @@ func UpdateSettings(defaultPassword string, settingsMgr *SettingsManager, update
 		}
 		cdSettings.AdminPasswordHash = hashedPassword
 		cdSettings.AdminPasswordMtime = time.Now().UTC()
+		cdSettings.AdminPasswordChangeRequired = passwordChangeRequired
 	}
 
 	if cdSettings.Certificate == nil {
@@ -543,3 +767,49 @@ func UpdateSettings(defaultPassword string, settingsMgr *SettingsManager, update
 
 	return cdSettings, settingsMgr.SaveSettings(cdSettings)
 }
+
+// GetOrCreateInitialAdminPassword returns the initial admin password stored in the initial admin
+// secret, generating a random password and creating the secret if it does not exist yet. The secret
+// is shared by the instances of the API server which are started concurrently.
+func (mgr *SettingsManager) GetOrCreateInitialAdminPassword() (string, error) {
+	secrets := mgr.clientset.CoreV1().Secrets(mgr.namespace)
+	secret, err := secrets.Get(common.ArgoCDInitialAdminSecretName, metav1.GetOptions{})
+	if err == nil {
+		if initialPassword := string(secret.Data[initialAdminPasswordKey]); initialPassword != "" {
+			return initialPassword, nil
+		}
+		return "", fmt.Errorf("secret %s has no %s key", common.ArgoCDInitialAdminSecretName, initialAdminPasswordKey)
+	}
+	if !apierr.IsNotFound(err) {
+		return "", err
+	}
+	initialPassword, err := password.GeneratePassword(initialAdminPasswordLength)
+	if err != nil {
+		return "", err
+	}
+	_, err = secrets.Create(&apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: common.ArgoCDInitialAdminSecretName,
+		},
+		Data: map[string][]byte{
+			initialAdminPasswordKey: []byte(initialPassword),
+		},
+	})
+	if apierr.IsAlreadyExists(err) {
+		// another instance of the API server created the secret first
+		return mgr.GetOrCreateInitialAdminPassword()
+	}
+	if err != nil {
+		return "", err
+	}
+	return initialPassword, nil
+}
+
+// DeleteInitialAdminPassword deletes the initial admin secret once the initial password was changed
+func (mgr *SettingsManager) DeleteInitialAdminPassword() error {
+	err := mgr.clientset.CoreV1().Secrets(mgr.namespace).Delete(common.ArgoCDInitialAdminSecretName, &metav1.DeleteOptions{})
+	if err != nil && !apierr.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
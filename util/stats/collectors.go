@@ -0,0 +1,28 @@
+package stats
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/argoproj/argo-cd"
+)
+
+// NewRuntimeCollectors returns the collectors of the build information of the running Argo CD
+// component, and of the metrics of the Go runtime and of the process
+func NewRuntimeCollectors() []prometheus.Collector {
+	version := argocd.GetVersion()
+	buildInfo := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "argocd_build_info",
+		Help: "Build information of the Argo CD component, which is always 1.",
+		ConstLabels: prometheus.Labels{
+			"version":    version.Version,
+			"commit":     version.GitCommit,
+			"go_version": version.GoVersion,
+		},
+	})
+	buildInfo.Set(1)
+	return []prometheus.Collector{
+		buildInfo,
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+	}
+}
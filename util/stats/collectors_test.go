@@ -0,0 +1,36 @@
+package stats
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd"
+)
+
+func TestRuntimeCollectors(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(NewRuntimeCollectors()...)
+	families, err := registry.Gather()
+	assert.NoError(t, err)
+
+	names := make(map[string]bool)
+	for _, family := range families {
+		names[family.GetName()] = true
+		if family.GetName() != "argocd_build_info" {
+			continue
+		}
+		assert.Len(t, family.Metric, 1)
+		labels := make(map[string]string)
+		for _, label := range family.Metric[0].Label {
+			labels[label.GetName()] = label.GetValue()
+		}
+		assert.Equal(t, argocd.GetVersion().Version, labels["version"])
+		assert.Equal(t, runtime.Version(), labels["go_version"])
+		assert.Equal(t, float64(1), family.Metric[0].GetGauge().GetValue())
+	}
+	assert.True(t, names["argocd_build_info"])
+	assert.True(t, names["go_goroutines"])
+}
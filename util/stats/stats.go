@@ -1,14 +1,23 @@
 package stats
 
 import (
+	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"runtime"
 	"runtime/pprof"
+	"sort"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/util"
 )
 
 // StartStatsTicker starts a goroutine which dumps stats at a specified interval
@@ -34,37 +43,137 @@ func RegisterStackDumper() {
 	}()
 }
 
-// RegisterHeapDumper spawns a goroutine which dumps heap profile upon a SIGUSR2
-func RegisterHeapDumper(filePath string) {
+const (
+	// DefaultProfileRetention is the default number of dumps kept for each profile
+	DefaultProfileRetention = 5
+)
+
+// AddProfileFlagsToCmd adds profile dump flags to a command and returns a function which creates
+// the ProfileDumper configured by them
+func AddProfileFlagsToCmd(cmd *cobra.Command) func() *ProfileDumper {
+	dir := ""
+	retention := 0
+	cmd.Flags().StringVar(&dir, "profile-dir", filepath.Join(os.TempDir(), "argocd-profiles"), "Directory where heap and goroutine profiles are dumped")
+	cmd.Flags().IntVar(&retention, "profile-retention", DefaultProfileRetention, "Number of dumps kept for each profile")
+	return func() *ProfileDumper {
+		return NewProfileDumper(dir, retention)
+	}
+}
+
+// ProfileDumper writes heap and goroutine profiles to a directory on demand, keeping only the most
+// recent dumps
+type ProfileDumper struct {
+	dir       string
+	retention int
+	lock      sync.Mutex
+}
+
+// NewProfileDumper returns a ProfileDumper which writes profiles to dir and keeps the last
+// retention dumps of each profile
+func NewProfileDumper(dir string, retention int) *ProfileDumper {
+	if retention < 1 {
+		retention = 1
+	}
+	return &ProfileDumper{dir: dir, retention: retention}
+}
+
+// Dump writes heap and goroutine profiles and returns the paths of the written files
+func (d *ProfileDumper) Dump() ([]string, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if err := os.MkdirAll(d.dir, 0755); err != nil {
+		return nil, err
+	}
+	runtime.GC()
+	timestamp := time.Now().UTC().Format("20060102T150405.000000000")
+	var paths []string
+	for _, profile := range profileNames {
+		filePath := filepath.Join(d.dir, fmt.Sprintf("%s-%s.pprof", profile, timestamp))
+		if err := writeProfile(profile, filePath); err != nil {
+			return paths, err
+		}
+		paths = append(paths, filePath)
+		if err := d.prune(profile); err != nil {
+			log.Warnf("could not remove old %s profiles: %v", profile, err)
+		}
+	}
+	return paths, nil
+}
+
+// RegisterSignalHandler spawns a goroutine which dumps profiles upon a SIGUSR2
+func (d *ProfileDumper) RegisterSignalHandler() {
 	go func() {
 		sigs := make(chan os.Signal, 1)
 		signal.Notify(sigs, syscall.SIGUSR2)
 		for {
 			<-sigs
-			runtime.GC()
-			if _, err := os.Stat(filePath); err == nil {
-				err = os.Remove(filePath)
-				if err != nil {
-					log.Warnf("could not delete heap profile file: %v", err)
-					return
-				}
-			}
-			f, err := os.Create(filePath)
-			if err != nil {
-				log.Warnf("could not create heap profile file: %v", err)
-				return
-			}
-
-			if err := pprof.WriteHeapProfile(f); err != nil {
-				log.Warnf("could not write heap profile: %v", err)
-				return
-			} else {
-				log.Infof("dumped heap profile to %s", filePath)
-			}
+			d.logDump()
 		}
 	}()
 }
 
+// Handler returns an HTTP handler which dumps profiles upon a POST request. Requests are rejected
+// unless authorize succeeds.
+func (d *ProfileDumper) Handler(authorize func(r *http.Request) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if err := authorize(r); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		paths, err := d.logDump()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		for _, filePath := range paths {
+			_, _ = fmt.Fprintln(w, filePath)
+		}
+	}
+}
+
+func (d *ProfileDumper) logDump() ([]string, error) {
+	paths, err := d.Dump()
+	if err != nil {
+		log.Warnf("could not dump profiles: %v", err)
+		return nil, err
+	}
+	log.Infof("dumped profiles to %s", strings.Join(paths, ", "))
+	return paths, nil
+}
+
+// prune removes all but the most recent dumps of the given profile
+func (d *ProfileDumper) prune(profile string) error {
+	files, err := filepath.Glob(filepath.Join(d.dir, profile+"-*.pprof"))
+	if err != nil {
+		return err
+	}
+	// timestamps in file names sort chronologically
+	sort.Strings(files)
+	for len(files) > d.retention {
+		if err := os.Remove(files[0]); err != nil {
+			return err
+		}
+		files = files[1:]
+	}
+	return nil
+}
+
+var profileNames = []string{"heap", "goroutine"}
+
+func writeProfile(profile string, filePath string) error {
+	f, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	defer util.Close(f)
+	return pprof.Lookup(profile).WriteTo(f, 0)
+}
+
 // LogStats logs runtime statistics
 func LogStats() {
 	var m runtime.MemStats
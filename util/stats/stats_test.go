@@ -0,0 +1,60 @@
+package stats
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileDumperRetention(t *testing.T) {
+	dir, err := ioutil.TempDir("", "profiles")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	dumper := NewProfileDumper(dir, 2)
+	for i := 0; i < 3; i++ {
+		paths, err := dumper.Dump()
+		assert.NoError(t, err)
+		assert.Len(t, paths, 2)
+	}
+	for _, profile := range profileNames {
+		files, err := filepath.Glob(filepath.Join(dir, profile+"-*.pprof"))
+		assert.NoError(t, err)
+		assert.Len(t, files, 2)
+	}
+}
+
+func TestProfileDumperHandler(t *testing.T) {
+	dir, err := ioutil.TempDir("", "profiles")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	allowed := false
+	handler := NewProfileDumper(dir, 1).Handler(func(r *http.Request) error {
+		if !allowed {
+			return os.ErrPermission
+		}
+		return nil
+	})
+
+	w := httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+
+	w = httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodPost, "/", nil))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	allowed = true
+	w = httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodPost, "/", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	files, err := ioutil.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+}
@@ -1,7 +1,8 @@
 package swagger
 
 import (
-	"fmt"
+	"bytes"
+	"html/template"
 	"log"
 	"net/http"
 	"path"
@@ -10,23 +11,74 @@ import (
 	"github.com/gobuffalo/packr"
 )
 
-// ServeSwaggerUI serves the Swagger UI and JSON spec.
+// swaggerUIAssets holds the assets of the swagger-ui-dist package (swagger-ui.css and swagger-ui-bundle.js),
+// which are downloaded by the swagger-ui target of the Makefile at a pinned version and embedded in the
+// binary, so that the Swagger UI page does not load scripts from a third-party CDN
+var swaggerUIAssets = packr.NewBox("./swagger-ui-dist")
+
+// swaggerUITemplate is the page of the Swagger UI, which lets users try the API from their browser. The
+// requests are authenticated by the session cookie of the Argo CD UI, or by the bearer token set with
+// the Authorize button
+var swaggerUITemplate = template.Must(template.New("swagger-ui").Parse(`<!DOCTYPE html>
+<html>
+  <head>
+    <meta charset="UTF-8">
+    <title>Argo CD API</title>
+    <link rel="stylesheet" type="text/css" href="{{ .AssetsURL }}/swagger-ui.css">
+  </head>
+  <body>
+    <div id="swagger-ui"></div>
+    <script src="{{ .AssetsURL }}/swagger-ui-bundle.js"></script>
+    <script>
+      window.onload = function() {
+        window.ui = SwaggerUIBundle({
+          url: "{{ .SpecURL }}",
+          dom_id: "#swagger-ui",
+          deepLinking: true,
+          presets: [SwaggerUIBundle.presets.apis],
+        });
+      };
+    </script>
+  </body>
+</html>
+`))
+
+// ServeSwaggerUI serves the JSON spec, the Swagger UI at the given path along with its assets, and the
+// Redoc documentation of the API next to it.
 func ServeSwaggerUI(mux *http.ServeMux, box packr.Box, uiPath string) {
 	prefix := path.Dir(uiPath)
 	specURL := path.Join(prefix, "swagger.json")
+	assetsURL := path.Join(uiPath, "assets")
 
-	swaggerJSON, err := box.MustString("swagger.json")
+	swaggerJSON, err := box.MustBytes("swagger.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var swaggerUI bytes.Buffer
+	err = swaggerUITemplate.Execute(&swaggerUI, struct {
+		AssetsURL string
+		SpecURL   string
+	}{assetsURL, specURL})
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	mux.HandleFunc(specURL, func(w http.ResponseWriter, r *http.Request) {
-		fmt.Fprintf(w, swaggerJSON)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(swaggerJSON)
+	})
+
+	mux.HandleFunc(uiPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write(swaggerUI.Bytes())
 	})
 
-	mux.Handle(uiPath, middleware.Redoc(middleware.RedocOpts{
+	mux.Handle(assetsURL+"/", http.StripPrefix(assetsURL+"/", http.FileServer(swaggerUIAssets)))
+
+	mux.Handle(path.Join(prefix, "redoc"), middleware.Redoc(middleware.RedocOpts{
 		BasePath: prefix,
 		SpecURL:  specURL,
-		Path:     path.Base(uiPath),
+		Path:     "redoc",
 	}, http.NotFoundHandler()))
 }
@@ -2,8 +2,10 @@ package swagger
 
 import (
 	"encoding/json"
+	"io/ioutil"
 	"net"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/go-openapi/loads"
@@ -64,4 +66,23 @@ func TestSwaggerUI(t *testing.T) {
 	if resp.StatusCode != 200 {
 		t.Fatalf("Was expecting status code 200 from swagger-ui, but got %d instead", resp.StatusCode)
 	}
+	if contentType := resp.Header.Get("Content-Type"); !strings.HasPrefix(contentType, "text/html") {
+		t.Fatalf("Was expecting an HTML page from swagger-ui, but got %s instead", contentType)
+	}
+	page, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(page), `src="/swagger-ui/assets/swagger-ui-bundle.js"`) {
+		t.Fatalf("Was expecting the swagger-ui page to load the embedded assets, but got %s instead", page)
+	}
+
+	resp, err = http.Get(server + "/redoc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != 200 {
+		t.Fatalf("Was expecting status code 200 from redoc, but got %d instead", resp.StatusCode)
+	}
 }
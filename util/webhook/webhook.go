@@ -3,11 +3,14 @@ package webhook
 import (
 	"net/http"
 	"net/url"
+	"path/filepath"
 	"regexp"
 	"strings"
 
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/util/argo"
+	"github.com/argoproj/argo-cd/util/git"
 	"github.com/argoproj/argo-cd/util/settings"
 	log "github.com/sirupsen/logrus"
 	webhooks "gopkg.in/go-playground/webhooks.v3"
@@ -20,6 +23,7 @@ import (
 type ArgoCDWebhookHandler struct {
 	ns               string
 	appClientset     appclientset.Interface
+	settings         *settings.ArgoCDSettings
 	github           *github.Webhook
 	githubHandler    http.Handler
 	gitlab           *gitlab.Webhook
@@ -32,6 +36,7 @@ func NewHandler(namespace string, appClientset appclientset.Interface, set *sett
 	acdWebhook := ArgoCDWebhookHandler{
 		ns:           namespace,
 		appClientset: appClientset,
+		settings:     set,
 		github:       github.New(&github.Config{Secret: set.WebhookGitHubSecret}),
 		gitlab:       gitlab.New(&gitlab.Config{Secret: set.WebhookGitLabSecret}),
 		bitbucket:    bitbucket.New(&bitbucket.Config{UUID: set.WebhookBitbucketUUID}),
@@ -45,12 +50,17 @@ func NewHandler(namespace string, appClientset appclientset.Interface, set *sett
 	return &acdWebhook
 }
 
+// githubMaxPayloadCommits is the maximum number of commits listed by the payload of a GitHub push event
+const githubMaxPayloadCommits = 20
+
 // affectedRevisionInfo examines a payload from a webhook event, and extracts the repo web URL,
-// the revision, and whether or not this affected origin/HEAD (the default branch of the repository)
-func affectedRevisionInfo(payloadIf interface{}) (string, string, bool) {
+// the revision, whether or not this affected origin/HEAD (the default branch of the repository), and
+// the files changed by the pushed commits. The changed files are nil if the payload does not list them
+func affectedRevisionInfo(payloadIf interface{}) (string, string, bool, []string) {
 	var webURL string
 	var revision string
 	var touchedHead bool
+	var changedFiles []string
 
 	parseRef := func(ref string) string {
 		refParts := strings.SplitN(ref, "/", 3)
@@ -63,12 +73,28 @@ func affectedRevisionInfo(payloadIf interface{}) (string, string, bool) {
 		webURL = payload.Repository.HTMLURL
 		revision = parseRef(payload.Ref)
 		touchedHead = bool(payload.Repository.DefaultBranch == revision)
+		// GitHub only lists the first 20 commits of large pushes
+		if len(payload.Commits) < githubMaxPayloadCommits {
+			for _, commit := range payload.Commits {
+				changedFiles = append(changedFiles, commit.Added...)
+				changedFiles = append(changedFiles, commit.Modified...)
+				changedFiles = append(changedFiles, commit.Removed...)
+			}
+		}
 	case gitlab.PushEventPayload:
 		// See: https://docs.gitlab.com/ee/user/project/integrations/webhooks.html
 		// NOTE: this is untested
 		webURL = payload.Project.WebURL
 		revision = parseRef(payload.Ref)
 		touchedHead = bool(payload.Project.DefaultBranch == revision)
+		// GitLab only lists the first commits of large pushes
+		if payload.TotalCommitsCount == int64(len(payload.Commits)) {
+			for _, commit := range payload.Commits {
+				changedFiles = append(changedFiles, commit.Added...)
+				changedFiles = append(changedFiles, commit.Modified...)
+				changedFiles = append(changedFiles, commit.Removed...)
+			}
+		}
 	case gitlab.TagEventPayload:
 		// See: https://docs.gitlab.com/ee/user/project/integrations/webhooks.html
 		// NOTE: this is untested
@@ -89,12 +115,66 @@ func affectedRevisionInfo(payloadIf interface{}) (string, string, bool) {
 		// payload alone. To be safe, we just return true and let the controller check for himself.
 		touchedHead = true
 	}
-	return webURL, revision, touchedHead
+	return webURL, revision, touchedHead, changedFiles
+}
+
+// isRefreshedByMappings returns whether the application is refreshed according to the refresh
+// mappings of the pushed repository. Without mappings, all the applications of the repository are
+// refreshed. Otherwise, the application has to be selected by a mapping and, if the changed files are
+// known, one of them has to be under the source path of the application or match the mapping paths
+func isRefreshedByMappings(app *v1alpha1.Application, mappings []settings.WebhookRefreshMapping, changedFiles []string) bool {
+	if len(mappings) == 0 {
+		return true
+	}
+	for _, mapping := range mappings {
+		if len(mapping.Projects) > 0 && !containsString(mapping.Projects, app.Spec.Project) {
+			continue
+		}
+		if len(mapping.Applications) > 0 && !containsString(mapping.Applications, app.Name) {
+			continue
+		}
+		if changedFiles == nil {
+			return true
+		}
+		paths := append([]string{app.Spec.Source.Path}, mapping.Paths...)
+		for _, file := range changedFiles {
+			for _, path := range paths {
+				if matchesPath(file, path) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// matchesPath returns whether the file, or one of the directories containing it, matches the given
+// path or glob pattern. The root directory of the repository matches all files
+func matchesPath(file string, path string) bool {
+	path = strings.Trim(filepath.Clean(path), "/")
+	if path == "." || path == "" {
+		return true
+	}
+	for ; file != "." && file != "/"; file = filepath.Dir(file) {
+		if matched, err := filepath.Match(path, file); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
 }
 
 // HandleEvent handles webhook events for repo push events
 func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}, header webhooks.Header) {
-	webURL, revision, touchedHead := affectedRevisionInfo(payload)
+	webURL, revision, touchedHead, changedFiles := affectedRevisionInfo(payload)
 	// NOTE: the webURL does not include the .git extension
 	if webURL == "" {
 		log.Info("Ignoring webhook event")
@@ -119,6 +199,13 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}, header webhooks.
 		return
 	}
 
+	var mappings []settings.WebhookRefreshMapping
+	for _, mapping := range a.settings.WebhookRefreshMappings() {
+		if repoRegexp.MatchString(mapping.RepoURL) {
+			mappings = append(mappings, mapping)
+		}
+	}
+
 	for _, app := range apps.Items {
 		if !repoRegexp.MatchString(app.Spec.Source.RepoURL) {
 			log.Debugf("%s does not match", app.Spec.Source.RepoURL)
@@ -132,7 +219,15 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}, header webhooks.
 		} else if targetRev != revision {
 			continue
 		}
-		_, err = argo.RefreshApp(appIf, app.ObjectMeta.Name)
+		if !isRefreshedByMappings(&app, mappings, changedFiles) {
+			log.Debugf("%s is not refreshed by the changed files", app.ObjectMeta.Name)
+			continue
+		}
+		if paths := argo.GetAppManifestGeneratePaths(&app); changedFiles != nil && paths != nil && !git.IsChangedInPaths(changedFiles, paths) {
+			log.Debugf("%s manifest generate paths are not changed", app.ObjectMeta.Name)
+			continue
+		}
+		_, err = argo.RefreshApp(appIf, app.ObjectMeta.Name, v1alpha1.RefreshTypeNormal)
 		if err != nil {
 			log.Warnf("Failed to refresh app '%s' for controller reprocessing: %v", app.ObjectMeta.Name, err)
 			continue
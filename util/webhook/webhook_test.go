@@ -2,15 +2,23 @@ package webhook
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-cd/util/settings"
 	"github.com/gobuffalo/packr"
 	"github.com/stretchr/testify/assert"
+	"gopkg.in/go-playground/webhooks.v3/github"
 )
 
 var (
@@ -44,3 +52,114 @@ func TestGitHubTagEvent(t *testing.T) {
 	h.Handler(w, req)
 	assert.Equal(t, w.Code, http.StatusOK)
 }
+
+func newTestApp(name string, project string, path string) *v1alpha1.Application {
+	return &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.ApplicationSpec{
+			Project: project,
+			Source: v1alpha1.ApplicationSource{
+				RepoURL: "https://github.com/jessesuen/test-repo.git",
+				Path:    path,
+			},
+		},
+	}
+}
+
+func TestGitHubCommitEventRefreshMappings(t *testing.T) {
+	appClientset := appclientset.NewSimpleClientset(
+		newTestApp("test-app", "default", "ksapps/test-app"),
+		newTestApp("other-app", "default", "ksapps/other-app"),
+		newTestApp("shared-app", "default", "ksapps/shared-app"),
+	)
+	h := NewHandler("", appClientset, &settings.ArgoCDSettings{
+		WebhookRefreshMappingsRAW: `
+- repoURL: https://github.com/jessesuen/test-repo
+  applications: [test-app, other-app]
+- repoURL: https://github.com/jessesuen/test-repo
+  applications: [shared-app]
+  paths: [ksapps/*/environments]
+`,
+	})
+	// events are handled asynchronously by the webhook handler, so the payload is handled directly
+	var payload github.PushPayload
+	err := json.Unmarshal(box.Bytes("github-commit-event.json"), &payload)
+	assert.NoError(t, err)
+	h.HandleEvent(payload, nil)
+
+	refreshed := func(name string) bool {
+		app, err := appClientset.ArgoprojV1alpha1().Applications("").Get(name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		_, ok := app.Annotations[common.AnnotationKeyRefresh]
+		return ok
+	}
+	assert.True(t, refreshed("test-app"))
+	assert.False(t, refreshed("other-app"))
+	assert.True(t, refreshed("shared-app"))
+}
+
+func TestGitHubCommitEventManifestGeneratePaths(t *testing.T) {
+	withPaths := func(app *v1alpha1.Application, paths string) *v1alpha1.Application {
+		app.Annotations = map[string]string{common.AnnotationKeyManifestGeneratePaths: paths}
+		return app
+	}
+	appClientset := appclientset.NewSimpleClientset(
+		withPaths(newTestApp("test-app", "default", "ksapps/test-app"), ""),
+		withPaths(newTestApp("other-app", "default", "ksapps/other-app"), ""),
+		withPaths(newTestApp("dependent-app", "default", "ksapps/dependent-app"), "/ksapps/test-app/app.yaml"),
+		newTestApp("unannotated-app", "default", "ksapps/unannotated-app"),
+	)
+	h := NewHandler("", appClientset, &settings.ArgoCDSettings{})
+	var payload github.PushPayload
+	err := json.Unmarshal(box.Bytes("github-commit-event.json"), &payload)
+	assert.NoError(t, err)
+	h.HandleEvent(payload, nil)
+
+	refreshed := func(name string) bool {
+		app, err := appClientset.ArgoprojV1alpha1().Applications("").Get(name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		_, ok := app.Annotations[common.AnnotationKeyRefresh]
+		return ok
+	}
+	assert.True(t, refreshed("test-app"))
+	assert.False(t, refreshed("other-app"))
+	assert.True(t, refreshed("dependent-app"))
+	assert.True(t, refreshed("unannotated-app"))
+}
+
+func TestIsRefreshedByMappings(t *testing.T) {
+	app := newTestApp("test-app", "team-a", "apps/test-app")
+	changedFiles := []string{"apps/test-app/deployment.yaml"}
+
+	assert.True(t, isRefreshedByMappings(app, nil, changedFiles))
+	assert.True(t, isRefreshedByMappings(app, []settings.WebhookRefreshMapping{{Projects: []string{"team-a"}}}, changedFiles))
+	assert.False(t, isRefreshedByMappings(app, []settings.WebhookRefreshMapping{{Projects: []string{"team-b"}}}, changedFiles))
+	assert.False(t, isRefreshedByMappings(app, []settings.WebhookRefreshMapping{{Applications: []string{"other-app"}}}, changedFiles))
+	// files outside of the source path only refresh the application if they match the mapping paths
+	assert.False(t, isRefreshedByMappings(app, []settings.WebhookRefreshMapping{{}}, []string{"apps/test-app-2/deployment.yaml"}))
+	assert.True(t, isRefreshedByMappings(app, []settings.WebhookRefreshMapping{{Paths: []string{"base"}}}, []string{"base/kustomization.yaml"}))
+	assert.True(t, isRefreshedByMappings(app, []settings.WebhookRefreshMapping{{Paths: []string{"*.libsonnet"}}}, []string{"params.libsonnet"}))
+	// unknown changed files refresh all the selected applications
+	assert.True(t, isRefreshedByMappings(app, []settings.WebhookRefreshMapping{{}}, nil))
+	// applications at the root of the repository are refreshed by any change
+	assert.True(t, isRefreshedByMappings(newTestApp("root-app", "team-a", "."), []settings.WebhookRefreshMapping{{}}, changedFiles))
+}
+
+func TestAffectedRevisionInfoGitHubLargePush(t *testing.T) {
+	newPayload := func(commits int) github.PushPayload {
+		var commitsJSON []string
+		for i := 0; i < commits; i++ {
+			commitsJSON = append(commitsJSON, fmt.Sprintf(`{"modified": ["apps/app-%d/deployment.yaml"]}`, i))
+		}
+		var payload github.PushPayload
+		err := json.Unmarshal([]byte(fmt.Sprintf(`{"ref": "refs/heads/master", "commits": [%s]}`, strings.Join(commitsJSON, ","))), &payload)
+		assert.NoError(t, err)
+		return payload
+	}
+
+	_, _, _, changedFiles := affectedRevisionInfo(newPayload(19))
+	assert.Len(t, changedFiles, 19)
+	// the changed files are unknown when the payload may not list all the pushed commits
+	_, _, _, changedFiles = affectedRevisionInfo(newPayload(20))
+	assert.Nil(t, changedFiles)
+}